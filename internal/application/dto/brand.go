@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// BrandDto 品牌及其上架的商品、SKU数量
+type BrandDto struct {
+	ID                 int64     `json:"id"`
+	BrandName          string    `json:"brand_name"`
+	Logo               string    `json:"logo"`
+	ActiveProductCount int64     `json:"active_product_count"`
+	ActiveSkuCount     int64     `json:"active_sku_count"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// ReassignBrandProductsDto 批量迁移品牌下的商品
+type ReassignBrandProductsDto struct {
+	FromBrandID int64   `json:"from_brand_id"`
+	ToBrandID   int64   `json:"to_brand_id"`
+	ProductIDs  []int64 `json:"product_ids"`
+}
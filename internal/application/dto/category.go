@@ -0,0 +1,10 @@
+package dto
+
+// CategoryNodeDto 分类树节点
+type CategoryNodeDto struct {
+	ID           int64              `json:"id"`
+	CategoryName string             `json:"category_name"`
+	ParentID     int64              `json:"parent_id"`
+	Level        int                `json:"level"`
+	Children     []*CategoryNodeDto `json:"children"`
+}
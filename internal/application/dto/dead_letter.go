@@ -0,0 +1,24 @@
+package dto
+
+// ListDeadLettersDto 死信查询DTO
+type ListDeadLettersDto struct {
+	Topic     string `json:"topic"`
+	EventType string `json:"event_type"`
+	Status    int32  `json:"status"`
+	Cursor    int64  `json:"cursor"` // 上一页返回的游标，首页为0
+	Limit     int32  `json:"limit"`
+}
+
+// ReplayDeadLettersDto 重新投递死信DTO
+type ReplayDeadLettersDto struct {
+	IDs        []int64 `json:"ids"`
+	OperatorID int64   `json:"operator_id"`
+}
+
+// ResolveDeadLettersDto 标记死信已解决或已丢弃DTO
+type ResolveDeadLettersDto struct {
+	IDs        []int64 `json:"ids"`
+	Status     int32   `json:"status"` // 3=已解决 4=已丢弃
+	OperatorID int64   `json:"operator_id"`
+	Reason     string  `json:"reason"`
+}
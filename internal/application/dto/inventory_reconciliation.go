@@ -0,0 +1,19 @@
+package dto
+
+// StockMismatchDto 库存与流水不一致的SKU
+type StockMismatchDto struct {
+	SkuID        int64  `json:"sku_id"`
+	Stock        uint32 `json:"stock"`          // 当前库存
+	LedgerStock  int64  `json:"ledger_stock"`   // 最后一条流水的变更后库存
+	Drift        int64  `json:"drift"`          // 当前库存 - 流水库存
+	LastRecordID int64  `json:"last_record_id"` // 最后一条流水ID
+	Corrected    bool   `json:"corrected"`      // 是否已写入修正流水
+}
+
+// ReconciliationReportDto 一批SKU的对账结果
+type ReconciliationReportDto struct {
+	Checked    int                 `json:"checked"`     // 对账的SKU数量
+	Untracked  int                 `json:"untracked"`   // 没有任何流水的SKU数量，不参与对账
+	Mismatches []*StockMismatchDto `json:"mismatches"`  // 不一致的SKU
+	NextCursor int64               `json:"next_cursor"` // 下一批的游标，0表示已对账完所有SKU
+}
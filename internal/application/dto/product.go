@@ -10,22 +10,6 @@ type ProductDto struct {
 	ProductImage       []*ProductImageDto `json:"product_image"`
 }
 
-type OrderProductInvetoryItem struct {
-	Id    int64 `json:"id"`
-	Count int64 `json:"count"`
-}
-
-type OrderProductSizeInvetoryItem struct {
-	Id    int64 `json:"id"`
-	Count int64 `json:"count"`
-}
-
-type OrderProductInvetoryDto struct {
-	OrderId             int64                           `json:"order_id"`
-	ProductInvetory     []*OrderProductInvetoryItem     `json:"product_invetory"`
-	ProductSizeInvetory []*OrderProductSizeInvetoryItem `json:"product_size_invetory"`
-}
-
 type AddProductResponse struct {
 	Id int64 `json:"id"`
 }
@@ -37,8 +21,23 @@ type OrderSkuDto struct {
 }
 
 type OrderSkuItemDto struct {
-	SkuID     int64  `json:"sku_id"`
-	Quantity  uint32 `json:"quantity"`
-	Stock     uint32 `json:"stock"`
-	Threshold uint32 `json:"threshold"`
+	SkuID      int64                    `json:"sku_id"`
+	Quantity   uint32                   `json:"quantity"`
+	Stock      uint32                   `json:"stock"`
+	Threshold  uint32                   `json:"threshold"`
+	Warehouses []WarehouseAllocationDto `json:"warehouses"` // 各仓库的变更数量及变更后的分仓库存：扣减为订单当前的分仓结果，退款为回补结果，收货为入库仓库
+}
+
+// OrderInventoryRestoreDto 订单库存回补DTO
+type OrderInventoryRestoreDto struct {
+	OrderID    int64                        `json:"order_id"`
+	Reason     string                       `json:"reason"`      // 回补原因：refund/cancel
+	RestoreKey string                       `json:"restore_key"` // 幂等键
+	Sku        []*OrderInventoryRestoreItem `json:"sku"`
+}
+
+// OrderInventoryRestoreItem 订单回补的SKU
+type OrderInventoryRestoreItem struct {
+	SkuID    int64  `json:"sku_id"`
+	Quantity uint32 `json:"quantity"`
 }
@@ -0,0 +1,99 @@
+package dto
+
+// ProductInputDto 商品基本信息
+type ProductInputDto struct {
+	ProductNo   string `json:"product_no"`
+	ProductName string `json:"product_name"`
+	CategoryID  int64  `json:"category_id"`
+	BrandID     int64  `json:"brand_id"` // 为0时不关联品牌
+	MainImage   string `json:"main_image"`
+	Description string `json:"description"`
+	Status      int32  `json:"status"` // 状态：0-下架 1-上架
+}
+
+// SpecInputDto 规格及规格值，顺序即显示顺序
+type SpecInputDto struct {
+	SpecName string               `json:"spec_name"`
+	Values   []*SpecValueInputDto `json:"values"`
+}
+
+// SpecValueInputDto 规格值
+type SpecValueInputDto struct {
+	ValueName  string `json:"value_name"`
+	ValueImage string `json:"value_image"`
+}
+
+// SkuInputDto SKU属性
+type SkuInputDto struct {
+	SpecValues  []string `json:"spec_values"` // 规格值名称，按规格顺序排列
+	SkuName     string   `json:"sku_name"`
+	Price       float64  `json:"price"`
+	MarketPrice float64  `json:"market_price"`
+	Stock       uint32   `json:"stock"`
+	StockWarn   uint32   `json:"stock_warn"`
+	MainImage   string   `json:"main_image"`
+	Images      []string `json:"images"`
+}
+
+// CreateProductWithSkusDto 创建商品并按规格矩阵生成SKU
+type CreateProductWithSkusDto struct {
+	Product    *ProductInputDto `json:"product"`
+	Specs      []*SpecInputDto  `json:"specs"`
+	DefaultSku *SkuInputDto     `json:"default_sku"` // 未单独指定的规格组合使用该属性
+	Skus       []*SkuInputDto   `json:"skus"`        // 单独指定属性的规格组合
+}
+
+// SkuUpdateDto 更新SKU，库存不在此处修改
+type SkuUpdateDto struct {
+	ID          int64    `json:"id"`
+	SkuName     string   `json:"sku_name"`
+	Price       float64  `json:"price"`
+	MarketPrice float64  `json:"market_price"`
+	StockWarn   uint32   `json:"stock_warn"`
+	MainImage   string   `json:"main_image"`
+	Images      []string `json:"images"` // 整体替换
+}
+
+// UpdateProductDto 更新商品
+type UpdateProductDto struct {
+	ID      int64            `json:"id"`
+	Product *ProductInputDto `json:"product"`
+	Skus    []*SkuUpdateDto  `json:"skus"`
+}
+
+// ListProductsDto 商品列表查询条件
+type ListProductsDto struct {
+	Keyword    string  `json:"keyword"`
+	CategoryID int64   `json:"category_id"`
+	BrandID    int64   `json:"brand_id"`
+	Statuses   []int32 `json:"statuses"`
+	Page       int32   `json:"page"`
+	PageSize   int32   `json:"page_size"`
+}
+
+// SkuMatrixDto 规格矩阵，specs为商品完整的目标规格
+type SkuMatrixDto struct {
+	ProductID  int64           `json:"product_id"`
+	Specs      []*SpecInputDto `json:"specs"`
+	DefaultSku *SkuInputDto    `json:"default_sku"` // 新增SKU的默认属性
+	Skus       []*SkuInputDto  `json:"skus"`        // 单独指定属性的新增SKU
+}
+
+// SkuMatrixDiffDto 规格矩阵与现有SKU的差异
+type SkuMatrixDiffDto struct {
+	Create  []*SkuMatrixItemDto `json:"create"`  // 需要新增的SKU
+	Disable []*SkuMatrixItemDto `json:"disable"` // 组合已不存在、需要下架的SKU
+	Keep    []*SkuMatrixItemDto `json:"keep"`    // 保持不变的SKU
+}
+
+// SkuMatrixItemDto 规格矩阵中的SKU
+type SkuMatrixItemDto struct {
+	SkuID         int64   `json:"sku_id"` // 新增SKU预览时为0
+	SkuNo         string  `json:"sku_no"` // 包含新增规格值的SKU预览时为空
+	SkuName       string  `json:"sku_name"`
+	SpecValueIDs  string  `json:"spec_value_ids"`
+	SpecValueText string  `json:"spec_value_text"`
+	Price         float64 `json:"price"`
+	Stock         uint32  `json:"stock"`
+	Status        int8    `json:"status"`
+}
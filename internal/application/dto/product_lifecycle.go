@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// PublishProductDto 上架商品DTO
+type PublishProductDto struct {
+	ProductID  int64   `json:"product_id"`
+	SkuIDs     []int64 `json:"sku_ids"`    // 一并上架的SKU，为空时上架草稿状态的SKU
+	OnSaleAt   string  `json:"on_sale_at"` // 定时上架时间，为空或不晚于当前时间时立即上架
+	OperatorID int64   `json:"operator_id"`
+	Reason     string  `json:"reason"`
+}
+
+// ProductStatusDto 下架或归档商品DTO
+type ProductStatusDto struct {
+	ProductID  int64  `json:"product_id"`
+	OperatorID int64  `json:"operator_id"`
+	Reason     string `json:"reason"`
+}
+
+// UnpublishSkuDto 下架SKU DTO
+type UnpublishSkuDto struct {
+	SkuID      int64  `json:"sku_id"`
+	OperatorID int64  `json:"operator_id"`
+	Reason     string `json:"reason"`
+}
+
+// SkuStatusChangeDto SKU状态变化
+type SkuStatusChangeDto struct {
+	SkuID      int64 `json:"sku_id"`
+	FromStatus int8  `json:"from_status"`
+	ToStatus   int8  `json:"to_status"`
+}
+
+// ProductStatusChangeDto 商品及其SKU的状态变化，商品状态未变时FromStatus与ToStatus相同
+type ProductStatusChangeDto struct {
+	ProductID  int64                 `json:"product_id"`
+	FromStatus int8                  `json:"from_status"`
+	ToStatus   int8                  `json:"to_status"`
+	OnSaleAt   *time.Time            `json:"on_sale_at"` // 已设置定时上架且尚未上架时的上架时间
+	Skus       []*SkuStatusChangeDto `json:"skus"`
+	OperatorID int64                 `json:"operator_id"`
+	Reason     string                `json:"reason"`
+}
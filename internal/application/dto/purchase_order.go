@@ -0,0 +1,36 @@
+package dto
+
+// PurchaseOrderItemDto 需要订货的补货记录
+type PurchaseOrderItemDto struct {
+	RestockID int64 `json:"restock_id"`
+	Quantity  int32 `json:"quantity"` // 本次订货数量，为0时订满剩余数量
+}
+
+// CreatePurchaseOrdersDto 根据补货记录生成采购单
+type CreatePurchaseOrdersDto struct {
+	Items      []*PurchaseOrderItemDto `json:"items"`
+	OperatorID int32                   `json:"operator_id"`
+	Remark     string                  `json:"remark"`
+}
+
+// ListPurchaseOrdersDto 查询采购单
+type ListPurchaseOrdersDto struct {
+	SupplierID int64   `json:"supplier_id"`
+	Statuses   []int32 `json:"statuses"`
+	Page       int32   `json:"page"`
+	PageSize   int32   `json:"page_size"`
+}
+
+// ReceiveGoodsItemDto 采购单明细的收货数量
+type ReceiveGoodsItemDto struct {
+	LineID   int64 `json:"line_id"`
+	Quantity int32 `json:"quantity"`
+}
+
+// ReceiveGoodsDto 采购收货
+type ReceiveGoodsDto struct {
+	PurchaseOrderID int64                  `json:"purchase_order_id"`
+	Items           []*ReceiveGoodsItemDto `json:"items"`
+	OperatorID      int32                  `json:"operator_id"`
+	WarehouseID     int64                  `json:"warehouse_id"` // 收货仓库
+}
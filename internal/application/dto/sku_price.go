@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// SchedulePriceChangeDto 计划调价DTO
+type SchedulePriceChangeDto struct {
+	SkuID         int64   `json:"sku_id"`
+	Price         float64 `json:"price"`
+	MarketPrice   float64 `json:"market_price"`   // 市场价，为0时不修改
+	EffectiveFrom string  `json:"effective_from"` // 生效时间，为空时立即生效
+	EffectiveTo   string  `json:"effective_to"`   // 结束时间，到期后恢复生效前的价格，为空表示长期有效
+	Reason        string  `json:"reason"`
+	OperatorID    int64   `json:"operator_id"`
+}
+
+// SkuPriceChangeDto 已生效或已恢复原价的价格变更
+type SkuPriceChangeDto struct {
+	ChangeID            int64      `json:"change_id"`
+	SkuID               int64      `json:"sku_id"`
+	ProductID           int64      `json:"product_id"`
+	ChangeType          int8       `json:"change_type"`
+	Price               float64    `json:"price"`
+	MarketPrice         *float64   `json:"market_price"`
+	PreviousPrice       float64    `json:"previous_price"`
+	PreviousMarketPrice *float64   `json:"previous_market_price"`
+	EffectiveTo         *time.Time `json:"effective_to"`
+	AppliedAt           time.Time  `json:"applied_at"`
+	OperatorID          int64      `json:"operator_id"`
+}
+
+// SkuPriceAtDto SKU在指定时刻的价格
+type SkuPriceAtDto struct {
+	SkuID       int64    `json:"sku_id"`
+	Price       float64  `json:"price"`
+	MarketPrice *float64 `json:"market_price"`
+	ChangeID    int64    `json:"change_id"` // 该时刻生效的价格变更记录ID，为0表示没有变更记录覆盖该时刻
+}
@@ -10,14 +10,15 @@ type CreateRestockApplyDto struct {
 
 // RestockRecordDto 补货记录DTO
 type RestockRecordDto struct {
-	ID           int64  `json:"id"`
-	UserID       int32  `json:"user_id"`
-	SkuID        uint64 `json:"sku_id"`
-	Quantity     int32  `json:"quantity"`
-	Reason       string `json:"reason"`
-	Status       uint8  `json:"status"`
-	FailedReason string `json:"failed_reason"`
-	CreatedAt    string `json:"created_at"`
+	ID            int64  `json:"id"`
+	UserID        int32  `json:"user_id"`
+	SkuID         uint64 `json:"sku_id"`
+	Quantity      int32  `json:"quantity"`
+	Reason        string `json:"reason"`
+	Status        uint8  `json:"status"`
+	FailedReason  string `json:"failed_reason"`
+	ApplicationNo string `json:"application_no"`
+	CreatedAt     string `json:"created_at"`
 }
 
 // SkuBasicInfoDto SKU基本信息DTO
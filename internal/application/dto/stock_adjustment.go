@@ -0,0 +1,35 @@
+package dto
+
+// AdjustStockDto 手动调整库存DTO
+type AdjustStockDto struct {
+	SkuID       int64  `json:"sku_id"`
+	Delta       int64  `json:"delta"` // 调整数量，正数增加、负数减少
+	Reason      string `json:"reason"`
+	OperatorID  int64  `json:"operator_id"`
+	WarehouseID int64  `json:"warehouse_id"` // 分仓库存与可售总库存同时调整
+}
+
+// StocktakeItemDto 盘点的SKU在仓库中的实盘数量
+type StocktakeItemDto struct {
+	SkuID           int64  `json:"sku_id"`
+	CountedQuantity uint32 `json:"counted_quantity"`
+}
+
+// StocktakeDto 提交盘点结果DTO
+type StocktakeDto struct {
+	Items       []*StocktakeItemDto `json:"items"`
+	Reason      string              `json:"reason"`
+	OperatorID  int64               `json:"operator_id"`
+	WarehouseID int64               `json:"warehouse_id"` // 盘点的仓库
+}
+
+// StockAdjustResultDto 单个SKU的库存调整结果
+type StockAdjustResultDto struct {
+	SkuID          int64  `json:"sku_id"`
+	Delta          int64  `json:"delta"`
+	BeforeStock    uint32 `json:"before_stock"`
+	AfterStock     uint32 `json:"after_stock"`
+	Threshold      uint32 `json:"threshold"`
+	WarehouseID    int64  `json:"warehouse_id"`
+	WarehouseStock uint32 `json:"warehouse_stock"` // 调整后的分仓库存
+}
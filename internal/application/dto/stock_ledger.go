@@ -0,0 +1,20 @@
+package dto
+
+// ListStockChangesDto 库存流水查询DTO
+type ListStockChangesDto struct {
+	SkuID           int64  `json:"sku_id"`
+	OrderID         int64  `json:"order_id"`
+	PurchaseOrderID int64  `json:"purchase_order_id"`
+	SourceType      int32  `json:"source_type"`
+	StartTime       string `json:"start_time"` // 格式：2006-01-02 15:04:05
+	EndTime         string `json:"end_time"`   // 格式：2006-01-02 15:04:05
+	Cursor          int64  `json:"cursor"`     // 上一页返回的游标，首页为0
+	Limit           int32  `json:"limit"`
+}
+
+// StockAtTimeDto SKU在某一时刻的库存
+type StockAtTimeDto struct {
+	SkuID    int64 `json:"sku_id"`
+	Stock    int64 `json:"stock"`
+	RecordID int64 `json:"record_id"` // 推算所依据的变更记录ID，没有变更记录时为0
+}
@@ -0,0 +1,14 @@
+package dto
+
+// ReserveStockItemDto 预占库存的SKU
+type ReserveStockItemDto struct {
+	SkuID    int64  `json:"sku_id"`
+	Quantity uint32 `json:"quantity"`
+}
+
+// ReserveStockDto 预占库存DTO
+type ReserveStockDto struct {
+	OrderID    int64                  `json:"order_id"`
+	Items      []*ReserveStockItemDto `json:"items"`
+	TtlSeconds int32                  `json:"ttl_seconds"` // 预占有效期（秒），为0时使用默认值
+}
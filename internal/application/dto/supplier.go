@@ -0,0 +1,22 @@
+package dto
+
+// SupplierInputDto 供应商基本信息
+type SupplierInputDto struct {
+	Name          string  `json:"name"`
+	ContactPerson string  `json:"contact_person"`
+	Phone         string  `json:"phone"`
+	Email         string  `json:"email"`
+	Address       string  `json:"address"`
+	Rating        float64 `json:"rating"`
+	LeadTimeDays  int32   `json:"lead_time_days"`
+	PaymentTerms  string  `json:"payment_terms"`
+}
+
+// LinkSupplierSkuDto 关联供应商与SKU
+type LinkSupplierSkuDto struct {
+	SupplierID       int64   `json:"supplier_id"`
+	SkuID            int64   `json:"sku_id"`
+	SupplyPrice      float64 `json:"supply_price"`
+	MinOrderQuantity int32   `json:"min_order_quantity"`
+	IsPreferred      bool    `json:"is_preferred"`
+}
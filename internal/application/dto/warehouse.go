@@ -0,0 +1,43 @@
+package dto
+
+// WarehouseInputDto 仓库信息
+type WarehouseInputDto struct {
+	Code      string  `json:"code"`
+	Name      string  `json:"name"`
+	Priority  int32   `json:"priority"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// SetSkuWarehouseStockDto 设置SKU分仓库存
+type SetSkuWarehouseStockDto struct {
+	SkuID       int64  `json:"sku_id"`
+	WarehouseID int64  `json:"warehouse_id"`
+	Stock       uint32 `json:"stock"`
+	Reason      string `json:"reason"`
+	OperatorID  int64  `json:"operator_id"`
+}
+
+// WarehouseStockItemDto SKU在单个仓库的库存
+type WarehouseStockItemDto struct {
+	WarehouseID int64  `json:"warehouse_id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Status      int8   `json:"status"`
+	Stock       uint32 `json:"stock"`
+}
+
+// SkuWarehouseStockDto SKU可售总库存及分仓库存
+type SkuWarehouseStockDto struct {
+	SkuID          int64                    `json:"sku_id"`
+	TotalAvailable uint32                   `json:"total_available"` // 可售总库存，即product_skus.stock
+	WarehouseTotal uint32                   `json:"warehouse_total"` // 各仓库库存之和
+	Warehouses     []*WarehouseStockItemDto `json:"warehouses"`
+}
+
+// WarehouseAllocationDto 订单SKU从单个仓库分配的数量，WarehouseID为0表示由未分仓库存满足
+type WarehouseAllocationDto struct {
+	WarehouseID int64  `json:"warehouse_id"`
+	Quantity    uint32 `json:"quantity"`
+	Stock       uint32 `json:"stock"` // 分配后的分仓库存
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateBrand 创建品牌
+func (appService *ProductApplicationService) CreateBrand(ctx context.Context, name string, logo string) (*productProto.CreateBrandResponse, error) {
+	var brand *dto.BrandDto
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		brand, txErr = appService.brandService.CreateBrand(txCtx, name, logo)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.CreateBrandResponse{Brand: toBrandInfo(brand)}, nil
+}
+
+// UpdateBrand 更新品牌
+func (appService *ProductApplicationService) UpdateBrand(ctx context.Context, id int64, name string, logo string) (*productProto.UpdateBrandResponse, error) {
+	var brand *dto.BrandDto
+	err := appService.executeWithBrandLock(ctx, id, func(txCtx context.Context) error {
+		var txErr error
+		brand, txErr = appService.brandService.UpdateBrand(txCtx, id, name, logo)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.UpdateBrandResponse{Brand: toBrandInfo(brand)}, nil
+}
+
+// GetBrand 获取品牌
+func (appService *ProductApplicationService) GetBrand(ctx context.Context, id int64) (*productProto.GetBrandResponse, error) {
+	brand, err := appService.brandService.GetBrand(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetBrandResponse{Brand: toBrandInfo(brand)}, nil
+}
+
+// ListBrands 分页查询品牌
+func (appService *ProductApplicationService) ListBrands(ctx context.Context, keyword string, page, pageSize int32) (*productProto.ListBrandsResponse, error) {
+	brands, total, err := appService.brandService.ListBrands(ctx, keyword, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	response := &productProto.ListBrandsResponse{
+		Brands:   make([]*productProto.BrandInfo, 0, len(brands)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, brand := range brands {
+		response.Brands = append(response.Brands, toBrandInfo(brand))
+	}
+	return response, nil
+}
+
+// DeleteBrand 删除品牌
+func (appService *ProductApplicationService) DeleteBrand(ctx context.Context, id int64) error {
+	return appService.executeWithBrandLock(ctx, id, func(txCtx context.Context) error {
+		return appService.brandService.DeleteBrand(txCtx, id)
+	})
+}
+
+// ReassignBrandProducts 批量迁移品牌下的商品，锁定目标品牌避免迁移过程中被删除
+func (appService *ProductApplicationService) ReassignBrandProducts(ctx context.Context, req *dto.ReassignBrandProductsDto) (*productProto.ReassignBrandProductsResponse, error) {
+	var affected int64
+	fn := func(txCtx context.Context) error {
+		var txErr error
+		affected, txErr = appService.brandService.ReassignProducts(txCtx, req)
+		return txErr
+	}
+	var err error
+	if req.ToBrandID > 0 {
+		err = appService.executeWithBrandLock(ctx, req.ToBrandID, fn)
+	} else {
+		err = appService.serviceContext.TxManager.Execute(ctx, fn)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ReassignBrandProductsResponse{Affected: affected}, nil
+}
+
+// executeWithBrandLock 以品牌维度加锁并在事务内执行
+func (appService *ProductApplicationService) executeWithBrandLock(ctx context.Context, brandId int64, fn func(txCtx context.Context) error) error {
+	if brandId <= 0 {
+		return status.Error(codes.InvalidArgument, "invalid brand id")
+	}
+	lockKey := "brand-" + strconv.FormatInt(brandId, 10)
+	lock := appService.serviceContext.LockManager.NewLock(lockKey, 15)
+	if err := lock.TryLock(ctx); err != nil {
+		return status.Error(codes.Aborted, "brand is being modified")
+	}
+	defer func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}()
+	return appService.serviceContext.TxManager.Execute(ctx, fn)
+}
+
+// toBrandInfo 转换品牌信息
+func toBrandInfo(brand *dto.BrandDto) *productProto.BrandInfo {
+	return &productProto.BrandInfo{
+		Id:                 brand.ID,
+		BrandName:          brand.BrandName,
+		Logo:               brand.Logo,
+		ActiveProductCount: brand.ActiveProductCount,
+		ActiveSkuCount:     brand.ActiveSkuCount,
+		CreatedAt:          brand.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:          brand.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
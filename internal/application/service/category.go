@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// categoryTreeLockKey 分类树的修改共用一把锁，避免并发移动产生环
+const categoryTreeLockKey = "category-tree"
+
+// CreateCategory 创建分类
+func (appService *ProductApplicationService) CreateCategory(ctx context.Context, name string, parentId int64) (*productProto.CreateCategoryResponse, error) {
+	var category *model.ProductCategory
+	err := appService.executeWithCategoryLock(ctx, func(txCtx context.Context) error {
+		var txErr error
+		category, txErr = appService.categoryService.CreateCategory(txCtx, name, parentId)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.CreateCategoryResponse{Category: toCategoryInfo(category)}, nil
+}
+
+// RenameCategory 修改分类名称
+func (appService *ProductApplicationService) RenameCategory(ctx context.Context, id int64, name string) (*productProto.RenameCategoryResponse, error) {
+	var category *model.ProductCategory
+	err := appService.executeWithCategoryLock(ctx, func(txCtx context.Context) error {
+		var txErr error
+		category, txErr = appService.categoryService.RenameCategory(txCtx, id, name)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.RenameCategoryResponse{Category: toCategoryInfo(category)}, nil
+}
+
+// MoveCategory 移动分类，子树层级在同一事务内调整
+func (appService *ProductApplicationService) MoveCategory(ctx context.Context, id int64, parentId int64) (*productProto.MoveCategoryResponse, error) {
+	var category *model.ProductCategory
+	err := appService.executeWithCategoryLock(ctx, func(txCtx context.Context) error {
+		var txErr error
+		category, txErr = appService.categoryService.MoveCategory(txCtx, id, parentId)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.MoveCategoryResponse{Category: toCategoryInfo(category)}, nil
+}
+
+// DeleteCategory 删除分类
+func (appService *ProductApplicationService) DeleteCategory(ctx context.Context, id int64) error {
+	return appService.executeWithCategoryLock(ctx, func(txCtx context.Context) error {
+		return appService.categoryService.DeleteCategory(txCtx, id)
+	})
+}
+
+// GetCategoryTree 获取分类树
+func (appService *ProductApplicationService) GetCategoryTree(ctx context.Context) (*productProto.GetCategoryTreeResponse, error) {
+	roots, err := appService.categoryService.GetCategoryTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetCategoryTreeResponse{Categories: toCategoryNodes(roots)}, nil
+}
+
+// ListCategorySkus 分页查询分类子树下的SKU
+func (appService *ProductApplicationService) ListCategorySkus(ctx context.Context, categoryId int64, page, pageSize int32) (*productProto.ListCategorySkusResponse, error) {
+	skus, total, err := appService.categoryService.ListSkusByCategory(ctx, categoryId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	response := &productProto.ListCategorySkusResponse{
+		Skus:     make([]*productProto.ProductSkuInfo, 0, len(skus)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for i := range skus {
+		response.Skus = append(response.Skus, toProductSkuInfo(&skus[i]))
+	}
+	return response, nil
+}
+
+// executeWithCategoryLock 锁定分类树并在事务内执行
+func (appService *ProductApplicationService) executeWithCategoryLock(ctx context.Context, fn func(txCtx context.Context) error) error {
+	lock := appService.serviceContext.LockManager.NewLock(categoryTreeLockKey, 15)
+	if err := lock.TryLock(ctx); err != nil {
+		return status.Error(codes.Aborted, "category tree is being modified")
+	}
+	defer func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}()
+	return appService.serviceContext.TxManager.Execute(ctx, fn)
+}
+
+// toCategoryInfo 转换分类信息
+func toCategoryInfo(category *model.ProductCategory) *productProto.CategoryInfo {
+	return &productProto.CategoryInfo{
+		Id:           category.ID,
+		CategoryName: category.CategoryName,
+		ParentId:     int64(category.ParentID),
+		Level:        int32(category.Level),
+	}
+}
+
+// toCategoryNodes 转换分类树
+func toCategoryNodes(nodes []*dto.CategoryNodeDto) []*productProto.CategoryNode {
+	result := make([]*productProto.CategoryNode, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, &productProto.CategoryNode{
+			Id:           node.ID,
+			CategoryName: node.CategoryName,
+			ParentId:     node.ParentID,
+			Level:        int32(node.Level),
+			Children:     toCategoryNodes(node.Children),
+		})
+	}
+	return result
+}
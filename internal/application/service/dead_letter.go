@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+)
+
+// ListDeadLetters 游标分页查询死信
+func (appService *ProductApplicationService) ListDeadLetters(ctx context.Context, req *dto.ListDeadLettersDto) (*productProto.ListDeadLettersResponse, error) {
+	deadLetters, nextCursor, err := appService.deadLetterService.ListDeadLetters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.ListDeadLettersResponse{
+		DeadLetters: make([]*productProto.DeadLetterInfo, 0, len(deadLetters)),
+		NextCursor:  nextCursor,
+	}
+	for i := range deadLetters {
+		response.DeadLetters = append(response.DeadLetters, toDeadLetterInfo(&deadLetters[i]))
+	}
+	return response, nil
+}
+
+// GetDeadLetter 查询死信详情
+func (appService *ProductApplicationService) GetDeadLetter(ctx context.Context, id int64) (*productProto.GetDeadLetterResponse, error) {
+	deadLetter, err := appService.deadLetterService.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	info := toDeadLetterInfo(deadLetter)
+	info.Payload = deadLetter.Payload
+	if deadLetter.Headers != "" {
+		if err := json.Unmarshal([]byte(deadLetter.Headers), &info.Headers); err != nil {
+			logger.Error("failed to decode headers of dead letter ", deadLetter.ID, ": ", err.Error())
+		}
+	}
+	return &productProto.GetDeadLetterResponse{DeadLetter: info}, nil
+}
+
+// ReplayDeadLetters 将死信重新投递到原主题
+// 投递失败的死信保持原状态并返回失败原因，其余死信标记为已重新投递
+func (appService *ProductApplicationService) ReplayDeadLetters(ctx context.Context, req *dto.ReplayDeadLettersDto) (*productProto.ReplayDeadLettersResponse, error) {
+	response := &productProto.ReplayDeadLettersResponse{}
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		deadLetters, err := appService.deadLetterService.LockReplayable(txCtx, req)
+		if err != nil {
+			return err
+		}
+		response.Results = make([]*productProto.DeadLetterReplayResult, 0, len(deadLetters))
+		now := time.Now()
+		for i := range deadLetters {
+			deadLetter := &deadLetters[i]
+			result := &productProto.DeadLetterReplayResult{Id: deadLetter.ID}
+			response.Results = append(response.Results, result)
+			if err := appService.deadLetterReplayer.Replay(txCtx, deadLetter); err != nil {
+				logger.Error("failed to replay dead letter ", deadLetter.ID, ": ", err.Error())
+				result.Error = err.Error()
+				continue
+			}
+			if err := appService.deadLetterService.MarkReplayed(txCtx, deadLetter, req.OperatorID, now); err != nil {
+				return err
+			}
+			result.Replayed = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ResolveDeadLetters 标记死信已解决或已丢弃
+func (appService *ProductApplicationService) ResolveDeadLetters(ctx context.Context, req *dto.ResolveDeadLettersDto) (*productProto.ResolveDeadLettersResponse, error) {
+	var deadLetters []model.DeadLetter
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		deadLetters, txErr = appService.deadLetterService.ResolveDeadLetters(txCtx, req, time.Now())
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.ResolveDeadLettersResponse{
+		DeadLetters: make([]*productProto.DeadLetterInfo, 0, len(deadLetters)),
+	}
+	for i := range deadLetters {
+		response.DeadLetters = append(response.DeadLetters, toDeadLetterInfo(&deadLetters[i]))
+	}
+	return response, nil
+}
+
+// toDeadLetterInfo 转换死信，不含消息头和消息体
+func toDeadLetterInfo(deadLetter *model.DeadLetter) *productProto.DeadLetterInfo {
+	info := &productProto.DeadLetterInfo{
+		Id:              deadLetter.ID,
+		EventId:         deadLetter.EventId,
+		Topic:           deadLetter.Topic,
+		OriginTopic:     deadLetter.OriginTopic,
+		EventType:       deadLetter.EventType,
+		EventKey:        deadLetter.EventKey,
+		Error:           deadLetter.Error,
+		OriginTimestamp: deadLetter.OriginTimestamp,
+		DeadAt:          deadLetter.DeadAt,
+		Status:          int32(deadLetter.Status),
+		ReplayCount:     int32(deadLetter.ReplayCount),
+		OperatorId:      deadLetter.OperatorID,
+		Reason:          deadLetter.Reason,
+	}
+	if deadLetter.ReplayedAt != nil {
+		info.ReplayedAt = deadLetter.ReplayedAt.Format("2006-01-02 15:04:05")
+	}
+	if deadLetter.HandledAt != nil {
+		info.HandledAt = deadLetter.HandledAt.Format("2006-01-02 15:04:05")
+	}
+	if deadLetter.CreatedAt.Valid {
+		info.CreatedAt = deadLetter.CreatedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return info
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconciliationLockKey 库存对账锁，多实例部署时同一时间只有一个实例对账
+const reconciliationLockKey = "inventoryreconciliation"
+
+// GetReconciliationReport 对账一批SKU并返回不一致的SKU，不写入修正流水
+func (appService *ProductApplicationService) GetReconciliationReport(ctx context.Context, cursor int64, limit int32) (*productProto.GetReconciliationReportResponse, error) {
+	report, err := appService.reconcileBatch(ctx, cursor, int(limit))
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.GetReconciliationReportResponse{
+		Checked:    int32(report.Checked),
+		Untracked:  int32(report.Untracked),
+		Mismatches: make([]*productProto.StockMismatch, 0, len(report.Mismatches)),
+		NextCursor: report.NextCursor,
+	}
+	for _, mismatch := range report.Mismatches {
+		response.Mismatches = append(response.Mismatches, toStockMismatch(mismatch))
+	}
+	return response, nil
+}
+
+// CorrectStockLedger 为库存与流水不一致的SKU写入修正流水，已一致的SKU不在返回结果中
+func (appService *ProductApplicationService) CorrectStockLedger(ctx context.Context, skuIds []int64, operatorId int64) (*productProto.CorrectStockLedgerResponse, error) {
+	if len(skuIds) == 0 || len(skuIds) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "sku_ids must be between 1 and 100")
+	}
+	if operatorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+	response := &productProto.CorrectStockLedgerResponse{
+		Corrected: make([]*productProto.StockMismatch, 0, len(skuIds)),
+	}
+	for _, skuId := range skuIds {
+		mismatch, err := appService.correctStockLedger(ctx, skuId, operatorId)
+		if err != nil {
+			return nil, err
+		}
+		if mismatch != nil {
+			response.Corrected = append(response.Corrected, toStockMismatch(mismatch))
+		}
+	}
+	return response, nil
+}
+
+// ReconcileInventory 对账所有SKU并更新监控指标，按配置自动修正，由后台协程周期调用
+func (appService *ProductApplicationService) ReconcileInventory(ctx context.Context) error {
+	conf := appService.serviceContext.Conf.Reconciliation
+	lock := appService.serviceContext.LockManager.NewLock(reconciliationLockKey, conf.Interval)
+	if err := lock.TryLock(ctx); err != nil {
+		logger.Info("inventory reconciliation is running on another instance, skipped")
+		return nil
+	}
+	defer func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}()
+
+	var checked, untracked, mismatched, corrected int
+	var drift int64
+	var cursor int64
+	for {
+		report, err := appService.reconcileBatch(ctx, cursor, conf.BatchSize)
+		if err != nil {
+			infrastructure.ReconciliationRunCount.WithLabelValues("failed").Inc()
+			return err
+		}
+		checked += report.Checked
+		untracked += report.Untracked
+		mismatched += len(report.Mismatches)
+		for _, mismatch := range report.Mismatches {
+			if mismatch.Drift < 0 {
+				drift -= mismatch.Drift
+			} else {
+				drift += mismatch.Drift
+			}
+			logger.Warn("stock of sku ", mismatch.SkuID, " is ", mismatch.Stock, " but ledger record ", mismatch.LastRecordID, " ends at ", mismatch.LedgerStock)
+			if !conf.AutoCorrect {
+				continue
+			}
+			fixed, err := appService.correctStockLedger(ctx, mismatch.SkuID, 0)
+			if err != nil {
+				logger.Error("failed to correct stock ledger of sku ", mismatch.SkuID, ": ", err.Error())
+				continue
+			}
+			if fixed != nil {
+				corrected++
+			}
+		}
+		cursor = report.NextCursor
+		if cursor == 0 || ctx.Err() != nil {
+			break
+		}
+	}
+	if ctx.Err() != nil {
+		infrastructure.ReconciliationRunCount.WithLabelValues("canceled").Inc()
+		return nil
+	}
+
+	infrastructure.ReconciliationCheckedSkus.Set(float64(checked))
+	infrastructure.ReconciliationUntrackedSkus.Set(float64(untracked))
+	infrastructure.ReconciliationMismatchedSkus.Set(float64(mismatched - corrected))
+	infrastructure.ReconciliationDriftUnits.Set(float64(drift))
+	infrastructure.ReconciliationRunCount.WithLabelValues("success").Inc()
+	infrastructure.ReconciliationLastSuccess.Set(float64(time.Now().Unix()))
+	logger.Info("inventory reconciliation finished, checked: ", checked, " mismatched: ", mismatched, " corrected: ", corrected, " untracked: ", untracked)
+	return nil
+}
+
+// reconcileBatch 在同一事务内读取一批SKU的库存与流水
+func (appService *ProductApplicationService) reconcileBatch(ctx context.Context, cursor int64, limit int) (*dto.ReconciliationReportDto, error) {
+	var report *dto.ReconciliationReportDto
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		report, txErr = appService.reconciliationService.Reconcile(txCtx, cursor, limit)
+		return txErr
+	})
+	return report, err
+}
+
+// correctStockLedger 持有SKU库存锁写入修正流水，避免与库存调整并发
+func (appService *ProductApplicationService) correctStockLedger(ctx context.Context, skuId int64, operatorId int64) (*dto.StockMismatchDto, error) {
+	var mismatch *dto.StockMismatchDto
+	err := appService.executeWithSkuStockLocks(ctx, []int64{skuId}, func(txCtx context.Context) error {
+		var txErr error
+		mismatch, txErr = appService.reconciliationService.Correct(txCtx, skuId, operatorId)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mismatch != nil {
+		infrastructure.ReconciliationCorrectedCount.Inc()
+		logger.Info("corrected stock ledger of sku ", strconv.FormatInt(skuId, 10), " by ", mismatch.Drift)
+	}
+	return mismatch, nil
+}
+
+// toStockMismatch 转换对账差异
+func toStockMismatch(mismatch *dto.StockMismatchDto) *productProto.StockMismatch {
+	return &productProto.StockMismatch{
+		SkuId:        mismatch.SkuID,
+		Stock:        mismatch.Stock,
+		LedgerStock:  mismatch.LedgerStock,
+		Drift:        mismatch.Drift,
+		LastRecordId: mismatch.LastRecordID,
+		Corrected:    mismatch.Corrected,
+	}
+}
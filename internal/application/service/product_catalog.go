@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateProduct 创建商品
+func (appService *ProductApplicationService) CreateProduct(ctx context.Context, req *dto.ProductInputDto) (*productProto.CreateProductResponse, error) {
+	var product *model.Product
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		product, txErr = appService.catalogService.CreateProduct(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.CreateProductResponse{Product: toProductDetail(product)}, nil
+}
+
+// CreateProductWithSkus 创建商品并按规格矩阵生成SKU，商品、规格、SKU在同一事务内创建
+func (appService *ProductApplicationService) CreateProductWithSkus(ctx context.Context, req *dto.CreateProductWithSkusDto) (*productProto.CreateProductWithSkusResponse, error) {
+	var product *model.Product
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		product, txErr = appService.catalogService.CreateProductWithSkus(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.CreateProductWithSkusResponse{Product: toProductDetail(product)}, nil
+}
+
+// UpdateProduct 更新商品
+func (appService *ProductApplicationService) UpdateProduct(ctx context.Context, req *dto.UpdateProductDto) (*productProto.UpdateProductResponse, error) {
+	var product *model.Product
+	err := appService.executeWithProductLock(ctx, req.ID, func(txCtx context.Context) error {
+		var txErr error
+		product, txErr = appService.catalogService.UpdateProduct(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.UpdateProductResponse{Product: toProductDetail(product)}, nil
+}
+
+// GetProduct 获取商品详情
+func (appService *ProductApplicationService) GetProduct(ctx context.Context, id int64) (*productProto.GetProductResponse, error) {
+	product, err := appService.catalogService.GetProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetProductResponse{Product: toProductDetail(product)}, nil
+}
+
+// ListProducts 分页查询商品
+func (appService *ProductApplicationService) ListProducts(ctx context.Context, req *dto.ListProductsDto) (*productProto.ListProductsResponse, error) {
+	products, total, err := appService.catalogService.ListProducts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.ListProductsResponse{
+		Products: make([]*productProto.ProductDetail, 0, len(products)),
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	for i := range products {
+		response.Products = append(response.Products, toProductDetail(&products[i]))
+	}
+	return response, nil
+}
+
+// DeleteProduct 软删除商品
+func (appService *ProductApplicationService) DeleteProduct(ctx context.Context, id int64) error {
+	return appService.executeWithProductLock(ctx, id, func(txCtx context.Context) error {
+		return appService.catalogService.DeleteProduct(txCtx, id)
+	})
+}
+
+// PreviewSkuMatrix 预览规格矩阵的变更
+func (appService *ProductApplicationService) PreviewSkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*productProto.SkuMatrixResponse, error) {
+	diff, err := appService.matrixService.PreviewSkuMatrix(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toSkuMatrixResponse(diff), nil
+}
+
+// ApplySkuMatrix 应用规格矩阵
+func (appService *ProductApplicationService) ApplySkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*productProto.SkuMatrixResponse, error) {
+	var diff *dto.SkuMatrixDiffDto
+	err := appService.executeWithProductLock(ctx, req.ProductID, func(txCtx context.Context) error {
+		var txErr error
+		diff, txErr = appService.matrixService.ApplySkuMatrix(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSkuMatrixResponse(diff), nil
+}
+
+// executeWithProductLock 以商品维度加锁并在事务内执行
+func (appService *ProductApplicationService) executeWithProductLock(ctx context.Context, productId int64, fn func(txCtx context.Context) error) error {
+	if productId == 0 {
+		return status.Error(codes.InvalidArgument, "id cannot be empty")
+	}
+	lockKey := "product-" + strconv.FormatInt(productId, 10)
+	lock := appService.serviceContext.LockManager.NewLock(lockKey, 15)
+	if err := lock.TryLock(ctx); err != nil {
+		return status.Error(codes.Aborted, "product is being modified")
+	}
+	defer func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}()
+	return appService.serviceContext.TxManager.Execute(ctx, fn)
+}
+
+// toProductDetail 转换商品详情，规格与SKU未加载时为空
+func toProductDetail(product *model.Product) *productProto.ProductDetail {
+	detail := &productProto.ProductDetail{
+		Id:          product.ID,
+		ProductNo:   product.ProductNo,
+		ProductName: product.ProductName,
+		CategoryId:  int64(product.CategoryID),
+		Status:      int32(product.Status),
+		CreatedAt:   product.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   product.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Specs:       make([]*productProto.ProductSpecInfo, 0, len(product.Specs)),
+		Skus:        make([]*productProto.ProductSkuInfo, 0, len(product.Skus)),
+	}
+	if product.BrandID != nil {
+		detail.BrandId = int64(*product.BrandID)
+	}
+	if product.MainImage != nil {
+		detail.MainImage = *product.MainImage
+	}
+	if product.Description != nil {
+		detail.Description = *product.Description
+	}
+	for _, spec := range product.Specs {
+		specInfo := &productProto.ProductSpecInfo{
+			Id:           spec.ID,
+			SpecName:     spec.SpecName,
+			DisplayOrder: int32(spec.DisplayOrder),
+			Values:       make([]*productProto.SpecValueInfo, 0, len(spec.SpecValues)),
+		}
+		for _, value := range spec.SpecValues {
+			valueInfo := &productProto.SpecValueInfo{
+				Id:           value.ID,
+				ValueName:    value.ValueName,
+				DisplayOrder: int32(value.DisplayOrder),
+			}
+			if value.ValueImage != nil {
+				valueInfo.ValueImage = *value.ValueImage
+			}
+			specInfo.Values = append(specInfo.Values, valueInfo)
+		}
+		detail.Specs = append(detail.Specs, specInfo)
+	}
+	for i := range product.Skus {
+		detail.Skus = append(detail.Skus, toProductSkuInfo(&product.Skus[i]))
+	}
+	return detail
+}
+
+// toProductSkuInfo 转换SKU信息
+func toProductSkuInfo(sku *model.ProductSku) *productProto.ProductSkuInfo {
+	info := &productProto.ProductSkuInfo{
+		Id:            sku.ID,
+		SkuNo:         sku.SkuNo,
+		SkuName:       sku.SkuName,
+		SpecValueIds:  sku.SpecValueIDs,
+		SpecValueText: sku.SpecValueText,
+		Price:         sku.Price,
+		Stock:         sku.Stock,
+		StockWarn:     sku.StockWarn,
+		Sales:         int32(sku.Sales),
+		Status:        int32(sku.Status),
+		Images:        make([]*productProto.SkuImageInfo, 0, len(sku.Images)),
+	}
+	if sku.MarketPrice != nil {
+		info.MarketPrice = *sku.MarketPrice
+	}
+	if sku.MainImage != nil {
+		info.MainImage = *sku.MainImage
+	}
+	for _, img := range sku.Images {
+		info.Images = append(info.Images, &productProto.SkuImageInfo{
+			Id:           img.ID,
+			ImageUrl:     img.ImageURL,
+			IsMain:       img.IsMain,
+			DisplayOrder: int32(img.DisplayOrder),
+		})
+	}
+	return info
+}
+
+// toSkuMatrixResponse 转换规格矩阵差异
+func toSkuMatrixResponse(diff *dto.SkuMatrixDiffDto) *productProto.SkuMatrixResponse {
+	convert := func(items []*dto.SkuMatrixItemDto) []*productProto.SkuMatrixItem {
+		result := make([]*productProto.SkuMatrixItem, 0, len(items))
+		for _, item := range items {
+			result = append(result, &productProto.SkuMatrixItem{
+				SkuId:         item.SkuID,
+				SkuNo:         item.SkuNo,
+				SkuName:       item.SkuName,
+				SpecValueIds:  item.SpecValueIDs,
+				SpecValueText: item.SpecValueText,
+				Price:         item.Price,
+				Stock:         item.Stock,
+				Status:        int32(item.Status),
+			})
+		}
+		return result
+	}
+	return &productProto.SkuMatrixResponse{
+		Create:  convert(diff.Create),
+		Disable: convert(diff.Disable),
+		Keep:    convert(diff.Keep),
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// productPublisherLockKey 定时上架锁，多实例部署时同一时间只有一个实例执行定时上架
+const productPublisherLockKey = "productpublisher"
+
+// PublishProduct 上架商品或设置定时上架
+func (appService *ProductApplicationService) PublishProduct(ctx context.Context, req *dto.PublishProductDto) (*productProto.PublishProductResponse, error) {
+	change, err := appService.changeProductStatus(ctx, func(txCtx context.Context) (*dto.ProductStatusChangeDto, error) {
+		return appService.productLifecycleService.PublishProduct(txCtx, req, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.PublishProductResponse{Change: toProductStatusChange(change)}, nil
+}
+
+// UnpublishProduct 下架商品，上架中的SKU一并下架
+func (appService *ProductApplicationService) UnpublishProduct(ctx context.Context, req *dto.ProductStatusDto) (*productProto.UnpublishProductResponse, error) {
+	change, err := appService.changeProductStatus(ctx, func(txCtx context.Context) (*dto.ProductStatusChangeDto, error) {
+		return appService.productLifecycleService.UnpublishProduct(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.UnpublishProductResponse{Change: toProductStatusChange(change)}, nil
+}
+
+// UnpublishSku 下架单个SKU
+func (appService *ProductApplicationService) UnpublishSku(ctx context.Context, req *dto.UnpublishSkuDto) (*productProto.UnpublishSkuResponse, error) {
+	change, err := appService.changeProductStatus(ctx, func(txCtx context.Context) (*dto.ProductStatusChangeDto, error) {
+		return appService.productLifecycleService.UnpublishSku(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.UnpublishSkuResponse{Change: toProductStatusChange(change)}, nil
+}
+
+// ArchiveProduct 归档商品及其所有SKU
+func (appService *ProductApplicationService) ArchiveProduct(ctx context.Context, req *dto.ProductStatusDto) (*productProto.ArchiveProductResponse, error) {
+	change, err := appService.changeProductStatus(ctx, func(txCtx context.Context) (*dto.ProductStatusChangeDto, error) {
+		return appService.productLifecycleService.ArchiveProduct(txCtx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ArchiveProductResponse{Change: toProductStatusChange(change)}, nil
+}
+
+// PublishScheduledProducts 执行所有到期的定时上架，由后台协程周期调用
+// 每个商品在单独的事务内处理，单个商品失败不影响其他商品，下一周期重试
+func (appService *ProductApplicationService) PublishScheduledProducts(ctx context.Context) error {
+	conf := appService.serviceContext.Conf.Publishing
+	lock := appService.serviceContext.LockManager.NewLock(productPublisherLockKey, conf.ScheduleInterval)
+	if err := lock.TryLock(ctx); err != nil {
+		logger.Info("product publisher is running on another instance, skipped")
+		return nil
+	}
+	defer func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}()
+
+	now := time.Now()
+	var cursor int64
+	var published int
+	for ctx.Err() == nil {
+		productIds, err := appService.productLifecycleService.ListDueOnSaleProductIDs(ctx, now, cursor, conf.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, productId := range productIds {
+			change, err := appService.changeProductStatus(ctx, func(txCtx context.Context) (*dto.ProductStatusChangeDto, error) {
+				return appService.productLifecycleService.ApplyDueOnSale(txCtx, productId, now)
+			})
+			if err != nil {
+				logger.Error("failed to publish scheduled product ", productId, ": ", err.Error())
+				continue
+			}
+			if change != nil {
+				published++
+			}
+		}
+		if len(productIds) < conf.BatchSize {
+			break
+		}
+		cursor = productIds[len(productIds)-1]
+	}
+	if published > 0 {
+		logger.Info("published ", published, " scheduled products")
+	}
+	return nil
+}
+
+// changeProductStatus 在事务内执行状态流转，商品或SKU状态发生变化时发布状态变化事件
+func (appService *ProductApplicationService) changeProductStatus(ctx context.Context, fn func(txCtx context.Context) (*dto.ProductStatusChangeDto, error)) (*dto.ProductStatusChangeDto, error) {
+	var change *dto.ProductStatusChangeDto
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		change, txErr = fn(txCtx)
+		if txErr != nil || change == nil {
+			return txErr
+		}
+		if change.FromStatus == change.ToStatus && len(change.Skus) == 0 {
+			return nil
+		}
+		changedEvent := productEvent.OnProductStatusChanged{
+			ProductId:  change.ProductID,
+			FromStatus: int32(change.FromStatus),
+			ToStatus:   int32(change.ToStatus),
+			Skus:       make([]*productEvent.SkuStatusChange, 0, len(change.Skus)),
+			OperatorId: change.OperatorID,
+			Reason:     change.Reason,
+		}
+		for _, sku := range change.Skus {
+			changedEvent.Skus = append(changedEvent.Skus, &productEvent.SkuStatusChange{
+				SkuId:      sku.SkuID,
+				FromStatus: int32(sku.FromStatus),
+				ToStatus:   int32(sku.ToStatus),
+			})
+		}
+		err := appService.publishEvent(txCtx, productEventTopic, &changedEvent, strconv.FormatInt(change.ProductID, 10), "OnProductStatusChanged")
+		if err != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// toProductStatusChange 转换商品状态变化
+func toProductStatusChange(change *dto.ProductStatusChangeDto) *productProto.ProductStatusChange {
+	info := &productProto.ProductStatusChange{
+		ProductId:  change.ProductID,
+		FromStatus: int32(change.FromStatus),
+		ToStatus:   int32(change.ToStatus),
+		Skus:       make([]*productProto.SkuStatusChange, 0, len(change.Skus)),
+	}
+	if change.OnSaleAt != nil {
+		info.OnSaleAt = change.OnSaleAt.Format("2006-01-02 15:04:05")
+	}
+	for _, sku := range change.Skus {
+		info.Skus = append(info.Skus, &productProto.SkuStatusChange{
+			SkuId:      sku.SkuID,
+			FromStatus: int32(sku.FromStatus),
+			ToStatus:   int32(sku.ToStatus),
+		})
+	}
+	return info
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePurchaseOrders 根据审核通过的补货记录生成采购单，补货记录在事务内锁定
+func (appService *ProductApplicationService) CreatePurchaseOrders(ctx context.Context, req *dto.CreatePurchaseOrdersDto) (*productProto.CreatePurchaseOrdersResponse, error) {
+	var orders []*model.PurchaseOrder
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		orders, txErr = appService.purchaseOrderService.CreatePurchaseOrders(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.CreatePurchaseOrdersResponse{
+		PurchaseOrders: make([]*productProto.PurchaseOrderInfo, 0, len(orders)),
+	}
+	for _, order := range orders {
+		response.PurchaseOrders = append(response.PurchaseOrders, toPurchaseOrderInfo(order))
+	}
+	return response, nil
+}
+
+// GetPurchaseOrder 获取采购单及其明细
+func (appService *ProductApplicationService) GetPurchaseOrder(ctx context.Context, id int64) (*productProto.GetPurchaseOrderResponse, error) {
+	order, err := appService.purchaseOrderService.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetPurchaseOrderResponse{PurchaseOrder: toPurchaseOrderInfo(order)}, nil
+}
+
+// ListPurchaseOrders 分页查询采购单
+func (appService *ProductApplicationService) ListPurchaseOrders(ctx context.Context, req *dto.ListPurchaseOrdersDto) (*productProto.ListPurchaseOrdersResponse, error) {
+	orders, total, err := appService.purchaseOrderService.ListPurchaseOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.ListPurchaseOrdersResponse{
+		PurchaseOrders: make([]*productProto.PurchaseOrderInfo, 0, len(orders)),
+		Total:          total,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+	}
+	for i := range orders {
+		response.PurchaseOrders = append(response.PurchaseOrders, toPurchaseOrderInfo(&orders[i]))
+	}
+	return response, nil
+}
+
+// ReceiveGoods 采购收货，增加SKU库存并写入库存变更记录，事务内发布收货入库事件
+func (appService *ProductApplicationService) ReceiveGoods(ctx context.Context, req *dto.ReceiveGoodsDto) (*productProto.ReceiveGoodsResponse, error) {
+	var order *model.PurchaseOrder
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var skuDto *dto.OrderSkuDto
+		var txErr error
+		order, skuDto, txErr = appService.purchaseOrderService.ReceiveGoods(txCtx, req)
+		if txErr != nil {
+			return txErr
+		}
+		receivedEvent := productEvent.OnStockReceived{
+			PurchaseOrderId: order.ID,
+			PurchaseOrderNo: order.PurchaseOrderNo,
+			OperatorId:      req.OperatorID,
+			Sku:             make([]*productEvent.SkuInfo, 0, len(skuDto.Sku)),
+		}
+		for i := range skuDto.Sku {
+			receivedEvent.Sku = append(receivedEvent.Sku, toEventSkuInfo(&skuDto.Sku[i]))
+		}
+		txErr = appService.publishEvent(txCtx, productEventTopic, &receivedEvent, order.PurchaseOrderNo, "OnStockReceived")
+		if txErr != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+txErr.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ReceiveGoodsResponse{PurchaseOrder: toPurchaseOrderInfo(order)}, nil
+}
+
+// toPurchaseOrderInfo 转换采购单，明细未加载时为空
+func toPurchaseOrderInfo(order *model.PurchaseOrder) *productProto.PurchaseOrderInfo {
+	info := &productProto.PurchaseOrderInfo{
+		Id:              order.ID,
+		PurchaseOrderNo: order.PurchaseOrderNo,
+		SupplierId:      order.SupplierID,
+		Status:          uint32(order.Status),
+		TotalQuantity:   order.TotalQuantity,
+		TotalAmount:     order.TotalAmount,
+		OperatorId:      int32(order.OperatorID),
+		Remark:          order.Remark,
+		CreatedAt:       order.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       order.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Lines:           make([]*productProto.PurchaseOrderLineInfo, 0, len(order.Lines)),
+	}
+	for _, line := range order.Lines {
+		lineInfo := &productProto.PurchaseOrderLineInfo{
+			Id:                line.ID,
+			SkuId:             line.SkuID,
+			RestockIds:        line.RestockIDs(),
+			RequestedQuantity: line.RequestedQuantity,
+			Quantity:          line.Quantity,
+			ReceivedQuantity:  line.ReceivedQuantity,
+			UnitPrice:         line.UnitPrice,
+			Amount:            line.Amount,
+		}
+		info.Lines = append(info.Lines, lineInfo)
+	}
+	return info
+}
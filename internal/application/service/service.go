@@ -2,26 +2,33 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"strconv"
+	"time"
 
 	"github.com/zhanshen02154/product/internal/application/dto"
 	"github.com/zhanshen02154/product/internal/domain/event/order"
 	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
 	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
 	"github.com/zhanshen02154/product/internal/domain/service"
 	"github.com/zhanshen02154/product/internal/infrastructure"
 	"github.com/zhanshen02154/product/internal/infrastructure/event"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
 	"github.com/zhanshen02154/product/pkg/swap"
 	productProto "github.com/zhanshen02154/product/proto/product"
 	"go-micro.dev/v4/logger"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+const productEventTopic = "ProductEvent"
+
 type IProductApplicationService interface {
 	AddProduct(ctx context.Context, productInfo *dto.ProductDto) (*dto.AddProductResponse, error)
 	DeductInventory(ctx context.Context, req *order.OnPaymentSuccess) error
-	DeductInvetoryRevert(ctx context.Context, req *dto.OrderProductInvetoryDto) error
+	DeductInvetoryRevert(ctx context.Context, req *dto.OrderInventoryRestoreDto) error
 	GetProductSkuDetail(ctx context.Context, skuID int64) (*productProto.GetProductSkuDetailResponse, error)
 	CheckSkuInventoryThreshold(ctx context.Context, skuIDs []int64, threshold uint32) (*productProto.CheckSkuInventoryThresholdResponse, error)
 	GetSkuStockBySkuNo(ctx context.Context, skuCode string) (*productProto.GetSkuStockBySkuNoResponse, error)
@@ -29,7 +36,72 @@ type IProductApplicationService interface {
 	GetSkuSalesVolume(ctx context.Context, skuCode string, startTime, endTime string) (*productProto.GetSkuSalesVolumeResponse, error)
 	GetSupplierInfo(ctx context.Context, skuCode string) (*productProto.GetSupplierInfoResponse, error)
 	GetRestockApplyInfo(ctx context.Context, applicationNo string, userID int32) (*productProto.GetRestockApplyInfoResponse, error)
+	ApproveRestockApply(ctx context.Context, applicationNo string, auditUserID uint32) (*productProto.ApproveRestockApplyResponse, error)
+	RejectRestockApply(ctx context.Context, applicationNo string, auditUserID uint32, reason string) (*productProto.RejectRestockApplyResponse, error)
 	GetSkuDailySales(ctx context.Context, skuCode string, startDate, endDate string) (*productProto.GetSkuDailySalesResponse, error)
+	ReserveStock(ctx context.Context, req *dto.ReserveStockDto) (*productProto.ReserveStockResponse, error)
+	ConfirmReservation(ctx context.Context, orderId int64) (*productProto.ConfirmReservationResponse, error)
+	ReleaseReservation(ctx context.Context, orderId int64) (*productProto.ReleaseReservationResponse, error)
+	ReleaseExpiredReservations(ctx context.Context) error
+	TryDeductSku(ctx context.Context, req *dto.ReserveStockDto) error
+	ConfirmDeductSku(ctx context.Context, orderId int64) error
+	CancelDeductSku(ctx context.Context, orderId int64) error
+	CreateProduct(ctx context.Context, req *dto.ProductInputDto) (*productProto.CreateProductResponse, error)
+	CreateProductWithSkus(ctx context.Context, req *dto.CreateProductWithSkusDto) (*productProto.CreateProductWithSkusResponse, error)
+	UpdateProduct(ctx context.Context, req *dto.UpdateProductDto) (*productProto.UpdateProductResponse, error)
+	GetProduct(ctx context.Context, id int64) (*productProto.GetProductResponse, error)
+	ListProducts(ctx context.Context, req *dto.ListProductsDto) (*productProto.ListProductsResponse, error)
+	DeleteProduct(ctx context.Context, id int64) error
+	PreviewSkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*productProto.SkuMatrixResponse, error)
+	ApplySkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*productProto.SkuMatrixResponse, error)
+	CreateCategory(ctx context.Context, name string, parentId int64) (*productProto.CreateCategoryResponse, error)
+	RenameCategory(ctx context.Context, id int64, name string) (*productProto.RenameCategoryResponse, error)
+	MoveCategory(ctx context.Context, id int64, parentId int64) (*productProto.MoveCategoryResponse, error)
+	DeleteCategory(ctx context.Context, id int64) error
+	GetCategoryTree(ctx context.Context) (*productProto.GetCategoryTreeResponse, error)
+	ListCategorySkus(ctx context.Context, categoryId int64, page, pageSize int32) (*productProto.ListCategorySkusResponse, error)
+	CreateBrand(ctx context.Context, name string, logo string) (*productProto.CreateBrandResponse, error)
+	UpdateBrand(ctx context.Context, id int64, name string, logo string) (*productProto.UpdateBrandResponse, error)
+	GetBrand(ctx context.Context, id int64) (*productProto.GetBrandResponse, error)
+	ListBrands(ctx context.Context, keyword string, page, pageSize int32) (*productProto.ListBrandsResponse, error)
+	DeleteBrand(ctx context.Context, id int64) error
+	ReassignBrandProducts(ctx context.Context, req *dto.ReassignBrandProductsDto) (*productProto.ReassignBrandProductsResponse, error)
+	CreateSupplier(ctx context.Context, req *dto.SupplierInputDto) (*productProto.CreateSupplierResponse, error)
+	UpdateSupplier(ctx context.Context, id int64, req *dto.SupplierInputDto) (*productProto.UpdateSupplierResponse, error)
+	GetSupplier(ctx context.Context, id int64) (*productProto.GetSupplierResponse, error)
+	ListSuppliers(ctx context.Context, keyword string, page, pageSize int32) (*productProto.ListSuppliersResponse, error)
+	DeleteSupplier(ctx context.Context, id int64) error
+	LinkSupplierSku(ctx context.Context, req *dto.LinkSupplierSkuDto) (*productProto.LinkSupplierSkuResponse, error)
+	UnlinkSupplierSku(ctx context.Context, supplierId int64, skuId int64) error
+	ListSkusBySupplier(ctx context.Context, supplierId int64, page, pageSize int32) (*productProto.ListSkusBySupplierResponse, error)
+	CreatePurchaseOrders(ctx context.Context, req *dto.CreatePurchaseOrdersDto) (*productProto.CreatePurchaseOrdersResponse, error)
+	GetPurchaseOrder(ctx context.Context, id int64) (*productProto.GetPurchaseOrderResponse, error)
+	ListPurchaseOrders(ctx context.Context, req *dto.ListPurchaseOrdersDto) (*productProto.ListPurchaseOrdersResponse, error)
+	ReceiveGoods(ctx context.Context, req *dto.ReceiveGoodsDto) (*productProto.ReceiveGoodsResponse, error)
+	AdjustStock(ctx context.Context, req *dto.AdjustStockDto) (*productProto.AdjustStockResponse, error)
+	SubmitStocktake(ctx context.Context, req *dto.StocktakeDto) (*productProto.SubmitStocktakeResponse, error)
+	ListStockChanges(ctx context.Context, req *dto.ListStockChangesDto) (*productProto.ListStockChangesResponse, error)
+	GetStockAtTime(ctx context.Context, skuId int64, at string) (*productProto.GetStockAtTimeResponse, error)
+	GetReconciliationReport(ctx context.Context, cursor int64, limit int32) (*productProto.GetReconciliationReportResponse, error)
+	CorrectStockLedger(ctx context.Context, skuIds []int64, operatorId int64) (*productProto.CorrectStockLedgerResponse, error)
+	ReconcileInventory(ctx context.Context) error
+	CreateWarehouse(ctx context.Context, req *dto.WarehouseInputDto) (*productProto.CreateWarehouseResponse, error)
+	ListWarehouses(ctx context.Context) (*productProto.ListWarehousesResponse, error)
+	SetSkuWarehouseStock(ctx context.Context, req *dto.SetSkuWarehouseStockDto) (*productProto.SetSkuWarehouseStockResponse, error)
+	GetSkuWarehouseStock(ctx context.Context, skuId int64) (*productProto.GetSkuWarehouseStockResponse, error)
+	SchedulePriceChange(ctx context.Context, req *dto.SchedulePriceChangeDto) (*productProto.SchedulePriceChangeResponse, error)
+	GetSkuPriceAt(ctx context.Context, skuId int64, at string) (*productProto.GetSkuPriceAtResponse, error)
+	ListSkuPriceHistory(ctx context.Context, skuId int64, page, pageSize int32) (*productProto.ListSkuPriceHistoryResponse, error)
+	ApplyScheduledPriceChanges(ctx context.Context) error
+	PublishProduct(ctx context.Context, req *dto.PublishProductDto) (*productProto.PublishProductResponse, error)
+	UnpublishProduct(ctx context.Context, req *dto.ProductStatusDto) (*productProto.UnpublishProductResponse, error)
+	UnpublishSku(ctx context.Context, req *dto.UnpublishSkuDto) (*productProto.UnpublishSkuResponse, error)
+	ArchiveProduct(ctx context.Context, req *dto.ProductStatusDto) (*productProto.ArchiveProductResponse, error)
+	PublishScheduledProducts(ctx context.Context) error
+	ListDeadLetters(ctx context.Context, req *dto.ListDeadLettersDto) (*productProto.ListDeadLettersResponse, error)
+	GetDeadLetter(ctx context.Context, id int64) (*productProto.GetDeadLetterResponse, error)
+	ReplayDeadLetters(ctx context.Context, req *dto.ReplayDeadLettersDto) (*productProto.ReplayDeadLettersResponse, error)
+	ResolveDeadLetters(ctx context.Context, req *dto.ResolveDeadLettersDto) (*productProto.ResolveDeadLettersResponse, error)
 }
 
 // ProductApplicationService 商品服务应用层
@@ -38,13 +110,59 @@ type ProductApplicationService struct {
 	productDomainService service.IProductDataService
 	// 补货领域服务
 	skuRestockService service.ISkuRestockService
+	// 库存预占领域服务
+	reservationService service.IStockReservationService
+	// 库存扣减TCC分支
+	stockTccService *StockTccService
+	// 商品目录领域服务
+	catalogService service.IProductCatalogService
+	// 规格矩阵领域服务
+	matrixService service.ISkuMatrixService
+	// 商品分类领域服务
+	categoryService service.ICategoryService
+	// 品牌领域服务
+	brandService service.IBrandService
+	// 供应商领域服务
+	supplierService service.ISupplierService
+	// 采购单领域服务
+	purchaseOrderService service.IPurchaseOrderService
+	// 库存调整领域服务
+	stockAdjustmentService service.IStockAdjustmentService
+	// 库存流水领域服务
+	stockLedgerService service.IStockLedgerService
+	// 库存对账领域服务
+	reconciliationService service.IInventoryReconciliationService
+	// 仓库领域服务
+	warehouseService service.IWarehouseService
+	// SKU价格领域服务
+	skuPriceService service.ISkuPriceService
+	// 商品状态领域服务
+	productLifecycleService service.IProductLifecycleService
+	// 死信领域服务
+	deadLetterService service.IDeadLetterService
 	// 服务上下文
 	serviceContext *infrastructure.ServiceContext
 	// 事件总线
 	eb event.Listener
+	// 事务发件箱
+	outboxRepo repository.OutboxEventRepository
+	// 死信重新投递
+	deadLetterReplayer event.DeadLetterReplayer
 }
 
 func NewProductApplicationService(serviceContext *infrastructure.ServiceContext, eb event.Listener) IProductApplicationService {
+	reservationService := service.NewStockReservationService(
+		serviceContext.NewProductSkuRepository(),
+		serviceContext.NewSkuStockReservationRepository(),
+		serviceContext.NewInventoryStockChangeRecordRepository(),
+		serviceContext.NewWarehouseRepository(),
+		serviceContext.Conf.Warehouse.AllocationStrategy,
+	)
+	matrixService := service.NewSkuMatrixService(
+		serviceContext.NewProductRepository(),
+		serviceContext.NewProductSkuRepository(),
+		serviceContext.NewInventoryStockChangeRecordRepository(),
+	)
 	return &ProductApplicationService{
 		productDomainService: service.NewProductDataService(
 			serviceContext.NewProductRepository(),
@@ -52,15 +170,88 @@ func NewProductApplicationService(serviceContext *infrastructure.ServiceContext,
 			serviceContext.NewProductSkuRepository(),
 			serviceContext.NewInventoryStockChangeRecordRepository(),
 			serviceContext.NewSupplierRepository(),
+			serviceContext.NewSkuStockReservationRepository(),
+			serviceContext.NewOrderInventoryRestoreRepository(),
+			serviceContext.NewWarehouseRepository(),
+			serviceContext.Conf.Warehouse.AllocationStrategy,
 		),
 		skuRestockService: service.NewSkuRestockService(
 			serviceContext.NewProductSkuRepository(),
 			serviceContext.NewSkuRestockRepository(),
 			serviceContext.NewSkuRestockAuditRepository(),
 		),
-		serviceContext: serviceContext,
-		eb:             eb,
+		reservationService: reservationService,
+		stockTccService:    NewStockTccService(serviceContext.TxManager, serviceContext.LockManager, reservationService),
+		catalogService: service.NewProductCatalogService(
+			serviceContext.NewProductRepository(),
+			serviceContext.NewProductSkuRepository(),
+			serviceContext.NewProductCategoryRepository(),
+			serviceContext.NewBrandRepository(),
+			matrixService,
+		),
+		categoryService: service.NewCategoryService(
+			serviceContext.NewProductCategoryRepository(),
+			serviceContext.NewProductRepository(),
+			serviceContext.NewProductSkuRepository(),
+		),
+		brandService: service.NewBrandService(
+			serviceContext.NewBrandRepository(),
+			serviceContext.NewProductRepository(),
+		),
+		supplierService: service.NewSupplierService(
+			serviceContext.NewSupplierRepository(),
+			serviceContext.NewProductSkuRepository(),
+		),
+		purchaseOrderService: service.NewPurchaseOrderService(
+			serviceContext.NewPurchaseOrderRepository(),
+			serviceContext.NewSkuRestockRepository(),
+			serviceContext.NewSupplierRepository(),
+			serviceContext.NewProductSkuRepository(),
+			serviceContext.NewInventoryStockChangeRecordRepository(),
+			serviceContext.NewWarehouseRepository(),
+		),
+		stockAdjustmentService: service.NewStockAdjustmentService(
+			serviceContext.NewProductSkuRepository(),
+			serviceContext.NewInventoryStockChangeRecordRepository(),
+			serviceContext.NewWarehouseRepository(),
+		),
+		stockLedgerService: service.NewStockLedgerService(
+			serviceContext.NewInventoryStockChangeRecordRepository(),
+			serviceContext.NewProductSkuRepository(),
+		),
+		reconciliationService: service.NewInventoryReconciliationService(
+			serviceContext.NewProductSkuRepository(),
+			serviceContext.NewInventoryStockChangeRecordRepository(),
+		),
+		warehouseService: service.NewWarehouseService(
+			serviceContext.NewWarehouseRepository(),
+			serviceContext.NewProductSkuRepository(),
+			serviceContext.NewInventoryStockChangeRecordRepository(),
+		),
+		skuPriceService: service.NewSkuPriceService(
+			serviceContext.NewProductSkuRepository(),
+			serviceContext.NewSkuPriceHistoryRepository(),
+		),
+		productLifecycleService: service.NewProductLifecycleService(
+			serviceContext.NewProductRepository(),
+			serviceContext.NewProductSkuRepository(),
+		),
+		deadLetterService:  service.NewDeadLetterService(serviceContext.NewDeadLetterRepository()),
+		matrixService:      matrixService,
+		serviceContext:     serviceContext,
+		eb:                 eb,
+		outboxRepo:         serviceContext.NewOutboxEventRepository(),
+		deadLetterReplayer: event.NewDeadLetterReplayer(event.WithServiceInfo(serviceContext.Conf.Service)),
+	}
+}
+
+// publishEvent 将事件写入发件箱，须在事务内调用，由发件箱中继在事务提交后发布
+func (appService *ProductApplicationService) publishEvent(txCtx context.Context, topic string, msg proto.Message, key string, eventType string) error {
+	outboxEvent, err := event.NewOutboxEvent(topic, msg, key, eventType)
+	if err != nil {
+		return err
 	}
+	return appService.outboxRepo.Create(txCtx, outboxEvent)
 }
 
 // AddProduct 添加产品
@@ -78,66 +269,92 @@ func (appService *ProductApplicationService) AddProduct(ctx context.Context, pro
 
 // DeductInventory 扣减订单的库存
 func (appService *ProductApplicationService) DeductInventory(ctx context.Context, req *order.OnPaymentSuccess) error {
-	err := appService.serviceContext.RetryPolicy.Execute(ctx, func() error {
-		eventExists, err := appService.productDomainService.FindEventExistsByOrderId(ctx, req.OrderId)
+	eventExists, err := appService.productDomainService.FindEventExistsByOrderId(ctx, req.OrderId)
+	if err != nil {
+		return status.Error(codes.NotFound, "check order inventory event error: "+err.Error())
+	}
+	if eventExists {
+		return nil
+	}
+	skuIds := make([]int64, 0, len(req.OrderDetails))
+	for _, item := range req.OrderDetails {
+		skuIds = append(skuIds, item.SkuId)
+	}
+	return appService.executeWithSkuStockLocks(ctx, skuIds, func(txCtx context.Context) error {
+		skuDto, err := appService.productDomainService.DeductInventory(txCtx, req)
 		if err != nil {
-			return status.Error(codes.NotFound, "check order inventory event error: "+err.Error())
+			return status.Error(codes.NotFound, "failed to deduct inventory error:"+err.Error())
 		}
-		if eventExists {
-			return nil
-		}
-		err = appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
-			skuDto, err := appService.productDomainService.DeductInventory(txCtx, req)
-			if err != nil {
-				return status.Error(codes.NotFound, "failed to deduct inventory error:"+err.Error())
-			}
 
-			inventoryEvent := productEvent.OnInventoryDeductSuccess{
-				OrderId: skuDto.OrderID,
-				Sku:     make([]*productEvent.SkuInfo, 0, len(skuDto.Sku)),
-			}
-			for _, item := range skuDto.Sku {
-				inventoryEvent.Sku = append(inventoryEvent.Sku, &productEvent.SkuInfo{
-					Id:        item.SkuID,
-					Quantity:  item.Quantity,
-					Stock:     item.Stock,
-					Threshold: item.Threshold,
-				})
-			}
-			err = appService.eb.Publish(txCtx, "ProductEvent", &inventoryEvent, strconv.FormatInt(req.OrderId, 10), "OnInventoryDeductSuccess")
-			if err != nil {
-				return status.Error(codes.Aborted, "failed to publish event error: "+err.Error())
-			}
-			return nil
-		})
-
-		return err
+		inventoryEvent := productEvent.OnInventoryDeductSuccess{
+			OrderId: skuDto.OrderID,
+			Sku:     make([]*productEvent.SkuInfo, 0, len(skuDto.Sku)),
+		}
+		for i := range skuDto.Sku {
+			inventoryEvent.Sku = append(inventoryEvent.Sku, toEventSkuInfo(&skuDto.Sku[i]))
+		}
+		err = appService.publishEvent(txCtx, productEventTopic, &inventoryEvent, strconv.FormatInt(req.OrderId, 10), "OnInventoryDeductSuccess")
+		if err != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+err.Error())
+		}
+		return nil
 	})
-	return err
 }
 
-// DeductInvetoryRevert 扣减订单的库存补偿
-func (appService *ProductApplicationService) DeductInvetoryRevert(ctx context.Context, req *dto.OrderProductInvetoryDto) error {
-	eventExists, err := appService.productDomainService.FindEventExistsByOrderId(ctx, req.OrderId)
+// DeductInvetoryRevert 订单退款或取消的库存补偿
+func (appService *ProductApplicationService) DeductInvetoryRevert(ctx context.Context, req *dto.OrderInventoryRestoreDto) error {
+	// 仅用于快速跳过已处理的回补，并发去重由事务内的回补记录保证
+	restoreExists, err := appService.productDomainService.FindRestoreExists(ctx, req.RestoreKey)
 	if err != nil {
-		return status.Error(codes.NotFound, "check order inventory event error: "+err.Error())
+		return status.Error(codes.Internal, "check order inventory restore error: "+err.Error())
 	}
-	if eventExists {
+	if restoreExists {
 		return nil
 	}
-	lockKey := "deductinvetoryrevert-" + strconv.FormatInt(req.OrderId, 10)
+	lockKey := "deductinvetoryrevert-" + strconv.FormatInt(req.OrderID, 10)
 	lock := appService.serviceContext.LockManager.NewLock(lockKey, 15)
 	if err := lock.TryLock(ctx); err != nil {
 		return err
 	}
-
-	defer func() {
+	unlock := func() {
 		if err := lock.UnLock(ctx); err != nil {
 			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
 		}
-	}()
-	return appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
-		return appService.productDomainService.DeductOrderInvetoryRevert(txCtx, req)
+	}
+	if !transaction.AfterCompletion(ctx, unlock) {
+		defer unlock()
+	}
+	// 订单取消时还会释放预占中的库存，预占过的SKU一并加锁
+	skuIds, err := appService.reservationService.FindReservedSkuIds(ctx, req.OrderID)
+	if err != nil {
+		return err
+	}
+	for _, item := range req.Sku {
+		if item != nil {
+			skuIds = append(skuIds, item.SkuID)
+		}
+	}
+	return appService.executeWithSkuStockLocks(ctx, skuIds, func(txCtx context.Context) error {
+		skuDto, err := appService.productDomainService.DeductOrderInvetoryRevert(txCtx, req)
+		if err != nil {
+			return err
+		}
+		if len(skuDto.Sku) == 0 {
+			return nil
+		}
+		restoredEvent := productEvent.OnInventoryRestored{
+			OrderId: skuDto.OrderID,
+			Reason:  req.Reason,
+			Sku:     make([]*productEvent.SkuInfo, 0, len(skuDto.Sku)),
+		}
+		for i := range skuDto.Sku {
+			restoredEvent.Sku = append(restoredEvent.Sku, toEventSkuInfo(&skuDto.Sku[i]))
+		}
+		err = appService.publishEvent(txCtx, productEventTopic, &restoredEvent, strconv.FormatInt(req.OrderID, 10), "OnInventoryRestored")
+		if err != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+err.Error())
+		}
+		return nil
 	})
 }
 
@@ -385,16 +602,7 @@ func (appService *ProductApplicationService) GetRestockApplyInfo(ctx context.Con
 	// 构建审核信息
 	var audit *productProto.RestockAuditInfo
 	if len(record.Audits) > 0 {
-		latestAudit := record.Audits[0]
-		audit = &productProto.RestockAuditInfo{
-			Id:                latestAudit.ID,
-			RestockId:         int64(latestAudit.RestockID),
-			AuditUserId:       uint32(latestAudit.AuditUserID),
-			AuditStatus:       uint32(latestAudit.AuditStatus),
-			AuditFailedReason: latestAudit.AuditFailedReason,
-			CreatedAt:         latestAudit.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:         latestAudit.UpdatedAt.Format("2006-01-02 15:04:05"),
-		}
+		audit = toRestockAuditInfo(&record.Audits[0])
 	}
 
 	return &productProto.GetRestockApplyInfoResponse{
@@ -448,3 +656,177 @@ func (appService *ProductApplicationService) GetSkuDailySales(ctx context.Contex
 		DailySales: dailySales,
 	}, nil
 }
+
+// ReserveStock 预占库存
+func (appService *ProductApplicationService) ReserveStock(ctx context.Context, req *dto.ReserveStockDto) (*productProto.ReserveStockResponse, error) {
+	if req.OrderID == 0 || len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id or items cannot be empty")
+	}
+	if req.TtlSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl_seconds cannot be negative")
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = time.Duration(appService.serviceContext.Conf.Reservation.DefaultTtl) * time.Second
+	}
+	expiresAt := sql.NullTime{Time: time.Now().Add(ttl), Valid: true}
+
+	var reservations []model.SkuStockReservation
+	reserveSkuIds := func(ctx context.Context, orderId int64) ([]int64, error) {
+		skuIds := make([]int64, 0, len(req.Items))
+		for _, item := range req.Items {
+			if item != nil {
+				skuIds = append(skuIds, item.SkuID)
+			}
+		}
+		return skuIds, nil
+	}
+	err := appService.executeWithOrderLock(ctx, req.OrderID, reserveSkuIds, func(txCtx context.Context) error {
+		var txErr error
+		reservations, txErr = appService.reservationService.ReserveStock(txCtx, req.OrderID, req.Items, expiresAt)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ReserveStockResponse{Reservations: toReservationInfo(reservations)}, nil
+}
+
+// ConfirmReservation 确认预占
+func (appService *ProductApplicationService) ConfirmReservation(ctx context.Context, orderId int64) (*productProto.ConfirmReservationResponse, error) {
+	if orderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id cannot be empty")
+	}
+	var reservations []model.SkuStockReservation
+	err := appService.executeWithOrderLock(ctx, orderId, appService.reservationService.FindReservedSkuIds, func(txCtx context.Context) error {
+		var txErr error
+		reservations, txErr = appService.reservationService.ConfirmReservation(txCtx, orderId)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ConfirmReservationResponse{Reservations: toReservationInfo(reservations)}, nil
+}
+
+// ReleaseReservation 释放预占
+func (appService *ProductApplicationService) ReleaseReservation(ctx context.Context, orderId int64) (*productProto.ReleaseReservationResponse, error) {
+	if orderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id cannot be empty")
+	}
+	var reservations []model.SkuStockReservation
+	err := appService.executeWithOrderLock(ctx, orderId, appService.reservationService.FindReservedSkuIds, func(txCtx context.Context) error {
+		var txErr error
+		reservations, txErr = appService.reservationService.ReleaseReservation(txCtx, orderId)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ReleaseReservationResponse{Reservations: toReservationInfo(reservations)}, nil
+}
+
+// ReleaseExpiredReservations 释放过期的预占，由后台协程周期调用
+func (appService *ProductApplicationService) ReleaseExpiredReservations(ctx context.Context) error {
+	batchSize := appService.serviceContext.Conf.Reservation.BatchSize
+	for {
+		var released int
+		err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+			var txErr error
+			released, txErr = appService.reservationService.ReleaseExpired(txCtx, time.Now(), batchSize)
+			return txErr
+		})
+		if err != nil {
+			return err
+		}
+		if released > 0 {
+			logger.Info("released ", released, " expired stock reservations")
+		}
+		if released < batchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// executeWithOrderLock 以订单维度加锁，再对订单涉及的SKU加库存锁后在事务内执行
+// 涉及的SKU在持有订单锁后查询，避免查询与加锁之间订单的预占发生变化
+func (appService *ProductApplicationService) executeWithOrderLock(ctx context.Context, orderId int64, skuIds func(ctx context.Context, orderId int64) ([]int64, error), fn func(txCtx context.Context) error) error {
+	unlock, err := lockOrderReservation(ctx, appService.serviceContext.LockManager, orderId)
+	if err != nil {
+		return err
+	}
+	if !transaction.AfterCompletion(ctx, unlock) {
+		defer unlock()
+	}
+	ids, err := skuIds(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	return appService.executeWithSkuStockLocks(ctx, ids, fn)
+}
+
+// lockOrderReservation 加订单预占锁，返回释放锁的函数
+func lockOrderReservation(ctx context.Context, lockManager infrastructure.LockManager, orderId int64) (func(), error) {
+	lock := lockManager.NewLock("stockreservation-"+strconv.FormatInt(orderId, 10), 15)
+	if err := lock.TryLock(ctx); err != nil {
+		return nil, status.Error(codes.Aborted, "reservation of order is being processed")
+	}
+	return func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}, nil
+}
+
+// toReservationInfo 转换预占记录
+func toReservationInfo(reservations []model.SkuStockReservation) []*productProto.StockReservationInfo {
+	result := make([]*productProto.StockReservationInfo, 0, len(reservations))
+	for _, item := range reservations {
+		info := &productProto.StockReservationInfo{
+			Id:       item.ID,
+			OrderId:  item.OrderID,
+			SkuId:    item.SkuID,
+			Quantity: item.Quantity,
+			Status:   uint32(item.Status),
+		}
+		if item.ExpiresAt.Valid {
+			info.ExpiresAt = item.ExpiresAt.Time.Format("2006-01-02 15:04:05")
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// TryDeductSku TCC Try阶段
+func (appService *ProductApplicationService) TryDeductSku(ctx context.Context, req *dto.ReserveStockDto) error {
+	return appService.stockTccService.Try(ctx, req)
+}
+
+// ConfirmDeductSku TCC Confirm阶段
+func (appService *ProductApplicationService) ConfirmDeductSku(ctx context.Context, orderId int64) error {
+	return appService.stockTccService.Confirm(ctx, orderId)
+}
+
+// CancelDeductSku TCC Cancel阶段
+func (appService *ProductApplicationService) CancelDeductSku(ctx context.Context, orderId int64) error {
+	return appService.stockTccService.Cancel(ctx, orderId)
+}
+
+// toEventSkuInfo 库存变更结果转换为事件中的SKU信息
+func toEventSkuInfo(item *dto.OrderSkuItemDto) *productEvent.SkuInfo {
+	skuInfo := &productEvent.SkuInfo{
+		Id:         item.SkuID,
+		Quantity:   item.Quantity,
+		Stock:      item.Stock,
+		Threshold:  item.Threshold,
+		Warehouses: make([]*productEvent.WarehouseAllocation, 0, len(item.Warehouses)),
+	}
+	for _, allocation := range item.Warehouses {
+		skuInfo.Warehouses = append(skuInfo.Warehouses, &productEvent.WarehouseAllocation{
+			WarehouseId: allocation.WarehouseID,
+			Quantity:    allocation.Quantity,
+			Stock:       allocation.Stock,
+		})
+	}
+	return skuInfo
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// priceSchedulerLockKey 调价调度锁，多实例部署时同一时间只有一个实例执行到期调价
+const priceSchedulerLockKey = "skupricescheduler"
+
+// SchedulePriceChange 计划调价，立即生效的调价在同一事务内修改价格并发布价格变更事件
+func (appService *ProductApplicationService) SchedulePriceChange(ctx context.Context, req *dto.SchedulePriceChangeDto) (*productProto.SchedulePriceChangeResponse, error) {
+	var change *model.SkuPriceHistory
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var applied []*dto.SkuPriceChangeDto
+		var txErr error
+		change, applied, txErr = appService.skuPriceService.SchedulePriceChange(txCtx, req, time.Now())
+		if txErr != nil {
+			return txErr
+		}
+		return appService.publishSkuPriceChanged(txCtx, applied)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.SchedulePriceChangeResponse{Change: toSkuPriceChangeInfo(change)}, nil
+}
+
+// GetSkuPriceAt 查询SKU在指定时刻的价格
+func (appService *ProductApplicationService) GetSkuPriceAt(ctx context.Context, skuId int64, at string) (*productProto.GetSkuPriceAtResponse, error) {
+	price, err := appService.skuPriceService.GetSkuPriceAt(ctx, skuId, at)
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.GetSkuPriceAtResponse{
+		SkuId:    price.SkuID,
+		Price:    price.Price,
+		ChangeId: price.ChangeID,
+	}
+	if price.MarketPrice != nil {
+		response.MarketPrice = *price.MarketPrice
+	}
+	return response, nil
+}
+
+// ListSkuPriceHistory 分页查询SKU的价格变更记录
+func (appService *ProductApplicationService) ListSkuPriceHistory(ctx context.Context, skuId int64, page, pageSize int32) (*productProto.ListSkuPriceHistoryResponse, error) {
+	changes, total, err := appService.skuPriceService.ListSkuPriceHistory(ctx, skuId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	response := &productProto.ListSkuPriceHistoryResponse{
+		Changes:  make([]*productProto.SkuPriceChangeInfo, 0, len(changes)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for i := range changes {
+		response.Changes = append(response.Changes, toSkuPriceChangeInfo(&changes[i]))
+	}
+	return response, nil
+}
+
+// ApplyScheduledPriceChanges 执行所有到期的调价与恢复原价，由后台协程周期调用
+// 每个SKU在单独的事务内处理，单个SKU失败不影响其他SKU，下一周期重试
+func (appService *ProductApplicationService) ApplyScheduledPriceChanges(ctx context.Context) error {
+	conf := appService.serviceContext.Conf.Pricing
+	lock := appService.serviceContext.LockManager.NewLock(priceSchedulerLockKey, conf.ScheduleInterval)
+	if err := lock.TryLock(ctx); err != nil {
+		logger.Info("price scheduler is running on another instance, skipped")
+		return nil
+	}
+	defer func() {
+		if err := lock.UnLock(ctx); err != nil {
+			logger.Error("failed to unlock: ", lock.GetKey(), " reason: ", err)
+		}
+	}()
+
+	now := time.Now()
+	var cursor int64
+	var applied int
+	for ctx.Err() == nil {
+		skuIds, err := appService.skuPriceService.ListDueSkuIDs(ctx, now, cursor, conf.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, skuId := range skuIds {
+			var changes []*dto.SkuPriceChangeDto
+			err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+				var txErr error
+				changes, txErr = appService.skuPriceService.ApplyDueChanges(txCtx, skuId, now)
+				if txErr != nil {
+					return txErr
+				}
+				return appService.publishSkuPriceChanged(txCtx, changes)
+			})
+			if err != nil {
+				logger.Error("failed to apply price changes of sku ", skuId, ": ", err.Error())
+				continue
+			}
+			applied += len(changes)
+		}
+		if len(skuIds) < conf.BatchSize {
+			break
+		}
+		cursor = skuIds[len(skuIds)-1]
+	}
+	if applied > 0 {
+		logger.Info("applied ", applied, " scheduled price changes")
+	}
+	return nil
+}
+
+// publishSkuPriceChanged 按生效顺序逐条发布价格变更事件，以SKU ID为键保证同一SKU的事件有序
+func (appService *ProductApplicationService) publishSkuPriceChanged(txCtx context.Context, changes []*dto.SkuPriceChangeDto) error {
+	for _, change := range changes {
+		changedEvent := productEvent.OnSkuPriceChanged{
+			ChangeId:      change.ChangeID,
+			SkuId:         change.SkuID,
+			ProductId:     change.ProductID,
+			ChangeType:    int32(change.ChangeType),
+			Price:         change.Price,
+			PreviousPrice: change.PreviousPrice,
+			AppliedAt:     change.AppliedAt.Format("2006-01-02 15:04:05"),
+			OperatorId:    change.OperatorID,
+		}
+		if change.MarketPrice != nil {
+			changedEvent.MarketPrice = *change.MarketPrice
+		}
+		if change.PreviousMarketPrice != nil {
+			changedEvent.PreviousMarketPrice = *change.PreviousMarketPrice
+		}
+		if change.EffectiveTo != nil {
+			changedEvent.EffectiveTo = change.EffectiveTo.Format("2006-01-02 15:04:05")
+		}
+		err := appService.publishEvent(txCtx, productEventTopic, &changedEvent, strconv.FormatInt(change.SkuID, 10), "OnSkuPriceChanged")
+		if err != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+err.Error())
+		}
+	}
+	return nil
+}
+
+// toSkuPriceChangeInfo 转换价格变更记录
+func toSkuPriceChangeInfo(change *model.SkuPriceHistory) *productProto.SkuPriceChangeInfo {
+	info := &productProto.SkuPriceChangeInfo{
+		Id:            change.ID,
+		SkuId:         change.SkuID,
+		ChangeType:    int32(change.ChangeType),
+		Price:         change.Price,
+		PreviousPrice: change.PreviousPrice,
+		EffectiveFrom: change.EffectiveFrom.Format("2006-01-02 15:04:05"),
+		Status:        int32(change.Status),
+		RevertOf:      change.RevertOf,
+		OperatorId:    change.OperatorID,
+		Reason:        change.Reason,
+		CreatedAt:     change.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if change.MarketPrice != nil {
+		info.MarketPrice = *change.MarketPrice
+	}
+	if change.PreviousMarketPrice != nil {
+		info.PreviousMarketPrice = *change.PreviousMarketPrice
+	}
+	if change.EffectiveTo != nil {
+		info.EffectiveTo = change.EffectiveTo.Format("2006-01-02 15:04:05")
+	}
+	if change.AppliedAt != nil {
+		info.AppliedAt = change.AppliedAt.Format("2006-01-02 15:04:05")
+	}
+	return info
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ApproveRestockApply 审核通过补货申请，并在同一事务内写入OnRestockApproved事件
+func (appService *ProductApplicationService) ApproveRestockApply(ctx context.Context, applicationNo string, auditUserID uint32) (*productProto.ApproveRestockApplyResponse, error) {
+	var record *model.SkuRestockRecord
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		record, txErr = appService.skuRestockService.ApproveRestockApply(txCtx, applicationNo, auditUserID)
+		if txErr != nil {
+			return txErr
+		}
+		approvedEvent := productEvent.OnRestockApproved{
+			RestockId:     record.ID,
+			ApplicationNo: record.ApplicationNo,
+			SkuId:         int64(record.SkuID),
+			Quantity:      record.Quantity,
+			AuditUserId:   auditUserID,
+		}
+		if txErr = appService.publishEvent(txCtx, productEventTopic, &approvedEvent, record.ApplicationNo, "OnRestockApproved"); txErr != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+txErr.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.ApproveRestockApplyResponse{
+		RestockRecord: toRestockRecordInfo(record),
+		Audit:         toRestockAuditInfo(&record.Audits[0]),
+	}, nil
+}
+
+// RejectRestockApply 驳回补货申请，并在同一事务内写入OnRestockRejected事件
+func (appService *ProductApplicationService) RejectRestockApply(ctx context.Context, applicationNo string, auditUserID uint32, reason string) (*productProto.RejectRestockApplyResponse, error) {
+	var record *model.SkuRestockRecord
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		record, txErr = appService.skuRestockService.RejectRestockApply(txCtx, applicationNo, auditUserID, reason)
+		if txErr != nil {
+			return txErr
+		}
+		rejectedEvent := productEvent.OnRestockRejected{
+			RestockId:     record.ID,
+			ApplicationNo: record.ApplicationNo,
+			SkuId:         int64(record.SkuID),
+			Quantity:      record.Quantity,
+			AuditUserId:   auditUserID,
+			Reason:        record.FailedReason,
+		}
+		if txErr = appService.publishEvent(txCtx, productEventTopic, &rejectedEvent, record.ApplicationNo, "OnRestockRejected"); txErr != nil {
+			return status.Error(codes.Aborted, "failed to publish event error: "+txErr.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.RejectRestockApplyResponse{
+		RestockRecord: toRestockRecordInfo(record),
+		Audit:         toRestockAuditInfo(&record.Audits[0]),
+	}, nil
+}
+
+// toRestockRecordInfo 转换补货记录
+func toRestockRecordInfo(record *model.SkuRestockRecord) *productProto.RestockRecordInfo {
+	info := &productProto.RestockRecordInfo{
+		Id:            record.ID,
+		UserId:        int32(record.UserID),
+		SkuId:         record.SkuID,
+		Quantity:      record.Quantity,
+		Reason:        record.Reason,
+		Status:        uint32(record.Status),
+		FailedReason:  record.FailedReason,
+		ApplicationNo: record.ApplicationNo,
+	}
+	if record.CreatedAt.Valid {
+		info.CreatedAt = record.CreatedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return info
+}
+
+// toRestockAuditInfo 转换补货审核记录
+func toRestockAuditInfo(audit *model.SkuRestockAudit) *productProto.RestockAuditInfo {
+	return &productProto.RestockAuditInfo{
+		Id:                audit.ID,
+		RestockId:         int64(audit.RestockID),
+		AuditUserId:       uint32(audit.AuditUserID),
+		AuditStatus:       uint32(audit.AuditStatus),
+		AuditFailedReason: audit.AuditFailedReason,
+		CreatedAt:         audit.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:         audit.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
+	productProto "github.com/zhanshen02154/product/proto/product"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdjustStock 手动调整SKU库存，事务内发布库存调整事件
+func (appService *ProductApplicationService) AdjustStock(ctx context.Context, req *dto.AdjustStockDto) (*productProto.AdjustStockResponse, error) {
+	var result *dto.StockAdjustResultDto
+	err := appService.executeWithSkuStockLocks(ctx, []int64{req.SkuID}, func(txCtx context.Context) error {
+		var txErr error
+		result, txErr = appService.stockAdjustmentService.AdjustStock(txCtx, req)
+		if txErr != nil {
+			return txErr
+		}
+		return appService.publishStockAdjusted(txCtx, []*dto.StockAdjustResultDto{result}, req.Reason, req.OperatorID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.AdjustStockResponse{Result: toStockAdjustResult(result)}, nil
+}
+
+// SubmitStocktake 提交盘点结果，所有SKU的差异在同一事务内调整
+func (appService *ProductApplicationService) SubmitStocktake(ctx context.Context, req *dto.StocktakeDto) (*productProto.SubmitStocktakeResponse, error) {
+	skuIds := make([]int64, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item != nil {
+			skuIds = append(skuIds, item.SkuID)
+		}
+	}
+	var results []*dto.StockAdjustResultDto
+	err := appService.executeWithSkuStockLocks(ctx, skuIds, func(txCtx context.Context) error {
+		var txErr error
+		results, txErr = appService.stockAdjustmentService.SubmitStocktake(txCtx, req)
+		if txErr != nil {
+			return txErr
+		}
+		return appService.publishStockAdjusted(txCtx, results, req.Reason, req.OperatorID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.SubmitStocktakeResponse{
+		Results: make([]*productProto.StockAdjustResult, 0, len(results)),
+	}
+	for _, result := range results {
+		response.Results = append(response.Results, toStockAdjustResult(result))
+	}
+	return response, nil
+}
+
+// publishStockAdjusted 发布库存调整事件，库存未变化的SKU不包含在事件中
+func (appService *ProductApplicationService) publishStockAdjusted(txCtx context.Context, results []*dto.StockAdjustResultDto, reason string, operatorId int64) error {
+	adjustedEvent := productEvent.OnStockAdjusted{
+		Reason:     reason,
+		OperatorId: operatorId,
+		Sku:        make([]*productEvent.SkuInfo, 0, len(results)),
+	}
+	for _, result := range results {
+		if result.Delta == 0 {
+			continue
+		}
+		quantity := result.Delta
+		if quantity < 0 {
+			quantity = -quantity
+		}
+		adjustedEvent.Sku = append(adjustedEvent.Sku, &productEvent.SkuInfo{
+			Id:        result.SkuID,
+			Quantity:  uint32(quantity),
+			Stock:     result.AfterStock,
+			Threshold: result.Threshold,
+			Warehouses: []*productEvent.WarehouseAllocation{{
+				WarehouseId: result.WarehouseID,
+				Quantity:    uint32(quantity),
+				Stock:       result.WarehouseStock,
+			}},
+		})
+	}
+	if len(adjustedEvent.Sku) == 0 {
+		return nil
+	}
+	err := appService.publishEvent(txCtx, productEventTopic, &adjustedEvent, strconv.FormatInt(adjustedEvent.Sku[0].Id, 10), "OnStockAdjusted")
+	if err != nil {
+		return status.Error(codes.Aborted, "failed to publish event error: "+err.Error())
+	}
+	return nil
+}
+
+// executeWithSkuStockLocks 加SKU库存锁后在事务内执行
+// ctx已在事务中时（如消费端幂等中间件开启的事务），本次执行只是保存点，锁在最外层事务结束后释放，
+// 避免其他请求在提交前拿到锁、读到未提交的库存
+func (appService *ProductApplicationService) executeWithSkuStockLocks(ctx context.Context, skuIds []int64, fn func(txCtx context.Context) error) error {
+	unlock, err := lockSkuStocks(ctx, appService.serviceContext.LockManager, skuIds)
+	if err != nil {
+		return err
+	}
+	if !transaction.AfterCompletion(ctx, unlock) {
+		defer unlock()
+	}
+	return appService.serviceContext.TxManager.Execute(ctx, fn)
+}
+
+// lockSkuStocks 按SKU ID升序逐个加库存锁，任一锁获取失败时释放已获取的锁
+// 所有变更SKU库存的入口都须先加锁，返回的函数按加锁的逆序释放
+func lockSkuStocks(ctx context.Context, lockManager infrastructure.LockManager, skuIds []int64) (func(), error) {
+	sorted := make([]int64, 0, len(skuIds))
+	seen := make(map[int64]struct{}, len(skuIds))
+	for _, id := range skuIds {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	locks := make([]infrastructure.DistributedLock, 0, len(sorted))
+	unlock := func() {
+		for i := len(locks) - 1; i >= 0; i-- {
+			if err := locks[i].UnLock(ctx); err != nil {
+				logger.Error("failed to unlock: ", locks[i].GetKey(), " reason: ", err)
+			}
+		}
+	}
+	for _, id := range sorted {
+		lock := lockManager.NewLock(skuStockLockKey(id), 15)
+		if err := lock.TryLock(ctx); err != nil {
+			unlock()
+			return nil, status.Error(codes.Aborted, "stock of sku "+strconv.FormatInt(id, 10)+" is being processed")
+		}
+		locks = append(locks, lock)
+	}
+	return unlock, nil
+}
+
+// skuStockLockKey SKU库存锁的键
+func skuStockLockKey(skuId int64) string {
+	return "skustock-" + strconv.FormatInt(skuId, 10)
+}
+
+// toStockAdjustResult 转换库存调整结果
+func toStockAdjustResult(result *dto.StockAdjustResultDto) *productProto.StockAdjustResult {
+	return &productProto.StockAdjustResult{
+		SkuId:          result.SkuID,
+		Delta:          result.Delta,
+		BeforeStock:    result.BeforeStock,
+		AfterStock:     result.AfterStock,
+		WarehouseId:    result.WarehouseID,
+		WarehouseStock: result.WarehouseStock,
+	}
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+)
+
+// ListStockChanges 游标分页查询库存流水
+func (appService *ProductApplicationService) ListStockChanges(ctx context.Context, req *dto.ListStockChangesDto) (*productProto.ListStockChangesResponse, error) {
+	records, nextCursor, err := appService.stockLedgerService.ListStockChanges(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.ListStockChangesResponse{
+		Records:    make([]*productProto.StockChangeInfo, 0, len(records)),
+		NextCursor: nextCursor,
+	}
+	for i := range records {
+		response.Records = append(response.Records, toStockChangeInfo(&records[i]))
+	}
+	return response, nil
+}
+
+// GetStockAtTime 根据库存流水推算SKU在指定时刻的库存
+func (appService *ProductApplicationService) GetStockAtTime(ctx context.Context, skuId int64, at string) (*productProto.GetStockAtTimeResponse, error) {
+	result, err := appService.stockLedgerService.GetStockAtTime(ctx, skuId, at)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetStockAtTimeResponse{
+		SkuId:    result.SkuID,
+		Stock:    result.Stock,
+		RecordId: result.RecordID,
+	}, nil
+}
+
+// toStockChangeInfo 转换库存变更记录
+func toStockChangeInfo(record *model.InventoryStockChangeRecord) *productProto.StockChangeInfo {
+	info := &productProto.StockChangeInfo{
+		Id:              record.ID,
+		OrderId:         record.OrderID,
+		PurchaseOrderId: record.PurchaseOrderID,
+		SkuId:           record.SkuID,
+		SourceType:      record.SourceType,
+		Quantity:        record.Quantity,
+		BeforeStock:     record.BeforeStock,
+		AfterStock:      record.AfterStock,
+		OperatorId:      record.OperatorID,
+		Reason:          record.Reason,
+	}
+	if record.CreatedAt.Valid {
+		info.CreatedAt = record.CreatedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	return info
+}
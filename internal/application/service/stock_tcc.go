@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StockTccService 库存扣减的TCC分支，各阶段与预占接口一样先加订单锁和SKU库存锁，再在子事务屏障内执行
+type StockTccService struct {
+	txManager          transaction.TransactionManager
+	lockManager        infrastructure.LockManager
+	reservationService service.IStockReservationService
+}
+
+// NewStockTccService 创建库存扣减TCC分支
+func NewStockTccService(txManager transaction.TransactionManager, lockManager infrastructure.LockManager, reservationService service.IStockReservationService) *StockTccService {
+	return &StockTccService{txManager: txManager, lockManager: lockManager, reservationService: reservationService}
+}
+
+// Try 预占库存，预占不过期，由Confirm/Cancel结束
+func (s *StockTccService) Try(ctx context.Context, req *dto.ReserveStockDto) error {
+	if req.OrderID == 0 || len(req.Items) == 0 {
+		return status.Error(codes.Aborted, "order_id or items cannot be empty")
+	}
+	skuIds := func(ctx context.Context, orderId int64) ([]int64, error) {
+		ids := make([]int64, 0, len(req.Items))
+		for _, item := range req.Items {
+			if item != nil {
+				ids = append(ids, item.SkuID)
+			}
+		}
+		return ids, nil
+	}
+	err := s.executeWithLocks(ctx, req.OrderID, skuIds, func(txCtx context.Context) error {
+		_, txErr := s.reservationService.ReserveStock(txCtx, req.OrderID, req.Items, sql.NullTime{})
+		return txErr
+	})
+	return toTccError(err)
+}
+
+// Confirm 确认预占并计入销量
+func (s *StockTccService) Confirm(ctx context.Context, orderId int64) error {
+	return s.executeWithLocks(ctx, orderId, s.reservationService.FindReservedSkuIds, func(txCtx context.Context) error {
+		_, txErr := s.reservationService.ConfirmReservation(txCtx, orderId)
+		return txErr
+	})
+}
+
+// Cancel 释放预占
+// 空补偿与悬挂由子事务屏障处理，屏障之外未找到预占时视为无需补偿
+func (s *StockTccService) Cancel(ctx context.Context, orderId int64) error {
+	return s.executeWithLocks(ctx, orderId, s.reservationService.FindReservedSkuIds, func(txCtx context.Context) error {
+		_, txErr := s.reservationService.ReleaseReservation(txCtx, orderId)
+		if status.Code(txErr) == codes.NotFound {
+			return nil
+		}
+		return txErr
+	})
+}
+
+// executeWithLocks 加订单锁，再对涉及的SKU加库存锁后在子事务屏障内执行，屏障事务提交后释放锁
+// 锁被占用时返回Unavailable，由DTM重试，不作为业务失败回滚全局事务
+func (s *StockTccService) executeWithLocks(ctx context.Context, orderId int64, skuIds func(ctx context.Context, orderId int64) ([]int64, error), fn func(txCtx context.Context) error) error {
+	unlockOrder, err := lockOrderReservation(ctx, s.lockManager, orderId)
+	if err != nil {
+		return status.Error(codes.Unavailable, status.Convert(err).Message())
+	}
+	defer unlockOrder()
+	ids, err := skuIds(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	unlock, err := lockSkuStocks(ctx, s.lockManager, ids)
+	if err != nil {
+		return status.Error(codes.Unavailable, status.Convert(err).Message())
+	}
+	defer unlock()
+	return s.txManager.ExecuteWithBarrier(ctx, fn)
+}
+
+// toTccError 业务失败转为Aborted，DTM收到Aborted时回滚全局事务，其余错误会被重试
+func toTccError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition:
+		return status.Error(codes.Aborted, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	productProto "github.com/zhanshen02154/product/proto/product"
+)
+
+// CreateSupplier 创建供应商
+func (appService *ProductApplicationService) CreateSupplier(ctx context.Context, req *dto.SupplierInputDto) (*productProto.CreateSupplierResponse, error) {
+	var supplier *model.Supplier
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		supplier, txErr = appService.supplierService.CreateSupplier(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.CreateSupplierResponse{Supplier: toSupplierDTO(supplier)}, nil
+}
+
+// UpdateSupplier 更新供应商
+func (appService *ProductApplicationService) UpdateSupplier(ctx context.Context, id int64, req *dto.SupplierInputDto) (*productProto.UpdateSupplierResponse, error) {
+	var supplier *model.Supplier
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		supplier, txErr = appService.supplierService.UpdateSupplier(txCtx, id, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.UpdateSupplierResponse{Supplier: toSupplierDTO(supplier)}, nil
+}
+
+// GetSupplier 获取供应商
+func (appService *ProductApplicationService) GetSupplier(ctx context.Context, id int64) (*productProto.GetSupplierResponse, error) {
+	supplier, err := appService.supplierService.GetSupplier(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetSupplierResponse{Supplier: toSupplierDTO(supplier)}, nil
+}
+
+// ListSuppliers 分页查询供应商
+func (appService *ProductApplicationService) ListSuppliers(ctx context.Context, keyword string, page, pageSize int32) (*productProto.ListSuppliersResponse, error) {
+	suppliers, total, err := appService.supplierService.ListSuppliers(ctx, keyword, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	response := &productProto.ListSuppliersResponse{
+		Suppliers: make([]*productProto.SupplierDTO, 0, len(suppliers)),
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	for i := range suppliers {
+		response.Suppliers = append(response.Suppliers, toSupplierDTO(&suppliers[i]))
+	}
+	return response, nil
+}
+
+// DeleteSupplier 删除供应商
+func (appService *ProductApplicationService) DeleteSupplier(ctx context.Context, id int64) error {
+	return appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		return appService.supplierService.DeleteSupplier(txCtx, id)
+	})
+}
+
+// LinkSupplierSku 关联供应商与SKU，首选标记的切换在同一事务内完成
+func (appService *ProductApplicationService) LinkSupplierSku(ctx context.Context, req *dto.LinkSupplierSkuDto) (*productProto.LinkSupplierSkuResponse, error) {
+	var item *repository.SupplierSkuInfo
+	err := appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		var txErr error
+		item, txErr = appService.supplierService.LinkSku(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.LinkSupplierSkuResponse{Item: toSupplierSkuItem(req.SupplierID, item)}, nil
+}
+
+// UnlinkSupplierSku 解除供应商与SKU的关联
+func (appService *ProductApplicationService) UnlinkSupplierSku(ctx context.Context, supplierId int64, skuId int64) error {
+	return appService.serviceContext.TxManager.Execute(ctx, func(txCtx context.Context) error {
+		return appService.supplierService.UnlinkSku(txCtx, supplierId, skuId)
+	})
+}
+
+// ListSkusBySupplier 分页查询供应商供应的SKU
+func (appService *ProductApplicationService) ListSkusBySupplier(ctx context.Context, supplierId int64, page, pageSize int32) (*productProto.ListSkusBySupplierResponse, error) {
+	skus, total, err := appService.supplierService.ListSkusBySupplier(ctx, supplierId, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	response := &productProto.ListSkusBySupplierResponse{
+		Skus:     make([]*productProto.SupplierSkuItem, 0, len(skus)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, item := range skus {
+		response.Skus = append(response.Skus, toSupplierSkuItem(supplierId, item))
+	}
+	return response, nil
+}
+
+// toSupplierDTO 转换供应商信息
+func toSupplierDTO(supplier *model.Supplier) *productProto.SupplierDTO {
+	return &productProto.SupplierDTO{
+		Id:            supplier.ID,
+		Name:          supplier.Name,
+		ContactPerson: supplier.ContactPerson,
+		Phone:         supplier.Phone,
+		Email:         supplier.Email,
+		Address:       supplier.Address,
+		Rating:        supplier.Rating,
+		LeadTimeDays:  int32(supplier.LeadTimeDays),
+		PaymentTerms:  supplier.PaymentTerms,
+	}
+}
+
+// toSupplierSkuItem 转换供应商供应的SKU
+func toSupplierSkuItem(supplierId int64, item *repository.SupplierSkuInfo) *productProto.SupplierSkuItem {
+	return &productProto.SupplierSkuItem{
+		SupplierId:       supplierId,
+		SkuId:            item.SkuID,
+		SkuNo:            item.SkuNo,
+		SkuName:          item.SkuName,
+		SpecValueText:    item.SpecValueText,
+		Price:            item.Price,
+		Stock:            item.Stock,
+		Status:           int32(item.Status),
+		SupplyPrice:      item.SupplyPrice,
+		MinOrderQuantity: int32(item.MinOrderQuantity),
+		IsPreferred:      item.IsPreferred,
+	}
+}
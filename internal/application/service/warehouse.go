@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	productProto "github.com/zhanshen02154/product/proto/product"
+)
+
+// CreateWarehouse 创建仓库
+func (appService *ProductApplicationService) CreateWarehouse(ctx context.Context, req *dto.WarehouseInputDto) (*productProto.CreateWarehouseResponse, error) {
+	warehouse, err := appService.warehouseService.CreateWarehouse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.CreateWarehouseResponse{Warehouse: toWarehouseInfo(warehouse)}, nil
+}
+
+// ListWarehouses 查询仓库列表
+func (appService *ProductApplicationService) ListWarehouses(ctx context.Context) (*productProto.ListWarehousesResponse, error) {
+	warehouses, err := appService.warehouseService.ListWarehouses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := &productProto.ListWarehousesResponse{
+		Warehouses: make([]*productProto.WarehouseInfo, 0, len(warehouses)),
+	}
+	for i := range warehouses {
+		response.Warehouses = append(response.Warehouses, toWarehouseInfo(&warehouses[i]))
+	}
+	return response, nil
+}
+
+// SetSkuWarehouseStock 设置SKU分仓库存，与库存调整共用SKU库存锁
+func (appService *ProductApplicationService) SetSkuWarehouseStock(ctx context.Context, req *dto.SetSkuWarehouseStockDto) (*productProto.SetSkuWarehouseStockResponse, error) {
+	var result *dto.SkuWarehouseStockDto
+	err := appService.executeWithSkuStockLocks(ctx, []int64{req.SkuID}, func(txCtx context.Context) error {
+		var txErr error
+		result, txErr = appService.warehouseService.SetSkuWarehouseStock(txCtx, req)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.SetSkuWarehouseStockResponse{Stock: toSkuWarehouseStockInfo(result)}, nil
+}
+
+// GetSkuWarehouseStock 查询SKU可售总库存及分仓库存
+func (appService *ProductApplicationService) GetSkuWarehouseStock(ctx context.Context, skuId int64) (*productProto.GetSkuWarehouseStockResponse, error) {
+	result, err := appService.warehouseService.GetSkuWarehouseStock(ctx, skuId)
+	if err != nil {
+		return nil, err
+	}
+	return &productProto.GetSkuWarehouseStockResponse{Stock: toSkuWarehouseStockInfo(result)}, nil
+}
+
+// toWarehouseInfo 转换仓库信息
+func toWarehouseInfo(warehouse *model.Warehouse) *productProto.WarehouseInfo {
+	return &productProto.WarehouseInfo{
+		Id:        warehouse.ID,
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Priority:  warehouse.Priority,
+		Latitude:  warehouse.Latitude,
+		Longitude: warehouse.Longitude,
+		Status:    int32(warehouse.Status),
+		CreatedAt: warehouse.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// toSkuWarehouseStockInfo 转换SKU分仓库存
+func toSkuWarehouseStockInfo(result *dto.SkuWarehouseStockDto) *productProto.SkuWarehouseStockInfo {
+	info := &productProto.SkuWarehouseStockInfo{
+		SkuId:          result.SkuID,
+		TotalAvailable: result.TotalAvailable,
+		WarehouseTotal: result.WarehouseTotal,
+		Warehouses:     make([]*productProto.WarehouseStockItem, 0, len(result.Warehouses)),
+	}
+	for _, item := range result.Warehouses {
+		info.Warehouses = append(info.Warehouses, &productProto.WarehouseStockItem{
+			WarehouseId: item.WarehouseID,
+			Code:        item.Code,
+			Name:        item.Name,
+			Status:      int32(item.Status),
+			Stock:       item.Stock,
+		})
+	}
+	return info
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Shopify/sarama"
-	"github.com/go-micro/plugins/v4/broker/kafka"
 	grpcclient "github.com/go-micro/plugins/v4/client/grpc"
 	"github.com/go-micro/plugins/v4/wrapper/trace/opentelemetry"
 	appservice "github.com/zhanshen02154/product/internal/application/service"
@@ -15,6 +13,7 @@ import (
 	"github.com/zhanshen02154/product/internal/infrastructure/event"
 	"github.com/zhanshen02154/product/internal/infrastructure/event/monitor"
 	"github.com/zhanshen02154/product/internal/infrastructure/event/wrapper"
+	"github.com/zhanshen02154/product/internal/infrastructure/worker"
 	"github.com/zhanshen02154/product/internal/intefaces/handler"
 	"github.com/zhanshen02154/product/internal/intefaces/subscriber"
 	"github.com/zhanshen02154/product/proto/product"
@@ -33,14 +32,23 @@ func RunService(conf *config.SysConfig, serviceContext *infrastructure.ServiceCo
 
 	// New Service
 	var eb event.Listener
+	var outboxRelay *event.OutboxRelay
+	var deadLetterIndexer *event.DeadLetterIndexer
+	var keyedConsumer *event.KeyedConsumer
+	var reservationExpirer *worker.PeriodicWorker
+	var stockReconciler *worker.PeriodicWorker
+	var priceScheduler *worker.PeriodicWorker
+	var productPublisher *worker.PeriodicWorker
+	var processedEventCleaner *worker.PeriodicWorker
+	outboxRepo := serviceContext.NewOutboxEventRepository()
 	client := grpcclient.NewClient(
 		grpcclient.PoolMaxIdle(100),
 	)
-	// 为 AsyncProducer 准备 channels，并把它们传给 kafka 插件
-	// 使用与 Kafka 配置中相同的缓冲，减少短时写阻塞风险
-	successChan := make(chan *sarama.ProducerMessage, conf.Broker.Kafka.ChannelBufferSize)
-	errorChan := make(chan *sarama.ProducerError, conf.Broker.Kafka.ChannelBufferSize)
-	broker := infrastructure.NewKafkaBroker(conf.Broker.Kafka, kafka.AsyncProducer(errorChan, successChan))
+	// 按配置的驱动创建Broker，发布回执统一进入侦听器的回调链
+	broker, ackOption, err := infrastructure.NewBroker(conf.Broker)
+	if err != nil {
+		return err
+	}
 	broker2.DefaultBroker = broker
 	logWrapper := infrastructure.NewLogWrapper(
 		infrastructure.WithZapLogger(zapLogger),
@@ -59,6 +67,34 @@ func RunService(conf *config.SysConfig, serviceContext *infrastructure.ServiceCo
 			if eb != nil {
 				eb.Start()
 			}
+			if outboxRelay != nil {
+				outboxRelay.Start()
+			}
+			if deadLetterIndexer != nil {
+				if err := deadLetterIndexer.Start(); err != nil {
+					logger.Error("failed to start dead letter indexer: " + err.Error())
+				}
+			}
+			if keyedConsumer != nil {
+				if err := keyedConsumer.Start(); err != nil {
+					logger.Error("failed to start keyed consumer: " + err.Error())
+				}
+			}
+			if reservationExpirer != nil {
+				reservationExpirer.Start()
+			}
+			if stockReconciler != nil {
+				stockReconciler.Start()
+			}
+			if priceScheduler != nil {
+				priceScheduler.Start()
+			}
+			if productPublisher != nil {
+				productPublisher.Start()
+			}
+			if processedEventCleaner != nil {
+				processedEventCleaner.Start()
+			}
 			return nil
 		}),
 		micro.BeforeStop(func() error {
@@ -75,6 +111,46 @@ func RunService(conf *config.SysConfig, serviceContext *infrastructure.ServiceCo
 			} else {
 				logger.Info("Successfully closed monitor servers")
 			}
+			if reservationExpirer != nil {
+				if err := reservationExpirer.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close reservation expirer: " + err.Error())
+				}
+			}
+			if stockReconciler != nil {
+				if err := stockReconciler.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close stock reconciler: " + err.Error())
+				}
+			}
+			if priceScheduler != nil {
+				if err := priceScheduler.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close price scheduler: " + err.Error())
+				}
+			}
+			if productPublisher != nil {
+				if err := productPublisher.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close product publisher: " + err.Error())
+				}
+			}
+			if processedEventCleaner != nil {
+				if err := processedEventCleaner.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close processed event cleaner: " + err.Error())
+				}
+			}
+			if keyedConsumer != nil {
+				if err := keyedConsumer.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close keyed consumer: " + err.Error())
+				}
+			}
+			if deadLetterIndexer != nil {
+				if err := deadLetterIndexer.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close dead letter indexer: " + err.Error())
+				}
+			}
+			if outboxRelay != nil {
+				if err := outboxRelay.Close(shutdownCtx); err != nil {
+					logger.Error("failed to close outbox relay: " + err.Error())
+				}
+			}
 			serviceContext.Close()
 			return nil
 		}),
@@ -95,10 +171,11 @@ func RunService(conf *config.SysConfig, serviceContext *infrastructure.ServiceCo
 
 	// 注册应用层服务及事件侦听器
 	eb = event.NewListener(
-		event.WithProducerChannels(successChan, errorChan),
+		ackOption,
 		event.WithServiceName(conf.Service.Name),
 		event.WithServiceVersion(conf.Service.Version),
 		event.WrapPublishCallback(
+			event.NewOutboxWrapper(outboxRepo, conf.Broker.Outbox.MaxRetries),
 			event.NewTracerWrapper(event.WithTracerProvider(otel.GetTracerProvider())),
 			event.NewDeadletterWrapper(event.WithTracer(otel.GetTracerProvider()), event.WithServiceInfo(conf.Service)),
 			event.NewPublicCallbackLogWrapper(
@@ -108,10 +185,49 @@ func RunService(conf *config.SysConfig, serviceContext *infrastructure.ServiceCo
 		),
 	)
 	event.RegisterPublisher(conf.Broker, eb, service.Client())
+	outboxRelay = event.NewOutboxRelay(outboxRepo, serviceContext.TxManager, eb,
+		event.WithOutboxPollInterval(time.Duration(conf.Broker.Outbox.PollInterval)*time.Millisecond),
+		event.WithOutboxBatchSize(conf.Broker.Outbox.BatchSize),
+		event.WithOutboxMaxRetries(conf.Broker.Outbox.MaxRetries),
+		event.WithOutboxPublishTimeout(time.Duration(conf.Broker.Outbox.PublishTimeout)*time.Millisecond),
+		event.WithOutboxRetention(time.Duration(conf.Broker.Outbox.RetentionHours)*time.Hour),
+	)
 	productService := appservice.NewProductApplicationService(serviceContext, eb)
+	reservationExpirer = worker.NewPeriodicWorker("reservation-expirer",
+		time.Duration(conf.Reservation.ExpireInterval)*time.Second,
+		productService.ReleaseExpiredReservations,
+	)
+	stockReconciler = worker.NewPeriodicWorker("stock-reconciler",
+		time.Duration(conf.Reconciliation.Interval)*time.Second,
+		productService.ReconcileInventory,
+	)
+	priceScheduler = worker.NewPeriodicWorker("price-scheduler",
+		time.Duration(conf.Pricing.ScheduleInterval)*time.Second,
+		productService.ApplyScheduledPriceChanges,
+	)
+	productPublisher = worker.NewPeriodicWorker("product-publisher",
+		time.Duration(conf.Publishing.ScheduleInterval)*time.Second,
+		productService.PublishScheduledProducts,
+	)
 
-	// 创建事件分发器
+	// 创建事件分发器，中间件依次为panic恢复、耗时统计、超时、重试、按事件ID幂等
 	eventDispatcher := event.NewEventDispatcher()
+	processedEventRepo := serviceContext.NewProcessedEventRepository()
+	eventTimeouts := make(map[string]time.Duration, len(conf.Broker.HandlerTimeout.EventTypes))
+	for eventType, timeout := range conf.Broker.HandlerTimeout.EventTypes {
+		eventTimeouts[eventType] = time.Duration(timeout) * time.Millisecond
+	}
+	eventDispatcher.Use(
+		event.NewRecoveryMiddleware(),
+		event.NewMetricsMiddleware(),
+		event.NewTimeoutMiddleware(time.Duration(conf.Broker.HandlerTimeout.Default)*time.Millisecond, eventTimeouts),
+		event.NewRetryMiddleware(serviceContext.RetryPolicy),
+		event.NewIdempotencyMiddleware(processedEventRepo, serviceContext.TxManager),
+	)
+	processedEventCleaner = event.NewProcessedEventCleaner(processedEventRepo,
+		time.Duration(conf.Broker.Idempotency.CleanupInterval)*time.Second,
+		time.Duration(conf.Broker.Idempotency.RetentionHours)*time.Hour,
+	)
 
 	// 注册支付事件处理器
 	paymentEventHandler := subscriber.NewPaymentEventHandler(productService)
@@ -126,12 +242,35 @@ func RunService(conf *config.SysConfig, serviceContext *infrastructure.ServiceCo
 		logger.Warn("PaymentEventHandler does not implement RegisterToDispatcher, using legacy method")
 	}
 
+	// 注册订单退款/取消事件处理器
+	orderEventHandler := subscriber.NewOrderEventHandler(productService)
+	if dispatcher, ok := interface{}(orderEventHandler).(interface {
+		RegisterToDispatcher(*event.EventDispatcher) error
+	}); ok {
+		if err := dispatcher.RegisterToDispatcher(eventDispatcher); err != nil {
+			return fmt.Errorf("failed to register order event handlers: %w", err)
+		}
+	}
+
+	// 按Pkey并发消费的主题不经过 micro server 订阅，须在注册订阅器之前创建
+	keyedConsumer = event.NewKeyedConsumer(eventDispatcher, broker, conf.Broker.KeyedConsumer.Topics,
+		event.WithKeyedConcurrency(conf.Broker.KeyedConsumer.Concurrency),
+		event.WithKeyedBufferSize(conf.Broker.KeyedConsumer.BufferSize),
+		event.WithKeyedSubscriberWrappers(service.Server().Options().SubWrappers...),
+	)
+
 	// 注册所有订阅器到 micro server
 	if err := eventDispatcher.RegisterSubscribers(service.Server()); err != nil {
 		return fmt.Errorf("failed to register subscribers: %w", err)
 	}
 
-	err := product.RegisterProductHandler(service.Server(), handler.NewProductHandler(productService))
+	// 索引发布和订阅主题的死信
+	deadLetterTopics := append([]string{}, conf.Broker.Publisher...)
+	deadLetterTopics = append(deadLetterTopics, eventDispatcher.GetTopics()...)
+	deadLetterTopics = append(deadLetterTopics, conf.Broker.DeadLetter.Topics...)
+	deadLetterIndexer = event.NewDeadLetterIndexer(serviceContext.NewDeadLetterRepository(), broker, deadLetterTopics, conf.Broker.DeadLetter.ConsumerGroup)
+
+	err = product.RegisterProductHandler(service.Server(), handler.NewProductHandler(productService))
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"github.com/go-micro/plugins/v4/config/source/consul"
+	"github.com/zhanshen02154/product/internal/domain/allocation"
 	"github.com/zhanshen02154/product/pkg/env"
 	"go-micro.dev/v4/config"
 	"go-micro.dev/v4/logger"
@@ -10,13 +11,18 @@ import (
 )
 
 type SysConfig struct {
-	Service     *ServiceInfo `json:"service" yaml:"service"`
-	Database    *MySqlConfig `json:"database" yaml:"database"`
-	Consul      *ConsulInfo  `json:"consul" yaml:"consul"`
-	Transaction *Transaction `yaml:"transaction" json:"transaction"`
-	Broker      *Broker      `json:"broker" yaml:"broker"`
-	Tracer      *Tracer      `json:"tracer" yaml:"tracer"`
-	Redis       *Redis       `json:"redis" yaml:"redis"`
+	Service        *ServiceInfo    `json:"service" yaml:"service"`
+	Database       *MySqlConfig    `json:"database" yaml:"database"`
+	Consul         *ConsulInfo     `json:"consul" yaml:"consul"`
+	Transaction    *Transaction    `yaml:"transaction" json:"transaction"`
+	Broker         *Broker         `json:"broker" yaml:"broker"`
+	Tracer         *Tracer         `json:"tracer" yaml:"tracer"`
+	Redis          *Redis          `json:"redis" yaml:"redis"`
+	Reservation    *Reservation    `json:"reservation" yaml:"reservation"`
+	Reconciliation *Reconciliation `json:"reconciliation" yaml:"reconciliation"`
+	Warehouse      *Warehouse      `json:"warehouse" yaml:"warehouse"`
+	Pricing        *Pricing        `json:"pricing" yaml:"pricing"`
+	Publishing     *Publishing     `json:"publishing" yaml:"publishing"`
 }
 
 type ServiceInfo struct {
@@ -47,6 +53,37 @@ type Redis struct {
 	LockDB         int    `json:"lock_db" yaml:"lock_db"`
 }
 
+// Reservation 库存预占
+type Reservation struct {
+	DefaultTtl     int `json:"default_ttl" yaml:"default_ttl"`
+	ExpireInterval int `json:"expire_interval" yaml:"expire_interval"`
+	BatchSize      int `json:"batch_size" yaml:"batch_size"`
+}
+
+// Reconciliation 库存对账
+type Reconciliation struct {
+	Interval    int  `json:"interval" yaml:"interval"`         // 对账周期（秒）
+	BatchSize   int  `json:"batch_size" yaml:"batch_size"`     // 每批对账的SKU数量
+	AutoCorrect bool `json:"auto_correct" yaml:"auto_correct"` // 是否自动写入修正流水
+}
+
+// Warehouse 分仓
+type Warehouse struct {
+	AllocationStrategy string `json:"allocation_strategy" yaml:"allocation_strategy"` // 订单分仓策略：priority、nearest、split_minimizing
+}
+
+// Pricing 调价
+type Pricing struct {
+	ScheduleInterval int `json:"schedule_interval" yaml:"schedule_interval"` // 检查到期调价的周期（秒）
+	BatchSize        int `json:"batch_size" yaml:"batch_size"`               // 每批处理的SKU数量
+}
+
+// Publishing 定时上架
+type Publishing struct {
+	ScheduleInterval int `json:"schedule_interval" yaml:"schedule_interval"` // 检查定时上架的周期（秒）
+	BatchSize        int `json:"batch_size" yaml:"batch_size"`               // 每批处理的商品数量
+}
+
 // ConsulInfo consul配置信息
 type ConsulInfo struct {
 	Addr             string   `json:"addr" yaml:"addr"`
@@ -75,11 +112,53 @@ type Transaction struct {
 }
 
 type Broker struct {
-	Driver                 string   `json:"driver" yaml:"driver"`
-	Kafka                  *Kafka   `json:"kafka" yaml:"kafka"`
-	Publisher              []string `json:"publisher" yaml:"publisher"`
-	PublishTimeThreshold   int64    `json:"publish_time_threshold" yaml:"publish_time_threshold"`
-	SubscribeSlowThreshold int64    `json:"subscribe_slow_threshold" yaml:"subscribe_slow_threshold"`
+	Driver                 string          `json:"driver" yaml:"driver"` // 消息中间件驱动：kafka（默认）、memory、nats、rabbitmq
+	Kafka                  *Kafka          `json:"kafka" yaml:"kafka"`
+	Memory                 *Memory         `json:"memory" yaml:"memory"`
+	Nats                   *Nats           `json:"nats" yaml:"nats"`
+	RabbitMQ               *RabbitMQ       `json:"rabbitmq" yaml:"rabbitmq"`
+	Publisher              []string        `json:"publisher" yaml:"publisher"`
+	PublishTimeThreshold   int64           `json:"publish_time_threshold" yaml:"publish_time_threshold"`
+	SubscribeSlowThreshold int64           `json:"subscribe_slow_threshold" yaml:"subscribe_slow_threshold"`
+	Outbox                 *Outbox         `json:"outbox" yaml:"outbox"`
+	DeadLetter             *DeadLetter     `json:"dead_letter" yaml:"dead_letter"`
+	Idempotency            *Idempotency    `json:"idempotency" yaml:"idempotency"`
+	HandlerTimeout         *HandlerTimeout `json:"handler_timeout" yaml:"handler_timeout"`
+	KeyedConsumer          *KeyedConsumer  `json:"keyed_consumer" yaml:"keyed_consumer"`
+}
+
+// KeyedConsumer 按Pkey并发消费
+type KeyedConsumer struct {
+	Topics      []string `json:"topics" yaml:"topics"`           // 按Pkey并发消费的主题，默认OrderEvent，配置为空列表时关闭
+	Concurrency int      `json:"concurrency" yaml:"concurrency"` // 同时处理的消息数量上限
+	BufferSize  int      `json:"buffer_size" yaml:"buffer_size"` // 已拉取未确认的消息数量上限
+}
+
+// HandlerTimeout 事件处理器超时
+type HandlerTimeout struct {
+	Default    int64            `json:"default" yaml:"default"`         // 默认超时时间（毫秒）
+	EventTypes map[string]int64 `json:"event_types" yaml:"event_types"` // 按事件类型覆盖的超时时间（毫秒）
+}
+
+// Idempotency 消费端幂等
+type Idempotency struct {
+	CleanupInterval int `json:"cleanup_interval" yaml:"cleanup_interval"` // 清理处理记录的周期（秒）
+	RetentionHours  int `json:"retention_hours" yaml:"retention_hours"`   // 处理记录的保留时间（小时）
+}
+
+// DeadLetter 死信索引
+type DeadLetter struct {
+	Topics        []string `json:"topics" yaml:"topics"`                 // 额外索引的原主题，发布和订阅的主题默认索引
+	ConsumerGroup string   `json:"consumer_group" yaml:"consumer_group"` // 死信索引器的消费者组
+}
+
+// Outbox 事务发件箱
+type Outbox struct {
+	PollInterval   int `json:"poll_interval" yaml:"poll_interval"`
+	BatchSize      int `json:"batch_size" yaml:"batch_size"`
+	MaxRetries     int `json:"max_retries" yaml:"max_retries"`
+	PublishTimeout int `json:"publish_timeout" yaml:"publish_timeout"`
+	RetentionHours int `json:"retention_hours" yaml:"retention_hours"`
 }
 
 type Kafka struct {
@@ -92,6 +171,28 @@ type Kafka struct {
 	ChannelBufferSize int            `json:"channel_buffer_size" yaml:"channel_buffer_size"`
 }
 
+// Memory 进程内Broker，用于本地开发和测试
+type Memory struct {
+	ChannelBufferSize int `json:"channel_buffer_size" yaml:"channel_buffer_size"` // 发布回执通道容量
+}
+
+// Nats NATS JetStream，每个主题对应一个流
+type Nats struct {
+	Hosts             []string `json:"hosts" yaml:"hosts"`
+	MaxAckPending     int      `json:"max_ack_pending" yaml:"max_ack_pending"`         // 每个消费者已投递未确认的消息数量上限
+	AckBufferSize     int      `json:"ack_buffer_size" yaml:"ack_buffer_size"`         // 发布回执通道容量
+	MaxProcessingTime int64    `json:"max_processing_time" yaml:"max_processing_time"` // 消息未确认时服务端重新投递的等待时间（毫秒）
+}
+
+// RabbitMQ 主题以路由键发布到topic类型的交换机，消费者组对应持久化队列
+type RabbitMQ struct {
+	Url               string `json:"url" yaml:"url"`
+	Exchange          string `json:"exchange" yaml:"exchange"`
+	PrefetchCount     int    `json:"prefetch_count" yaml:"prefetch_count"`           // 每个消费者已投递未确认的消息数量上限
+	AckBufferSize     int    `json:"ack_buffer_size" yaml:"ack_buffer_size"`         // 发布回执通道容量
+	MaxProcessingTime int64  `json:"max_processing_time" yaml:"max_processing_time"` // 消息未确认时服务端关闭消费通道并重新入队的等待时间（毫秒）
+}
+
 type KafkaProducer struct {
 	MaxRetry        int `json:"max_retry" yaml:"max_retry"`
 	MaxRetryBackOff int `json:"max_retry_back_off" yaml:"max_retry_back_off"`
@@ -150,11 +251,117 @@ func (c *SysConfig) CheckConfig() error {
 			c.Database.SlowThreshold = 300
 		}
 	}
-	if c.Broker.SubscribeSlowThreshold <= 0 || c.Broker.Kafka.Consumer.MaxProcessingTime <= 0 {
-		return errors.New("invalid subscribe_slow_threshold or max_processing_time")
+	if c.Broker.SubscribeSlowThreshold <= 0 {
+		return errors.New("invalid subscribe_slow_threshold")
 	}
-	if c.Broker.SubscribeSlowThreshold >= c.Broker.Kafka.Consumer.MaxProcessingTime {
-		return errors.New("subscribe_slow_threshold must less than kafka.consumer.max_processing_time")
+
+	if c.Broker.Driver == "" {
+		c.Broker.Driver = "kafka"
+	}
+	switch c.Broker.Driver {
+	case "kafka":
+		if c.Broker.Kafka == nil || c.Broker.Kafka.Consumer == nil {
+			return errors.New("kafka consumer config is nil")
+		}
+		if c.Broker.Kafka.Consumer.MaxProcessingTime <= 0 {
+			return errors.New("invalid kafka.consumer.max_processing_time")
+		}
+		if c.Broker.SubscribeSlowThreshold >= c.Broker.Kafka.Consumer.MaxProcessingTime {
+			return errors.New("subscribe_slow_threshold must less than kafka.consumer.max_processing_time")
+		}
+	case "memory":
+		if c.Broker.Memory == nil {
+			c.Broker.Memory = &Memory{}
+		}
+		if c.Broker.Memory.ChannelBufferSize <= 0 {
+			c.Broker.Memory.ChannelBufferSize = 1024
+		}
+	case "nats":
+		if c.Broker.Nats == nil || len(c.Broker.Nats.Hosts) == 0 {
+			return errors.New("nats hosts is empty")
+		}
+		if c.Broker.Nats.MaxAckPending <= 0 {
+			c.Broker.Nats.MaxAckPending = 1000
+		}
+		if c.Broker.Nats.AckBufferSize <= 0 {
+			c.Broker.Nats.AckBufferSize = 1024
+		}
+		if c.Broker.Nats.MaxProcessingTime <= 0 {
+			c.Broker.Nats.MaxProcessingTime = 30000
+		}
+		if c.Broker.SubscribeSlowThreshold >= c.Broker.Nats.MaxProcessingTime {
+			return errors.New("subscribe_slow_threshold must less than nats.max_processing_time")
+		}
+	case "rabbitmq":
+		if c.Broker.RabbitMQ == nil || c.Broker.RabbitMQ.Url == "" {
+			return errors.New("rabbitmq url is empty")
+		}
+		if c.Broker.RabbitMQ.Exchange == "" {
+			c.Broker.RabbitMQ.Exchange = "product"
+		}
+		if c.Broker.RabbitMQ.PrefetchCount <= 0 {
+			c.Broker.RabbitMQ.PrefetchCount = 1000
+		}
+		if c.Broker.RabbitMQ.AckBufferSize <= 0 {
+			c.Broker.RabbitMQ.AckBufferSize = 1024
+		}
+		if c.Broker.RabbitMQ.MaxProcessingTime <= 0 {
+			c.Broker.RabbitMQ.MaxProcessingTime = 1800000
+		}
+		if c.Broker.SubscribeSlowThreshold >= c.Broker.RabbitMQ.MaxProcessingTime {
+			return errors.New("subscribe_slow_threshold must less than rabbitmq.max_processing_time")
+		}
+	}
+	if c.Broker.Outbox == nil {
+		c.Broker.Outbox = &Outbox{}
+	}
+	if c.Broker.Outbox.PollInterval <= 0 {
+		c.Broker.Outbox.PollInterval = 1000
+	}
+	if c.Broker.Outbox.BatchSize <= 0 {
+		c.Broker.Outbox.BatchSize = 100
+	}
+	if c.Broker.Outbox.MaxRetries <= 0 {
+		c.Broker.Outbox.MaxRetries = 10
+	}
+	if c.Broker.Outbox.PublishTimeout <= 0 {
+		c.Broker.Outbox.PublishTimeout = 30000
+	}
+	if c.Broker.Outbox.RetentionHours <= 0 {
+		c.Broker.Outbox.RetentionHours = 72
+	}
+	if c.Broker.DeadLetter == nil {
+		c.Broker.DeadLetter = &DeadLetter{}
+	}
+	if c.Broker.DeadLetter.ConsumerGroup == "" {
+		c.Broker.DeadLetter.ConsumerGroup = "product-dlq-indexer"
+	}
+	if c.Broker.Idempotency == nil {
+		c.Broker.Idempotency = &Idempotency{}
+	}
+	if c.Broker.Idempotency.CleanupInterval <= 0 {
+		c.Broker.Idempotency.CleanupInterval = 3600
+	}
+	if c.Broker.Idempotency.RetentionHours <= 0 {
+		c.Broker.Idempotency.RetentionHours = 168
+	}
+	if c.Broker.HandlerTimeout == nil {
+		c.Broker.HandlerTimeout = &HandlerTimeout{}
+	}
+	if c.Broker.HandlerTimeout.Default <= 0 {
+		c.Broker.HandlerTimeout.Default = 30000
+	}
+	if c.Broker.KeyedConsumer == nil {
+		c.Broker.KeyedConsumer = &KeyedConsumer{}
+	}
+	if c.Broker.KeyedConsumer.Topics == nil {
+		c.Broker.KeyedConsumer.Topics = []string{"OrderEvent"}
+	}
+	if c.Broker.KeyedConsumer.Concurrency <= 0 {
+		c.Broker.KeyedConsumer.Concurrency = 16
+	}
+	if c.Broker.KeyedConsumer.BufferSize <= 0 {
+		c.Broker.KeyedConsumer.BufferSize = 1000
 	}
 
 	// 检查Redis配置
@@ -177,6 +384,54 @@ func (c *SysConfig) CheckConfig() error {
 			c.Redis.MinIdleConns = 1
 		}
 	}
+	if c.Reservation == nil {
+		c.Reservation = &Reservation{}
+	}
+	if c.Reservation.DefaultTtl <= 0 {
+		c.Reservation.DefaultTtl = 900
+	}
+	if c.Reservation.ExpireInterval <= 0 {
+		c.Reservation.ExpireInterval = 30
+	}
+	if c.Reservation.BatchSize <= 0 {
+		c.Reservation.BatchSize = 100
+	}
+	if c.Reconciliation == nil {
+		c.Reconciliation = &Reconciliation{}
+	}
+	if c.Reconciliation.Interval <= 0 {
+		c.Reconciliation.Interval = 3600
+	}
+	if c.Reconciliation.BatchSize <= 0 {
+		c.Reconciliation.BatchSize = 200
+	}
+	if c.Warehouse == nil {
+		c.Warehouse = &Warehouse{}
+	}
+	if c.Warehouse.AllocationStrategy == "" {
+		c.Warehouse.AllocationStrategy = allocation.StrategyPriority
+	}
+	if !allocation.IsValidStrategy(c.Warehouse.AllocationStrategy) {
+		return errors.New("invalid warehouse allocation_strategy: " + c.Warehouse.AllocationStrategy)
+	}
+	if c.Pricing == nil {
+		c.Pricing = &Pricing{}
+	}
+	if c.Pricing.ScheduleInterval <= 0 {
+		c.Pricing.ScheduleInterval = 30
+	}
+	if c.Pricing.BatchSize <= 0 {
+		c.Pricing.BatchSize = 100
+	}
+	if c.Publishing == nil {
+		c.Publishing = &Publishing{}
+	}
+	if c.Publishing.ScheduleInterval <= 0 {
+		c.Publishing.ScheduleInterval = 30
+	}
+	if c.Publishing.BatchSize <= 0 {
+		c.Publishing.BatchSize = 100
+	}
 	logLevels := [3]string{"info", "warn", "error"}
 	if c.Service.LogLevel == "" {
 		c.Service.LogLevel = "info"
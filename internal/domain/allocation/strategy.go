@@ -0,0 +1,20 @@
+package allocation
+
+// 订单分仓策略，不依赖其他包，供配置校验与领域服务共用
+const (
+	// StrategyPriority 按仓库优先级依次分配
+	StrategyPriority = "priority"
+	// StrategyNearest 按与收货地址的距离由近到远分配，没有收货坐标时按优先级
+	StrategyNearest = "nearest"
+	// StrategySplitMinimizing 尽量减少发货仓库数量，能由单个仓库满足时不拆单
+	StrategySplitMinimizing = "split_minimizing"
+)
+
+// IsValidStrategy 是否为支持的分仓策略
+func IsValidStrategy(strategy string) bool {
+	switch strategy {
+	case StrategyPriority, StrategyNearest, StrategySplitMinimizing:
+		return true
+	}
+	return false
+}
@@ -23,12 +23,14 @@ const (
 
 // 基础事件
 type BaseEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp       int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EventType       string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload         []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	SchemaVersion   int32                  `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`      // 事件内容的结构版本，0表示发布方未声明，按版本1处理
+	ProducerVersion string                 `protobuf:"bytes,6,opt,name=producer_version,json=producerVersion,proto3" json:"producer_version,omitempty"` // 发布方服务版本
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *BaseEvent) Reset() {
@@ -82,16 +84,32 @@ func (x *BaseEvent) GetPayload() []byte {
 	return nil
 }
 
+func (x *BaseEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *BaseEvent) GetProducerVersion() string {
+	if x != nil {
+		return x.ProducerVersion
+	}
+	return ""
+}
+
 var File_proto_base_event_proto protoreflect.FileDescriptor
 
 const file_proto_base_event_proto_rawDesc = "" +
 	"\n" +
-	"\x16proto/base_event.proto\x12\x06common\"b\n" +
+	"\x16proto/base_event.proto\x12\x06common\"\xb4\x01\n" +
 	"\tBaseEvent\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x1d\n" +
 	"\n" +
 	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
-	"\apayload\x18\x04 \x01(\fR\apayloadB\x19Z\x17./internal/domain/eventb\x06proto3"
+	"\apayload\x18\x04 \x01(\fR\apayload\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\x05R\rschemaVersion\x12)\n" +
+	"\x10producer_version\x18\x06 \x01(\tR\x0fproducerVersionB\x19Z\x17./internal/domain/eventb\x06proto3"
 
 var (
 	file_proto_base_event_proto_rawDescOnce sync.Once
@@ -21,13 +21,15 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// 订单支付成功事件
+// 订单支付成功事件，收货坐标用于就近分仓，未提供时为0
 type OnPaymentSuccess struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
-	OrderDetails  []*OrderDetail         `protobuf:"bytes,2,rep,name=OrderDetails,proto3" json:"OrderDetails,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrderId           int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	OrderDetails      []*OrderDetail         `protobuf:"bytes,2,rep,name=OrderDetails,proto3" json:"OrderDetails,omitempty"`
+	ShippingLatitude  float64                `protobuf:"fixed64,3,opt,name=ShippingLatitude,proto3" json:"ShippingLatitude,omitempty"`
+	ShippingLongitude float64                `protobuf:"fixed64,4,opt,name=ShippingLongitude,proto3" json:"ShippingLongitude,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *OnPaymentSuccess) Reset() {
@@ -74,6 +76,20 @@ func (x *OnPaymentSuccess) GetOrderDetails() []*OrderDetail {
 	return nil
 }
 
+func (x *OnPaymentSuccess) GetShippingLatitude() float64 {
+	if x != nil {
+		return x.ShippingLatitude
+	}
+	return 0
+}
+
+func (x *OnPaymentSuccess) GetShippingLongitude() float64 {
+	if x != nil {
+		return x.ShippingLongitude
+	}
+	return 0
+}
+
 // 订单-商品Sku
 type OrderDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -135,19 +151,142 @@ func (x *OrderDetail) GetQuantity() uint32 {
 	return 0
 }
 
+// 订单退款事件，同一订单可多次部分退款，以RefundId区分
+type OnOrderRefunded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	RefundId      int64                  `protobuf:"varint,2,opt,name=RefundId,proto3" json:"RefundId,omitempty"`
+	OrderDetails  []*OrderDetail         `protobuf:"bytes,3,rep,name=OrderDetails,proto3" json:"OrderDetails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnOrderRefunded) Reset() {
+	*x = OnOrderRefunded{}
+	mi := &file_order_order_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnOrderRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnOrderRefunded) ProtoMessage() {}
+
+func (x *OnOrderRefunded) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnOrderRefunded.ProtoReflect.Descriptor instead.
+func (*OnOrderRefunded) Descriptor() ([]byte, []int) {
+	return file_order_order_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OnOrderRefunded) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OnOrderRefunded) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *OnOrderRefunded) GetOrderDetails() []*OrderDetail {
+	if x != nil {
+		return x.OrderDetails
+	}
+	return nil
+}
+
+// 订单取消事件
+type OnOrderCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	OrderDetails  []*OrderDetail         `protobuf:"bytes,2,rep,name=OrderDetails,proto3" json:"OrderDetails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnOrderCancelled) Reset() {
+	*x = OnOrderCancelled{}
+	mi := &file_order_order_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnOrderCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnOrderCancelled) ProtoMessage() {}
+
+func (x *OnOrderCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnOrderCancelled.ProtoReflect.Descriptor instead.
+func (*OnOrderCancelled) Descriptor() ([]byte, []int) {
+	return file_order_order_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OnOrderCancelled) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OnOrderCancelled) GetOrderDetails() []*OrderDetail {
+	if x != nil {
+		return x.OrderDetails
+	}
+	return nil
+}
+
 var File_order_order_event_proto protoreflect.FileDescriptor
 
 const file_order_order_event_proto_rawDesc = "" +
 	"\n" +
-	"\x17order/order_event.proto\x12\vorder.event\"j\n" +
+	"\x17order/order_event.proto\x12\vorder.event\"\xc4\x01\n" +
 	"\x10OnPaymentSuccess\x12\x18\n" +
 	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12<\n" +
-	"\fOrderDetails\x18\x02 \x03(\v2\x18.order.event.OrderDetailR\fOrderDetails\"_\n" +
+	"\fOrderDetails\x18\x02 \x03(\v2\x18.order.event.OrderDetailR\fOrderDetails\x12*\n" +
+	"\x10ShippingLatitude\x18\x03 \x01(\x01R\x10ShippingLatitude\x12,\n" +
+	"\x11ShippingLongitude\x18\x04 \x01(\x01R\x11ShippingLongitude\"_\n" +
 	"\vOrderDetail\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x15\n" +
 	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\rR\bquantityB\x1fZ\x1d./internal/domain/event/orderb\x06proto3"
+	"\bquantity\x18\x03 \x01(\rR\bquantity\"\x85\x01\n" +
+	"\x0fOnOrderRefunded\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12\x1a\n" +
+	"\bRefundId\x18\x02 \x01(\x03R\bRefundId\x12<\n" +
+	"\fOrderDetails\x18\x03 \x03(\v2\x18.order.event.OrderDetailR\fOrderDetails\"j\n" +
+	"\x10OnOrderCancelled\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12<\n" +
+	"\fOrderDetails\x18\x02 \x03(\v2\x18.order.event.OrderDetailR\fOrderDetailsB\x1fZ\x1d./internal/domain/event/orderb\x06proto3"
 
 var (
 	file_order_order_event_proto_rawDescOnce sync.Once
@@ -161,18 +300,22 @@ func file_order_order_event_proto_rawDescGZIP() []byte {
 	return file_order_order_event_proto_rawDescData
 }
 
-var file_order_order_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_order_order_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_order_order_event_proto_goTypes = []any{
 	(*OnPaymentSuccess)(nil), // 0: order.event.OnPaymentSuccess
 	(*OrderDetail)(nil),      // 1: order.event.OrderDetail
+	(*OnOrderRefunded)(nil),  // 2: order.event.OnOrderRefunded
+	(*OnOrderCancelled)(nil), // 3: order.event.OnOrderCancelled
 }
 var file_order_order_event_proto_depIdxs = []int32{
 	1, // 0: order.event.OnPaymentSuccess.OrderDetails:type_name -> order.event.OrderDetail
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: order.event.OnOrderRefunded.OrderDetails:type_name -> order.event.OrderDetail
+	1, // 2: order.event.OnOrderCancelled.OrderDetails:type_name -> order.event.OrderDetail
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_order_order_event_proto_init() }
@@ -186,7 +329,7 @@ func file_order_order_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_event_proto_rawDesc), len(file_order_order_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -74,19 +74,644 @@ func (x *OnInventoryDeductSuccess) GetSku() []*SkuInfo {
 	return nil
 }
 
+// 库存回补成功
+type OnInventoryRestored struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Sku           []*SkuInfo             `protobuf:"bytes,3,rep,name=Sku,proto3" json:"Sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnInventoryRestored) Reset() {
+	*x = OnInventoryRestored{}
+	mi := &file_proto_product_product_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnInventoryRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnInventoryRestored) ProtoMessage() {}
+
+func (x *OnInventoryRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnInventoryRestored.ProtoReflect.Descriptor instead.
+func (*OnInventoryRestored) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OnInventoryRestored) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OnInventoryRestored) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OnInventoryRestored) GetSku() []*SkuInfo {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+// 补货申请审核通过
+type OnRestockApproved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestockId     int64                  `protobuf:"varint,1,opt,name=RestockId,proto3" json:"RestockId,omitempty"`
+	ApplicationNo string                 `protobuf:"bytes,2,opt,name=ApplicationNo,proto3" json:"ApplicationNo,omitempty"`
+	SkuId         int64                  `protobuf:"varint,3,opt,name=SkuId,proto3" json:"SkuId,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	AuditUserId   uint32                 `protobuf:"varint,5,opt,name=AuditUserId,proto3" json:"AuditUserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnRestockApproved) Reset() {
+	*x = OnRestockApproved{}
+	mi := &file_proto_product_product_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnRestockApproved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnRestockApproved) ProtoMessage() {}
+
+func (x *OnRestockApproved) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnRestockApproved.ProtoReflect.Descriptor instead.
+func (*OnRestockApproved) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OnRestockApproved) GetRestockId() int64 {
+	if x != nil {
+		return x.RestockId
+	}
+	return 0
+}
+
+func (x *OnRestockApproved) GetApplicationNo() string {
+	if x != nil {
+		return x.ApplicationNo
+	}
+	return ""
+}
+
+func (x *OnRestockApproved) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *OnRestockApproved) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OnRestockApproved) GetAuditUserId() uint32 {
+	if x != nil {
+		return x.AuditUserId
+	}
+	return 0
+}
+
+// 补货申请被驳回
+type OnRestockRejected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestockId     int64                  `protobuf:"varint,1,opt,name=RestockId,proto3" json:"RestockId,omitempty"`
+	ApplicationNo string                 `protobuf:"bytes,2,opt,name=ApplicationNo,proto3" json:"ApplicationNo,omitempty"`
+	SkuId         int64                  `protobuf:"varint,3,opt,name=SkuId,proto3" json:"SkuId,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	AuditUserId   uint32                 `protobuf:"varint,5,opt,name=AuditUserId,proto3" json:"AuditUserId,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnRestockRejected) Reset() {
+	*x = OnRestockRejected{}
+	mi := &file_proto_product_product_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnRestockRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnRestockRejected) ProtoMessage() {}
+
+func (x *OnRestockRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnRestockRejected.ProtoReflect.Descriptor instead.
+func (*OnRestockRejected) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OnRestockRejected) GetRestockId() int64 {
+	if x != nil {
+		return x.RestockId
+	}
+	return 0
+}
+
+func (x *OnRestockRejected) GetApplicationNo() string {
+	if x != nil {
+		return x.ApplicationNo
+	}
+	return ""
+}
+
+func (x *OnRestockRejected) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *OnRestockRejected) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OnRestockRejected) GetAuditUserId() uint32 {
+	if x != nil {
+		return x.AuditUserId
+	}
+	return 0
+}
+
+func (x *OnRestockRejected) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 采购收货入库
+type OnStockReceived struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId int64                  `protobuf:"varint,1,opt,name=PurchaseOrderId,proto3" json:"PurchaseOrderId,omitempty"`
+	PurchaseOrderNo string                 `protobuf:"bytes,2,opt,name=PurchaseOrderNo,proto3" json:"PurchaseOrderNo,omitempty"`
+	OperatorId      int32                  `protobuf:"varint,3,opt,name=OperatorId,proto3" json:"OperatorId,omitempty"`
+	Sku             []*SkuInfo             `protobuf:"bytes,4,rep,name=Sku,proto3" json:"Sku,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OnStockReceived) Reset() {
+	*x = OnStockReceived{}
+	mi := &file_proto_product_product_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnStockReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnStockReceived) ProtoMessage() {}
+
+func (x *OnStockReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnStockReceived.ProtoReflect.Descriptor instead.
+func (*OnStockReceived) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OnStockReceived) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *OnStockReceived) GetPurchaseOrderNo() string {
+	if x != nil {
+		return x.PurchaseOrderNo
+	}
+	return ""
+}
+
+func (x *OnStockReceived) GetOperatorId() int32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *OnStockReceived) GetSku() []*SkuInfo {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+// 手动调整或盘点库存
+type OnStockAdjusted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=OperatorId,proto3" json:"OperatorId,omitempty"`
+	Sku           []*SkuInfo             `protobuf:"bytes,3,rep,name=Sku,proto3" json:"Sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnStockAdjusted) Reset() {
+	*x = OnStockAdjusted{}
+	mi := &file_proto_product_product_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnStockAdjusted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnStockAdjusted) ProtoMessage() {}
+
+func (x *OnStockAdjusted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnStockAdjusted.ProtoReflect.Descriptor instead.
+func (*OnStockAdjusted) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OnStockAdjusted) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OnStockAdjusted) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *OnStockAdjusted) GetSku() []*SkuInfo {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+// SKU价格变更生效，ChangeType为2时表示限时调价到期恢复原价，市场价为0表示未设置
+type OnSkuPriceChanged struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId            int64                  `protobuf:"varint,1,opt,name=ChangeId,proto3" json:"ChangeId,omitempty"`
+	SkuId               int64                  `protobuf:"varint,2,opt,name=SkuId,proto3" json:"SkuId,omitempty"`
+	ProductId           int64                  `protobuf:"varint,3,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	ChangeType          int32                  `protobuf:"varint,4,opt,name=ChangeType,proto3" json:"ChangeType,omitempty"`
+	Price               float64                `protobuf:"fixed64,5,opt,name=Price,proto3" json:"Price,omitempty"`
+	MarketPrice         float64                `protobuf:"fixed64,6,opt,name=MarketPrice,proto3" json:"MarketPrice,omitempty"`
+	PreviousPrice       float64                `protobuf:"fixed64,7,opt,name=PreviousPrice,proto3" json:"PreviousPrice,omitempty"`
+	PreviousMarketPrice float64                `protobuf:"fixed64,8,opt,name=PreviousMarketPrice,proto3" json:"PreviousMarketPrice,omitempty"`
+	AppliedAt           string                 `protobuf:"bytes,9,opt,name=AppliedAt,proto3" json:"AppliedAt,omitempty"`
+	EffectiveTo         string                 `protobuf:"bytes,10,opt,name=EffectiveTo,proto3" json:"EffectiveTo,omitempty"`
+	OperatorId          int64                  `protobuf:"varint,11,opt,name=OperatorId,proto3" json:"OperatorId,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OnSkuPriceChanged) Reset() {
+	*x = OnSkuPriceChanged{}
+	mi := &file_proto_product_product_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnSkuPriceChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnSkuPriceChanged) ProtoMessage() {}
+
+func (x *OnSkuPriceChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnSkuPriceChanged.ProtoReflect.Descriptor instead.
+func (*OnSkuPriceChanged) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OnSkuPriceChanged) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetPreviousPrice() float64 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetPreviousMarketPrice() float64 {
+	if x != nil {
+		return x.PreviousMarketPrice
+	}
+	return 0
+}
+
+func (x *OnSkuPriceChanged) GetAppliedAt() string {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return ""
+}
+
+func (x *OnSkuPriceChanged) GetEffectiveTo() string {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *OnSkuPriceChanged) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// 商品或SKU状态变化，商品状态未变时FromStatus与ToStatus相同
+// 状态：0-下架 1-上架 2-草稿 3-已归档；OperatorId为0表示定时上架
+type OnProductStatusChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	FromStatus    int32                  `protobuf:"varint,2,opt,name=FromStatus,proto3" json:"FromStatus,omitempty"`
+	ToStatus      int32                  `protobuf:"varint,3,opt,name=ToStatus,proto3" json:"ToStatus,omitempty"`
+	Skus          []*SkuStatusChange     `protobuf:"bytes,4,rep,name=Skus,proto3" json:"Skus,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=OperatorId,proto3" json:"OperatorId,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnProductStatusChanged) Reset() {
+	*x = OnProductStatusChanged{}
+	mi := &file_proto_product_product_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnProductStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnProductStatusChanged) ProtoMessage() {}
+
+func (x *OnProductStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnProductStatusChanged.ProtoReflect.Descriptor instead.
+func (*OnProductStatusChanged) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OnProductStatusChanged) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OnProductStatusChanged) GetFromStatus() int32 {
+	if x != nil {
+		return x.FromStatus
+	}
+	return 0
+}
+
+func (x *OnProductStatusChanged) GetToStatus() int32 {
+	if x != nil {
+		return x.ToStatus
+	}
+	return 0
+}
+
+func (x *OnProductStatusChanged) GetSkus() []*SkuStatusChange {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *OnProductStatusChanged) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *OnProductStatusChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SkuStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=SkuId,proto3" json:"SkuId,omitempty"`
+	FromStatus    int32                  `protobuf:"varint,2,opt,name=FromStatus,proto3" json:"FromStatus,omitempty"`
+	ToStatus      int32                  `protobuf:"varint,3,opt,name=ToStatus,proto3" json:"ToStatus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuStatusChange) Reset() {
+	*x = SkuStatusChange{}
+	mi := &file_proto_product_product_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuStatusChange) ProtoMessage() {}
+
+func (x *SkuStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuStatusChange.ProtoReflect.Descriptor instead.
+func (*SkuStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SkuStatusChange) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuStatusChange) GetFromStatus() int32 {
+	if x != nil {
+		return x.FromStatus
+	}
+	return 0
+}
+
+func (x *SkuStatusChange) GetToStatus() int32 {
+	if x != nil {
+		return x.ToStatus
+	}
+	return 0
+}
+
 type SkuInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	Quantity      uint32                 `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
 	Stock         uint32                 `protobuf:"varint,3,opt,name=Stock,proto3" json:"Stock,omitempty"`
 	Threshold     uint32                 `protobuf:"varint,4,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	Warehouses    []*WarehouseAllocation `protobuf:"bytes,5,rep,name=Warehouses,proto3" json:"Warehouses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SkuInfo) Reset() {
 	*x = SkuInfo{}
-	mi := &file_proto_product_product_event_proto_msgTypes[1]
+	mi := &file_proto_product_product_event_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +723,7 @@ func (x *SkuInfo) String() string {
 func (*SkuInfo) ProtoMessage() {}
 
 func (x *SkuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_product_event_proto_msgTypes[1]
+	mi := &file_proto_product_product_event_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +736,7 @@ func (x *SkuInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkuInfo.ProtoReflect.Descriptor instead.
 func (*SkuInfo) Descriptor() ([]byte, []int) {
-	return file_proto_product_product_event_proto_rawDescGZIP(), []int{1}
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SkuInfo) GetId() int64 {
@@ -142,6 +767,74 @@ func (x *SkuInfo) GetThreshold() uint32 {
 	return 0
 }
 
+func (x *SkuInfo) GetWarehouses() []*WarehouseAllocation {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// 分仓分配结果，WarehouseId为0表示由未分仓库存满足
+type WarehouseAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Stock         uint32                 `protobuf:"varint,3,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseAllocation) Reset() {
+	*x = WarehouseAllocation{}
+	mi := &file_proto_product_product_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseAllocation) ProtoMessage() {}
+
+func (x *WarehouseAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_product_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseAllocation.ProtoReflect.Descriptor instead.
+func (*WarehouseAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_product_product_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WarehouseAllocation) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseAllocation) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WarehouseAllocation) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
 var File_proto_product_product_event_proto protoreflect.FileDescriptor
 
 const file_proto_product_product_event_proto_rawDesc = "" +
@@ -149,12 +842,83 @@ const file_proto_product_product_event_proto_rawDesc = "" +
 	"!proto/product/product_event.proto\x12\rproduct.event\"^\n" +
 	"\x18OnInventoryDeductSuccess\x12\x18\n" +
 	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12(\n" +
-	"\x03Sku\x18\x02 \x03(\v2\x16.product.event.SkuInfoR\x03Sku\"i\n" +
+	"\x03Sku\x18\x02 \x03(\v2\x16.product.event.SkuInfoR\x03Sku\"q\n" +
+	"\x13OnInventoryRestored\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12\x16\n" +
+	"\x06Reason\x18\x02 \x01(\tR\x06Reason\x12(\n" +
+	"\x03Sku\x18\x03 \x03(\v2\x16.product.event.SkuInfoR\x03Sku\"\xab\x01\n" +
+	"\x11OnRestockApproved\x12\x1c\n" +
+	"\tRestockId\x18\x01 \x01(\x03R\tRestockId\x12$\n" +
+	"\rApplicationNo\x18\x02 \x01(\tR\rApplicationNo\x12\x14\n" +
+	"\x05SkuId\x18\x03 \x01(\x03R\x05SkuId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x05R\bQuantity\x12 \n" +
+	"\vAuditUserId\x18\x05 \x01(\rR\vAuditUserId\"\xc3\x01\n" +
+	"\x11OnRestockRejected\x12\x1c\n" +
+	"\tRestockId\x18\x01 \x01(\x03R\tRestockId\x12$\n" +
+	"\rApplicationNo\x18\x02 \x01(\tR\rApplicationNo\x12\x14\n" +
+	"\x05SkuId\x18\x03 \x01(\x03R\x05SkuId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x05R\bQuantity\x12 \n" +
+	"\vAuditUserId\x18\x05 \x01(\rR\vAuditUserId\x12\x16\n" +
+	"\x06Reason\x18\x06 \x01(\tR\x06Reason\"\xaf\x01\n" +
+	"\x0fOnStockReceived\x12(\n" +
+	"\x0fPurchaseOrderId\x18\x01 \x01(\x03R\x0fPurchaseOrderId\x12(\n" +
+	"\x0fPurchaseOrderNo\x18\x02 \x01(\tR\x0fPurchaseOrderNo\x12\x1e\n" +
+	"\n" +
+	"OperatorId\x18\x03 \x01(\x05R\n" +
+	"OperatorId\x12(\n" +
+	"\x03Sku\x18\x04 \x03(\v2\x16.product.event.SkuInfoR\x03Sku\"s\n" +
+	"\x0fOnStockAdjusted\x12\x16\n" +
+	"\x06Reason\x18\x01 \x01(\tR\x06Reason\x12\x1e\n" +
+	"\n" +
+	"OperatorId\x18\x02 \x01(\x03R\n" +
+	"OperatorId\x12(\n" +
+	"\x03Sku\x18\x03 \x03(\v2\x16.product.event.SkuInfoR\x03Sku\"\xf3\x02\n" +
+	"\x11OnSkuPriceChanged\x12\x1a\n" +
+	"\bChangeId\x18\x01 \x01(\x03R\bChangeId\x12\x14\n" +
+	"\x05SkuId\x18\x02 \x01(\x03R\x05SkuId\x12\x1c\n" +
+	"\tProductId\x18\x03 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"ChangeType\x18\x04 \x01(\x05R\n" +
+	"ChangeType\x12\x14\n" +
+	"\x05Price\x18\x05 \x01(\x01R\x05Price\x12 \n" +
+	"\vMarketPrice\x18\x06 \x01(\x01R\vMarketPrice\x12$\n" +
+	"\rPreviousPrice\x18\a \x01(\x01R\rPreviousPrice\x120\n" +
+	"\x13PreviousMarketPrice\x18\b \x01(\x01R\x13PreviousMarketPrice\x12\x1c\n" +
+	"\tAppliedAt\x18\t \x01(\tR\tAppliedAt\x12 \n" +
+	"\vEffectiveTo\x18\n" +
+	" \x01(\tR\vEffectiveTo\x12\x1e\n" +
+	"\n" +
+	"OperatorId\x18\v \x01(\x03R\n" +
+	"OperatorId\"\xde\x01\n" +
+	"\x16OnProductStatusChanged\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"FromStatus\x18\x02 \x01(\x05R\n" +
+	"FromStatus\x12\x1a\n" +
+	"\bToStatus\x18\x03 \x01(\x05R\bToStatus\x122\n" +
+	"\x04Skus\x18\x04 \x03(\v2\x1e.product.event.SkuStatusChangeR\x04Skus\x12\x1e\n" +
+	"\n" +
+	"OperatorId\x18\x05 \x01(\x03R\n" +
+	"OperatorId\x12\x16\n" +
+	"\x06Reason\x18\x06 \x01(\tR\x06Reason\"c\n" +
+	"\x0fSkuStatusChange\x12\x14\n" +
+	"\x05SkuId\x18\x01 \x01(\x03R\x05SkuId\x12\x1e\n" +
+	"\n" +
+	"FromStatus\x18\x02 \x01(\x05R\n" +
+	"FromStatus\x12\x1a\n" +
+	"\bToStatus\x18\x03 \x01(\x05R\bToStatus\"\xad\x01\n" +
 	"\aSkuInfo\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1a\n" +
 	"\bQuantity\x18\x02 \x01(\rR\bQuantity\x12\x14\n" +
 	"\x05Stock\x18\x03 \x01(\rR\x05Stock\x12\x1c\n" +
-	"\tThreshold\x18\x04 \x01(\rR\tThresholdB!Z\x1f./internal/domain/event/productb\x06proto3"
+	"\tThreshold\x18\x04 \x01(\rR\tThreshold\x12B\n" +
+	"\n" +
+	"Warehouses\x18\x05 \x03(\v2\".product.event.WarehouseAllocationR\n" +
+	"Warehouses\"i\n" +
+	"\x13WarehouseAllocation\x12 \n" +
+	"\vWarehouseId\x18\x01 \x01(\x03R\vWarehouseId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\rR\bQuantity\x12\x14\n" +
+	"\x05Stock\x18\x03 \x01(\rR\x05StockB!Z\x1f./internal/domain/event/productb\x06proto3"
 
 var (
 	file_proto_product_product_event_proto_rawDescOnce sync.Once
@@ -168,18 +932,32 @@ func file_proto_product_product_event_proto_rawDescGZIP() []byte {
 	return file_proto_product_product_event_proto_rawDescData
 }
 
-var file_proto_product_product_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_product_product_event_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_product_product_event_proto_goTypes = []any{
 	(*OnInventoryDeductSuccess)(nil), // 0: product.event.OnInventoryDeductSuccess
-	(*SkuInfo)(nil),                  // 1: product.event.SkuInfo
+	(*OnInventoryRestored)(nil),      // 1: product.event.OnInventoryRestored
+	(*OnRestockApproved)(nil),        // 2: product.event.OnRestockApproved
+	(*OnRestockRejected)(nil),        // 3: product.event.OnRestockRejected
+	(*OnStockReceived)(nil),          // 4: product.event.OnStockReceived
+	(*OnStockAdjusted)(nil),          // 5: product.event.OnStockAdjusted
+	(*OnSkuPriceChanged)(nil),        // 6: product.event.OnSkuPriceChanged
+	(*OnProductStatusChanged)(nil),   // 7: product.event.OnProductStatusChanged
+	(*SkuStatusChange)(nil),          // 8: product.event.SkuStatusChange
+	(*SkuInfo)(nil),                  // 9: product.event.SkuInfo
+	(*WarehouseAllocation)(nil),      // 10: product.event.WarehouseAllocation
 }
 var file_proto_product_product_event_proto_depIdxs = []int32{
-	1, // 0: product.event.OnInventoryDeductSuccess.Sku:type_name -> product.event.SkuInfo
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: product.event.OnInventoryDeductSuccess.Sku:type_name -> product.event.SkuInfo
+	9,  // 1: product.event.OnInventoryRestored.Sku:type_name -> product.event.SkuInfo
+	9,  // 2: product.event.OnStockReceived.Sku:type_name -> product.event.SkuInfo
+	9,  // 3: product.event.OnStockAdjusted.Sku:type_name -> product.event.SkuInfo
+	8,  // 4: product.event.OnProductStatusChanged.Skus:type_name -> product.event.SkuStatusChange
+	10, // 5: product.event.SkuInfo.Warehouses:type_name -> product.event.WarehouseAllocation
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_product_event_proto_init() }
@@ -193,7 +971,7 @@ func file_proto_product_product_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_product_event_proto_rawDesc), len(file_proto_product_product_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 死信状态常量
+const (
+	DeadLetterStatusPending   = 1 // 待处理
+	DeadLetterStatusReplayed  = 2 // 已重新投递到原主题
+	DeadLetterStatusResolved  = 3 // 已解决
+	DeadLetterStatusDiscarded = 4 // 已丢弃
+)
+
+// DeadLetter 死信记录，由死信索引器消费<topic>DLQ写入，原消息头和消息体原样保存以便重新投递
+type DeadLetter struct {
+	ID              int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	EventId         string       `gorm:"column:event_id;type:varchar(50);not null;default:'';uniqueIndex:uk_event_dead_at,priority:1;comment:事件ID"`
+	DeadAt          int64        `gorm:"column:dead_at;not null;default:0;uniqueIndex:uk_event_dead_at,priority:2;comment:进入死信队列的时间（毫秒时间戳）"`
+	Topic           string       `gorm:"column:topic;type:varchar(100);not null;default:'';index:idx_topic_status,priority:1;comment:死信主题"`
+	OriginTopic     string       `gorm:"column:origin_topic;type:varchar(100);not null;default:'';comment:原主题"`
+	EventType       string       `gorm:"column:event_type;type:varchar(100);not null;default:'';comment:原事件类型"`
+	EventKey        string       `gorm:"column:event_key;type:varchar(100);not null;default:'';comment:分区键"`
+	Headers         string       `gorm:"column:headers;type:text;comment:消息头（JSON）"`
+	Payload         []byte       `gorm:"column:payload;type:blob;comment:消息体"`
+	Error           string       `gorm:"column:error;type:varchar(1000);not null;default:'';comment:失败原因"`
+	OriginTimestamp int64        `gorm:"column:origin_timestamp;not null;default:0;comment:原消息发布时间（毫秒时间戳）"`
+	Status          uint8        `gorm:"column:status;not null;default:1;index:idx_topic_status,priority:2;comment:状态:1=待处理 2=已重新投递 3=已解决 4=已丢弃"`
+	ReplayCount     int          `gorm:"column:replay_count;not null;default:0;comment:重新投递次数"`
+	ReplayedAt      *time.Time   `gorm:"column:replayed_at;comment:最后一次重新投递时间"`
+	HandledAt       *time.Time   `gorm:"column:handled_at;comment:解决或丢弃的时间"`
+	OperatorID      int64        `gorm:"column:operator_id;not null;default:0;comment:最后操作人ID"`
+	Reason          string       `gorm:"column:reason;type:varchar(255);not null;default:'';comment:处理原因"`
+	CreatedAt       sql.NullTime `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+	UpdatedAt       sql.NullTime `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (DeadLetter) TableName() string {
+	return "dead_letters"
+}
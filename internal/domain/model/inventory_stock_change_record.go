@@ -9,21 +9,29 @@ import (
 const (
 	SourceTypeOrderPayment = 1 // 订单支付成功扣减库存
 	SourceTypeOrderRefund  = 2 // 订单退款回补库存
-	SourceTypeManual       = 3 // 手动调整库存
+	SourceTypeManual       = 3 // 手动调整或盘点库存
+	SourceTypeReserve      = 4 // 订单预占库存
+	SourceTypeRelease      = 5 // 释放预占库存
+	SourceTypeExpire       = 6 // 预占过期回补库存
+	SourceTypeInitial      = 7 // 创建SKU时的初始库存
+	SourceTypePurchase     = 8 // 采购收货增加库存，关联采购单ID
 )
 
 // InventoryStockChangeRecord 库存变更记录
 type InventoryStockChangeRecord struct {
-	ID          int64          `gorm:"column:id;primaryKey;autoIncrement"`
-	OrderID     int64          `gorm:"column:order_id;not null;default:0;comment:订单ID"`
-	SkuID       int64          `gorm:"column:sku_id;not null;default:0;comment:SKU ID"`
-	SourceType  int32          `gorm:"column:source_type;not null;default:0;comment:来源类型:1-订单支付 2-退款 3-手动调整"`
-	Quantity    int64          `gorm:"column:quantity;not null;default:0;comment:变更数量"`
-	BeforeStock int64          `gorm:"column:before_stock;not null;default:0;comment:变更前库存"`
-	AfterStock  int64          `gorm:"column:after_stock;not null;default:0;comment:变更后库存"`
-	CreatedAt   sql.NullTime   `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
-	UpdatedAt   sql.NullTime   `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
-	DeletedAt   gorm.DeletedAt `gorm:"index;comment:删除时间"` // GORM软删除标准字段，用于查询过滤
+	ID              int64          `gorm:"column:id;primaryKey;autoIncrement"`
+	OrderID         int64          `gorm:"column:order_id;not null;default:0;comment:订单ID"`
+	PurchaseOrderID int64          `gorm:"column:purchase_order_id;not null;default:0;index:idx_purchase_order_id;comment:采购单ID，采购收货时填写"`
+	SkuID           int64          `gorm:"column:sku_id;not null;default:0;index:idx_sku_created,priority:1;comment:SKU ID"`
+	SourceType      int32          `gorm:"column:source_type;not null;default:0;comment:来源类型:1-订单支付 2-退款 3-手动调整 4-预占 5-释放预占 6-预占过期 7-初始库存 8-采购收货"`
+	Quantity        int64          `gorm:"column:quantity;not null;default:0;comment:变更数量"`
+	BeforeStock     int64          `gorm:"column:before_stock;not null;default:0;comment:变更前库存"`
+	AfterStock      int64          `gorm:"column:after_stock;not null;default:0;comment:变更后库存"`
+	OperatorID      int64          `gorm:"column:operator_id;not null;default:0;comment:操作人ID，手动调整时必填"`
+	Reason          string         `gorm:"column:reason;type:varchar(255);not null;default:'';comment:变更原因"`
+	CreatedAt       sql.NullTime   `gorm:"column:created_at;autoCreateTime;index:idx_sku_created,priority:2;comment:创建时间"`
+	UpdatedAt       sql.NullTime   `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
+	DeletedAt       gorm.DeletedAt `gorm:"index;comment:删除时间"` // GORM软删除标准字段，用于查询过滤
 }
 
 // TableName 指定表名
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"database/sql"
+)
+
+// 库存回补原因
+const (
+	RestoreReasonRefund = "refund" // 订单退款
+	RestoreReasonCancel = "cancel" // 订单取消
+)
+
+// OrderInventoryRestore 订单库存回补记录，与扣减的幂等记录分开，按回补键去重
+type OrderInventoryRestore struct {
+	ID         int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	RestoreKey string       `gorm:"column:restore_key;type:varchar(100);not null;default:'';uniqueIndex:uk_restore_key;comment:幂等键"`
+	OrderId    int64        `gorm:"column:order_id;not null;default:0;index:idx_order_id;comment:订单ID"`
+	EventId    string       `gorm:"column:event_id;type:varchar(50);not null;default:'';comment:事件ID"`
+	Reason     string       `gorm:"column:reason;type:varchar(20);not null;default:'';comment:回补原因:refund=退款 cancel=取消"`
+	CreatedAt  sql.NullTime `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+	UpdatedAt  sql.NullTime `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (OrderInventoryRestore) TableName() string {
+	return "order_inventory_restores"
+}
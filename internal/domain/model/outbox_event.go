@@ -0,0 +1,35 @@
+package model
+
+import (
+	"database/sql"
+)
+
+// 发件箱事件状态常量
+const (
+	OutboxStatusPending    = 1 // 待发布
+	OutboxStatusPublishing = 2 // 发布中（已交给异步生产者，等待回调）
+	OutboxStatusSent       = 3 // 已发布
+	OutboxStatusFailed     = 4 // 超过重试次数
+)
+
+// OutboxEvent 事务发件箱，与业务数据在同一事务中写入，由中继协程发布到broker
+type OutboxEvent struct {
+	ID            int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	EventId       string       `gorm:"column:event_id;type:varchar(50);not null;default:'';uniqueIndex:uk_event_id;comment:事件ID"`
+	Topic         string       `gorm:"column:topic;type:varchar(100);not null;default:'';comment:主题"`
+	EventType     string       `gorm:"column:event_type;type:varchar(100);not null;default:'';comment:事件类型"`
+	EventKey      string       `gorm:"column:event_key;type:varchar(100);not null;default:'';comment:分区键"`
+	Payload       []byte       `gorm:"column:payload;type:blob;comment:事件内容"`
+	SchemaVersion int32        `gorm:"column:schema_version;not null;default:1;comment:写入时事件内容的结构版本"`
+	Status        uint8        `gorm:"column:status;not null;default:1;index:idx_status_updated_at,priority:1;comment:状态:1=待发布 2=发布中 3=已发布 4=失败"`
+	Retries       int          `gorm:"column:retries;not null;default:0;comment:重试次数"`
+	LastError     string       `gorm:"column:last_error;type:varchar(500);not null;default:'';comment:最后一次失败原因"`
+	PublishedAt   sql.NullTime `gorm:"column:published_at;comment:发布成功时间"`
+	CreatedAt     sql.NullTime `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+	UpdatedAt     sql.NullTime `gorm:"column:updated_at;autoUpdateTime;index:idx_status_updated_at,priority:2;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
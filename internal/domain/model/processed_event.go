@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+)
+
+// ProcessedEvent 已处理的事件，与处理器的业务数据在同一事务中写入，事件ID与处理器唯一，用于消费端幂等
+type ProcessedEvent struct {
+	ID        int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	EventId   string       `gorm:"column:event_id;type:varchar(50);not null;default:'';uniqueIndex:uk_event_handler,priority:1;comment:事件ID"`
+	Handler   string       `gorm:"column:handler;type:varchar(100);not null;default:'';uniqueIndex:uk_event_handler,priority:2;comment:处理器名称"`
+	EventType string       `gorm:"column:event_type;type:varchar(100);not null;default:'';comment:事件类型"`
+	CreatedAt sql.NullTime `gorm:"column:created_at;autoCreateTime;index:idx_created_at;comment:处理时间"`
+}
+
+// TableName 指定表名
+func (ProcessedEvent) TableName() string {
+	return "processed_events"
+}
@@ -5,20 +5,47 @@ import (
 	"time"
 )
 
+// 商品及SKU状态，0和1沿用原有的下架、上架取值
+const (
+	ProductStatusOffSale  = 0 // 下架
+	ProductStatusOnSale   = 1 // 上架
+	ProductStatusDraft    = 2 // 草稿，创建后尚未上架
+	ProductStatusArchived = 3 // 已归档，终态
+)
+
+// productStatusTransitions 商品状态允许的流转，SKU状态沿用同一套流转
+var productStatusTransitions = map[int8][]int8{
+	ProductStatusDraft:   {ProductStatusOnSale, ProductStatusArchived},
+	ProductStatusOnSale:  {ProductStatusOffSale, ProductStatusArchived},
+	ProductStatusOffSale: {ProductStatusOnSale, ProductStatusArchived},
+}
+
+// CanProductStatusTransit 商品或SKU状态能否从from流转到to
+func CanProductStatusTransit(from, to int8) bool {
+	for _, next := range productStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
 // Product 对应商品表 (products)
 type Product struct {
-	ID          int64          `gorm:"primaryKey;autoIncrement;comment:商品ID"`
-	ProductNo   string         `gorm:"type:varchar(64);not null;uniqueIndex:uk_product_no;comment:商品编号"`
-	ProductName string         `gorm:"type:varchar(255);not null;comment:商品名称"`
-	CategoryID  uint           `gorm:"not null;index:idx_category;comment:分类ID"`
-	BrandID     *uint          `gorm:"index:idx_brand;comment:品牌ID"`
-	MainImage   *string        `gorm:"type:varchar(500);comment:主图"`
-	Description *string        `gorm:"type:text;comment:商品描述"`
-	Status      int8           `gorm:"not null;default:1;index:idx_status;comment:状态：0-下架 1-上架"`
-	IsDeleted   bool           `gorm:"softDelete:flag;default:0;comment:删除标记"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime;index:idx_created_at;comment:创建时间"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime;comment:更新时间"`
-	DeletedAt   gorm.DeletedAt `gorm:"index"` // GORM软删除标准字段，用于查询过滤
+	ID           int64          `gorm:"primaryKey;autoIncrement;comment:商品ID"`
+	ProductNo    string         `gorm:"type:varchar(64);not null;uniqueIndex:uk_product_no;comment:商品编号"`
+	ProductName  string         `gorm:"type:varchar(255);not null;comment:商品名称"`
+	CategoryID   uint           `gorm:"not null;index:idx_category;comment:分类ID"`
+	BrandID      *uint          `gorm:"index:idx_brand;comment:品牌ID"`
+	MainImage    *string        `gorm:"type:varchar(500);comment:主图"`
+	Description  *string        `gorm:"type:text;comment:商品描述"`
+	Status       int8           `gorm:"not null;default:1;index:idx_status;comment:状态：0-下架 1-上架 2-草稿 3-已归档"`
+	OnSaleAt     *time.Time     `gorm:"index:idx_on_sale_at;comment:定时上架时间"`
+	OnSaleSkuIDs string         `gorm:"type:varchar(2000);not null;default:'';comment:定时上架时一并上架的SKU ID，逗号分隔"`
+	IsDeleted    bool           `gorm:"softDelete:flag;default:0;comment:删除标记"`
+	CreatedAt    time.Time      `gorm:"autoCreateTime;index:idx_created_at;comment:创建时间"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime;comment:更新时间"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"` // GORM软删除标准字段，用于查询过滤
 
 	// 关联关系
 	Category *ProductCategory `gorm:"foreignKey:CategoryID"`
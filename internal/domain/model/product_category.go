@@ -5,6 +5,9 @@ import (
 	"time"
 )
 
+// MaxCategoryLevel 分类最大层级，一级分类的层级为1
+const MaxCategoryLevel = 3
+
 // ProductCategory 对应商品分类表 (product_categories)
 type ProductCategory struct {
 	ID           int64          `gorm:"primaryKey;autoIncrement;comment:分类ID"`
@@ -19,7 +19,7 @@ type ProductSku struct {
 	StockWarn     uint32         `gorm:"not null;default:10;comment:库存预警值"`
 	Sales         int            `gorm:"not null;default:0;index:idx_sales;comment:销量"`
 	MainImage     *string        `gorm:"type:varchar(500);comment:SKU主图"`
-	Status        int8           `gorm:"not null;default:1;index:idx_status;comment:状态：0-下架 1-上架"`
+	Status        int8           `gorm:"not null;default:1;index:idx_status;comment:状态：0-下架 1-上架 2-草稿 3-已归档，取值同商品状态"`
 	CreatedAt     time.Time      `gorm:"autoCreateTime;index:idx_created_at;comment:创建时间"`
 	UpdatedAt     time.Time      `gorm:"autoUpdateTime;comment:更新时间"`
 	DeletedAt     gorm.DeletedAt `gorm:"index"`
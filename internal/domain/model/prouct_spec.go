@@ -25,3 +25,20 @@ type ProductSpec struct {
 func (ProductSpec) TableName() string {
 	return "product_specs"
 }
+
+// SoftDelete 标记删除
+func (m *ProductSpec) SoftDelete(now time.Time) {
+	m.IsDeleted = true
+	m.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+}
+
+// Restore 恢复已删除的规格
+func (m *ProductSpec) Restore() {
+	m.IsDeleted = false
+	m.DeletedAt = gorm.DeletedAt{}
+}
+
+// Removed 是否已删除
+func (m *ProductSpec) Removed() bool {
+	return m.DeletedAt.Valid
+}
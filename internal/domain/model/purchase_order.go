@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 采购单状态常量
+const (
+	PurchaseOrderStatusPlaced   = 1 // 已下单
+	PurchaseOrderStatusPartial  = 2 // 部分收货
+	PurchaseOrderStatusReceived = 3 // 已收货
+)
+
+// PurchaseOrder 采购单，每张采购单对应一个供应商
+type PurchaseOrder struct {
+	ID              int64          `gorm:"primaryKey;autoIncrement;comment:ID"`
+	PurchaseOrderNo string         `gorm:"column:purchase_order_no;type:varchar(50);not null;uniqueIndex:uk_purchase_order_no;comment:采购单号"`
+	SupplierID      int64          `gorm:"column:supplier_id;not null;index:idx_supplier_id;comment:供应商ID"`
+	Status          uint8          `gorm:"column:status;not null;default:1;index:idx_status;comment:状态:1=已下单 2=部分收货 3=已收货"`
+	TotalQuantity   int32          `gorm:"column:total_quantity;not null;default:0;comment:订货总数量"`
+	TotalAmount     float64        `gorm:"column:total_amount;type:decimal(18,2);not null;default:0.00;comment:订货总金额"`
+	OperatorID      int            `gorm:"column:operator_id;not null;default:0;comment:操作人ID"`
+	Remark          string         `gorm:"column:remark;type:varchar(255);not null;default:'';comment:备注"`
+	CreatedAt       time.Time      `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+	UpdatedAt       time.Time      `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
+	DeletedAt       gorm.DeletedAt `gorm:"index;comment:删除时间"`
+
+	// 关联关系
+	Lines []PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID;references:ID"`
+}
+
+// TableName 指定表名
+func (PurchaseOrder) TableName() string {
+	return "purchase_orders"
+}
+
+// PurchaseOrderLine 采购单明细，同一采购单内每个SKU一行
+type PurchaseOrderLine struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement;comment:ID"`
+	PurchaseOrderID   int64     `gorm:"column:purchase_order_id;not null;index:idx_purchase_order_id;comment:采购单ID"`
+	SkuID             int64     `gorm:"column:sku_id;not null;index:idx_sku_id;comment:SKU ID"`
+	RequestedQuantity int32     `gorm:"column:requested_quantity;not null;default:0;comment:补货申请数量"`
+	Quantity          int32     `gorm:"column:quantity;not null;default:0;comment:订货数量，按最小起订量取整"`
+	ReceivedQuantity  int32     `gorm:"column:received_quantity;not null;default:0;comment:已收货数量"`
+	UnitPrice         float64   `gorm:"column:unit_price;type:decimal(10,2);not null;default:0.00;comment:供应价"`
+	Amount            float64   `gorm:"column:amount;type:decimal(18,2);not null;default:0.00;comment:金额"`
+	CreatedAt         time.Time `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+	UpdatedAt         time.Time `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
+
+	// 关联关系
+	Restocks []PurchaseOrderLineRestock `gorm:"foreignKey:PurchaseOrderLineID;references:ID"`
+}
+
+// TableName 指定表名
+func (PurchaseOrderLine) TableName() string {
+	return "purchase_order_lines"
+}
+
+// RemainingQuantity 尚未收货的数量
+func (l *PurchaseOrderLine) RemainingQuantity() int32 {
+	if l.ReceivedQuantity >= l.Quantity {
+		return 0
+	}
+	return l.Quantity - l.ReceivedQuantity
+}
+
+// RestockIDs 明细关联的补货记录ID
+func (l *PurchaseOrderLine) RestockIDs() []int64 {
+	ids := make([]int64, 0, len(l.Restocks))
+	for _, restock := range l.Restocks {
+		ids = append(ids, restock.RestockID)
+	}
+	return ids
+}
+
+// PurchaseOrderLineRestock 采购单明细与补货记录的关联，一条明细可合并多条补货记录
+type PurchaseOrderLineRestock struct {
+	ID                  int64     `gorm:"primaryKey;autoIncrement;comment:ID"`
+	PurchaseOrderLineID int64     `gorm:"column:purchase_order_line_id;not null;uniqueIndex:uk_line_restock;comment:采购单明细ID"`
+	RestockID           int64     `gorm:"column:restock_id;not null;uniqueIndex:uk_line_restock;index:idx_restock_id;comment:补货记录ID"`
+	CreatedAt           time.Time `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+}
+
+// TableName 指定表名
+func (PurchaseOrderLineRestock) TableName() string {
+	return "purchase_order_line_restocks"
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// 价格变更状态
+const (
+	PriceChangeStatusPending  = 0 // 待生效
+	PriceChangeStatusApplied  = 1 // 已生效
+	PriceChangeStatusReverted = 2 // 生效期结束，已恢复为生效前的价格
+)
+
+// 价格变更类型
+const (
+	PriceChangeTypeScheduled = 1 // 计划调价
+	PriceChangeTypeRevert    = 2 // 限时调价到期后恢复原价，RevertOf为对应的调价记录
+)
+
+// SkuPriceHistory SKU价格变更记录表
+// 每条已生效的记录对应SKU价格的一次实际变化，按生效时间回放即可得到任意时刻的价格；
+// 带结束时间的限时调价到期后写入一条恢复原价的记录；同一时刻生效的多条记录按生效序号区分先后
+type SkuPriceHistory struct {
+	ID                  int64      `gorm:"primaryKey;autoIncrement;comment:ID"`
+	SkuID               int64      `gorm:"not null;index:idx_sku_applied,priority:1;comment:SKU ID"`
+	ChangeType          int8       `gorm:"not null;default:1;comment:类型：1-计划调价 2-恢复原价"`
+	Price               float64    `gorm:"type:decimal(18,2);not null;comment:价格"`
+	MarketPrice         *float64   `gorm:"type:decimal(18,2);comment:市场价，为空时不修改"`
+	PreviousPrice       float64    `gorm:"type:decimal(18,2);not null;default:0;comment:生效前价格"`
+	PreviousMarketPrice *float64   `gorm:"type:decimal(18,2);comment:生效前市场价"`
+	EffectiveFrom       time.Time  `gorm:"not null;index:idx_status_from,priority:2;comment:生效时间"`
+	EffectiveTo         *time.Time `gorm:"comment:结束时间，为空表示长期有效"`
+	Status              int8       `gorm:"not null;default:0;index:idx_status_from,priority:1;comment:状态：0-待生效 1-已生效 2-已恢复原价"`
+	RevertOf            int64      `gorm:"not null;default:0;comment:恢复原价对应的调价记录ID"`
+	AppliedAt           *time.Time `gorm:"index:idx_sku_applied,priority:2;comment:实际生效时间"`
+	AppliedSeq          int64      `gorm:"not null;default:0;index:idx_sku_applied,priority:3;comment:生效序号，同一SKU按执行顺序递增"`
+	OperatorID          int64      `gorm:"not null;default:0;comment:操作人ID"`
+	Reason              string     `gorm:"type:varchar(255);not null;default:'';comment:调价原因"`
+	CreatedAt           time.Time  `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt           time.Time  `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (SkuPriceHistory) TableName() string {
+	return "sku_price_history"
+}
@@ -7,10 +7,11 @@ import (
 
 // 补货状态常量
 const (
-	RestockStatusPending = 1 // 待订货
-	RestockStatusPartial = 2 // 部分订货
-	RestockStatusOrdered = 3 // 已订货
-	RestockStatusFailed  = 4 // 失败
+	RestockStatusPending  = 1 // 待订货
+	RestockStatusPartial  = 2 // 部分订货
+	RestockStatusOrdered  = 3 // 已订货
+	RestockStatusFailed   = 4 // 失败
+	RestockStatusReceived = 5 // 已到货
 )
 
 // 审核状态常量
@@ -20,19 +21,27 @@ const (
 	AuditStatusRejected = 3 // 审核失败
 )
 
+// restockStatusTransitions 补货状态允许的流转，已到货和失败为终态
+var restockStatusTransitions = map[uint8][]uint8{
+	RestockStatusPending: {RestockStatusPartial, RestockStatusOrdered, RestockStatusFailed},
+	RestockStatusPartial: {RestockStatusOrdered, RestockStatusFailed},
+	RestockStatusOrdered: {RestockStatusReceived},
+}
+
 // SkuRestockRecord 补货记录表
 type SkuRestockRecord struct {
-	ID            int64        `gorm:"primaryKey;autoIncrement;comment:ID" json:"id"`
-	UserID        int          `gorm:"column:user_id;not null;default:-1;index:idx_user_id;comment:用户ID" json:"user_id"`
-	SkuID         uint64       `gorm:"column:sku_id;not null;default:0;index:idx_sku_id;comment:SKU ID" json:"sku_id"`
-	Quantity      int32        `gorm:"column:quantity;not null;comment:补货数量" json:"quantity"`
-	Reason        string       `gorm:"column:reason;type:varchar(255);not null;comment:补货原因" json:"reason"`
-	Status        uint8        `gorm:"column:status;not null;default:1;index:idx_status;comment:补货状态:1=待订货 2=部分订货 3=已订货 4=失败" json:"status"`
-	FailedReason  string       `gorm:"column:failed_reason;type:varchar(200);not null;default:'';comment:补货失败原因" json:"failed_reason"`
-	ApplicationNo string       `gorm:"column:application_no;type:varchar(50);not null;default:'';comment:业务流水号" json:"application_no"`
-	CreatedAt     sql.NullTime `gorm:"column:created_at;autoCreateTime;comment:创建时间" json:"created_at"`
-	UpdatedAt     sql.NullTime `gorm:"column:updated_at;autoUpdateTime;comment:更新时间" json:"updated_at"`
-	DeletedAt     sql.NullTime `gorm:"column:deleted_at;index:idx_deleted_at;comment:删除时间" json:"deleted_at"`
+	ID              int64        `gorm:"primaryKey;autoIncrement;comment:ID" json:"id"`
+	UserID          int          `gorm:"column:user_id;not null;default:-1;index:idx_user_id;comment:用户ID" json:"user_id"`
+	SkuID           uint64       `gorm:"column:sku_id;not null;default:0;index:idx_sku_id;comment:SKU ID" json:"sku_id"`
+	Quantity        int32        `gorm:"column:quantity;not null;comment:补货数量" json:"quantity"`
+	OrderedQuantity int32        `gorm:"column:ordered_quantity;not null;default:0;comment:已订货数量" json:"ordered_quantity"`
+	Reason          string       `gorm:"column:reason;type:varchar(255);not null;comment:补货原因" json:"reason"`
+	Status          uint8        `gorm:"column:status;not null;default:1;index:idx_status;comment:补货状态:1=待订货 2=部分订货 3=已订货 4=失败 5=已到货" json:"status"`
+	FailedReason    string       `gorm:"column:failed_reason;type:varchar(200);not null;default:'';comment:补货失败原因" json:"failed_reason"`
+	ApplicationNo   string       `gorm:"column:application_no;type:varchar(50);not null;default:'';comment:业务流水号" json:"application_no"`
+	CreatedAt       sql.NullTime `gorm:"column:created_at;autoCreateTime;comment:创建时间" json:"created_at"`
+	UpdatedAt       sql.NullTime `gorm:"column:updated_at;autoUpdateTime;comment:更新时间" json:"updated_at"`
+	DeletedAt       sql.NullTime `gorm:"column:deleted_at;index:idx_deleted_at;comment:删除时间" json:"deleted_at"`
 
 	// 关联关系
 	Sku    *ProductSku       `gorm:"foreignKey:SkuID;references:ID" json:"sku"`
@@ -44,6 +53,16 @@ func (SkuRestockRecord) TableName() string {
 	return "sku_restock_records"
 }
 
+// CanTransitTo 补货状态能否流转到目标状态
+func (r *SkuRestockRecord) CanTransitTo(status uint8) bool {
+	for _, next := range restockStatusTransitions[r.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
 // SkuRestockAudit 补货审核记录
 type SkuRestockAudit struct {
 	ID                int64        `gorm:"primaryKey;autoIncrement;comment:ID"`
@@ -63,3 +82,16 @@ type SkuRestockAudit struct {
 func (SkuRestockAudit) TableName() string {
 	return "sku_restock_audit"
 }
+
+// RemainingQuantity 尚未订货的数量
+func (r *SkuRestockRecord) RemainingQuantity() int32 {
+	if r.OrderedQuantity >= r.Quantity {
+		return 0
+	}
+	return r.Quantity - r.OrderedQuantity
+}
+
+// Decided 是否已审核，只有待审核的记录可以通过或驳回
+func (a *SkuRestockAudit) Decided() bool {
+	return a.AuditStatus != AuditStatusPending
+}
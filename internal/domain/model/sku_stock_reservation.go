@@ -0,0 +1,30 @@
+package model
+
+import (
+	"database/sql"
+)
+
+// 库存预占状态常量
+const (
+	ReservationStatusHeld      = 1 // 预占中
+	ReservationStatusConfirmed = 2 // 已确认（转为实际扣减）
+	ReservationStatusReleased  = 3 // 已释放
+	ReservationStatusExpired   = 4 // 已过期
+)
+
+// SkuStockReservation 库存预占记录，预占时即扣减可售库存，确认后计入销量，释放或过期后回补库存
+type SkuStockReservation struct {
+	ID        int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	OrderID   int64        `gorm:"column:order_id;not null;default:0;uniqueIndex:uk_order_sku,priority:1;comment:订单ID"`
+	SkuID     int64        `gorm:"column:sku_id;not null;default:0;uniqueIndex:uk_order_sku,priority:2;comment:SKU ID"`
+	Quantity  uint32       `gorm:"column:quantity;not null;default:0;comment:预占数量"`
+	Status    uint8        `gorm:"column:status;not null;default:1;index:idx_status_expires_at,priority:1;comment:状态:1=预占中 2=已确认 3=已释放 4=已过期"`
+	ExpiresAt sql.NullTime `gorm:"column:expires_at;index:idx_status_expires_at,priority:2;comment:过期时间，为空则不过期"`
+	CreatedAt sql.NullTime `gorm:"column:created_at;autoCreateTime;comment:创建时间"`
+	UpdatedAt sql.NullTime `gorm:"column:updated_at;autoUpdateTime;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (SkuStockReservation) TableName() string {
+	return "sku_stock_reservations"
+}
@@ -25,3 +25,20 @@ type SpecValue struct {
 func (SpecValue) TableName() string {
 	return "spec_values"
 }
+
+// SoftDelete 标记删除
+func (m *SpecValue) SoftDelete(now time.Time) {
+	m.IsDeleted = true
+	m.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+}
+
+// Restore 恢复已删除的规格值
+func (m *SpecValue) Restore() {
+	m.IsDeleted = false
+	m.DeletedAt = gorm.DeletedAt{}
+}
+
+// Removed 是否已删除
+func (m *SpecValue) Removed() bool {
+	return m.DeletedAt.Valid
+}
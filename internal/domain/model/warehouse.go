@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 仓库状态
+const (
+	WarehouseStatusDisabled = 0 // 停用，不参与订单分配
+	WarehouseStatusEnabled  = 1 // 启用
+)
+
+// Warehouse 仓库表
+type Warehouse struct {
+	ID        int64          `gorm:"primaryKey;autoIncrement;comment:仓库ID"`
+	Code      string         `gorm:"type:varchar(32);not null;uniqueIndex:uk_code;comment:仓库编码"`
+	Name      string         `gorm:"type:varchar(100);not null;comment:仓库名称"`
+	Priority  int32          `gorm:"not null;default:0;comment:分配优先级，数值越小越优先"`
+	Latitude  float64        `gorm:"type:decimal(10,6);not null;default:0;comment:纬度"`
+	Longitude float64        `gorm:"type:decimal(10,6);not null;default:0;comment:经度"`
+	Status    int8           `gorm:"not null;default:1;comment:状态：0-停用 1-启用"`
+	CreatedAt time.Time      `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;comment:更新时间"`
+	DeletedAt gorm.DeletedAt `gorm:"index;comment:删除时间"`
+}
+
+func (Warehouse) TableName() string {
+	return "warehouses"
+}
+
+// SkuWarehouseStock SKU分仓库存表
+// 分仓库存为仓库中的实物库存，product_skus.stock 仍为可售总库存，所有变更都同时调整两者：
+// 设置分仓库存、手动调整、盘点和采购收货按指定仓库变更，预占和订单支付扣减时按分配结果扣减，
+// 释放、过期和退款时按订单的分配记录回补；未登记到任何仓库的库存视为未分仓库存
+type SkuWarehouseStock struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement;comment:ID"`
+	SkuID       int64     `gorm:"not null;uniqueIndex:uk_sku_warehouse,priority:1;comment:SKU ID"`
+	WarehouseID int64     `gorm:"not null;uniqueIndex:uk_sku_warehouse,priority:2;index:idx_warehouse_id;comment:仓库ID"`
+	Stock       uint32    `gorm:"not null;default:0;comment:分仓库存"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+
+	Warehouse *Warehouse `gorm:"foreignKey:WarehouseID"`
+}
+
+func (SkuWarehouseStock) TableName() string {
+	return "sku_warehouse_stocks"
+}
+
+// OrderWarehouseAllocation 订单分仓记录，预占或订单支付扣减时按分配结果写入，释放、过期和退款时据此回补分仓库存
+// WarehouseID为0表示由未分仓库存满足
+type OrderWarehouseAllocation struct {
+	ID               int64     `gorm:"primaryKey;autoIncrement;comment:ID"`
+	OrderID          int64     `gorm:"not null;index:idx_order_sku,priority:1;comment:订单ID"`
+	SkuID            int64     `gorm:"not null;index:idx_order_sku,priority:2;comment:SKU ID"`
+	WarehouseID      int64     `gorm:"not null;default:0;comment:仓库ID，0为未分仓库存"`
+	Quantity         uint32    `gorm:"not null;default:0;comment:分配数量"`
+	RestoredQuantity uint32    `gorm:"not null;default:0;comment:已回补数量"`
+	CreatedAt        time.Time `gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (OrderWarehouseAllocation) TableName() string {
+	return "order_warehouse_allocations"
+}
+
+// RestorableQuantity 尚未回补的数量
+func (a *OrderWarehouseAllocation) RestorableQuantity() uint32 {
+	if a.RestoredQuantity >= a.Quantity {
+		return 0
+	}
+	return a.Quantity - a.RestoredQuantity
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// BrandProductStat 品牌下上架的商品与SKU数量
+type BrandProductStat struct {
+	BrandID      int64
+	ProductCount int64
+	SkuCount     int64
+}
+
+// BrandRepository 品牌仓储接口
+type BrandRepository interface {
+	// FindByID 根据ID查询品牌
+	FindByID(ctx context.Context, id int64) (*model.ProductBrand, error)
+
+	// ExistsName 品牌名称是否已存在
+	ExistsName(ctx context.Context, name string, excludeID int64) (bool, error)
+
+	// Create 创建品牌
+	Create(ctx context.Context, brand *model.ProductBrand) error
+
+	// Update 更新品牌名称和Logo
+	Update(ctx context.Context, brand *model.ProductBrand) error
+
+	// Delete 软删除品牌
+	Delete(ctx context.Context, id int64) error
+
+	// List 分页查询品牌，keyword匹配品牌名称
+	List(ctx context.Context, keyword string, offset, limit int) ([]model.ProductBrand, int64, error)
+
+	// CountActiveProducts 统计品牌下上架的商品和SKU数量，没有商品的品牌不在结果中
+	CountActiveProducts(ctx context.Context, brandIDs []int64) ([]BrandProductStat, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// DeadLetterFilter 死信查询条件，零值字段不参与过滤
+type DeadLetterFilter struct {
+	Topic     string
+	EventType string
+	Status    uint8
+}
+
+// DeadLetterRepository 死信仓储接口
+type DeadLetterRepository interface {
+	// Create 写入死信，同一事件同一时间进入死信队列的记录已存在时返回false
+	Create(ctx context.Context, deadLetter *model.DeadLetter) (bool, error)
+	// FindByID 根据ID查询死信
+	FindByID(ctx context.Context, id int64) (*model.DeadLetter, error)
+	// FindByIDsForUpdate 锁定指定的死信，按ID升序返回
+	FindByIDsForUpdate(ctx context.Context, ids []int64) ([]model.DeadLetter, error)
+	// ListByCursor 按ID倒序游标分页查询死信，cursor为0时从最新一条开始
+	ListByCursor(ctx context.Context, filter *DeadLetterFilter, cursor int64, limit int) ([]model.DeadLetter, error)
+	// UpdateStatus 更新死信的处理状态
+	UpdateStatus(ctx context.Context, deadLetter *model.DeadLetter) error
+}
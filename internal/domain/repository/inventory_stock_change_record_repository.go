@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/zhanshen02154/product/internal/domain/model"
 )
 
@@ -12,6 +14,16 @@ type DailySalesData struct {
 	Date        string
 }
 
+// StockChangeFilter 库存变更记录查询条件，零值字段不参与过滤
+type StockChangeFilter struct {
+	SkuID           int64
+	OrderID         int64
+	PurchaseOrderID int64
+	SourceType      int32
+	StartTime       time.Time // 包含
+	EndTime         time.Time // 包含
+}
+
 // InventoryStockChangeRecordRepository 库存变更记录仓储接口
 type InventoryStockChangeRecordRepository interface {
 	// BatchCreate 批量创建库存变更记录
@@ -20,4 +32,14 @@ type InventoryStockChangeRecordRepository interface {
 	GetSalesVolume(ctx context.Context, skuID int64, startTime, endTime string) (int64, float64, error)
 	// GetDailySales 获取SKU在指定时间范围内的每日销量数据
 	GetDailySales(ctx context.Context, skuID int64, skuCode string, startDate, endDate string) ([]*DailySalesData, error)
+	// SumQuantityByOrderId 按SKU汇总订单指定来源类型的变更数量
+	SumQuantityByOrderId(ctx context.Context, orderId int64, sourceType int32) (map[int64]int64, error)
+	// ListByCursor 按ID倒序查询ID小于游标的变更记录，游标为0时从最新记录开始
+	ListByCursor(ctx context.Context, filter *StockChangeFilter, cursor int64, limit int) ([]model.InventoryStockChangeRecord, error)
+	// FindLatestAtOrBefore 查询SKU在指定时间及之前的最后一条变更记录
+	FindLatestAtOrBefore(ctx context.Context, skuID int64, at time.Time) (*model.InventoryStockChangeRecord, error)
+	// FindEarliestAfter 查询SKU在指定时间之后的第一条变更记录
+	FindEarliestAfter(ctx context.Context, skuID int64, at time.Time) (*model.InventoryStockChangeRecord, error)
+	// FindLatestBySkuIDs 查询各SKU最后写入的一条变更记录，没有记录的SKU不在结果中
+	FindLatestBySkuIDs(ctx context.Context, skuIDs []int64) (map[int64]*model.InventoryStockChangeRecord, error)
 }
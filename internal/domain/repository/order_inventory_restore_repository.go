@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// OrderInventoryRestoreRepository 订单库存回补记录仓储接口
+type OrderInventoryRestoreRepository interface {
+	// ExistsByRestoreKey 检查回补是否已处理过
+	ExistsByRestoreKey(ctx context.Context, restoreKey string) (bool, error)
+	// Create 创建回补记录（须在回补的事务内调用），回补键已存在时返回false
+	Create(ctx context.Context, restore *model.OrderInventoryRestore) (bool, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// OutboxEventRepository 事务发件箱仓储接口
+type OutboxEventRepository interface {
+	// Create 写入发件箱（须在业务事务内调用）
+	Create(ctx context.Context, outboxEvent *model.OutboxEvent) error
+	// FindPendingForUpdate 锁定待发布及发布超时的事件，已被其他实例锁定的行会被跳过
+	FindPendingForUpdate(ctx context.Context, staleBefore time.Time, limit int) ([]model.OutboxEvent, error)
+	// MarkPublishing 标记为发布中
+	MarkPublishing(ctx context.Context, ids []int64) error
+	// MarkSent 标记为已发布
+	MarkSent(ctx context.Context, id int64) error
+	// MarkFailed 记录发布失败，重试次数达到上限后不再发布
+	MarkFailed(ctx context.Context, id int64, reason string, maxRetries int) error
+	// DeleteSentBefore 清理指定时间之前已发布的事件
+	DeleteSentBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// ProcessedEventRepository 已处理事件仓储接口
+type ProcessedEventRepository interface {
+	// Create 记录事件已处理（须在处理器的事务内调用），该处理器已处理过该事件时返回false
+	Create(ctx context.Context, processedEvent *model.ProcessedEvent) (bool, error)
+	// DeleteBefore 清理指定时间之前的处理记录
+	DeleteBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
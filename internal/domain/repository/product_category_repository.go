@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// ProductCategoryRepository 商品分类仓储接口
+type ProductCategoryRepository interface {
+	// FindByID 根据ID查询分类
+	FindByID(ctx context.Context, id int64) (*model.ProductCategory, error)
+
+	// FindAll 查询全部分类，分类数量有限，树形结构在内存中组装
+	FindAll(ctx context.Context) ([]model.ProductCategory, error)
+
+	// ExistsSiblingName 同一父分类下是否已存在同名分类
+	ExistsSiblingName(ctx context.Context, parentID int64, name string, excludeID int64) (bool, error)
+
+	// Create 创建分类
+	Create(ctx context.Context, category *model.ProductCategory) error
+
+	// UpdateName 修改分类名称
+	UpdateName(ctx context.Context, id int64, name string) error
+
+	// UpdateParent 修改父分类和层级
+	UpdateParent(ctx context.Context, id int64, parentID int64, level int) error
+
+	// ShiftLevel 批量调整分类层级
+	ShiftLevel(ctx context.Context, ids []int64, delta int) error
+
+	// CountChildren 统计子分类数量
+	CountChildren(ctx context.Context, id int64) (int64, error)
+
+	// Delete 软删除分类
+	Delete(ctx context.Context, id int64) error
+}
@@ -2,9 +2,15 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"github.com/zhanshen02154/product/internal/domain/model"
 )
 
+// ErrProductNotFound 商品不存在
+var ErrProductNotFound = errors.New("product not found")
+
 type IProductRepository interface {
 	FindProductByID(ctx context.Context, id int64) (*model.Product, error)
 	CreateProduct(ctx context.Context, productInfo *model.Product) (int64, error)
@@ -12,6 +18,26 @@ type IProductRepository interface {
 	FindProductListByIds(ctx context.Context, productIds []int64) ([]model.Product, error)
 	DeductProductSizeInventory(ctx context.Context, id int64, num int64) error
 	DeductProductInventory(ctx context.Context, id int64, num int64) error
-	DeductProductSizeInvetoryRevert(ctx context.Context, id int64, num int64) error
-	DeductProductInventoryRevert(ctx context.Context, id int64, num int64) error
+	FindProductDetailByID(ctx context.Context, id int64) (*model.Product, error)
+	ExistsProductNo(ctx context.Context, productNo string) (bool, error)
+	UpdateProduct(ctx context.Context, product *model.Product) error
+	ListProducts(ctx context.Context, filter *ProductListFilter, offset, limit int) ([]model.Product, int64, error)
+	DeleteProduct(ctx context.Context, id int64) error
+	FindSpecsByProductID(ctx context.Context, productID int64) ([]model.ProductSpec, error)
+	SaveSpec(ctx context.Context, spec *model.ProductSpec) error
+	SaveSpecValue(ctx context.Context, value *model.SpecValue) error
+	CountByCategoryIds(ctx context.Context, categoryIds []int64) (int64, error)
+	CountByBrandId(ctx context.Context, brandId int64) (int64, error)
+	ReassignBrand(ctx context.Context, fromBrandId, toBrandId int64, productIds []int64) (int64, error)
+	FindProductStatusForUpdate(ctx context.Context, id int64) (*model.Product, error)
+	UpdateProductStatus(ctx context.Context, product *model.Product) error
+	ListDueOnSaleProductIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error)
+}
+
+// ProductListFilter 商品列表筛选条件，零值表示不过滤
+type ProductListFilter struct {
+	Keyword    string
+	CategoryID int64
+	BrandID    int64
+	Statuses   []int8
 }
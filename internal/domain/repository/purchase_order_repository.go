@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// PurchaseOrderListFilter 采购单查询条件
+type PurchaseOrderListFilter struct {
+	SupplierID int64
+	Statuses   []uint8
+}
+
+// PurchaseOrderRepository 采购单仓储接口
+type PurchaseOrderRepository interface {
+	// Create 创建采购单及其明细
+	Create(ctx context.Context, order *model.PurchaseOrder) error
+
+	// FindByID 根据ID查询采购单及其明细
+	FindByID(ctx context.Context, id int64) (*model.PurchaseOrder, error)
+
+	// FindByIDForUpdate 锁定采购单并查询其明细
+	FindByIDForUpdate(ctx context.Context, id int64) (*model.PurchaseOrder, error)
+
+	// UpdateStatus 更新采购单状态
+	UpdateStatus(ctx context.Context, id int64, status uint8) error
+
+	// UpdateLineReceived 更新明细的已收货数量
+	UpdateLineReceived(ctx context.Context, lineID int64, receivedQuantity int32) error
+
+	// CountOpenLinesByRestockID 统计包含补货记录且未收满的明细数量
+	CountOpenLinesByRestockID(ctx context.Context, restockID int64) (int64, error)
+
+	// List 分页查询采购单，不包含明细
+	List(ctx context.Context, filter *PurchaseOrderListFilter, offset, limit int) ([]model.PurchaseOrder, int64, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// SkuPriceHistoryRepository SKU价格变更记录仓储接口
+type SkuPriceHistoryRepository interface {
+	// Create 创建价格变更记录
+	Create(ctx context.Context, history *model.SkuPriceHistory) error
+
+	// FindByID 根据ID查询价格变更记录，不存在时返回nil
+	FindByID(ctx context.Context, id int64) (*model.SkuPriceHistory, error)
+
+	// Update 更新价格变更记录
+	Update(ctx context.Context, history *model.SkuPriceHistory) error
+
+	// ListOpenBySkuID 查询SKU待生效的调价及尚未恢复原价的限时调价，按生效时间升序
+	ListOpenBySkuID(ctx context.Context, skuID int64) ([]model.SkuPriceHistory, error)
+
+	// ListDueSkuIDs 查询在指定时间前有待生效或待恢复原价记录的SKU，按ID升序返回ID大于afterId的SKU
+	ListDueSkuIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error)
+
+	// ListBySkuID 分页查询SKU的价格变更记录，按ID倒序
+	ListBySkuID(ctx context.Context, skuID int64, offset, limit int) ([]model.SkuPriceHistory, int64, error)
+
+	// MaxAppliedSeq 查询SKU已生效记录的最大生效序号，没有时返回0
+	MaxAppliedSeq(ctx context.Context, skuID int64) (int64, error)
+
+	// FindLatestAppliedAtOrBefore 查询SKU在指定时间及之前最后生效的记录，不存在时返回nil
+	FindLatestAppliedAtOrBefore(ctx context.Context, skuID int64, at time.Time) (*model.SkuPriceHistory, error)
+
+	// FindEarliestAppliedAfter 查询SKU在指定时间之后最早生效的记录，不存在时返回nil
+	FindEarliestAppliedAfter(ctx context.Context, skuID int64, at time.Time) (*model.SkuPriceHistory, error)
+}
@@ -2,13 +2,34 @@ package repository
 
 import (
 	"context"
+	"errors"
+
 	"github.com/zhanshen02154/product/internal/domain/model"
 )
 
+// ErrInsufficientStock 库存不足
+var ErrInsufficientStock = errors.New("insufficient stock")
+
 type ProductSkuRepository interface {
 	BatchGetSkuByIDsWithFields(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error)
 	DeductInventoryById(ctx context.Context, id int64, count uint32) error
 	GetSkuDetailByID(ctx context.Context, skuID int64) (*model.ProductSku, error)
 	BatchGetSkuInventoryInfo(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error)
 	GetSkuStockBySkuNo(ctx context.Context, skuNo string) (*model.ProductSku, error)
+	BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error)
+	ReserveInventoryById(ctx context.Context, id int64, count uint32) error
+	RestoreInventoryById(ctx context.Context, id int64, count uint32) error
+	IncreaseSalesById(ctx context.Context, id int64, count uint32) error
+	RefundInventoryById(ctx context.Context, id int64, count uint32) error
+	BatchCreateSkus(ctx context.Context, skus []*model.ProductSku) error
+	FindSkusByProductID(ctx context.Context, productID int64) ([]model.ProductSku, error)
+	UpdateSkuInfo(ctx context.Context, sku *model.ProductSku) error
+	ReplaceSkuImages(ctx context.Context, skuID int64, images []*model.SkuImage) error
+	UpdateSkuStatusByIds(ctx context.Context, ids []int64, status int8) error
+	ListSkusByCategoryIds(ctx context.Context, categoryIds []int64, offset, limit int) ([]model.ProductSku, int64, error)
+	AdjustInventoryById(ctx context.Context, id int64, delta int64) error
+	ListSkuStockAfterID(ctx context.Context, afterId int64, limit int) ([]model.ProductSku, error)
+	FindSkuPriceForUpdate(ctx context.Context, id int64) (*model.ProductSku, error)
+	UpdatePriceById(ctx context.Context, id int64, price float64, marketPrice *float64) error
+	FindSkuStatusByProductIDForUpdate(ctx context.Context, productID int64) ([]model.ProductSku, error)
 }
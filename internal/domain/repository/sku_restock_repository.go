@@ -25,6 +25,15 @@ type SkuRestockRepository interface {
 	// GetByApplicationNo 根据业务流水号和用户ID查询补货记录
 	GetByApplicationNo(ctx context.Context, applicationNo string, userID int) (*model.SkuRestockRecord, error)
 
+	// GetByApplicationNoForUpdate 根据业务流水号查询并锁定补货记录
+	GetByApplicationNoForUpdate(ctx context.Context, applicationNo string) (*model.SkuRestockRecord, error)
+
+	// ListOrderableByIDsForUpdate 按ID顺序锁定审核通过且未订满的补货记录
+	ListOrderableByIDsForUpdate(ctx context.Context, ids []int64) ([]model.SkuRestockRecord, error)
+
+	// UpdateOrdered 更新已订货数量和补货状态
+	UpdateOrdered(ctx context.Context, id int64, orderedQuantity int32, status uint8) error
+
 	// ListBySkuID 根据SKU ID查询补货记录列表
 	ListBySkuID(ctx context.Context, skuID uint64, offset, limit int) ([]model.SkuRestockRecord, int64, error)
 
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// SkuStockReservationRepository 库存预占仓储接口
+type SkuStockReservationRepository interface {
+	// BatchCreate 批量创建预占记录
+	BatchCreate(ctx context.Context, reservations []*model.SkuStockReservation) error
+	// FindByOrderIdForUpdate 锁定订单的全部预占记录
+	FindByOrderIdForUpdate(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error)
+	// FindSkuIdsByOrderId 查询订单预占过的SKU ID，不加锁
+	FindSkuIdsByOrderId(ctx context.Context, orderId int64) ([]int64, error)
+	// FindExpiredForUpdate 锁定已过期仍处于预占中的记录，已被其他实例锁定的行会被跳过
+	FindExpiredForUpdate(ctx context.Context, now time.Time, limit int) ([]model.SkuStockReservation, error)
+	// UpdateStatusByIds 将指定状态的预占记录更新为新状态，返回受影响行数
+	UpdateStatusByIds(ctx context.Context, ids []int64, fromStatus uint8, toStatus uint8) (int64, error)
+}
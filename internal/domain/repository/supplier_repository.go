@@ -2,12 +2,64 @@ package repository
 
 import (
 	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
 )
 
 // SupplierRepository 供应商仓储接口
 type SupplierRepository interface {
 	// GetSupplierInfoBySkuID 获取指定SKU的供应商信息列表
 	GetSupplierInfoBySkuID(ctx context.Context, skuID int64) ([]*SupplierInfo, error)
+
+	// FindByID 根据ID查询供应商
+	FindByID(ctx context.Context, id int64) (*model.Supplier, error)
+
+	// ExistsName 供应商名称是否已存在
+	ExistsName(ctx context.Context, name string, excludeID int64) (bool, error)
+
+	// Create 创建供应商
+	Create(ctx context.Context, supplier *model.Supplier) error
+
+	// Update 更新供应商信息
+	Update(ctx context.Context, supplier *model.Supplier) error
+
+	// Delete 软删除供应商
+	Delete(ctx context.Context, id int64) error
+
+	// List 分页查询供应商，keyword匹配供应商名称
+	List(ctx context.Context, keyword string, offset, limit int) ([]model.Supplier, int64, error)
+
+	// CountSupplierProducts 统计供应商关联的SKU数量
+	CountSupplierProducts(ctx context.Context, supplierID int64) (int64, error)
+
+	// FindSupplierProduct 查询供应商与SKU的关联
+	FindSupplierProduct(ctx context.Context, supplierID int64, skuID int64) (*model.SupplierProduct, error)
+
+	// SaveSupplierProduct 创建或更新供应商与SKU的关联
+	SaveSupplierProduct(ctx context.Context, supplierProduct *model.SupplierProduct) error
+
+	// DeleteSupplierProduct 删除供应商与SKU的关联
+	DeleteSupplierProduct(ctx context.Context, id int64) error
+
+	// ClearPreferred 取消SKU除excludeID外其他关联的首选标记
+	ClearPreferred(ctx context.Context, skuID int64, excludeID int64) error
+
+	// ListSkusBySupplier 分页查询供应商供应的SKU
+	ListSkusBySupplier(ctx context.Context, supplierID int64, offset, limit int) ([]*SupplierSkuInfo, int64, error)
+}
+
+// SupplierSkuInfo 供应商供应的SKU及供货信息
+type SupplierSkuInfo struct {
+	SkuID            int64   // SKU ID
+	SkuNo            string  // SKU编号
+	SkuName          string  // SKU名称
+	SpecValueText    string  // 规格文本
+	Price            float64 // 售价
+	Stock            uint32  // 库存
+	Status           int8    // SKU状态
+	SupplyPrice      float64 // 供应价
+	MinOrderQuantity int     // 最小起订量
+	IsPreferred      bool    // 是否首选
 }
 
 // SupplierInfo 供应商信息（包含供应商和关联的商品信息）
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// WarehouseRepository 仓库及分仓库存仓储接口
+type WarehouseRepository interface {
+	// FindByID 根据ID查询仓库
+	FindByID(ctx context.Context, id int64) (*model.Warehouse, error)
+
+	// ExistsCode 仓库编码是否已存在
+	ExistsCode(ctx context.Context, code string) (bool, error)
+
+	// Create 创建仓库
+	Create(ctx context.Context, warehouse *model.Warehouse) error
+
+	// List 查询全部仓库，按优先级升序
+	List(ctx context.Context) ([]model.Warehouse, error)
+
+	// ListSkuStocks 查询SKU在各仓库的库存（包含仓库信息）
+	ListSkuStocks(ctx context.Context, skuID int64) ([]model.SkuWarehouseStock, error)
+
+	// ListEnabledSkuStocksForUpdate 锁定并查询SKU在启用仓库中的库存（包含仓库信息）
+	ListEnabledSkuStocksForUpdate(ctx context.Context, skuIDs []int64) ([]model.SkuWarehouseStock, error)
+
+	// FindSkuStockForUpdate 锁定并查询SKU在指定仓库的库存，不存在时返回nil
+	FindSkuStockForUpdate(ctx context.Context, skuID int64, warehouseID int64) (*model.SkuWarehouseStock, error)
+
+	// SaveSkuStock 创建或更新分仓库存
+	SaveSkuStock(ctx context.Context, stock *model.SkuWarehouseStock) error
+
+	// DeductSkuStock 扣减分仓库存，库存不足时返回ErrInsufficientStock
+	DeductSkuStock(ctx context.Context, id int64, count uint32) error
+
+	// BatchCreateAllocations 批量写入订单分仓记录
+	BatchCreateAllocations(ctx context.Context, allocations []*model.OrderWarehouseAllocation) error
+
+	// FindAllocationsByOrderIdForUpdate 锁定并查询订单的分仓记录，按ID升序
+	FindAllocationsByOrderIdForUpdate(ctx context.Context, orderID int64) ([]model.OrderWarehouseAllocation, error)
+
+	// UpdateAllocationRestored 更新分仓记录的已回补数量
+	UpdateAllocationRestored(ctx context.Context, id int64, restoredQuantity uint32) error
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IBrandService interface {
+	CreateBrand(ctx context.Context, name string, logo string) (*dto.BrandDto, error)
+	UpdateBrand(ctx context.Context, id int64, name string, logo string) (*dto.BrandDto, error)
+	GetBrand(ctx context.Context, id int64) (*dto.BrandDto, error)
+	ListBrands(ctx context.Context, keyword string, page, pageSize int32) ([]*dto.BrandDto, int64, error)
+	DeleteBrand(ctx context.Context, id int64) error
+	ReassignProducts(ctx context.Context, req *dto.ReassignBrandProductsDto) (int64, error)
+}
+
+// NewBrandService 创建品牌服务
+func NewBrandService(brandRepo repository.BrandRepository, productRepo repository.IProductRepository) IBrandService {
+	return &BrandService{brandRepo: brandRepo, productRepo: productRepo}
+}
+
+// BrandService 品牌服务
+type BrandService struct {
+	brandRepo   repository.BrandRepository
+	productRepo repository.IProductRepository
+}
+
+// CreateBrand 创建品牌，品牌名称不可重复
+func (s *BrandService) CreateBrand(ctx context.Context, name string, logo string) (*dto.BrandDto, error) {
+	name, err := validateBrandInput(name, logo)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBrandName(ctx, name, 0); err != nil {
+		return nil, err
+	}
+	brand := &model.ProductBrand{BrandName: name}
+	if logo != "" {
+		brand.Logo = &logo
+	}
+	if err := s.brandRepo.Create(ctx, brand); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create brand: "+err.Error())
+	}
+	return toBrandDto(brand), nil
+}
+
+// UpdateBrand 更新品牌名称和Logo
+func (s *BrandService) UpdateBrand(ctx context.Context, id int64, name string, logo string) (*dto.BrandDto, error) {
+	name, err := validateBrandInput(name, logo)
+	if err != nil {
+		return nil, err
+	}
+	brand, err := s.findBrand(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBrandName(ctx, name, id); err != nil {
+		return nil, err
+	}
+	brand.BrandName = name
+	brand.Logo = nil
+	if logo != "" {
+		brand.Logo = &logo
+	}
+	if err := s.brandRepo.Update(ctx, brand); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update brand: "+err.Error())
+	}
+	return s.GetBrand(ctx, id)
+}
+
+// GetBrand 获取品牌及其上架的商品、SKU数量
+func (s *BrandService) GetBrand(ctx context.Context, id int64) (*dto.BrandDto, error) {
+	brand, err := s.findBrand(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result := []*dto.BrandDto{toBrandDto(brand)}
+	if err := s.fillCounts(ctx, result); err != nil {
+		return nil, err
+	}
+	return result[0], nil
+}
+
+// ListBrands 分页查询品牌及其上架的商品、SKU数量
+func (s *BrandService) ListBrands(ctx context.Context, keyword string, page, pageSize int32) ([]*dto.BrandDto, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	brands, total, err := s.brandRepo.List(ctx, strings.TrimSpace(keyword), int(page-1)*int(pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list brands: "+err.Error())
+	}
+	result := make([]*dto.BrandDto, 0, len(brands))
+	for i := range brands {
+		result = append(result, toBrandDto(&brands[i]))
+	}
+	if err := s.fillCounts(ctx, result); err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+// DeleteBrand 删除品牌，仍被商品引用时拒绝删除，须先迁移商品
+func (s *BrandService) DeleteBrand(ctx context.Context, id int64) error {
+	if _, err := s.findBrand(ctx, id); err != nil {
+		return err
+	}
+	products, err := s.productRepo.CountByBrandId(ctx, id)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count products: "+err.Error())
+	}
+	if products > 0 {
+		return status.Error(codes.FailedPrecondition, "brand is referenced by "+strconv.FormatInt(products, 10)+" products")
+	}
+	if err := s.brandRepo.Delete(ctx, id); err != nil {
+		return status.Error(codes.Internal, "failed to delete brand: "+err.Error())
+	}
+	return nil
+}
+
+// ReassignProducts 将原品牌下的商品迁移到目标品牌，目标品牌为0时解除品牌关联，返回迁移的商品数量
+func (s *BrandService) ReassignProducts(ctx context.Context, req *dto.ReassignBrandProductsDto) (int64, error) {
+	if req.FromBrandID <= 0 || req.ToBrandID < 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid from_brand_id or to_brand_id")
+	}
+	if req.FromBrandID == req.ToBrandID {
+		return 0, status.Error(codes.InvalidArgument, "from_brand_id and to_brand_id cannot be the same")
+	}
+	if len(req.ProductIDs) > maxPageSize {
+		return 0, status.Error(codes.InvalidArgument, "product_ids cannot be more than "+strconv.Itoa(maxPageSize))
+	}
+	if _, err := s.findBrand(ctx, req.FromBrandID); err != nil {
+		return 0, err
+	}
+	if req.ToBrandID > 0 {
+		if _, err := s.findBrand(ctx, req.ToBrandID); err != nil {
+			return 0, err
+		}
+	}
+	affected, err := s.productRepo.ReassignBrand(ctx, req.FromBrandID, req.ToBrandID, req.ProductIDs)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "failed to reassign products: "+err.Error())
+	}
+	return affected, nil
+}
+
+// findBrand 查询品牌，不存在时返回NotFound
+func (s *BrandService) findBrand(ctx context.Context, id int64) (*model.ProductBrand, error) {
+	if id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid brand id")
+	}
+	brand, err := s.brandRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query brand: "+err.Error())
+	}
+	if brand == nil {
+		return nil, status.Error(codes.NotFound, "brand "+strconv.FormatInt(id, 10)+" not found")
+	}
+	return brand, nil
+}
+
+// checkBrandName 品牌名称不可重复
+func (s *BrandService) checkBrandName(ctx context.Context, name string, excludeId int64) error {
+	exists, err := s.brandRepo.ExistsName(ctx, name, excludeId)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to check brand name: "+err.Error())
+	}
+	if exists {
+		return status.Error(codes.AlreadyExists, "brand "+name+" already exists")
+	}
+	return nil
+}
+
+// fillCounts 填充品牌上架的商品和SKU数量
+func (s *BrandService) fillCounts(ctx context.Context, brands []*dto.BrandDto) error {
+	if len(brands) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(brands))
+	for _, brand := range brands {
+		ids = append(ids, brand.ID)
+	}
+	stats, err := s.brandRepo.CountActiveProducts(ctx, ids)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count products of brands: "+err.Error())
+	}
+	statMap := make(map[int64]repository.BrandProductStat, len(stats))
+	for _, stat := range stats {
+		statMap[stat.BrandID] = stat
+	}
+	for _, brand := range brands {
+		if stat, ok := statMap[brand.ID]; ok {
+			brand.ActiveProductCount = stat.ProductCount
+			brand.ActiveSkuCount = stat.SkuCount
+		}
+	}
+	return nil
+}
+
+// validateBrandInput 校验品牌名称和Logo
+func validateBrandInput(name string, logo string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return "", status.Error(codes.InvalidArgument, "brand_name cannot be empty or longer than 100")
+	}
+	if len(logo) > 500 {
+		return "", status.Error(codes.InvalidArgument, "logo cannot be longer than 500")
+	}
+	return name, nil
+}
+
+// toBrandDto 转换品牌，数量另行填充
+func toBrandDto(brand *model.ProductBrand) *dto.BrandDto {
+	result := &dto.BrandDto{
+		ID:        brand.ID,
+		BrandName: brand.BrandName,
+		CreatedAt: brand.CreatedAt,
+		UpdatedAt: brand.UpdatedAt,
+	}
+	if brand.Logo != nil {
+		result.Logo = *brand.Logo
+	}
+	return result
+}
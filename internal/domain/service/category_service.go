@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ICategoryService interface {
+	CreateCategory(ctx context.Context, name string, parentId int64) (*model.ProductCategory, error)
+	RenameCategory(ctx context.Context, id int64, name string) (*model.ProductCategory, error)
+	MoveCategory(ctx context.Context, id int64, parentId int64) (*model.ProductCategory, error)
+	DeleteCategory(ctx context.Context, id int64) error
+	GetCategoryTree(ctx context.Context) ([]*dto.CategoryNodeDto, error)
+	ListSkusByCategory(ctx context.Context, id int64, page, pageSize int32) ([]model.ProductSku, int64, error)
+}
+
+// NewCategoryService 创建分类服务
+func NewCategoryService(categoryRepo repository.ProductCategoryRepository, productRepo repository.IProductRepository, skuRepo repository.ProductSkuRepository) ICategoryService {
+	return &CategoryService{categoryRepo: categoryRepo, productRepo: productRepo, skuRepo: skuRepo}
+}
+
+// CategoryService 商品分类服务
+// 分类树的修改须串行执行，由应用层加锁
+type CategoryService struct {
+	categoryRepo repository.ProductCategoryRepository
+	productRepo  repository.IProductRepository
+	skuRepo      repository.ProductSkuRepository
+}
+
+// CreateCategory 创建分类，parentId为0时创建一级分类
+func (s *CategoryService) CreateCategory(ctx context.Context, name string, parentId int64) (*model.ProductCategory, error) {
+	name, err := validateCategoryName(name)
+	if err != nil {
+		return nil, err
+	}
+	if parentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid parent_id")
+	}
+	level := 1
+	if parentId > 0 {
+		parent, err := s.findCategory(ctx, parentId)
+		if err != nil {
+			return nil, err
+		}
+		level = parent.Level + 1
+	}
+	if level > model.MaxCategoryLevel {
+		return nil, status.Error(codes.FailedPrecondition, "category level cannot exceed "+strconv.Itoa(model.MaxCategoryLevel))
+	}
+	if err := s.checkSiblingName(ctx, parentId, name, 0); err != nil {
+		return nil, err
+	}
+	category := &model.ProductCategory{
+		CategoryName: name,
+		ParentID:     uint(parentId),
+		Level:        level,
+	}
+	if err := s.categoryRepo.Create(ctx, category); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create category: "+err.Error())
+	}
+	return category, nil
+}
+
+// RenameCategory 修改分类名称
+func (s *CategoryService) RenameCategory(ctx context.Context, id int64, name string) (*model.ProductCategory, error) {
+	name, err := validateCategoryName(name)
+	if err != nil {
+		return nil, err
+	}
+	category, err := s.findCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSiblingName(ctx, int64(category.ParentID), name, id); err != nil {
+		return nil, err
+	}
+	if err := s.categoryRepo.UpdateName(ctx, id, name); err != nil {
+		return nil, status.Error(codes.Internal, "failed to rename category: "+err.Error())
+	}
+	category.CategoryName = name
+	return category, nil
+}
+
+// MoveCategory 移动分类到新的父分类下，须在事务内调用
+// 新的父分类不能是自身或其后代，移动后整棵子树的层级一并调整且不能超过最大层级
+func (s *CategoryService) MoveCategory(ctx context.Context, id int64, parentId int64) (*model.ProductCategory, error) {
+	if id == 0 || parentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id or parent_id")
+	}
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query categories: "+err.Error())
+	}
+	byId := make(map[int64]*model.ProductCategory, len(categories))
+	for i := range categories {
+		byId[categories[i].ID] = &categories[i]
+	}
+	category, ok := byId[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "category not found")
+	}
+	if int64(category.ParentID) == parentId {
+		return category, nil
+	}
+	level := 1
+	if parentId > 0 {
+		parent, ok := byId[parentId]
+		if !ok {
+			return nil, status.Error(codes.NotFound, "parent category not found")
+		}
+		// 沿父分类向上查找，遇到自身说明新的父分类在子树内
+		for node, steps := parent, 0; node != nil && steps <= len(categories); node, steps = byId[int64(node.ParentID)], steps+1 {
+			if node.ID == id {
+				return nil, status.Error(codes.FailedPrecondition, "cannot move category under itself or its descendants")
+			}
+		}
+		level = parent.Level + 1
+	}
+
+	descendants := descendantIds(categories, id)
+	delta := level - category.Level
+	depth := 0
+	for _, descendantId := range descendants {
+		if d := byId[descendantId].Level - category.Level; d > depth {
+			depth = d
+		}
+	}
+	if level+depth > model.MaxCategoryLevel {
+		return nil, status.Error(codes.FailedPrecondition, "category level cannot exceed "+strconv.Itoa(model.MaxCategoryLevel))
+	}
+	if err := s.checkSiblingName(ctx, parentId, category.CategoryName, id); err != nil {
+		return nil, err
+	}
+	if err := s.categoryRepo.UpdateParent(ctx, id, parentId, level); err != nil {
+		return nil, status.Error(codes.Internal, "failed to move category: "+err.Error())
+	}
+	if err := s.categoryRepo.ShiftLevel(ctx, descendants, delta); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update level of descendants: "+err.Error())
+	}
+	category.ParentID = uint(parentId)
+	category.Level = level
+	return category, nil
+}
+
+// DeleteCategory 删除分类，存在子分类或被商品引用时拒绝删除
+func (s *CategoryService) DeleteCategory(ctx context.Context, id int64) error {
+	if _, err := s.findCategory(ctx, id); err != nil {
+		return err
+	}
+	children, err := s.categoryRepo.CountChildren(ctx, id)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count children: "+err.Error())
+	}
+	if children > 0 {
+		return status.Error(codes.FailedPrecondition, "category has sub categories")
+	}
+	products, err := s.productRepo.CountByCategoryIds(ctx, []int64{id})
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count products: "+err.Error())
+	}
+	if products > 0 {
+		return status.Error(codes.FailedPrecondition, "category is referenced by "+strconv.FormatInt(products, 10)+" products")
+	}
+	if err := s.categoryRepo.Delete(ctx, id); err != nil {
+		return status.Error(codes.Internal, "failed to delete category: "+err.Error())
+	}
+	return nil
+}
+
+// GetCategoryTree 获取完整的分类树
+func (s *CategoryService) GetCategoryTree(ctx context.Context) ([]*dto.CategoryNodeDto, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query categories: "+err.Error())
+	}
+	nodes := make(map[int64]*dto.CategoryNodeDto, len(categories))
+	for _, item := range categories {
+		nodes[item.ID] = &dto.CategoryNodeDto{
+			ID:           item.ID,
+			CategoryName: item.CategoryName,
+			ParentID:     int64(item.ParentID),
+			Level:        item.Level,
+			Children:     make([]*dto.CategoryNodeDto, 0),
+		}
+	}
+	roots := make([]*dto.CategoryNodeDto, 0)
+	for _, item := range categories {
+		node := nodes[item.ID]
+		parent, ok := nodes[node.ParentID]
+		if node.ParentID == 0 || !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	return roots, nil
+}
+
+// ListSkusByCategory 分页查询分类及其全部子分类下的SKU
+func (s *CategoryService) ListSkusByCategory(ctx context.Context, id int64, page, pageSize int32) ([]model.ProductSku, int64, error) {
+	if id == 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "category_id cannot be empty")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to query categories: "+err.Error())
+	}
+	found := false
+	for _, item := range categories {
+		if item.ID == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, 0, status.Error(codes.NotFound, "category not found")
+	}
+	ids := append([]int64{id}, descendantIds(categories, id)...)
+	skus, total, err := s.skuRepo.ListSkusByCategoryIds(ctx, ids, int(page-1)*int(pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list skus: "+err.Error())
+	}
+	return skus, total, nil
+}
+
+// findCategory 查询分类，不存在时返回NotFound
+func (s *CategoryService) findCategory(ctx context.Context, id int64) (*model.ProductCategory, error) {
+	if id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid category id")
+	}
+	category, err := s.categoryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query category: "+err.Error())
+	}
+	if category == nil {
+		return nil, status.Error(codes.NotFound, "category "+strconv.FormatInt(id, 10)+" not found")
+	}
+	return category, nil
+}
+
+// checkSiblingName 同一父分类下分类名称不可重复
+func (s *CategoryService) checkSiblingName(ctx context.Context, parentId int64, name string, excludeId int64) error {
+	exists, err := s.categoryRepo.ExistsSiblingName(ctx, parentId, name, excludeId)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to check category name: "+err.Error())
+	}
+	if exists {
+		return status.Error(codes.AlreadyExists, "category "+name+" already exists")
+	}
+	return nil
+}
+
+// validateCategoryName 校验分类名称
+func validateCategoryName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return "", status.Error(codes.InvalidArgument, "category_name cannot be empty or longer than 100")
+	}
+	return name, nil
+}
+
+// descendantIds 查找分类的全部后代ID，不包括自身
+func descendantIds(categories []model.ProductCategory, id int64) []int64 {
+	children := make(map[int64][]int64, len(categories))
+	for _, item := range categories {
+		children[int64(item.ParentID)] = append(children[int64(item.ParentID)], item.ID)
+	}
+	result := make([]int64, 0)
+	queue := []int64{id}
+	visited := map[int64]struct{}{id: {}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range children[current] {
+			if _, ok := visited[child]; ok {
+				continue
+			}
+			visited[child] = struct{}{}
+			result = append(result, child)
+			queue = append(queue, child)
+		}
+	}
+	return result
+}
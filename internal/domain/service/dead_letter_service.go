@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IDeadLetterService interface {
+	ListDeadLetters(ctx context.Context, req *dto.ListDeadLettersDto) ([]model.DeadLetter, int64, error)
+	GetDeadLetter(ctx context.Context, id int64) (*model.DeadLetter, error)
+	LockReplayable(ctx context.Context, req *dto.ReplayDeadLettersDto) ([]model.DeadLetter, error)
+	MarkReplayed(ctx context.Context, deadLetter *model.DeadLetter, operatorId int64, now time.Time) error
+	ResolveDeadLetters(ctx context.Context, req *dto.ResolveDeadLettersDto, now time.Time) ([]model.DeadLetter, error)
+}
+
+// NewDeadLetterService 创建死信服务
+func NewDeadLetterService(deadLetterRepo repository.DeadLetterRepository) IDeadLetterService {
+	return &DeadLetterService{deadLetterRepo: deadLetterRepo}
+}
+
+// DeadLetterService 死信服务
+// 待处理或已重新投递的死信可以（再次）重新投递，已解决、已丢弃为终态
+type DeadLetterService struct {
+	deadLetterRepo repository.DeadLetterRepository
+}
+
+// ListDeadLetters 按条件游标分页查询死信，返回下一页游标，没有更多记录时游标为0
+func (s *DeadLetterService) ListDeadLetters(ctx context.Context, req *dto.ListDeadLettersDto) ([]model.DeadLetter, int64, error) {
+	if req.Limit <= 0 || req.Limit > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "limit must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	if req.Cursor < 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	if req.Status < 0 || req.Status > model.DeadLetterStatusDiscarded {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid status")
+	}
+	filter := &repository.DeadLetterFilter{
+		Topic:     req.Topic,
+		EventType: req.EventType,
+		Status:    uint8(req.Status),
+	}
+	// 多查一条判断是否还有下一页
+	deadLetters, err := s.deadLetterRepo.ListByCursor(ctx, filter, req.Cursor, int(req.Limit)+1)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list dead letters: "+err.Error())
+	}
+	var nextCursor int64
+	if len(deadLetters) > int(req.Limit) {
+		deadLetters = deadLetters[:req.Limit]
+		nextCursor = deadLetters[len(deadLetters)-1].ID
+	}
+	return deadLetters, nextCursor, nil
+}
+
+// GetDeadLetter 查询死信详情，包含原消息头和消息体
+func (s *DeadLetterService) GetDeadLetter(ctx context.Context, id int64) (*model.DeadLetter, error) {
+	if id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+	deadLetter, err := s.deadLetterRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query dead letter: "+err.Error())
+	}
+	if deadLetter == nil {
+		return nil, status.Error(codes.NotFound, "dead letter not found")
+	}
+	return deadLetter, nil
+}
+
+// LockReplayable 锁定待重新投递的死信，须在事务内调用
+// 任一死信不存在或已解决、已丢弃时整批拒绝
+func (s *DeadLetterService) LockReplayable(ctx context.Context, req *dto.ReplayDeadLettersDto) ([]model.DeadLetter, error) {
+	if _, err := checkAdjustOperator("", req.OperatorID, false); err != nil {
+		return nil, err
+	}
+	deadLetters, err := s.lockDeadLetters(ctx, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range deadLetters {
+		if deadLetters[i].Status == model.DeadLetterStatusResolved || deadLetters[i].Status == model.DeadLetterStatusDiscarded {
+			return nil, status.Error(codes.FailedPrecondition, "dead letter "+strconv.FormatInt(deadLetters[i].ID, 10)+" is already handled")
+		}
+	}
+	return deadLetters, nil
+}
+
+// MarkReplayed 记录死信已重新投递
+func (s *DeadLetterService) MarkReplayed(ctx context.Context, deadLetter *model.DeadLetter, operatorId int64, now time.Time) error {
+	deadLetter.Status = model.DeadLetterStatusReplayed
+	deadLetter.ReplayCount++
+	deadLetter.ReplayedAt = &now
+	deadLetter.OperatorID = operatorId
+	if err := s.deadLetterRepo.UpdateStatus(ctx, deadLetter); err != nil {
+		return status.Error(codes.Internal, "failed to update dead letter: "+err.Error())
+	}
+	return nil
+}
+
+// ResolveDeadLetters 标记死信已解决或已丢弃，须在事务内调用
+// 已是目标状态的死信跳过，已标记为另一终态的死信整批拒绝
+func (s *DeadLetterService) ResolveDeadLetters(ctx context.Context, req *dto.ResolveDeadLettersDto, now time.Time) ([]model.DeadLetter, error) {
+	if req.Status != model.DeadLetterStatusResolved && req.Status != model.DeadLetterStatusDiscarded {
+		return nil, status.Error(codes.InvalidArgument, "status must be resolved or discarded")
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, false)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters, err := s.lockDeadLetters(ctx, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	toStatus := uint8(req.Status)
+	for i := range deadLetters {
+		deadLetter := &deadLetters[i]
+		if deadLetter.Status == toStatus {
+			continue
+		}
+		if deadLetter.Status == model.DeadLetterStatusResolved || deadLetter.Status == model.DeadLetterStatusDiscarded {
+			return nil, status.Error(codes.FailedPrecondition, "dead letter "+strconv.FormatInt(deadLetter.ID, 10)+" is already handled")
+		}
+		deadLetter.Status = toStatus
+		deadLetter.HandledAt = &now
+		deadLetter.OperatorID = req.OperatorID
+		deadLetter.Reason = reason
+		if err := s.deadLetterRepo.UpdateStatus(ctx, deadLetter); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update dead letter: "+err.Error())
+		}
+	}
+	return deadLetters, nil
+}
+
+// lockDeadLetters 去重后锁定死信，任一死信不存在时返回NotFound
+func (s *DeadLetterService) lockDeadLetters(ctx context.Context, ids []int64) ([]model.DeadLetter, error) {
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids cannot be empty")
+	}
+	if len(ids) > maxPageSize {
+		return nil, status.Error(codes.InvalidArgument, "ids cannot be more than "+strconv.Itoa(maxPageSize))
+	}
+	uniqueIds := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid id")
+		}
+		if !seen[id] {
+			seen[id] = true
+			uniqueIds = append(uniqueIds, id)
+		}
+	}
+	deadLetters, err := s.deadLetterRepo.FindByIDsForUpdate(ctx, uniqueIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query dead letters: "+err.Error())
+	}
+	if len(deadLetters) != len(uniqueIds) {
+		found := make(map[int64]bool, len(deadLetters))
+		for i := range deadLetters {
+			found[deadLetters[i].ID] = true
+		}
+		for _, id := range uniqueIds {
+			if !found[id] {
+				return nil, status.Error(codes.NotFound, "dead letter "+strconv.FormatInt(id, 10)+" not found")
+			}
+		}
+	}
+	return deadLetters, nil
+}
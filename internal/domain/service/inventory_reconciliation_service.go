@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconciliationReason 对账修正流水的变更原因
+const reconciliationReason = "inventory reconciliation"
+
+type IInventoryReconciliationService interface {
+	Reconcile(ctx context.Context, cursor int64, limit int) (*dto.ReconciliationReportDto, error)
+	Correct(ctx context.Context, skuId int64, operatorId int64) (*dto.StockMismatchDto, error)
+}
+
+// NewInventoryReconciliationService 创建库存对账服务
+func NewInventoryReconciliationService(skuRepo repository.ProductSkuRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository) IInventoryReconciliationService {
+	return &InventoryReconciliationService{skuRepo: skuRepo, stockChangeRepo: stockChangeRepo}
+}
+
+// InventoryReconciliationService 库存对账服务
+// 流水按写入顺序回放，最后一条记录的变更后库存即流水应有的库存，与SKU当前库存比较
+// 库存扣减与写流水是两条语句，且人工修改数据库会绕过流水，两者可能不一致
+type InventoryReconciliationService struct {
+	skuRepo         repository.ProductSkuRepository
+	stockChangeRepo repository.InventoryStockChangeRecordRepository
+}
+
+// Reconcile 按SKU ID升序对账ID大于游标的一批SKU，只读，须在事务内调用以保证库存与流水读取同一快照
+func (s *InventoryReconciliationService) Reconcile(ctx context.Context, cursor int64, limit int) (*dto.ReconciliationReportDto, error) {
+	if limit <= 0 || limit > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 1 and 1000")
+	}
+	if cursor < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	skuList, err := s.skuRepo.ListSkuStockAfterID(ctx, cursor, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list skus: "+err.Error())
+	}
+	report := &dto.ReconciliationReportDto{
+		Checked:    len(skuList),
+		Mismatches: make([]*dto.StockMismatchDto, 0),
+	}
+	if len(skuList) == 0 {
+		return report, nil
+	}
+	if len(skuList) == limit {
+		report.NextCursor = skuList[len(skuList)-1].ID
+	}
+	skuIds := make([]int64, 0, len(skuList))
+	for _, sku := range skuList {
+		skuIds = append(skuIds, sku.ID)
+	}
+	latest, err := s.stockChangeRepo.FindLatestBySkuIDs(ctx, skuIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query stock changes: "+err.Error())
+	}
+	for _, sku := range skuList {
+		record, ok := latest[sku.ID]
+		if !ok {
+			report.Untracked++
+			continue
+		}
+		if mismatch := compareLedger(&sku, record); mismatch != nil {
+			report.Mismatches = append(report.Mismatches, mismatch)
+		}
+	}
+	return report, nil
+}
+
+// Correct 锁定SKU后重新比较，仍不一致时写入一条手动调整流水使流水与当前库存一致，库存本身不变
+// 一致或没有任何流水时返回nil，须在事务内调用
+func (s *InventoryReconciliationService) Correct(ctx context.Context, skuId int64, operatorId int64) (*dto.StockMismatchDto, error) {
+	if skuId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	skuList, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, []int64{skuId})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	if len(skuList) == 0 {
+		return nil, status.Error(codes.NotFound, "sku "+strconv.FormatInt(skuId, 10)+" not found")
+	}
+	latest, err := s.stockChangeRepo.FindLatestBySkuIDs(ctx, []int64{skuId})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query stock changes: "+err.Error())
+	}
+	record, ok := latest[skuId]
+	if !ok {
+		return nil, nil
+	}
+	mismatch := compareLedger(&skuList[0], record)
+	if mismatch == nil {
+		return nil, nil
+	}
+	err = s.stockChangeRepo.BatchCreate(ctx, []*model.InventoryStockChangeRecord{{
+		SkuID:       skuId,
+		SourceType:  model.SourceTypeManual,
+		Quantity:    mismatch.Drift,
+		BeforeStock: mismatch.LedgerStock,
+		AfterStock:  int64(mismatch.Stock),
+		OperatorID:  operatorId,
+		Reason:      reconciliationReason,
+	}})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+	}
+	mismatch.Corrected = true
+	return mismatch, nil
+}
+
+// compareLedger 比较SKU当前库存与最后一条流水，一致时返回nil
+func compareLedger(sku *model.ProductSku, record *model.InventoryStockChangeRecord) *dto.StockMismatchDto {
+	if int64(sku.Stock) == record.AfterStock {
+		return nil
+	}
+	return &dto.StockMismatchDto{
+		SkuID:        sku.ID,
+		Stock:        sku.Stock,
+		LedgerStock:  record.AfterStock,
+		Drift:        int64(sku.Stock) - record.AfterStock,
+		LastRecordID: record.ID,
+	}
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPageSize 列表每页最大数量
+	maxPageSize = 100
+)
+
+type IProductCatalogService interface {
+	CreateProduct(ctx context.Context, req *dto.ProductInputDto) (*model.Product, error)
+	CreateProductWithSkus(ctx context.Context, req *dto.CreateProductWithSkusDto) (*model.Product, error)
+	UpdateProduct(ctx context.Context, req *dto.UpdateProductDto) (*model.Product, error)
+	GetProduct(ctx context.Context, id int64) (*model.Product, error)
+	ListProducts(ctx context.Context, req *dto.ListProductsDto) ([]model.Product, int64, error)
+	DeleteProduct(ctx context.Context, id int64) error
+}
+
+// NewProductCatalogService 创建商品目录服务
+func NewProductCatalogService(productRepo repository.IProductRepository, skuRepo repository.ProductSkuRepository, categoryRepo repository.ProductCategoryRepository, brandRepo repository.BrandRepository, matrixService ISkuMatrixService) IProductCatalogService {
+	return &ProductCatalogService{productRepo: productRepo, skuRepo: skuRepo, categoryRepo: categoryRepo, brandRepo: brandRepo, matrixService: matrixService}
+}
+
+// ProductCatalogService 商品目录服务，负责商品、规格、规格值、SKU及SKU图片的维护
+type ProductCatalogService struct {
+	productRepo   repository.IProductRepository
+	skuRepo       repository.ProductSkuRepository
+	categoryRepo  repository.ProductCategoryRepository
+	brandRepo     repository.BrandRepository
+	matrixService ISkuMatrixService
+}
+
+// CreateProduct 创建商品，不生成规格和SKU
+func (s *ProductCatalogService) CreateProduct(ctx context.Context, req *dto.ProductInputDto) (*model.Product, error) {
+	product, err := s.newProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.productRepo.CreateProduct(ctx, product); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create product: "+err.Error())
+	}
+	return s.GetProduct(ctx, product.ID)
+}
+
+// CreateProductWithSkus 创建商品，并按规格矩阵生成SKU，须在事务内调用
+func (s *ProductCatalogService) CreateProductWithSkus(ctx context.Context, req *dto.CreateProductWithSkusDto) (*model.Product, error) {
+	if req.DefaultSku == nil {
+		return nil, status.Error(codes.InvalidArgument, "default_sku cannot be empty")
+	}
+	product, err := s.newProduct(ctx, req.Product)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.productRepo.CreateProduct(ctx, product); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create product: "+err.Error())
+	}
+	_, err = s.matrixService.ApplySkuMatrix(ctx, &dto.SkuMatrixDto{
+		ProductID:  product.ID,
+		Specs:      req.Specs,
+		DefaultSku: req.DefaultSku,
+		Skus:       req.Skus,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetProduct(ctx, product.ID)
+}
+
+// UpdateProduct 更新商品基本信息和SKU，须在事务内调用
+func (s *ProductCatalogService) UpdateProduct(ctx context.Context, req *dto.UpdateProductDto) (*model.Product, error) {
+	if req.ID == 0 || req.Product == nil {
+		return nil, status.Error(codes.InvalidArgument, "id or product cannot be empty")
+	}
+	if err := validateProductInput(req.Product, false); err != nil {
+		return nil, err
+	}
+	product, err := s.GetProduct(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status == model.ProductStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "archived product cannot be modified")
+	}
+	if err := s.checkCategory(ctx, req.Product.CategoryID); err != nil {
+		return nil, err
+	}
+	if err := s.checkBrand(ctx, req.Product.BrandID); err != nil {
+		return nil, err
+	}
+	skuMap := make(map[int64]struct{}, len(product.Skus))
+	for _, sku := range product.Skus {
+		skuMap[sku.ID] = struct{}{}
+	}
+	updated := make(map[int64]struct{}, len(req.Skus))
+	for _, item := range req.Skus {
+		if item == nil {
+			continue
+		}
+		if _, ok := skuMap[item.ID]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "sku "+strconv.FormatInt(item.ID, 10)+" does not belong to product")
+		}
+		if _, ok := updated[item.ID]; ok {
+			return nil, status.Error(codes.InvalidArgument, "duplicate sku "+strconv.FormatInt(item.ID, 10))
+		}
+		updated[item.ID] = struct{}{}
+		if strings.TrimSpace(item.SkuName) == "" {
+			return nil, status.Error(codes.InvalidArgument, "sku_name cannot be empty")
+		}
+		if err := validateSkuInput(item.SkuName, item.Price, item.MarketPrice, item.MainImage, item.Images); err != nil {
+			return nil, err
+		}
+	}
+
+	fillProduct(product, req.Product)
+	if err := s.productRepo.UpdateProduct(ctx, product); err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, status.Error(codes.NotFound, "product not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update product: "+err.Error())
+	}
+	for _, item := range req.Skus {
+		if item == nil {
+			continue
+		}
+		sku := &model.ProductSku{
+			ID:        item.ID,
+			SkuName:   strings.TrimSpace(item.SkuName),
+			Price:     item.Price,
+			StockWarn: item.StockWarn,
+			MainImage: skuMainImage(item.MainImage, item.Images),
+		}
+		if item.MarketPrice > 0 {
+			marketPrice := item.MarketPrice
+			sku.MarketPrice = &marketPrice
+		}
+		if err := s.skuRepo.UpdateSkuInfo(ctx, sku); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update sku "+strconv.FormatInt(item.ID, 10)+": "+err.Error())
+		}
+		images := newSkuImages(item.Images)
+		imagePtrs := make([]*model.SkuImage, 0, len(images))
+		for i := range images {
+			imagePtrs = append(imagePtrs, &images[i])
+		}
+		if err := s.skuRepo.ReplaceSkuImages(ctx, item.ID, imagePtrs); err != nil {
+			return nil, status.Error(codes.Internal, "failed to replace sku images: "+err.Error())
+		}
+	}
+	return s.GetProduct(ctx, req.ID)
+}
+
+// GetProduct 获取商品详情
+func (s *ProductCatalogService) GetProduct(ctx context.Context, id int64) (*model.Product, error) {
+	if id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be empty")
+	}
+	product, err := s.productRepo.FindProductDetailByID(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get product: "+err.Error())
+	}
+	if product == nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	return product, nil
+}
+
+// ListProducts 分页查询商品
+func (s *ProductCatalogService) ListProducts(ctx context.Context, req *dto.ListProductsDto) ([]model.Product, int64, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	filter := &repository.ProductListFilter{
+		Keyword:    strings.TrimSpace(req.Keyword),
+		CategoryID: req.CategoryID,
+		BrandID:    req.BrandID,
+		Statuses:   make([]int8, 0, len(req.Statuses)),
+	}
+	for _, item := range req.Statuses {
+		if item < model.ProductStatusOffSale || item > model.ProductStatusArchived {
+			return nil, 0, status.Error(codes.InvalidArgument, "invalid status "+strconv.Itoa(int(item)))
+		}
+		filter.Statuses = append(filter.Statuses, int8(item))
+	}
+	offset := int(req.Page-1) * int(req.PageSize)
+	products, total, err := s.productRepo.ListProducts(ctx, filter, offset, int(req.PageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list products: "+err.Error())
+	}
+	return products, total, nil
+}
+
+// DeleteProduct 软删除商品，规格、规格值和SKU一并删除，须在事务内调用
+func (s *ProductCatalogService) DeleteProduct(ctx context.Context, id int64) error {
+	if id == 0 {
+		return status.Error(codes.InvalidArgument, "id cannot be empty")
+	}
+	if err := s.productRepo.DeleteProduct(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return status.Error(codes.NotFound, "product not found")
+		}
+		return status.Error(codes.Internal, "failed to delete product: "+err.Error())
+	}
+	return nil
+}
+
+// newProduct 校验并生成商品，商品编号不可重复
+func (s *ProductCatalogService) newProduct(ctx context.Context, req *dto.ProductInputDto) (*model.Product, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "product cannot be empty")
+	}
+	if err := validateProductInput(req, true); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategory(ctx, req.CategoryID); err != nil {
+		return nil, err
+	}
+	if err := s.checkBrand(ctx, req.BrandID); err != nil {
+		return nil, err
+	}
+	productNo := strings.TrimSpace(req.ProductNo)
+	exists, err := s.productRepo.ExistsProductNo(ctx, productNo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check product_no: "+err.Error())
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "product_no "+productNo+" already exists")
+	}
+	product := &model.Product{
+		ProductNo: productNo,
+		Status:    int8(req.Status),
+	}
+	fillProduct(product, req)
+	return product, nil
+}
+
+// checkCategory 检查商品引用的分类是否存在
+func (s *ProductCatalogService) checkCategory(ctx context.Context, categoryId int64) error {
+	category, err := s.categoryRepo.FindByID(ctx, categoryId)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to query category: "+err.Error())
+	}
+	if category == nil {
+		return status.Error(codes.InvalidArgument, "category "+strconv.FormatInt(categoryId, 10)+" not found")
+	}
+	return nil
+}
+
+// checkBrand 检查商品引用的品牌是否存在，brandId为0时不关联品牌
+func (s *ProductCatalogService) checkBrand(ctx context.Context, brandId int64) error {
+	if brandId == 0 {
+		return nil
+	}
+	brand, err := s.brandRepo.FindByID(ctx, brandId)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to query brand: "+err.Error())
+	}
+	if brand == nil {
+		return status.Error(codes.InvalidArgument, "brand "+strconv.FormatInt(brandId, 10)+" not found")
+	}
+	return nil
+}
+
+// fillProduct 填充商品的可修改字段
+func fillProduct(product *model.Product, req *dto.ProductInputDto) {
+	product.ProductName = strings.TrimSpace(req.ProductName)
+	product.CategoryID = uint(req.CategoryID)
+	product.BrandID = nil
+	if req.BrandID > 0 {
+		brandId := uint(req.BrandID)
+		product.BrandID = &brandId
+	}
+	product.MainImage = nil
+	if req.MainImage != "" {
+		mainImage := req.MainImage
+		product.MainImage = &mainImage
+	}
+	product.Description = nil
+	if req.Description != "" {
+		description := req.Description
+		product.Description = &description
+	}
+}
+
+// validateProductInput 校验商品基本信息，创建时校验商品编号和状态
+func validateProductInput(req *dto.ProductInputDto, creating bool) error {
+	if creating {
+		productNo := strings.TrimSpace(req.ProductNo)
+		if productNo == "" || len(productNo) > 64 {
+			return status.Error(codes.InvalidArgument, "product_no cannot be empty or longer than 64")
+		}
+		switch req.Status {
+		case model.ProductStatusOffSale, model.ProductStatusOnSale, model.ProductStatusDraft:
+		default:
+			return status.Error(codes.InvalidArgument, "status must be 0, 1 or 2")
+		}
+	}
+	productName := strings.TrimSpace(req.ProductName)
+	if productName == "" || utf8.RuneCountInString(productName) > 255 {
+		return status.Error(codes.InvalidArgument, "product_name cannot be empty or longer than 255")
+	}
+	if req.CategoryID <= 0 {
+		return status.Error(codes.InvalidArgument, "category_id cannot be empty")
+	}
+	if req.BrandID < 0 {
+		return status.Error(codes.InvalidArgument, "invalid brand_id")
+	}
+	if len(req.MainImage) > 500 {
+		return status.Error(codes.InvalidArgument, "main_image cannot be longer than 500")
+	}
+	return nil
+}
+
+// validateSkuInput 校验SKU的名称、价格和图片
+func validateSkuInput(skuName string, price, marketPrice float64, mainImage string, images []string) error {
+	if utf8.RuneCountInString(strings.TrimSpace(skuName)) > 255 {
+		return status.Error(codes.InvalidArgument, "sku_name cannot be longer than 255")
+	}
+	if price <= 0 {
+		return status.Error(codes.InvalidArgument, "price must be greater than 0")
+	}
+	if marketPrice < 0 {
+		return status.Error(codes.InvalidArgument, "market_price cannot be negative")
+	}
+	if len(mainImage) > 500 {
+		return status.Error(codes.InvalidArgument, "main_image cannot be longer than 500")
+	}
+	for _, image := range images {
+		if image == "" || len(image) > 500 {
+			return status.Error(codes.InvalidArgument, "image cannot be empty or longer than 500")
+		}
+	}
+	return nil
+}
+
+// newSkuImages 生成SKU图片，第一张为主图
+func newSkuImages(images []string) []model.SkuImage {
+	result := make([]model.SkuImage, 0, len(images))
+	for i, image := range images {
+		result = append(result, model.SkuImage{
+			ImageURL:     image,
+			IsMain:       i == 0,
+			DisplayOrder: i,
+		})
+	}
+	return result
+}
+
+// skuMainImage SKU主图，未指定时使用第一张图片
+func skuMainImage(mainImage string, images []string) *string {
+	if mainImage == "" && len(images) > 0 {
+		mainImage = images[0]
+	}
+	if mainImage == "" {
+		return nil
+	}
+	return &mainImage
+}
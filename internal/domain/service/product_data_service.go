@@ -9,6 +9,7 @@ import (
 	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/domain/repository"
 	"github.com/zhanshen02154/product/pkg/metadata"
+	"go-micro.dev/v4/logger"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,7 +17,8 @@ import (
 type IProductDataService interface {
 	AddProduct(ctx context.Context, productInfo *model.Product) (int64, error)
 	DeductInventory(ctx context.Context, req *order.OnPaymentSuccess) (*dto.OrderSkuDto, error)
-	DeductOrderInvetoryRevert(ctx context.Context, req *dto.OrderProductInvetoryDto) error
+	DeductOrderInvetoryRevert(ctx context.Context, req *dto.OrderInventoryRestoreDto) (*dto.OrderSkuDto, error)
+	FindRestoreExists(ctx context.Context, restoreKey string) (bool, error)
 	FindEventExistsByOrderId(ctx context.Context, orderId int64) (bool, error)
 	GetProductSkuDetail(ctx context.Context, skuID int64) (*model.ProductSku, error)
 	BatchGetSkuInventoryInfo(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error)
@@ -28,8 +30,9 @@ type IProductDataService interface {
 }
 
 // NewProductDataService 创建
-func NewProductDataService(productRepository repository.IProductRepository, orderInventoryRepo repository.OrderInventoryEventRepository, skuRepo repository.ProductSkuRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository, supplierRepo repository.SupplierRepository) IProductDataService {
-	return &ProductDataService{productRepository: productRepository, orderInventoryRepo: orderInventoryRepo, skuRepo: skuRepo, stockChangeRepo: stockChangeRepo, supplierRepo: supplierRepo}
+func NewProductDataService(productRepository repository.IProductRepository, orderInventoryRepo repository.OrderInventoryEventRepository, skuRepo repository.ProductSkuRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository, supplierRepo repository.SupplierRepository, reservationRepo repository.SkuStockReservationRepository, restoreRepo repository.OrderInventoryRestoreRepository, warehouseRepo repository.WarehouseRepository, allocationStrategy string) IProductDataService {
+	return &ProductDataService{productRepository: productRepository, orderInventoryRepo: orderInventoryRepo, skuRepo: skuRepo, stockChangeRepo: stockChangeRepo, supplierRepo: supplierRepo, reservationRepo: reservationRepo, restoreRepo: restoreRepo,
+		warehouseStock: &warehouseStockKeeper{warehouseRepo: warehouseRepo, strategy: allocationStrategy}}
 }
 
 type ProductDataService struct {
@@ -38,6 +41,9 @@ type ProductDataService struct {
 	skuRepo            repository.ProductSkuRepository
 	stockChangeRepo    repository.InventoryStockChangeRecordRepository
 	supplierRepo       repository.SupplierRepository
+	reservationRepo    repository.SkuStockReservationRepository
+	restoreRepo        repository.OrderInventoryRestoreRepository
+	warehouseStock     *warehouseStockKeeper
 }
 
 // AddProduct 插入
@@ -46,6 +52,10 @@ func (u *ProductDataService) AddProduct(ctx context.Context, product *model.Prod
 }
 
 // DeductInventory 扣减库存
+// 订单存在预占时消费预占：预占中的部分转为确认并计入销量，已确认的部分不再扣减，超出预占的部分按原逻辑扣减
+// 订单明细中没有的SKU的预占不再需要，在同一事务内释放
+// 预占在预占时已分配到仓库，超出预占的部分按分仓策略从各仓库扣减，仓库库存不足的部分由未分仓库存满足
+// 返回的分仓结果为订单各SKU当前的分仓数量
 func (u *ProductDataService) DeductInventory(ctx context.Context, req *order.OnPaymentSuccess) (*dto.OrderSkuDto, error) {
 	var err error
 	skuLenth := len(req.OrderDetails)
@@ -55,16 +65,52 @@ func (u *ProductDataService) DeductInventory(ctx context.Context, req *order.OnP
 		skuIds = append(skuIds, orderDetail.SkuId)
 		skuQuantity[orderDetail.SkuId] = orderDetail.Quantity
 	}
-	skuList, err := u.skuRepo.BatchGetSkuByIDsWithFields(ctx, skuIds)
+	reservations, err := u.reservationRepo.FindByOrderIdForUpdate(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "reservation query error:"+err.Error())
+	}
+	heldQuantity := make(map[int64]uint32, len(reservations))
+	confirmedQuantity := make(map[int64]uint32, len(reservations))
+	heldIds := make([]int64, 0, len(reservations))
+	unused := make([]model.SkuStockReservation, 0)
+	lockIds := append(make([]int64, 0, skuLenth), skuIds...)
+	for _, item := range reservations {
+		switch item.Status {
+		case model.ReservationStatusHeld:
+			if _, ok := skuQuantity[item.SkuID]; !ok {
+				unused = append(unused, item)
+				lockIds = append(lockIds, item.SkuID)
+				continue
+			}
+			heldQuantity[item.SkuID] += item.Quantity
+			heldIds = append(heldIds, item.ID)
+		case model.ReservationStatusConfirmed:
+			confirmedQuantity[item.SkuID] += item.Quantity
+		}
+	}
+	// 锁定SKU行，避免并发扣减读取到相同的库存
+	lockedList, err := u.skuRepo.BatchGetSkuByIDsForUpdate(ctx, lockIds)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "sku query error:"+err.Error())
 	}
+	skuList := make([]model.ProductSku, 0, skuLenth)
+	stock := make(map[int64]uint32, len(lockedList))
+	for _, sku := range lockedList {
+		stock[sku.ID] = sku.Stock
+		if _, ok := skuQuantity[sku.ID]; ok {
+			skuList = append(skuList, sku)
+		}
+	}
 	if len(skuList) == 0 || len(skuList) != skuLenth {
 		return nil, status.Error(codes.NotFound, "sku not found")
 	}
 	for _, sku := range skuList {
+		if sku.Status != model.ProductStatusOnSale {
+			return nil, status.Error(codes.NotFound, "sku not found")
+		}
 		if val, ok := skuQuantity[sku.ID]; ok {
-			if sku.Stock < val {
+			covered := heldQuantity[sku.ID] + confirmedQuantity[sku.ID]
+			if val > covered && sku.Stock < val-covered {
 				return nil, status.Error(codes.FailedPrecondition, "sku stock out of order")
 			}
 		} else {
@@ -72,6 +118,21 @@ func (u *ProductDataService) DeductInventory(ctx context.Context, req *order.OnP
 		}
 	}
 
+	// 超出预占的部分按策略分配到仓库，预占的部分在预占时已分配
+	demand := make(map[int64]uint32, skuLenth)
+	for skuId, quantity := range skuQuantity {
+		if covered := heldQuantity[skuId] + confirmedQuantity[skuId]; quantity > covered {
+			demand[skuId] = quantity - covered
+		}
+	}
+	var destination *GeoPoint
+	if req.ShippingLatitude != 0 || req.ShippingLongitude != 0 {
+		destination = &GeoPoint{Latitude: req.ShippingLatitude, Longitude: req.ShippingLongitude}
+	}
+	if _, err = u.warehouseStock.allocate(ctx, req.OrderId, skuIds, demand, destination); err != nil {
+		return nil, err
+	}
+
 	// 执行扣减
 	eventId, ok := metadata.GetEventId(ctx)
 	orderSkuDto := &dto.OrderSkuDto{
@@ -81,30 +142,93 @@ func (u *ProductDataService) DeductInventory(ctx context.Context, req *order.OnP
 
 	// 准备库存变更记录
 	stockChangeRecords := make([]*model.InventoryStockChangeRecord, 0, skuLenth)
+	surplusQuantity := make(map[int64]uint32)
 
 	for _, sku := range skuList {
-		err = u.skuRepo.DeductInventoryById(ctx, sku.ID, skuQuantity[sku.ID])
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+		quantity := skuQuantity[sku.ID]
+		held := heldQuantity[sku.ID]
+		confirmed := confirmedQuantity[sku.ID]
+		afterStock := sku.Stock
+		// sold 本次计入销量的数量，reservedSold 其中由预占转化的数量，surplus 预占多于订单数量需回补的数量
+		var sold, reservedSold, surplus uint32
+		if quantity > held+confirmed {
+			extra := quantity - held - confirmed
+			err = u.skuRepo.DeductInventoryById(ctx, sku.ID, extra)
+			if err != nil {
+				if errors.Is(err, repository.ErrInsufficientStock) {
+					return nil, status.Error(codes.FailedPrecondition, "sku stock out of order")
+				}
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			afterStock -= extra
+			reservedSold = held
+			sold = extra + held
+		} else {
+			if quantity > confirmed {
+				reservedSold = quantity - confirmed
+			}
+			sold = reservedSold
+			surplus = held - reservedSold
+		}
+		if reservedSold > 0 {
+			if err = u.skuRepo.IncreaseSalesById(ctx, sku.ID, reservedSold); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
 		}
 
-		afterStock := sku.Stock - skuQuantity[sku.ID]
+		// 构建库存变更记录
+		if sold > 0 {
+			stockChangeRecords = append(stockChangeRecords, &model.InventoryStockChangeRecord{
+				OrderID:     req.OrderId,
+				SkuID:       sku.ID,
+				SourceType:  model.SourceTypeOrderPayment,
+				Quantity:    int64(sold),
+				BeforeStock: int64(sku.Stock),
+				AfterStock:  int64(afterStock),
+			})
+		}
+		if surplus > 0 {
+			if err = u.skuRepo.RestoreInventoryById(ctx, sku.ID, surplus); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			stockChangeRecords = append(stockChangeRecords, &model.InventoryStockChangeRecord{
+				OrderID:     req.OrderId,
+				SkuID:       sku.ID,
+				SourceType:  model.SourceTypeRelease,
+				Quantity:    int64(surplus),
+				BeforeStock: int64(afterStock),
+				AfterStock:  int64(afterStock + surplus),
+			})
+			afterStock += surplus
+			surplusQuantity[sku.ID] = surplus
+		}
 		orderSkuDto.Sku = append(orderSkuDto.Sku, dto.OrderSkuItemDto{
 			SkuID:     sku.ID,
-			Quantity:  skuQuantity[sku.ID],
+			Quantity:  quantity,
 			Stock:     afterStock,
 			Threshold: sku.StockWarn,
 		})
+	}
+	if _, err = u.warehouseStock.restore(ctx, req.OrderId, surplusQuantity); err != nil {
+		return nil, err
+	}
 
-		// 构建库存变更记录
-		stockChangeRecords = append(stockChangeRecords, &model.InventoryStockChangeRecord{
-			OrderID:     req.OrderId,
-			SkuID:       sku.ID,
-			SourceType:  model.SourceTypeOrderPayment,
-			Quantity:    int64(skuQuantity[sku.ID]),
-			BeforeStock: int64(sku.Stock),
-			AfterStock:  int64(afterStock),
-		})
+	if len(heldIds) > 0 {
+		if _, err = u.reservationRepo.UpdateStatusByIds(ctx, heldIds, model.ReservationStatusHeld, model.ReservationStatusConfirmed); err != nil {
+			return nil, status.Error(codes.Internal, "failed to confirm reservations: "+err.Error())
+		}
+	}
+	releaseRecords, _, _, err := u.releaseReservations(ctx, req.OrderId, unused, stock)
+	if err != nil {
+		return nil, err
+	}
+	stockChangeRecords = append(stockChangeRecords, releaseRecords...)
+	warehouses, err := u.warehouseStock.outstanding(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range orderSkuDto.Sku {
+		orderSkuDto.Sku[i].Warehouses = warehouses[orderSkuDto.Sku[i].SkuID]
 	}
 
 	// 批量写入库存变更记录
@@ -125,38 +249,189 @@ func (u *ProductDataService) DeductInventory(ctx context.Context, req *order.OnP
 	return orderSkuDto, err
 }
 
-// DeductOrderInvetoryRevert 扣减订单库存补偿操作
-func (u *ProductDataService) DeductOrderInvetoryRevert(ctx context.Context, req *dto.OrderProductInvetoryDto) error {
-	var err error
-	if len(req.ProductSizeInvetory) == 0 || len(req.ProductSizeInvetory) == 0 {
-		err = errors.New("product data cannot be empty")
+// DeductOrderInvetoryRevert 订单退款或取消时回补SKU库存
+// 回补数量不超过订单已扣减且未回补的数量，按订单的分仓记录回补分仓库存；订单取消时预占中的库存一并释放
+// 先在事务内写入回补记录占用回补键，重复或并发的同一回补不做任何变更
+func (u *ProductDataService) DeductOrderInvetoryRevert(ctx context.Context, req *dto.OrderInventoryRestoreDto) (*dto.OrderSkuDto, error) {
+	if req.OrderID == 0 || req.RestoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id or restore key cannot be empty")
+	}
+	eventId, _ := metadata.GetEventId(ctx)
+	created, err := u.restoreRepo.Create(ctx, &model.OrderInventoryRestore{
+		RestoreKey: req.RestoreKey,
+		OrderId:    req.OrderID,
+		EventId:    eventId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create restore record: "+err.Error())
+	}
+	if !created {
+		logger.Infof("order %d inventory restore %s has been processed", req.OrderID, req.RestoreKey)
+		return &dto.OrderSkuDto{OrderID: req.OrderID}, nil
+	}
+	skuIds := make([]int64, 0, len(req.Sku))
+	requested := make(map[int64]uint32, len(req.Sku))
+	for _, item := range req.Sku {
+		if item == nil || item.SkuID == 0 || item.Quantity == 0 {
+			continue
+		}
+		if _, ok := requested[item.SkuID]; !ok {
+			skuIds = append(skuIds, item.SkuID)
+		}
+		requested[item.SkuID] += item.Quantity
 	}
-	for _, item := range req.ProductInvetory {
-		err = u.productRepository.DeductProductInventoryRevert(ctx, item.Id, item.Count)
+
+	held := make([]model.SkuStockReservation, 0)
+	if req.Reason == model.RestoreReasonCancel {
+		reservations, err := u.reservationRepo.FindByOrderIdForUpdate(ctx, req.OrderID)
 		if err != nil {
-			break
+			return nil, status.Error(codes.Internal, "reservation query error:"+err.Error())
+		}
+		for _, item := range reservations {
+			if item.Status != model.ReservationStatusHeld {
+				continue
+			}
+			held = append(held, item)
+			if _, ok := requested[item.SkuID]; !ok {
+				requested[item.SkuID] = 0
+				skuIds = append(skuIds, item.SkuID)
+			}
 		}
 	}
+
+	paid, err := u.stockChangeRepo.SumQuantityByOrderId(ctx, req.OrderID, model.SourceTypeOrderPayment)
 	if err != nil {
-		return err
+		return nil, status.Error(codes.Internal, "stock change query error:"+err.Error())
 	}
-	for _, item := range req.ProductSizeInvetory {
-		err = u.productRepository.DeductProductSizeInvetoryRevert(ctx, item.Id, item.Count)
-		if err != nil {
-			break
+	refunded, err := u.stockChangeRepo.SumQuantityByOrderId(ctx, req.OrderID, model.SourceTypeOrderRefund)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "stock change query error:"+err.Error())
+	}
+	skuList, err := u.skuRepo.BatchGetSkuByIDsForUpdate(ctx, skuIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error:"+err.Error())
+	}
+	skuMap := make(map[int64]model.ProductSku, len(skuList))
+	for _, sku := range skuList {
+		skuMap[sku.ID] = sku
+	}
+
+	stock := make(map[int64]uint32, len(skuList))
+	for _, sku := range skuList {
+		stock[sku.ID] = sku.Stock
+	}
+
+	// 释放预占中的库存
+	stockChangeRecords, restored, warehouses, err := u.releaseReservations(ctx, req.OrderID, held, stock)
+	if err != nil {
+		return nil, err
+	}
+
+	// 回补已扣减的库存
+	refunds := make(map[int64]uint32, len(skuIds))
+	for _, skuId := range skuIds {
+		quantity := int64(requested[skuId])
+		if req.Reason == model.RestoreReasonCancel {
+			// 取消时已释放的预占不再重复回补
+			quantity -= int64(restored[skuId])
 		}
+		restorable := paid[skuId] - refunded[skuId]
+		if quantity > restorable {
+			logger.Warnf("order %d sku %d restore quantity %d exceeds deducted quantity %d", req.OrderID, skuId, quantity, restorable)
+			quantity = restorable
+		}
+		if quantity <= 0 {
+			continue
+		}
+		if _, ok := skuMap[skuId]; !ok {
+			return nil, status.Error(codes.NotFound, "sku not found")
+		}
+		if err = u.skuRepo.RefundInventoryById(ctx, skuId, uint32(quantity)); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		stockChangeRecords = append(stockChangeRecords, &model.InventoryStockChangeRecord{
+			OrderID:     req.OrderID,
+			SkuID:       skuId,
+			SourceType:  model.SourceTypeOrderRefund,
+			Quantity:    quantity,
+			BeforeStock: int64(stock[skuId]),
+			AfterStock:  int64(stock[skuId]) + quantity,
+		})
+		stock[skuId] += uint32(quantity)
+		restored[skuId] += uint32(quantity)
+		refunds[skuId] += uint32(quantity)
+	}
+	refundWarehouses, err := u.warehouseStock.restore(ctx, req.OrderID, refunds)
+	if err != nil {
+		return nil, err
+	}
+	for skuId, items := range refundWarehouses {
+		warehouses[skuId] = append(warehouses[skuId], items...)
 	}
 
-	eventId, ok := metadata.GetEventId(ctx)
-	if ok {
-		orderInventoryEvent := model.OrderInventoryEvent{
-			EventId: eventId,
-			OrderId: req.OrderId,
+	if len(stockChangeRecords) > 0 {
+		if err = u.stockChangeRepo.BatchCreate(ctx, stockChangeRecords); err != nil {
+			return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+		}
+	}
+
+	orderSkuDto := &dto.OrderSkuDto{
+		OrderID: req.OrderID,
+		Sku:     make([]dto.OrderSkuItemDto, 0, len(restored)),
+	}
+	for _, skuId := range skuIds {
+		if restored[skuId] == 0 {
+			continue
+		}
+		orderSkuDto.Sku = append(orderSkuDto.Sku, dto.OrderSkuItemDto{
+			SkuID:      skuId,
+			Quantity:   restored[skuId],
+			Stock:      stock[skuId],
+			Threshold:  skuMap[skuId].StockWarn,
+			Warehouses: warehouses[skuId],
+		})
+	}
+	return orderSkuDto, nil
+}
+
+// releaseReservations 释放预占中的库存并将预占标记为已释放，按订单的分仓记录回补分仓库存，stock为已锁定SKU的当前库存，释放后同步更新
+// 返回释放对应的库存变更记录、各SKU释放的数量及分仓回补结果，记录由调用方与其他变更一并写入
+func (u *ProductDataService) releaseReservations(ctx context.Context, orderId int64, held []model.SkuStockReservation, stock map[int64]uint32) ([]*model.InventoryStockChangeRecord, map[int64]uint32, map[int64][]dto.WarehouseAllocationDto, error) {
+	records := make([]*model.InventoryStockChangeRecord, 0, len(held))
+	released := make(map[int64]uint32, len(held))
+	heldIds := make([]int64, 0, len(held))
+	for _, item := range held {
+		if err := u.skuRepo.RestoreInventoryById(ctx, item.SkuID, item.Quantity); err != nil {
+			return nil, nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		records = append(records, &model.InventoryStockChangeRecord{
+			OrderID:     orderId,
+			SkuID:       item.SkuID,
+			SourceType:  model.SourceTypeRelease,
+			Quantity:    int64(item.Quantity),
+			BeforeStock: int64(stock[item.SkuID]),
+			AfterStock:  int64(stock[item.SkuID] + item.Quantity),
+		})
+		stock[item.SkuID] += item.Quantity
+		released[item.SkuID] += item.Quantity
+		heldIds = append(heldIds, item.ID)
+	}
+	if len(heldIds) > 0 {
+		if _, err := u.reservationRepo.UpdateStatusByIds(ctx, heldIds, model.ReservationStatusHeld, model.ReservationStatusReleased); err != nil {
+			return nil, nil, nil, status.Error(codes.Internal, "failed to release reservations: "+err.Error())
 		}
-		_, err = u.orderInventoryRepo.Create(ctx, &orderInventoryEvent)
 	}
+	warehouses, err := u.warehouseStock.restore(ctx, orderId, released)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return records, released, warehouses, nil
+}
 
-	return err
+// FindRestoreExists 检查订单库存回补是否已处理过
+func (u *ProductDataService) FindRestoreExists(ctx context.Context, restoreKey string) (bool, error) {
+	return u.restoreRepo.ExistsByRestoreKey(ctx, restoreKey)
 }
 
 // FindEventExistsByOrderId 查找订单库存已被处理过
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IProductLifecycleService interface {
+	PublishProduct(ctx context.Context, req *dto.PublishProductDto, now time.Time) (*dto.ProductStatusChangeDto, error)
+	UnpublishProduct(ctx context.Context, req *dto.ProductStatusDto) (*dto.ProductStatusChangeDto, error)
+	UnpublishSku(ctx context.Context, req *dto.UnpublishSkuDto) (*dto.ProductStatusChangeDto, error)
+	ArchiveProduct(ctx context.Context, req *dto.ProductStatusDto) (*dto.ProductStatusChangeDto, error)
+	ApplyDueOnSale(ctx context.Context, productId int64, now time.Time) (*dto.ProductStatusChangeDto, error)
+	ListDueOnSaleProductIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error)
+}
+
+// NewProductLifecycleService 创建商品状态服务
+func NewProductLifecycleService(productRepo repository.IProductRepository, skuRepo repository.ProductSkuRepository) IProductLifecycleService {
+	return &ProductLifecycleService{productRepo: productRepo, skuRepo: skuRepo}
+}
+
+// ProductLifecycleService 商品状态服务，维护商品及SKU的草稿、上架、下架、归档状态流转
+// 所有方法须在事务内调用，先锁商品行再锁SKU行；商品下架时其上架中的SKU一并下架，归档时所有SKU一并归档
+type ProductLifecycleService struct {
+	productRepo repository.IProductRepository
+	skuRepo     repository.ProductSkuRepository
+}
+
+// PublishProduct 上架商品及指定SKU，未指定SKU时上架草稿状态的SKU，上架后商品至少有一个上架中的SKU
+// 上架时间晚于当前时间时只记录定时上架，到期由ApplyDueOnSale执行
+func (s *ProductLifecycleService) PublishProduct(ctx context.Context, req *dto.PublishProductDto, now time.Time) (*dto.ProductStatusChangeDto, error) {
+	if req.ProductID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid product_id")
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, false)
+	if err != nil {
+		return nil, err
+	}
+	onSaleAt, err := parseLedgerTime(req.OnSaleAt, "on_sale_at")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.SkuIDs) > maxPageSize {
+		return nil, status.Error(codes.InvalidArgument, "sku_ids cannot be more than "+strconv.Itoa(maxPageSize))
+	}
+	product, skus, err := s.lockProduct(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status == model.ProductStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "archived product cannot be published")
+	}
+	skuById := make(map[int64]*model.ProductSku, len(skus))
+	for i := range skus {
+		skuById[skus[i].ID] = &skus[i]
+	}
+	targets := make([]*model.ProductSku, 0, len(req.SkuIDs))
+	for _, id := range req.SkuIDs {
+		sku, ok := skuById[id]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "sku "+strconv.FormatInt(id, 10)+" does not belong to product")
+		}
+		if sku.Status == model.ProductStatusArchived {
+			return nil, status.Error(codes.FailedPrecondition, "sku "+strconv.FormatInt(id, 10)+" is archived")
+		}
+		targets = append(targets, sku)
+	}
+	if len(req.SkuIDs) == 0 {
+		for i := range skus {
+			if skus[i].Status == model.ProductStatusDraft {
+				targets = append(targets, &skus[i])
+			}
+		}
+	}
+	onSale := len(targets) > 0
+	for i := range skus {
+		onSale = onSale || skus[i].Status == model.ProductStatusOnSale
+	}
+	if !onSale {
+		return nil, status.Error(codes.FailedPrecondition, "product has no sku on sale, sku_ids must be specified")
+	}
+
+	if onSaleAt.After(now) {
+		if product.Status == model.ProductStatusOnSale {
+			return nil, status.Error(codes.FailedPrecondition, "product is already on sale")
+		}
+		ids := make([]string, 0, len(req.SkuIDs))
+		for _, id := range req.SkuIDs {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		product.OnSaleAt = &onSaleAt
+		product.OnSaleSkuIDs = strings.Join(ids, ",")
+		if err := s.productRepo.UpdateProductStatus(ctx, product); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update product status: "+err.Error())
+		}
+		return &dto.ProductStatusChangeDto{
+			ProductID:  product.ID,
+			FromStatus: product.Status,
+			ToStatus:   product.Status,
+			OnSaleAt:   product.OnSaleAt,
+			Skus:       []*dto.SkuStatusChangeDto{},
+			OperatorID: req.OperatorID,
+			Reason:     reason,
+		}, nil
+	}
+	return s.transit(ctx, product, model.ProductStatusOnSale, targets, model.ProductStatusOnSale, req.OperatorID, reason)
+}
+
+// UnpublishProduct 下架商品，上架中的SKU一并下架；草稿或已下架的商品只取消定时上架
+func (s *ProductLifecycleService) UnpublishProduct(ctx context.Context, req *dto.ProductStatusDto) (*dto.ProductStatusChangeDto, error) {
+	reason, err := checkProductStatusRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	product, skus, err := s.lockProduct(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status == model.ProductStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "product is archived")
+	}
+	toStatus := product.Status
+	if product.Status == model.ProductStatusOnSale {
+		toStatus = model.ProductStatusOffSale
+	}
+	targets := make([]*model.ProductSku, 0, len(skus))
+	for i := range skus {
+		if skus[i].Status == model.ProductStatusOnSale {
+			targets = append(targets, &skus[i])
+		}
+	}
+	return s.transit(ctx, product, toStatus, targets, model.ProductStatusOffSale, req.OperatorID, reason)
+}
+
+// UnpublishSku 下架单个SKU，商品状态不变
+func (s *ProductLifecycleService) UnpublishSku(ctx context.Context, req *dto.UnpublishSkuDto) (*dto.ProductStatusChangeDto, error) {
+	if req.SkuID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, false)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := s.skuRepo.GetSkuDetailByID(ctx, req.SkuID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get sku detail: "+err.Error())
+	}
+	if detail == nil {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+	product, skus, err := s.lockProduct(ctx, int64(detail.ProductID))
+	if err != nil {
+		return nil, err
+	}
+	for i := range skus {
+		if skus[i].ID != req.SkuID {
+			continue
+		}
+		if skus[i].Status != model.ProductStatusOnSale {
+			return nil, status.Error(codes.FailedPrecondition, "sku is not on sale")
+		}
+		return s.transit(ctx, product, product.Status, []*model.ProductSku{&skus[i]}, model.ProductStatusOffSale, req.OperatorID, reason)
+	}
+	return nil, status.Error(codes.NotFound, "sku not found")
+}
+
+// ArchiveProduct 归档商品及其所有SKU，归档为终态，同时取消定时上架
+func (s *ProductLifecycleService) ArchiveProduct(ctx context.Context, req *dto.ProductStatusDto) (*dto.ProductStatusChangeDto, error) {
+	reason, err := checkProductStatusRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	product, skus, err := s.lockProduct(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status == model.ProductStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "product is already archived")
+	}
+	targets := make([]*model.ProductSku, 0, len(skus))
+	for i := range skus {
+		if skus[i].Status != model.ProductStatusArchived {
+			targets = append(targets, &skus[i])
+		}
+	}
+	return s.transit(ctx, product, model.ProductStatusArchived, targets, model.ProductStatusArchived, req.OperatorID, reason)
+}
+
+// ApplyDueOnSale 执行已到期的定时上架，定时上架未设置或未到期时返回nil
+// 设置定时上架后被归档或下架的SKU不再上架
+func (s *ProductLifecycleService) ApplyDueOnSale(ctx context.Context, productId int64, now time.Time) (*dto.ProductStatusChangeDto, error) {
+	product, skus, err := s.lockProduct(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if product.OnSaleAt == nil || product.OnSaleAt.After(now) {
+		return nil, nil
+	}
+	scheduled := make(map[int64]bool)
+	for _, item := range strings.Split(product.OnSaleSkuIDs, ",") {
+		if id, err := strconv.ParseInt(item, 10, 64); err == nil {
+			scheduled[id] = true
+		}
+	}
+	targets := make([]*model.ProductSku, 0, len(skus))
+	for i := range skus {
+		if (len(scheduled) == 0 && skus[i].Status == model.ProductStatusDraft) || (scheduled[skus[i].ID] && skus[i].Status != model.ProductStatusArchived) {
+			targets = append(targets, &skus[i])
+		}
+	}
+	return s.transit(ctx, product, model.ProductStatusOnSale, targets, model.ProductStatusOnSale, 0, "scheduled on sale")
+}
+
+// ListDueOnSaleProductIDs 查询定时上架已到期的商品，按ID升序返回ID大于afterId的一批
+func (s *ProductLifecycleService) ListDueOnSaleProductIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error) {
+	ids, err := s.productRepo.ListDueOnSaleProductIDs(ctx, now, afterId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query due products: "+err.Error())
+	}
+	return ids, nil
+}
+
+// transit 将商品流转到toStatus、目标SKU流转到skuStatus，并清除定时上架
+// 状态已是目标状态的SKU跳过，不允许的流转返回FailedPrecondition
+func (s *ProductLifecycleService) transit(ctx context.Context, product *model.Product, toStatus int8, targets []*model.ProductSku, skuStatus int8, operatorId int64, reason string) (*dto.ProductStatusChangeDto, error) {
+	if product.Status != toStatus && !model.CanProductStatusTransit(product.Status, toStatus) {
+		return nil, status.Error(codes.FailedPrecondition, "product status cannot change from "+strconv.Itoa(int(product.Status))+" to "+strconv.Itoa(int(toStatus)))
+	}
+	change := &dto.ProductStatusChangeDto{
+		ProductID:  product.ID,
+		FromStatus: product.Status,
+		ToStatus:   toStatus,
+		Skus:       make([]*dto.SkuStatusChangeDto, 0, len(targets)),
+		OperatorID: operatorId,
+		Reason:     reason,
+	}
+	skuIds := make([]int64, 0, len(targets))
+	for _, sku := range targets {
+		if sku.Status == skuStatus {
+			continue
+		}
+		if !model.CanProductStatusTransit(sku.Status, skuStatus) {
+			return nil, status.Error(codes.FailedPrecondition, "status of sku "+strconv.FormatInt(sku.ID, 10)+" cannot change from "+strconv.Itoa(int(sku.Status))+" to "+strconv.Itoa(int(skuStatus)))
+		}
+		change.Skus = append(change.Skus, &dto.SkuStatusChangeDto{SkuID: sku.ID, FromStatus: sku.Status, ToStatus: skuStatus})
+		skuIds = append(skuIds, sku.ID)
+		sku.Status = skuStatus
+	}
+	if err := s.skuRepo.UpdateSkuStatusByIds(ctx, skuIds, skuStatus); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update sku status: "+err.Error())
+	}
+	if product.Status != toStatus || product.OnSaleAt != nil {
+		product.Status = toStatus
+		product.OnSaleAt = nil
+		product.OnSaleSkuIDs = ""
+		if err := s.productRepo.UpdateProductStatus(ctx, product); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update product status: "+err.Error())
+		}
+	}
+	return change, nil
+}
+
+// lockProduct 锁定商品及其SKU
+func (s *ProductLifecycleService) lockProduct(ctx context.Context, productId int64) (*model.Product, []model.ProductSku, error) {
+	product, err := s.productRepo.FindProductStatusForUpdate(ctx, productId)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to query product: "+err.Error())
+	}
+	if product == nil {
+		return nil, nil, status.Error(codes.NotFound, "product not found")
+	}
+	skus, err := s.skuRepo.FindSkuStatusByProductIDForUpdate(ctx, productId)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	return product, skus, nil
+}
+
+// checkProductStatusRequest 校验下架或归档请求，返回去除首尾空白后的原因
+func checkProductStatusRequest(req *dto.ProductStatusDto) (string, error) {
+	if req.ProductID <= 0 {
+		return "", status.Error(codes.InvalidArgument, "invalid product_id")
+	}
+	return checkAdjustOperator(req.Reason, req.OperatorID, false)
+}
@@ -0,0 +1,433 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purchaseReceiptReason 采购收货的库存变更原因
+const purchaseReceiptReason = "purchase receipt"
+
+type IPurchaseOrderService interface {
+	CreatePurchaseOrders(ctx context.Context, req *dto.CreatePurchaseOrdersDto) ([]*model.PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, id int64) (*model.PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, req *dto.ListPurchaseOrdersDto) ([]model.PurchaseOrder, int64, error)
+	ReceiveGoods(ctx context.Context, req *dto.ReceiveGoodsDto) (*model.PurchaseOrder, *dto.OrderSkuDto, error)
+}
+
+// NewPurchaseOrderService 创建采购单服务
+func NewPurchaseOrderService(
+	purchaseOrderRepo repository.PurchaseOrderRepository,
+	restockRepo repository.SkuRestockRepository,
+	supplierRepo repository.SupplierRepository,
+	skuRepo repository.ProductSkuRepository,
+	stockChangeRepo repository.InventoryStockChangeRecordRepository,
+	warehouseRepo repository.WarehouseRepository,
+) IPurchaseOrderService {
+	return &PurchaseOrderService{
+		purchaseOrderRepo: purchaseOrderRepo,
+		restockRepo:       restockRepo,
+		supplierRepo:      supplierRepo,
+		skuRepo:           skuRepo,
+		stockChangeRepo:   stockChangeRepo,
+		warehouseStock:    &warehouseStockKeeper{warehouseRepo: warehouseRepo},
+	}
+}
+
+// PurchaseOrderService 采购单服务，将审核通过的补货记录按首选供应商合并为采购单
+type PurchaseOrderService struct {
+	purchaseOrderRepo repository.PurchaseOrderRepository
+	restockRepo       repository.SkuRestockRepository
+	supplierRepo      repository.SupplierRepository
+	skuRepo           repository.ProductSkuRepository
+	stockChangeRepo   repository.InventoryStockChangeRecordRepository
+	warehouseStock    *warehouseStockKeeper
+}
+
+// purchaseLineDraft 合并中的采购单明细
+type purchaseLineDraft struct {
+	skuId      int64
+	requested  int32
+	restockIds []int64
+	supply     *repository.SupplierInfo
+}
+
+// CreatePurchaseOrders 根据审核通过的补货记录生成采购单，须在事务内调用
+// 每个供应商生成一张采购单，同一SKU合并为一行，订货数量按最小起订量向上取整
+// 补货记录订满后流转为已订货，否则为部分订货
+func (s *PurchaseOrderService) CreatePurchaseOrders(ctx context.Context, req *dto.CreatePurchaseOrdersDto) ([]*model.PurchaseOrder, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxPageSize {
+		return nil, status.Error(codes.InvalidArgument, "items must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	remark := strings.TrimSpace(req.Remark)
+	if utf8.RuneCountInString(remark) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "remark cannot be longer than 255")
+	}
+	quantities := make(map[int64]int32, len(req.Items))
+	ids := make([]int64, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.RestockID <= 0 || item.Quantity < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid restock_id or quantity")
+		}
+		if _, ok := quantities[item.RestockID]; ok {
+			return nil, status.Error(codes.InvalidArgument, "duplicate restock_id "+strconv.FormatInt(item.RestockID, 10))
+		}
+		quantities[item.RestockID] = item.Quantity
+		ids = append(ids, item.RestockID)
+	}
+
+	records, err := s.restockRepo.ListOrderableByIDsForUpdate(ctx, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to lock restock records: "+err.Error())
+	}
+	if len(records) != len(ids) {
+		found := make(map[int64]struct{}, len(records))
+		for _, record := range records {
+			found[record.ID] = struct{}{}
+		}
+		for _, id := range ids {
+			if _, ok := found[id]; !ok {
+				return nil, status.Error(codes.FailedPrecondition, "restock "+strconv.FormatInt(id, 10)+" is not approved or has been fully ordered")
+			}
+		}
+	}
+
+	// 按供应商、SKU合并
+	supplies := make(map[int64]*repository.SupplierInfo)
+	drafts := make(map[int64]map[int64]*purchaseLineDraft)
+	for i := range records {
+		record := &records[i]
+		quantity := quantities[record.ID]
+		if quantity == 0 {
+			quantity = record.RemainingQuantity()
+		}
+		if quantity <= 0 || quantity > record.RemainingQuantity() {
+			return nil, status.Error(codes.InvalidArgument, "quantity of restock "+strconv.FormatInt(record.ID, 10)+" must be between 1 and "+strconv.Itoa(int(record.RemainingQuantity())))
+		}
+		skuId := int64(record.SkuID)
+		supply, ok := supplies[skuId]
+		if !ok {
+			supply, err = s.preferredSupply(ctx, skuId)
+			if err != nil {
+				return nil, err
+			}
+			supplies[skuId] = supply
+		}
+		lines, ok := drafts[supply.SupplierID]
+		if !ok {
+			lines = make(map[int64]*purchaseLineDraft)
+			drafts[supply.SupplierID] = lines
+		}
+		line, ok := lines[skuId]
+		if !ok {
+			line = &purchaseLineDraft{skuId: skuId, supply: supply}
+			lines[skuId] = line
+		}
+		line.requested += quantity
+		line.restockIds = append(line.restockIds, record.ID)
+
+		ordered := record.OrderedQuantity + quantity
+		nextStatus := uint8(model.RestockStatusPartial)
+		if ordered >= record.Quantity {
+			nextStatus = model.RestockStatusOrdered
+		}
+		if nextStatus != record.Status && !record.CanTransitTo(nextStatus) {
+			return nil, status.Error(codes.FailedPrecondition, "restock "+strconv.FormatInt(record.ID, 10)+" cannot be ordered")
+		}
+		if err := s.restockRepo.UpdateOrdered(ctx, record.ID, ordered, nextStatus); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update restock record: "+err.Error())
+		}
+	}
+
+	supplierIds := make([]int64, 0, len(drafts))
+	for supplierId := range drafts {
+		supplierIds = append(supplierIds, supplierId)
+	}
+	sort.Slice(supplierIds, func(i, j int) bool { return supplierIds[i] < supplierIds[j] })
+	orders := make([]*model.PurchaseOrder, 0, len(supplierIds))
+	for _, supplierId := range supplierIds {
+		order := newPurchaseOrder(supplierId, drafts[supplierId])
+		order.OperatorID = int(req.OperatorID)
+		order.Remark = remark
+		if err := s.purchaseOrderRepo.Create(ctx, order); err != nil {
+			return nil, status.Error(codes.Internal, "failed to create purchase order: "+err.Error())
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// GetPurchaseOrder 获取采购单及其明细
+func (s *PurchaseOrderService) GetPurchaseOrder(ctx context.Context, id int64) (*model.PurchaseOrder, error) {
+	if id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid purchase order id")
+	}
+	order, err := s.purchaseOrderRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query purchase order: "+err.Error())
+	}
+	if order == nil {
+		return nil, status.Error(codes.NotFound, "purchase order not found")
+	}
+	return order, nil
+}
+
+// ListPurchaseOrders 分页查询采购单
+func (s *PurchaseOrderService) ListPurchaseOrders(ctx context.Context, req *dto.ListPurchaseOrdersDto) ([]model.PurchaseOrder, int64, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	filter := &repository.PurchaseOrderListFilter{
+		SupplierID: req.SupplierID,
+		Statuses:   make([]uint8, 0, len(req.Statuses)),
+	}
+	for _, item := range req.Statuses {
+		if item < model.PurchaseOrderStatusPlaced || item > model.PurchaseOrderStatusReceived {
+			return nil, 0, status.Error(codes.InvalidArgument, "invalid status "+strconv.Itoa(int(item)))
+		}
+		filter.Statuses = append(filter.Statuses, uint8(item))
+	}
+	orders, total, err := s.purchaseOrderRepo.List(ctx, filter, int(req.Page-1)*int(req.PageSize), int(req.PageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list purchase orders: "+err.Error())
+	}
+	return orders, total, nil
+}
+
+// ReceiveGoods 按采购单明细收货到指定仓库，同时增加分仓库存与可售总库存，允许分批收货，须在事务内调用
+// 采购单全部收满后流转为已收货，明细收满后其关联的补货记录在全部订货明细收满时流转为已到货
+func (s *PurchaseOrderService) ReceiveGoods(ctx context.Context, req *dto.ReceiveGoodsDto) (*model.PurchaseOrder, *dto.OrderSkuDto, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxPageSize {
+		return nil, nil, status.Error(codes.InvalidArgument, "items must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	if req.PurchaseOrderID <= 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid purchase_order_id")
+	}
+	warehouse, err := s.warehouseStock.findWarehouse(ctx, req.WarehouseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	order, err := s.purchaseOrderRepo.FindByIDForUpdate(ctx, req.PurchaseOrderID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to lock purchase order: "+err.Error())
+	}
+	if order == nil {
+		return nil, nil, status.Error(codes.NotFound, "purchase order not found")
+	}
+	if order.Status == model.PurchaseOrderStatusReceived {
+		return nil, nil, status.Error(codes.FailedPrecondition, "purchase order has been fully received")
+	}
+
+	lines := make(map[int64]*model.PurchaseOrderLine, len(order.Lines))
+	for i := range order.Lines {
+		lines[order.Lines[i].ID] = &order.Lines[i]
+	}
+	received := make(map[int64]int32, len(req.Items))
+	skuIds := make([]int64, 0, len(req.Items))
+	skuQuantity := make(map[int64]int32, len(req.Items))
+	for _, item := range req.Items {
+		line, ok := lines[item.LineID]
+		if !ok {
+			return nil, nil, status.Error(codes.InvalidArgument, "line "+strconv.FormatInt(item.LineID, 10)+" does not belong to the purchase order")
+		}
+		if _, ok := received[item.LineID]; ok {
+			return nil, nil, status.Error(codes.InvalidArgument, "duplicate line_id "+strconv.FormatInt(item.LineID, 10))
+		}
+		if item.Quantity <= 0 || item.Quantity > line.RemainingQuantity() {
+			return nil, nil, status.Error(codes.InvalidArgument, "quantity of line "+strconv.FormatInt(item.LineID, 10)+" must be between 1 and "+strconv.Itoa(int(line.RemainingQuantity())))
+		}
+		received[item.LineID] = item.Quantity
+		if _, ok := skuQuantity[line.SkuID]; !ok {
+			skuIds = append(skuIds, line.SkuID)
+		}
+		skuQuantity[line.SkuID] += item.Quantity
+	}
+
+	// 锁定SKU以记录准确的变更前库存
+	skus, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, skuIds)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to lock skus: "+err.Error())
+	}
+	skuMap := make(map[int64]*model.ProductSku, len(skus))
+	for i := range skus {
+		skuMap[skus[i].ID] = &skus[i]
+	}
+	sort.Slice(skuIds, func(i, j int) bool { return skuIds[i] < skuIds[j] })
+	stockChangeRecords := make([]*model.InventoryStockChangeRecord, 0, len(skuIds))
+	skuDto := &dto.OrderSkuDto{
+		Sku: make([]dto.OrderSkuItemDto, 0, len(skuIds)),
+	}
+	for _, skuId := range skuIds {
+		sku, ok := skuMap[skuId]
+		if !ok {
+			return nil, nil, status.Error(codes.NotFound, "sku "+strconv.FormatInt(skuId, 10)+" not found")
+		}
+		quantity := skuQuantity[skuId]
+		warehouseStock, err := s.warehouseStock.change(ctx, skuId, warehouse.ID, int64(quantity))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := s.skuRepo.RestoreInventoryById(ctx, skuId, uint32(quantity)); err != nil {
+			return nil, nil, status.Error(codes.Internal, "failed to increase stock: "+err.Error())
+		}
+		stockChangeRecords = append(stockChangeRecords, &model.InventoryStockChangeRecord{
+			PurchaseOrderID: order.ID,
+			SkuID:           skuId,
+			SourceType:      model.SourceTypePurchase,
+			Quantity:        int64(quantity),
+			BeforeStock:     int64(sku.Stock),
+			AfterStock:      int64(sku.Stock) + int64(quantity),
+			OperatorID:      int64(req.OperatorID),
+			Reason:          withWarehouseCode(purchaseReceiptReason, warehouse.Code),
+		})
+		skuDto.Sku = append(skuDto.Sku, dto.OrderSkuItemDto{
+			SkuID:     skuId,
+			Quantity:  uint32(quantity),
+			Stock:     sku.Stock + uint32(quantity),
+			Threshold: sku.StockWarn,
+			Warehouses: []dto.WarehouseAllocationDto{{
+				WarehouseID: warehouse.ID,
+				Quantity:    uint32(quantity),
+				Stock:       warehouseStock,
+			}},
+		})
+	}
+	if err := s.stockChangeRepo.BatchCreate(ctx, stockChangeRecords); err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+	}
+
+	closedLines := make([]*model.PurchaseOrderLine, 0, len(received))
+	fullyReceived := true
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		if quantity, ok := received[line.ID]; ok {
+			line.ReceivedQuantity += quantity
+			if err := s.purchaseOrderRepo.UpdateLineReceived(ctx, line.ID, line.ReceivedQuantity); err != nil {
+				return nil, nil, status.Error(codes.Internal, "failed to update purchase order line: "+err.Error())
+			}
+			if line.RemainingQuantity() == 0 {
+				closedLines = append(closedLines, line)
+			}
+		}
+		if line.RemainingQuantity() > 0 {
+			fullyReceived = false
+		}
+	}
+	nextStatus := uint8(model.PurchaseOrderStatusPartial)
+	if fullyReceived {
+		nextStatus = model.PurchaseOrderStatusReceived
+	}
+	if nextStatus != order.Status {
+		if err := s.purchaseOrderRepo.UpdateStatus(ctx, order.ID, nextStatus); err != nil {
+			return nil, nil, status.Error(codes.Internal, "failed to update purchase order status: "+err.Error())
+		}
+		order.Status = nextStatus
+	}
+
+	for _, line := range closedLines {
+		if err := s.closeRestocks(ctx, line); err != nil {
+			return nil, nil, err
+		}
+	}
+	return order, skuDto, nil
+}
+
+// closeRestocks 明细收满后，已订满且全部订货明细都已收满的补货记录流转为已到货
+// 明细收货数量已在同一事务内更新，统计未收满明细时不包括当前明细
+func (s *PurchaseOrderService) closeRestocks(ctx context.Context, line *model.PurchaseOrderLine) error {
+	for _, restockId := range line.RestockIDs() {
+		record, err := s.restockRepo.GetByID(ctx, restockId)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to query restock record: "+err.Error())
+		}
+		if record == nil || !record.CanTransitTo(model.RestockStatusReceived) {
+			continue
+		}
+		open, err := s.purchaseOrderRepo.CountOpenLinesByRestockID(ctx, restockId)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to count open purchase order lines: "+err.Error())
+		}
+		if open > 0 {
+			continue
+		}
+		if err := s.restockRepo.UpdateStatus(ctx, restockId, model.RestockStatusReceived, ""); err != nil {
+			return status.Error(codes.Internal, "failed to update restock status: "+err.Error())
+		}
+	}
+	return nil
+}
+
+// preferredSupply 查询SKU的首选供应商
+func (s *PurchaseOrderService) preferredSupply(ctx context.Context, skuId int64) (*repository.SupplierInfo, error) {
+	supplies, err := s.supplierRepo.GetSupplierInfoBySkuID(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query suppliers of sku: "+err.Error())
+	}
+	for _, supply := range supplies {
+		if supply.IsPreferred {
+			return supply, nil
+		}
+	}
+	return nil, status.Error(codes.FailedPrecondition, "sku "+strconv.FormatInt(skuId, 10)+" has no preferred supplier")
+}
+
+// newPurchaseOrder 生成采购单，明细按SKU ID排序
+func newPurchaseOrder(supplierId int64, drafts map[int64]*purchaseLineDraft) *model.PurchaseOrder {
+	skuIds := make([]int64, 0, len(drafts))
+	for skuId := range drafts {
+		skuIds = append(skuIds, skuId)
+	}
+	sort.Slice(skuIds, func(i, j int) bool { return skuIds[i] < skuIds[j] })
+	order := &model.PurchaseOrder{
+		PurchaseOrderNo: newBusinessNo("PO"),
+		SupplierID:      supplierId,
+		Status:          model.PurchaseOrderStatusPlaced,
+		Lines:           make([]model.PurchaseOrderLine, 0, len(skuIds)),
+	}
+	for _, skuId := range skuIds {
+		draft := drafts[skuId]
+		quantity := roundUpToMoq(draft.requested, int32(draft.supply.MinOrderQuantity))
+		amount := roundAmount(draft.supply.SupplyPrice * float64(quantity))
+		restocks := make([]model.PurchaseOrderLineRestock, 0, len(draft.restockIds))
+		for _, restockId := range draft.restockIds {
+			restocks = append(restocks, model.PurchaseOrderLineRestock{RestockID: restockId})
+		}
+		order.Lines = append(order.Lines, model.PurchaseOrderLine{
+			SkuID:             skuId,
+			Restocks:          restocks,
+			RequestedQuantity: draft.requested,
+			Quantity:          quantity,
+			UnitPrice:         draft.supply.SupplyPrice,
+			Amount:            amount,
+		})
+		order.TotalQuantity += quantity
+		order.TotalAmount = roundAmount(order.TotalAmount + amount)
+	}
+	return order
+}
+
+// roundUpToMoq 订货数量按最小起订量的整数倍向上取整，最小起订量为0时不取整
+func roundUpToMoq(quantity int32, moq int32) int32 {
+	if moq <= 0 || quantity%moq == 0 {
+		return quantity
+	}
+	return (quantity/moq + 1) * moq
+}
+
+// roundAmount 金额保留两位小数
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
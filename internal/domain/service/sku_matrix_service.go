@@ -0,0 +1,533 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSkuCombinations 规格矩阵最多生成的SKU数量
+const maxSkuCombinations = 1000
+
+type ISkuMatrixService interface {
+	PreviewSkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*dto.SkuMatrixDiffDto, error)
+	ApplySkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*dto.SkuMatrixDiffDto, error)
+}
+
+// NewSkuMatrixService 创建规格矩阵服务
+func NewSkuMatrixService(productRepo repository.IProductRepository, skuRepo repository.ProductSkuRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository) ISkuMatrixService {
+	return &SkuMatrixService{productRepo: productRepo, skuRepo: skuRepo, stockChangeRepo: stockChangeRepo}
+}
+
+// SkuMatrixService 规格矩阵服务
+// 根据商品的目标规格生成规格值的笛卡尔积，与现有SKU比对得出新增、下架和保持不变的SKU
+// SpecValueIDs为各规格的规格值ID按规格ID升序以逗号拼接，SKU编号为商品编号加上以"-"拼接的规格值ID，
+// 规格按名称、规格值按规格内名称匹配已有记录（包括已删除的），因此移除后再加回的规格值ID不变，对应的SKU会被保留
+type SkuMatrixService struct {
+	productRepo     repository.IProductRepository
+	skuRepo         repository.ProductSkuRepository
+	stockChangeRepo repository.InventoryStockChangeRecordRepository
+}
+
+// matrixSpec 目标规格，ID为0的规格或规格值为新增
+type matrixSpec struct {
+	spec   *model.ProductSpec
+	values []*model.SpecValue
+}
+
+// matrixCombo 规格组合，values按目标规格顺序排列
+type matrixCombo struct {
+	values []*model.SpecValue
+	input  *dto.SkuInputDto
+	sku    *model.ProductSku
+}
+
+// matrixPlan 规格矩阵的变更计划
+type matrixPlan struct {
+	product       *model.Product
+	defaultSku    *dto.SkuInputDto
+	specs         []*matrixSpec
+	idOrder       []int // SpecValueIDs中规格的顺序，已有规格按ID升序，新增规格按目标顺序排在最后
+	removedSpecs  []*model.ProductSpec
+	removedValues []*model.SpecValue
+	create        []*matrixCombo
+	disable       []model.ProductSku
+	keep          []model.ProductSku
+	enable        []int64 // 保留的SKU中已下架的，应用时恢复为商品状态
+}
+
+// PreviewSkuMatrix 预览规格矩阵的变更，不写入数据
+func (s *SkuMatrixService) PreviewSkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*dto.SkuMatrixDiffDto, error) {
+	plan, err := s.plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return plan.diff(), nil
+}
+
+// ApplySkuMatrix 应用规格矩阵，须在事务内调用
+// 新增和恢复目标中的规格与规格值，删除目标之外的规格与规格值，创建新增的SKU并记录初始库存，下架组合已不存在的SKU，
+// 重新上架组合被加回的已下架SKU
+func (s *SkuMatrixService) ApplySkuMatrix(ctx context.Context, req *dto.SkuMatrixDto) (*dto.SkuMatrixDiffDto, error) {
+	plan, err := s.plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i, item := range plan.specs {
+		item.spec.ProductID = uint(plan.product.ID)
+		item.spec.DisplayOrder = i
+		item.spec.Restore()
+		if err := s.productRepo.SaveSpec(ctx, item.spec); err != nil {
+			return nil, status.Error(codes.Internal, "failed to save spec: "+err.Error())
+		}
+		for j, value := range item.values {
+			value.SpecID = uint(item.spec.ID)
+			value.DisplayOrder = j
+			value.Restore()
+			if err := s.productRepo.SaveSpecValue(ctx, value); err != nil {
+				return nil, status.Error(codes.Internal, "failed to save spec value: "+err.Error())
+			}
+		}
+	}
+	for _, spec := range plan.removedSpecs {
+		spec.SoftDelete(now)
+		if err := s.productRepo.SaveSpec(ctx, spec); err != nil {
+			return nil, status.Error(codes.Internal, "failed to delete spec: "+err.Error())
+		}
+	}
+	for _, value := range plan.removedValues {
+		value.SoftDelete(now)
+		if err := s.productRepo.SaveSpecValue(ctx, value); err != nil {
+			return nil, status.Error(codes.Internal, "failed to delete spec value: "+err.Error())
+		}
+	}
+
+	skus := make([]*model.ProductSku, 0, len(plan.create))
+	for _, combo := range plan.create {
+		sku, err := plan.newSku(combo)
+		if err != nil {
+			return nil, err
+		}
+		combo.sku = sku
+		skus = append(skus, sku)
+	}
+	if err := s.skuRepo.BatchCreateSkus(ctx, skus); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create skus: "+err.Error())
+	}
+	records := make([]*model.InventoryStockChangeRecord, 0, len(skus))
+	for _, sku := range skus {
+		if sku.Stock == 0 {
+			continue
+		}
+		records = append(records, &model.InventoryStockChangeRecord{
+			SkuID:       sku.ID,
+			SourceType:  model.SourceTypeInitial,
+			Quantity:    int64(sku.Stock),
+			BeforeStock: 0,
+			AfterStock:  int64(sku.Stock),
+		})
+	}
+	if len(records) > 0 {
+		if err := s.stockChangeRepo.BatchCreate(ctx, records); err != nil {
+			return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+		}
+	}
+
+	disableIds := make([]int64, 0, len(plan.disable))
+	for i := range plan.disable {
+		disableIds = append(disableIds, plan.disable[i].ID)
+		plan.disable[i].Status = model.ProductStatusOffSale
+	}
+	if err := s.skuRepo.UpdateSkuStatusByIds(ctx, disableIds, model.ProductStatusOffSale); err != nil {
+		return nil, status.Error(codes.Internal, "failed to disable skus: "+err.Error())
+	}
+	if err := s.skuRepo.UpdateSkuStatusByIds(ctx, plan.enable, plan.product.Status); err != nil {
+		return nil, status.Error(codes.Internal, "failed to enable skus: "+err.Error())
+	}
+	return plan.diff(), nil
+}
+
+// plan 校验目标规格并与现有规格、SKU比对
+func (s *SkuMatrixService) plan(ctx context.Context, req *dto.SkuMatrixDto) (*matrixPlan, error) {
+	if req.ProductID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id cannot be empty")
+	}
+	if err := validateSpecs(req.Specs); err != nil {
+		return nil, err
+	}
+	combinations := 1
+	for _, spec := range req.Specs {
+		combinations *= len(spec.Values)
+		if combinations > maxSkuCombinations {
+			return nil, status.Error(codes.InvalidArgument, "too many spec combinations, at most "+strconv.Itoa(maxSkuCombinations))
+		}
+	}
+	if req.DefaultSku != nil {
+		if err := validateSkuInput(req.DefaultSku.SkuName, req.DefaultSku.Price, req.DefaultSku.MarketPrice, req.DefaultSku.MainImage, req.DefaultSku.Images); err != nil {
+			return nil, err
+		}
+	}
+	overrides := make(map[string]*dto.SkuInputDto, len(req.Skus))
+	for _, item := range req.Skus {
+		if item == nil {
+			continue
+		}
+		if err := validateSkuOverride(req.Specs, item); err != nil {
+			return nil, err
+		}
+		key := comboKey(item.SpecValues)
+		if _, ok := overrides[key]; ok {
+			return nil, status.Error(codes.InvalidArgument, "duplicate sku for spec values "+strings.Join(item.SpecValues, ","))
+		}
+		overrides[key] = item
+	}
+
+	product, err := s.productRepo.FindProductDetailByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get product: "+err.Error())
+	}
+	if product == nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if product.Status == model.ProductStatusArchived {
+		return nil, status.Error(codes.FailedPrecondition, "archived product cannot be modified")
+	}
+	existingSpecs, err := s.productRepo.FindSpecsByProductID(ctx, req.ProductID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get specs: "+err.Error())
+	}
+
+	plan := &matrixPlan{product: product, defaultSku: req.DefaultSku, specs: make([]*matrixSpec, 0, len(req.Specs))}
+	specByName := make(map[string]*model.ProductSpec, len(existingSpecs))
+	for i := range existingSpecs {
+		spec := &existingSpecs[i]
+		if matched, ok := specByName[spec.SpecName]; !ok || (matched.Removed() && !spec.Removed()) {
+			specByName[spec.SpecName] = spec
+		}
+	}
+	usedSpecs := make(map[*model.ProductSpec]struct{}, len(req.Specs))
+	usedValues := make(map[*model.SpecValue]struct{})
+	for _, item := range req.Specs {
+		specName := strings.TrimSpace(item.SpecName)
+		spec, ok := specByName[specName]
+		if !ok {
+			spec = &model.ProductSpec{SpecName: specName}
+		}
+		usedSpecs[spec] = struct{}{}
+		valueByName := make(map[string]*model.SpecValue, len(spec.SpecValues))
+		for i := range spec.SpecValues {
+			value := &spec.SpecValues[i]
+			if matched, ok := valueByName[value.ValueName]; !ok || (matched.Removed() && !value.Removed()) {
+				valueByName[value.ValueName] = value
+			}
+		}
+		target := &matrixSpec{spec: spec, values: make([]*model.SpecValue, 0, len(item.Values))}
+		for _, input := range item.Values {
+			valueName := strings.TrimSpace(input.ValueName)
+			value, ok := valueByName[valueName]
+			if !ok {
+				value = &model.SpecValue{ValueName: valueName}
+			}
+			value.ValueImage = nil
+			if input.ValueImage != "" {
+				valueImage := input.ValueImage
+				value.ValueImage = &valueImage
+			}
+			usedValues[value] = struct{}{}
+			target.values = append(target.values, value)
+		}
+		plan.specs = append(plan.specs, target)
+	}
+	for i := range existingSpecs {
+		spec := &existingSpecs[i]
+		if _, ok := usedSpecs[spec]; !ok {
+			if !spec.Removed() {
+				plan.removedSpecs = append(plan.removedSpecs, spec)
+			}
+			continue
+		}
+		for j := range spec.SpecValues {
+			value := &spec.SpecValues[j]
+			if _, ok := usedValues[value]; !ok && !value.Removed() {
+				plan.removedValues = append(plan.removedValues, value)
+			}
+		}
+	}
+	for _, spec := range plan.removedSpecs {
+		for j := range spec.SpecValues {
+			if !spec.SpecValues[j].Removed() {
+				plan.removedValues = append(plan.removedValues, &spec.SpecValues[j])
+			}
+		}
+	}
+
+	plan.idOrder = make([]int, 0, len(plan.specs))
+	for i := range plan.specs {
+		plan.idOrder = append(plan.idOrder, i)
+	}
+	sort.SliceStable(plan.idOrder, func(i, j int) bool {
+		a, b := plan.specs[plan.idOrder[i]].spec.ID, plan.specs[plan.idOrder[j]].spec.ID
+		if a == 0 || b == 0 {
+			return a != 0 && b == 0
+		}
+		return a < b
+	})
+
+	skuByIds := make(map[string]model.ProductSku, len(product.Skus))
+	for _, sku := range product.Skus {
+		skuByIds[sku.SpecValueIDs] = sku
+	}
+	for _, values := range matrixCombinations(plan.specs) {
+		combo := &matrixCombo{values: values}
+		names := make([]string, 0, len(values))
+		for _, value := range values {
+			names = append(names, value.ValueName)
+		}
+		if ids, ok := plan.specValueIDs(combo); ok {
+			if sku, exists := skuByIds[ids]; exists {
+				if _, overridden := overrides[comboKey(names)]; overridden {
+					return nil, status.Error(codes.InvalidArgument, "sku for spec values "+strings.Join(names, ",")+" already exists")
+				}
+				// 移除后再加回的组合沿用原SKU，原SKU已下架时随商品状态恢复
+				if sku.Status == model.ProductStatusOffSale && plan.product.Status != model.ProductStatusOffSale {
+					plan.enable = append(plan.enable, sku.ID)
+					sku.Status = plan.product.Status
+				}
+				plan.keep = append(plan.keep, sku)
+				delete(skuByIds, ids)
+				continue
+			}
+		}
+		combo.input = req.DefaultSku
+		if item, ok := overrides[comboKey(names)]; ok {
+			combo.input = item
+		}
+		if combo.input == nil {
+			return nil, status.Error(codes.InvalidArgument, "default_sku cannot be empty when skus are created")
+		}
+		plan.create = append(plan.create, combo)
+	}
+	for _, sku := range product.Skus {
+		if _, ok := skuByIds[sku.SpecValueIDs]; ok && sku.Status != model.ProductStatusOffSale && sku.Status != model.ProductStatusArchived {
+			plan.disable = append(plan.disable, sku)
+		}
+	}
+	return plan, nil
+}
+
+// specValueIDs 规格组合的SpecValueIDs，包含新增规格值时返回false
+func (p *matrixPlan) specValueIDs(combo *matrixCombo) (string, bool) {
+	ids := make([]string, 0, len(combo.values))
+	for _, i := range p.idOrder {
+		if combo.values[i].ID == 0 {
+			return "", false
+		}
+		ids = append(ids, strconv.FormatInt(combo.values[i].ID, 10))
+	}
+	return strings.Join(ids, ","), true
+}
+
+// skuNo SKU编号，没有规格时为商品编号
+func (p *matrixPlan) skuNo(specValueIDs string) string {
+	if specValueIDs == "" {
+		return p.product.ProductNo
+	}
+	return p.product.ProductNo + "-" + strings.ReplaceAll(specValueIDs, ",", "-")
+}
+
+// specValueText 规格值文本，按目标规格顺序以"规格:规格值"拼接
+func (p *matrixPlan) specValueText(combo *matrixCombo) string {
+	texts := make([]string, 0, len(combo.values))
+	for i, value := range combo.values {
+		texts = append(texts, p.specs[i].spec.SpecName+":"+value.ValueName)
+	}
+	return strings.Join(texts, ";")
+}
+
+// skuName 单独指定了名称时使用指定的名称，否则由商品名称和规格值生成
+func (p *matrixPlan) skuName(combo *matrixCombo) string {
+	if combo.input != p.defaultSku {
+		if name := strings.TrimSpace(combo.input.SkuName); name != "" {
+			return name
+		}
+	}
+	names := make([]string, 0, len(combo.values)+1)
+	names = append(names, p.product.ProductName)
+	for _, value := range combo.values {
+		names = append(names, value.ValueName)
+	}
+	return strings.Join(names, " ")
+}
+
+// newSku 生成新增的SKU，规格值须已保存
+func (p *matrixPlan) newSku(combo *matrixCombo) (*model.ProductSku, error) {
+	ids, ok := p.specValueIDs(combo)
+	if !ok {
+		return nil, status.Error(codes.Internal, "spec values of sku have not been saved")
+	}
+	skuNo := p.skuNo(ids)
+	if len(skuNo) > 64 {
+		return nil, status.Error(codes.InvalidArgument, "generated sku_no "+skuNo+" is too long, use a shorter product_no")
+	}
+	sku := &model.ProductSku{
+		ProductID:     uint(p.product.ID),
+		SkuNo:         skuNo,
+		SkuName:       p.skuName(combo),
+		SpecValueIDs:  ids,
+		SpecValueText: p.specValueText(combo),
+		Price:         combo.input.Price,
+		Stock:         combo.input.Stock,
+		StockWarn:     combo.input.StockWarn,
+		MainImage:     skuMainImage(combo.input.MainImage, combo.input.Images),
+		Status:        p.product.Status,
+		Images:        newSkuImages(combo.input.Images),
+	}
+	if combo.input.MarketPrice > 0 {
+		marketPrice := combo.input.MarketPrice
+		sku.MarketPrice = &marketPrice
+	}
+	return sku, nil
+}
+
+// diff 转换为差异结果，新增SKU已创建时带上SKU ID
+func (p *matrixPlan) diff() *dto.SkuMatrixDiffDto {
+	result := &dto.SkuMatrixDiffDto{
+		Create:  make([]*dto.SkuMatrixItemDto, 0, len(p.create)),
+		Disable: make([]*dto.SkuMatrixItemDto, 0, len(p.disable)),
+		Keep:    make([]*dto.SkuMatrixItemDto, 0, len(p.keep)),
+	}
+	for _, combo := range p.create {
+		item := &dto.SkuMatrixItemDto{
+			SkuName:       p.skuName(combo),
+			SpecValueText: p.specValueText(combo),
+			Price:         combo.input.Price,
+			Stock:         combo.input.Stock,
+			Status:        p.product.Status,
+		}
+		if ids, ok := p.specValueIDs(combo); ok {
+			item.SpecValueIDs = ids
+			item.SkuNo = p.skuNo(ids)
+		}
+		if combo.sku != nil {
+			item.SkuID = combo.sku.ID
+		}
+		result.Create = append(result.Create, item)
+	}
+	for i := range p.disable {
+		result.Disable = append(result.Disable, toSkuMatrixItem(&p.disable[i]))
+	}
+	for i := range p.keep {
+		result.Keep = append(result.Keep, toSkuMatrixItem(&p.keep[i]))
+	}
+	return result
+}
+
+// toSkuMatrixItem 转换已有SKU
+func toSkuMatrixItem(sku *model.ProductSku) *dto.SkuMatrixItemDto {
+	return &dto.SkuMatrixItemDto{
+		SkuID:         sku.ID,
+		SkuNo:         sku.SkuNo,
+		SkuName:       sku.SkuName,
+		SpecValueIDs:  sku.SpecValueIDs,
+		SpecValueText: sku.SpecValueText,
+		Price:         sku.Price,
+		Stock:         sku.Stock,
+		Status:        sku.Status,
+	}
+}
+
+// matrixCombinations 按目标规格顺序生成规格值的笛卡尔积，没有规格时返回一个空组合
+func matrixCombinations(specs []*matrixSpec) [][]*model.SpecValue {
+	combinations := [][]*model.SpecValue{{}}
+	for _, spec := range specs {
+		next := make([][]*model.SpecValue, 0, len(combinations)*len(spec.values))
+		for _, prefix := range combinations {
+			for _, value := range spec.values {
+				combination := make([]*model.SpecValue, 0, len(prefix)+1)
+				combination = append(combination, prefix...)
+				next = append(next, append(combination, value))
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// comboKey 规格值名称组合的键
+func comboKey(names []string) string {
+	trimmed := make([]string, 0, len(names))
+	for _, name := range names {
+		trimmed = append(trimmed, strings.TrimSpace(name))
+	}
+	return strings.Join(trimmed, "\x00")
+}
+
+// validateSpecs 校验规格矩阵，规格名称和同一规格下的规格值不可重复
+func validateSpecs(specs []*dto.SpecInputDto) error {
+	specNames := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		if spec == nil {
+			return status.Error(codes.InvalidArgument, "spec cannot be empty")
+		}
+		specName := strings.TrimSpace(spec.SpecName)
+		if specName == "" || utf8.RuneCountInString(specName) > 100 {
+			return status.Error(codes.InvalidArgument, "spec_name cannot be empty or longer than 100")
+		}
+		if _, ok := specNames[specName]; ok {
+			return status.Error(codes.InvalidArgument, "duplicate spec "+specName)
+		}
+		specNames[specName] = struct{}{}
+		if len(spec.Values) == 0 {
+			return status.Error(codes.InvalidArgument, "spec "+specName+" has no values")
+		}
+		valueNames := make(map[string]struct{}, len(spec.Values))
+		for _, value := range spec.Values {
+			if value == nil {
+				return status.Error(codes.InvalidArgument, "spec value cannot be empty")
+			}
+			valueName := strings.TrimSpace(value.ValueName)
+			if valueName == "" || utf8.RuneCountInString(valueName) > 100 {
+				return status.Error(codes.InvalidArgument, "value_name cannot be empty or longer than 100")
+			}
+			if _, ok := valueNames[valueName]; ok {
+				return status.Error(codes.InvalidArgument, "duplicate value "+valueName+" of spec "+specName)
+			}
+			valueNames[valueName] = struct{}{}
+			if len(value.ValueImage) > 500 {
+				return status.Error(codes.InvalidArgument, "value_image cannot be longer than 500")
+			}
+		}
+	}
+	return nil
+}
+
+// validateSkuOverride 校验单独指定的SKU，规格值须与规格矩阵中的某个组合一致
+func validateSkuOverride(specs []*dto.SpecInputDto, item *dto.SkuInputDto) error {
+	if len(item.SpecValues) != len(specs) {
+		return status.Error(codes.InvalidArgument, "spec_values of sku must match all specs")
+	}
+	for i, spec := range specs {
+		valueName := strings.TrimSpace(item.SpecValues[i])
+		found := false
+		for _, value := range spec.Values {
+			if strings.TrimSpace(value.ValueName) == valueName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return status.Error(codes.InvalidArgument, "value "+valueName+" not found in spec "+spec.SpecName)
+		}
+	}
+	return validateSkuInput(item.SkuName, item.Price, item.MarketPrice, item.MainImage, item.Images)
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ISkuPriceService interface {
+	SchedulePriceChange(ctx context.Context, req *dto.SchedulePriceChangeDto, now time.Time) (*model.SkuPriceHistory, []*dto.SkuPriceChangeDto, error)
+	ApplyDueChanges(ctx context.Context, skuId int64, now time.Time) ([]*dto.SkuPriceChangeDto, error)
+	ListDueSkuIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error)
+	GetSkuPriceAt(ctx context.Context, skuId int64, at string) (*dto.SkuPriceAtDto, error)
+	ListSkuPriceHistory(ctx context.Context, skuId int64, page, pageSize int32) ([]model.SkuPriceHistory, int64, error)
+}
+
+// NewSkuPriceService 创建SKU价格服务
+func NewSkuPriceService(skuRepo repository.ProductSkuRepository, priceHistoryRepo repository.SkuPriceHistoryRepository) ISkuPriceService {
+	return &SkuPriceService{skuRepo: skuRepo, priceHistoryRepo: priceHistoryRepo}
+}
+
+// SkuPriceService SKU价格服务，计划调价到达生效时间后修改product_skus的价格并写入变更记录
+// 限时调价期间不能再开始其他调价，两条调价也不能同时生效，保证到期恢复的原价没有被其他调价覆盖
+type SkuPriceService struct {
+	skuRepo          repository.ProductSkuRepository
+	priceHistoryRepo repository.SkuPriceHistoryRepository
+}
+
+// priceEvent 到期的生效或恢复原价操作
+type priceEvent struct {
+	at     time.Time
+	revert bool
+	change *model.SkuPriceHistory
+}
+
+// SchedulePriceChange 创建计划调价，须在事务内调用；生效时间为空或不晚于当前时间时立即生效，并返回本次生效的价格变更
+func (s *SkuPriceService) SchedulePriceChange(ctx context.Context, req *dto.SchedulePriceChangeDto, now time.Time) (*model.SkuPriceHistory, []*dto.SkuPriceChangeDto, error) {
+	if req.SkuID <= 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	if err := checkPrice(req.Price, "price", false); err != nil {
+		return nil, nil, err
+	}
+	if err := checkPrice(req.MarketPrice, "market_price", true); err != nil {
+		return nil, nil, err
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	effectiveFrom, err := parseLedgerTime(req.EffectiveFrom, "effective_from")
+	if err != nil {
+		return nil, nil, err
+	}
+	if effectiveFrom.Before(now) {
+		effectiveFrom = now
+	}
+	effectiveTo, err := parseLedgerTime(req.EffectiveTo, "effective_to")
+	if err != nil {
+		return nil, nil, err
+	}
+	change := &model.SkuPriceHistory{
+		SkuID:         req.SkuID,
+		ChangeType:    model.PriceChangeTypeScheduled,
+		Price:         req.Price,
+		EffectiveFrom: effectiveFrom,
+		Status:        model.PriceChangeStatusPending,
+		OperatorID:    req.OperatorID,
+		Reason:        reason,
+	}
+	if req.MarketPrice > 0 {
+		marketPrice := req.MarketPrice
+		change.MarketPrice = &marketPrice
+	}
+	if !effectiveTo.IsZero() {
+		if !effectiveTo.After(effectiveFrom) {
+			return nil, nil, status.Error(codes.InvalidArgument, "effective_to must be later than effective_from and now")
+		}
+		change.EffectiveTo = &effectiveTo
+	}
+
+	sku, err := s.skuRepo.FindSkuPriceForUpdate(ctx, req.SkuID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	if sku == nil {
+		return nil, nil, status.Error(codes.NotFound, "sku not found")
+	}
+	openChanges, err := s.priceHistoryRepo.ListOpenBySkuID(ctx, req.SkuID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to query price changes: "+err.Error())
+	}
+	for i := range openChanges {
+		if conflict := &openChanges[i]; priceChangesOverlap(change, conflict) {
+			return nil, nil, status.Error(codes.FailedPrecondition, "conflicts with price change "+strconv.FormatInt(conflict.ID, 10))
+		}
+	}
+	if err := s.priceHistoryRepo.Create(ctx, change); err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to create price change: "+err.Error())
+	}
+	if change.EffectiveFrom.After(now) {
+		return change, []*dto.SkuPriceChangeDto{}, nil
+	}
+	applied, err := s.ApplyDueChanges(ctx, req.SkuID, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	change, err = s.priceHistoryRepo.FindByID(ctx, change.ID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to query price change: "+err.Error())
+	}
+	return change, applied, nil
+}
+
+// ApplyDueChanges 锁定SKU并按时间顺序执行已到期的调价与恢复原价，须在事务内调用
+// 同一时刻既有限时调价到期又有新调价生效时先恢复原价，返回结果按执行顺序排列
+// 同一批执行的记录生效时间相同，按执行顺序分配递增的生效序号，查询历史价格时据此确定最后生效的记录
+func (s *SkuPriceService) ApplyDueChanges(ctx context.Context, skuId int64, now time.Time) ([]*dto.SkuPriceChangeDto, error) {
+	sku, err := s.skuRepo.FindSkuPriceForUpdate(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	if sku == nil {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+	openChanges, err := s.priceHistoryRepo.ListOpenBySkuID(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query price changes: "+err.Error())
+	}
+	events := make([]priceEvent, 0, len(openChanges))
+	for i := range openChanges {
+		change := &openChanges[i]
+		if change.Status == model.PriceChangeStatusPending {
+			if change.EffectiveFrom.After(now) {
+				continue
+			}
+			events = append(events, priceEvent{at: change.EffectiveFrom, change: change})
+		}
+		if change.EffectiveTo != nil && !change.EffectiveTo.After(now) {
+			events = append(events, priceEvent{at: *change.EffectiveTo, revert: true, change: change})
+		}
+	}
+	if len(events) == 0 {
+		return []*dto.SkuPriceChangeDto{}, nil
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].at.Equal(events[j].at) {
+			return events[i].at.Before(events[j].at)
+		}
+		if events[i].revert != events[j].revert {
+			return events[i].revert
+		}
+		return events[i].change.ID < events[j].change.ID
+	})
+
+	seq, err := s.priceHistoryRepo.MaxAppliedSeq(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query price changes: "+err.Error())
+	}
+
+	price, marketPrice := sku.Price, sku.MarketPrice
+	results := make([]*dto.SkuPriceChangeDto, 0, len(events))
+	for _, event := range events {
+		change := event.change
+		appliedAt := now
+		seq++
+		if event.revert {
+			revert := &model.SkuPriceHistory{
+				SkuID:               skuId,
+				ChangeType:          model.PriceChangeTypeRevert,
+				Price:               change.PreviousPrice,
+				MarketPrice:         change.PreviousMarketPrice,
+				PreviousPrice:       price,
+				PreviousMarketPrice: marketPrice,
+				EffectiveFrom:       *change.EffectiveTo,
+				Status:              model.PriceChangeStatusApplied,
+				RevertOf:            change.ID,
+				AppliedAt:           &appliedAt,
+				AppliedSeq:          seq,
+				OperatorID:          change.OperatorID,
+				Reason:              change.Reason,
+			}
+			if err := s.priceHistoryRepo.Create(ctx, revert); err != nil {
+				return nil, status.Error(codes.Internal, "failed to create price change: "+err.Error())
+			}
+			change.Status = model.PriceChangeStatusReverted
+			change = revert
+		} else {
+			change.PreviousPrice = price
+			change.PreviousMarketPrice = marketPrice
+			if change.MarketPrice == nil {
+				change.MarketPrice = marketPrice
+			}
+			change.Status = model.PriceChangeStatusApplied
+			change.AppliedAt = &appliedAt
+			change.AppliedSeq = seq
+		}
+		if err := s.priceHistoryRepo.Update(ctx, event.change); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update price change: "+err.Error())
+		}
+		price, marketPrice = change.Price, change.MarketPrice
+		results = append(results, &dto.SkuPriceChangeDto{
+			ChangeID:            change.ID,
+			SkuID:               skuId,
+			ProductID:           int64(sku.ProductID),
+			ChangeType:          change.ChangeType,
+			Price:               change.Price,
+			MarketPrice:         change.MarketPrice,
+			PreviousPrice:       change.PreviousPrice,
+			PreviousMarketPrice: change.PreviousMarketPrice,
+			EffectiveTo:         change.EffectiveTo,
+			AppliedAt:           appliedAt,
+			OperatorID:          change.OperatorID,
+		})
+	}
+	if err := s.skuRepo.UpdatePriceById(ctx, skuId, price, marketPrice); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update sku price: "+err.Error())
+	}
+	return results, nil
+}
+
+// ListDueSkuIDs 查询有到期调价或到期恢复原价的SKU，按ID升序返回ID大于afterId的一批
+func (s *SkuPriceService) ListDueSkuIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error) {
+	skuIds, err := s.priceHistoryRepo.ListDueSkuIDs(ctx, now, afterId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query due price changes: "+err.Error())
+	}
+	return skuIds, nil
+}
+
+// GetSkuPriceAt 查询SKU在指定时刻的价格，用于订单核对下单时的价格
+// 取该时刻及之前最后生效的变更；早于所有变更时取最早变更的生效前价格；没有变更记录时取当前价格
+func (s *SkuPriceService) GetSkuPriceAt(ctx context.Context, skuId int64, at string) (*dto.SkuPriceAtDto, error) {
+	if skuId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	if at == "" {
+		return nil, status.Error(codes.InvalidArgument, "at is required")
+	}
+	atTime, err := parseLedgerTime(at, "at")
+	if err != nil {
+		return nil, err
+	}
+	sku, err := s.skuRepo.GetSkuDetailByID(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get sku detail: "+err.Error())
+	}
+	if sku == nil {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+
+	change, err := s.priceHistoryRepo.FindLatestAppliedAtOrBefore(ctx, skuId, atTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query price changes: "+err.Error())
+	}
+	if change != nil {
+		return &dto.SkuPriceAtDto{SkuID: skuId, Price: change.Price, MarketPrice: change.MarketPrice, ChangeID: change.ID}, nil
+	}
+	change, err = s.priceHistoryRepo.FindEarliestAppliedAfter(ctx, skuId, atTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query price changes: "+err.Error())
+	}
+	if change != nil {
+		return &dto.SkuPriceAtDto{SkuID: skuId, Price: change.PreviousPrice, MarketPrice: change.PreviousMarketPrice}, nil
+	}
+	return &dto.SkuPriceAtDto{SkuID: skuId, Price: sku.Price, MarketPrice: sku.MarketPrice}, nil
+}
+
+// ListSkuPriceHistory 分页查询SKU的价格变更记录，包含待生效的调价
+func (s *SkuPriceService) ListSkuPriceHistory(ctx context.Context, skuId int64, page, pageSize int32) ([]model.SkuPriceHistory, int64, error) {
+	if skuId <= 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	changes, total, err := s.priceHistoryRepo.ListBySkuID(ctx, skuId, int(page-1)*int(pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list price changes: "+err.Error())
+	}
+	return changes, total, nil
+}
+
+// priceChangesOverlap 两条调价是否冲突：同时生效，或一条在另一条限时调价的有效期内生效
+func priceChangesOverlap(a, b *model.SkuPriceHistory) bool {
+	if a.EffectiveFrom.Equal(b.EffectiveFrom) {
+		return true
+	}
+	within := func(t time.Time, c *model.SkuPriceHistory) bool {
+		return c.EffectiveTo != nil && !t.Before(c.EffectiveFrom) && t.Before(*c.EffectiveTo)
+	}
+	return within(a.EffectiveFrom, b) || within(b.EffectiveFrom, a)
+}
+
+// checkPrice 校验价格为正数且最多两位小数，allowZero为true时允许为0
+func checkPrice(price float64, field string, allowZero bool) error {
+	if math.IsNaN(price) || math.IsInf(price, 0) || price < 0 {
+		return status.Error(codes.InvalidArgument, field+" must not be negative")
+	}
+	if price == 0 && !allowZero {
+		return status.Error(codes.InvalidArgument, field+" must be greater than 0")
+	}
+	if math.Abs(math.Round(price*100)-price*100) > 1e-6 {
+		return status.Error(codes.InvalidArgument, field+" must have at most 2 decimal places")
+	}
+	return nil
+}
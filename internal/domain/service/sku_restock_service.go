@@ -3,15 +3,23 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"github.com/zhanshen02154/product/internal/application/dto"
 	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ISkuRestockService interface {
 	CreateRestockApply(ctx context.Context, req *dto.CreateRestockApplyDto) (*dto.CreateRestockApplyResponseDto, error)
 	GetRestockApplyInfo(ctx context.Context, applicationNo string, userID int) (*model.SkuRestockRecord, error)
+	ApproveRestockApply(ctx context.Context, applicationNo string, auditUserID uint32) (*model.SkuRestockRecord, error)
+	RejectRestockApply(ctx context.Context, applicationNo string, auditUserID uint32, reason string) (*model.SkuRestockRecord, error)
 }
 
 // NewSkuRestockService 创建补货服务
@@ -66,12 +74,13 @@ func (s *SkuRestockService) CreateRestockApply(ctx context.Context, req *dto.Cre
 
 	// 4. 创建补货记录
 	restockRecord := &model.SkuRestockRecord{
-		UserID:       int(req.UserID),
-		SkuID:        uint64(sku.ID),
-		Quantity:     req.Quantity,
-		Reason:       req.Reason,
-		Status:       model.RestockStatusPending, // 待订货
-		FailedReason: "",
+		ApplicationNo: newBusinessNo("RS"),
+		UserID:        int(req.UserID),
+		SkuID:         uint64(sku.ID),
+		Quantity:      req.Quantity,
+		Reason:        req.Reason,
+		Status:        model.RestockStatusPending, // 待订货
+		FailedReason:  "",
 	}
 
 	// 5. 保存补货记录
@@ -80,16 +89,26 @@ func (s *SkuRestockService) CreateRestockApply(ctx context.Context, req *dto.Cre
 		return nil, err
 	}
 
-	// 6. 构建响应
+	// 6. 创建待审核记录
+	_, err = s.auditRepo.Create(ctx, &model.SkuRestockAudit{
+		RestockID:   uint64(createdRecord.ID),
+		AuditStatus: model.AuditStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 7. 构建响应
 	response := &dto.CreateRestockApplyResponseDto{
 		RestockRecord: &dto.RestockRecordDto{
-			ID:           createdRecord.ID,
-			UserID:       int32(createdRecord.UserID),
-			SkuID:        createdRecord.SkuID,
-			Quantity:     createdRecord.Quantity,
-			Reason:       createdRecord.Reason,
-			Status:       createdRecord.Status,
-			FailedReason: createdRecord.FailedReason,
+			ID:            createdRecord.ID,
+			UserID:        int32(createdRecord.UserID),
+			SkuID:         createdRecord.SkuID,
+			Quantity:      createdRecord.Quantity,
+			Reason:        createdRecord.Reason,
+			Status:        createdRecord.Status,
+			FailedReason:  createdRecord.FailedReason,
+			ApplicationNo: createdRecord.ApplicationNo,
 		},
 		SkuInfo: &dto.SkuBasicInfoDto{
 			ID:            sku.ID,
@@ -131,3 +150,75 @@ func (s *SkuRestockService) GetRestockApplyInfo(ctx context.Context, application
 
 	return record, nil
 }
+
+// ApproveRestockApply 审核通过补货申请，补货记录保持待订货，须在事务内调用
+func (s *SkuRestockService) ApproveRestockApply(ctx context.Context, applicationNo string, auditUserID uint32) (*model.SkuRestockRecord, error) {
+	return s.auditRestockApply(ctx, applicationNo, auditUserID, model.AuditStatusApproved, "")
+}
+
+// RejectRestockApply 驳回补货申请，补货记录流转为失败，须在事务内调用
+func (s *SkuRestockService) RejectRestockApply(ctx context.Context, applicationNo string, auditUserID uint32, reason string) (*model.SkuRestockRecord, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > 200 {
+		return nil, status.Error(codes.InvalidArgument, "reason cannot be empty or longer than 200")
+	}
+	return s.auditRestockApply(ctx, applicationNo, auditUserID, model.AuditStatusRejected, reason)
+}
+
+// auditRestockApply 锁定补货记录后审核，只有待审核且待订货的申请可以审核
+// 早于审核流程创建、没有审核记录的申请视为待审核，审核时补建审核记录
+func (s *SkuRestockService) auditRestockApply(ctx context.Context, applicationNo string, auditUserID uint32, auditStatus uint8, reason string) (*model.SkuRestockRecord, error) {
+	if applicationNo == "" {
+		return nil, status.Error(codes.InvalidArgument, "application_no cannot be empty")
+	}
+	record, err := s.restockRepo.GetByApplicationNoForUpdate(ctx, applicationNo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query restock apply: "+err.Error())
+	}
+	if record == nil {
+		return nil, status.Error(codes.NotFound, "restock apply not found")
+	}
+	audit, err := s.auditRepo.GetLatestByRestockID(ctx, uint64(record.ID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query restock audit: "+err.Error())
+	}
+	if audit != nil && audit.Decided() {
+		return nil, status.Error(codes.FailedPrecondition, "restock apply has already been audited")
+	}
+	if record.Status != model.RestockStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "only pending restock apply can be audited")
+	}
+	if auditStatus == model.AuditStatusRejected {
+		if !record.CanTransitTo(model.RestockStatusFailed) {
+			return nil, status.Error(codes.FailedPrecondition, "restock apply cannot be rejected")
+		}
+		if err := s.restockRepo.UpdateStatus(ctx, record.ID, model.RestockStatusFailed, reason); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update restock status: "+err.Error())
+		}
+		record.Status = model.RestockStatusFailed
+		record.FailedReason = reason
+	}
+
+	if audit == nil {
+		audit = &model.SkuRestockAudit{RestockID: uint64(record.ID)}
+	}
+	audit.AuditUserID = uint(auditUserID)
+	audit.AuditStatus = auditStatus
+	audit.AuditFailedReason = reason
+	if audit.ID == 0 {
+		_, err = s.auditRepo.Create(ctx, audit)
+	} else {
+		err = s.auditRepo.Update(ctx, audit)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save restock audit: "+err.Error())
+	}
+	record.Audits = []model.SkuRestockAudit{*audit}
+	return record, nil
+}
+
+// newBusinessNo 生成业务单号：前缀 + 时间 + 12位随机串
+func newBusinessNo(prefix string) string {
+	random := strings.ReplaceAll(uuid.New().String(), "-", "")
+	return prefix + time.Now().Format("20060102150405") + strings.ToUpper(random[:12])
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReasonLength 调整原因的最大长度（字符）
+const maxReasonLength = 255
+
+type IStockAdjustmentService interface {
+	AdjustStock(ctx context.Context, req *dto.AdjustStockDto) (*dto.StockAdjustResultDto, error)
+	SubmitStocktake(ctx context.Context, req *dto.StocktakeDto) ([]*dto.StockAdjustResultDto, error)
+}
+
+// NewStockAdjustmentService 创建库存调整服务
+func NewStockAdjustmentService(skuRepo repository.ProductSkuRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository, warehouseRepo repository.WarehouseRepository) IStockAdjustmentService {
+	return &StockAdjustmentService{skuRepo: skuRepo, stockChangeRepo: stockChangeRepo, warehouseStock: &warehouseStockKeeper{warehouseRepo: warehouseRepo}}
+}
+
+// StockAdjustmentService 库存调整服务
+// 手动调整与盘点均须在事务内调用，按指定仓库同时调整分仓库存与可售总库存，SKU行锁下计算变更前后库存并写入库存变更记录
+type StockAdjustmentService struct {
+	skuRepo         repository.ProductSkuRepository
+	stockChangeRepo repository.InventoryStockChangeRecordRepository
+	warehouseStock  *warehouseStockKeeper
+}
+
+// AdjustStock 按差值手动调整单个SKU在指定仓库的库存，调整后分仓库存与总库存都不能为负数
+func (s *StockAdjustmentService) AdjustStock(ctx context.Context, req *dto.AdjustStockDto) (*dto.StockAdjustResultDto, error) {
+	if req.SkuID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	if req.Delta == 0 {
+		return nil, status.Error(codes.InvalidArgument, "delta cannot be zero")
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, true)
+	if err != nil {
+		return nil, err
+	}
+	warehouse, err := s.warehouseStock.findWarehouse(ctx, req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	skuList, err := s.lockSkus(ctx, []int64{req.SkuID})
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.adjust(ctx, skuList, warehouse, map[int64]int64{req.SkuID: req.Delta}, reason, req.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// SubmitStocktake 提交仓库的盘点结果，按实盘数量与当前分仓库存的差异调整库存，无差异的SKU不写变更记录
+func (s *StockAdjustmentService) SubmitStocktake(ctx context.Context, req *dto.StocktakeDto) ([]*dto.StockAdjustResultDto, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxPageSize {
+		return nil, status.Error(codes.InvalidArgument, "items must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, false)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		reason = "stocktake"
+	}
+	warehouse, err := s.warehouseStock.findWarehouse(ctx, req.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	skuIds := make([]int64, 0, len(req.Items))
+	counted := make(map[int64]uint32, len(req.Items))
+	for _, item := range req.Items {
+		if item == nil || item.SkuID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+		}
+		if _, ok := counted[item.SkuID]; ok {
+			return nil, status.Error(codes.InvalidArgument, "duplicate sku_id "+strconv.FormatInt(item.SkuID, 10))
+		}
+		counted[item.SkuID] = item.CountedQuantity
+		skuIds = append(skuIds, item.SkuID)
+	}
+
+	skuList, err := s.lockSkus(ctx, skuIds)
+	if err != nil {
+		return nil, err
+	}
+	deltas := make(map[int64]int64, len(skuList))
+	for _, sku := range skuList {
+		current, err := s.warehouseStock.current(ctx, sku.ID, warehouse.ID)
+		if err != nil {
+			return nil, err
+		}
+		deltas[sku.ID] = int64(counted[sku.ID]) - int64(current)
+	}
+	return s.adjust(ctx, skuList, warehouse, deltas, reason, req.OperatorID)
+}
+
+// lockSkus 按ID升序锁定SKU行，任一SKU不存在时返回NotFound
+func (s *StockAdjustmentService) lockSkus(ctx context.Context, skuIds []int64) ([]model.ProductSku, error) {
+	skuList, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, skuIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	if len(skuList) != len(skuIds) {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+	sort.Slice(skuList, func(i, j int) bool { return skuList[i].ID < skuList[j].ID })
+	return skuList, nil
+}
+
+// adjust 调整已锁定SKU在仓库中的库存与总库存并批量写入库存变更记录，返回结果包含差值为0的SKU
+func (s *StockAdjustmentService) adjust(ctx context.Context, skuList []model.ProductSku, warehouse *model.Warehouse, deltas map[int64]int64, reason string, operatorId int64) ([]*dto.StockAdjustResultDto, error) {
+	results := make([]*dto.StockAdjustResultDto, 0, len(skuList))
+	records := make([]*model.InventoryStockChangeRecord, 0, len(skuList))
+	for _, sku := range skuList {
+		delta := deltas[sku.ID]
+		afterStock := int64(sku.Stock) + delta
+		if afterStock < 0 {
+			return nil, status.Error(codes.FailedPrecondition, "stock of sku "+strconv.FormatInt(sku.ID, 10)+" cannot be negative")
+		}
+		if delta == 0 {
+			warehouseStock, err := s.warehouseStock.current(ctx, sku.ID, warehouse.ID)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, &dto.StockAdjustResultDto{
+				SkuID:          sku.ID,
+				BeforeStock:    sku.Stock,
+				AfterStock:     sku.Stock,
+				Threshold:      sku.StockWarn,
+				WarehouseID:    warehouse.ID,
+				WarehouseStock: warehouseStock,
+			})
+			continue
+		}
+		warehouseStock, err := s.warehouseStock.change(ctx, sku.ID, warehouse.ID, delta)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &dto.StockAdjustResultDto{
+			SkuID:          sku.ID,
+			Delta:          delta,
+			BeforeStock:    sku.Stock,
+			AfterStock:     uint32(afterStock),
+			Threshold:      sku.StockWarn,
+			WarehouseID:    warehouse.ID,
+			WarehouseStock: warehouseStock,
+		})
+		if err := s.skuRepo.AdjustInventoryById(ctx, sku.ID, delta); err != nil {
+			if errors.Is(err, repository.ErrInsufficientStock) {
+				return nil, status.Error(codes.FailedPrecondition, "stock of sku "+strconv.FormatInt(sku.ID, 10)+" cannot be negative")
+			}
+			return nil, status.Error(codes.Internal, "failed to adjust stock: "+err.Error())
+		}
+		records = append(records, &model.InventoryStockChangeRecord{
+			SkuID:       sku.ID,
+			SourceType:  model.SourceTypeManual,
+			Quantity:    delta,
+			BeforeStock: int64(sku.Stock),
+			AfterStock:  afterStock,
+			OperatorID:  operatorId,
+			Reason:      withWarehouseCode(reason, warehouse.Code),
+		})
+	}
+	if len(records) > 0 {
+		if err := s.stockChangeRepo.BatchCreate(ctx, records); err != nil {
+			return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+		}
+	}
+	return results, nil
+}
+
+// checkAdjustOperator 校验操作人与调整原因，返回去除首尾空白后的原因
+func checkAdjustOperator(reason string, operatorId int64, reasonRequired bool) (string, error) {
+	if operatorId <= 0 {
+		return "", status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+	reason = strings.TrimSpace(reason)
+	if reasonRequired && reason == "" {
+		return "", status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxReasonLength {
+		return "", status.Error(codes.InvalidArgument, "reason must not exceed "+strconv.Itoa(maxReasonLength)+" characters")
+	}
+	return reason, nil
+}
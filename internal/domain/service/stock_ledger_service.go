@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ledgerTimeLayout 库存流水查询的时间格式
+const ledgerTimeLayout = "2006-01-02 15:04:05"
+
+type IStockLedgerService interface {
+	ListStockChanges(ctx context.Context, req *dto.ListStockChangesDto) ([]model.InventoryStockChangeRecord, int64, error)
+	GetStockAtTime(ctx context.Context, skuId int64, at string) (*dto.StockAtTimeDto, error)
+}
+
+// NewStockLedgerService 创建库存流水服务
+func NewStockLedgerService(stockChangeRepo repository.InventoryStockChangeRecordRepository, skuRepo repository.ProductSkuRepository) IStockLedgerService {
+	return &StockLedgerService{stockChangeRepo: stockChangeRepo, skuRepo: skuRepo}
+}
+
+// StockLedgerService 库存流水服务
+// 库存变更记录即库存台账，每条记录保存变更前后库存，按时间回溯即可得到任意时刻的库存
+type StockLedgerService struct {
+	stockChangeRepo repository.InventoryStockChangeRecordRepository
+	skuRepo         repository.ProductSkuRepository
+}
+
+// ListStockChanges 按条件游标分页查询库存流水，返回下一页游标，没有更多记录时游标为0
+func (s *StockLedgerService) ListStockChanges(ctx context.Context, req *dto.ListStockChangesDto) ([]model.InventoryStockChangeRecord, int64, error) {
+	if req.Limit <= 0 || req.Limit > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "limit must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	if req.Cursor < 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	if req.SourceType < 0 || req.SourceType > model.SourceTypePurchase {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid source_type")
+	}
+	filter := &repository.StockChangeFilter{
+		SkuID:           req.SkuID,
+		OrderID:         req.OrderID,
+		PurchaseOrderID: req.PurchaseOrderID,
+		SourceType:      req.SourceType,
+	}
+	var err error
+	if filter.StartTime, err = parseLedgerTime(req.StartTime, "start_time"); err != nil {
+		return nil, 0, err
+	}
+	if filter.EndTime, err = parseLedgerTime(req.EndTime, "end_time"); err != nil {
+		return nil, 0, err
+	}
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && filter.EndTime.Before(filter.StartTime) {
+		return nil, 0, status.Error(codes.InvalidArgument, "end_time must not be earlier than start_time")
+	}
+
+	// 多查一条判断是否还有下一页
+	records, err := s.stockChangeRepo.ListByCursor(ctx, filter, req.Cursor, int(req.Limit)+1)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list stock changes: "+err.Error())
+	}
+	var nextCursor int64
+	if len(records) > int(req.Limit) {
+		records = records[:req.Limit]
+		nextCursor = records[len(records)-1].ID
+	}
+	return records, nextCursor, nil
+}
+
+// GetStockAtTime 根据库存流水推算SKU在指定时刻的库存
+// 取该时刻及之前最后一条记录的变更后库存；该时刻早于所有记录时取其后第一条记录的变更前库存；没有任何记录时库存从未变更，取当前库存
+func (s *StockLedgerService) GetStockAtTime(ctx context.Context, skuId int64, at string) (*dto.StockAtTimeDto, error) {
+	if skuId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	if at == "" {
+		return nil, status.Error(codes.InvalidArgument, "at cannot be empty")
+	}
+	atTime, err := parseLedgerTime(at, "at")
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.stockChangeRepo.FindLatestAtOrBefore(ctx, skuId, atTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query stock changes: "+err.Error())
+	}
+	if record != nil {
+		return &dto.StockAtTimeDto{SkuID: skuId, Stock: record.AfterStock, RecordID: record.ID}, nil
+	}
+	record, err = s.stockChangeRepo.FindEarliestAfter(ctx, skuId, atTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query stock changes: "+err.Error())
+	}
+	if record != nil {
+		return &dto.StockAtTimeDto{SkuID: skuId, Stock: record.BeforeStock, RecordID: record.ID}, nil
+	}
+
+	sku, err := s.skuRepo.GetSkuDetailByID(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get sku detail: "+err.Error())
+	}
+	if sku == nil {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+	return &dto.StockAtTimeDto{SkuID: skuId, Stock: int64(sku.Stock)}, nil
+}
+
+// parseLedgerTime 按本地时区解析查询时间，空字符串返回零值
+func parseLedgerTime(value string, field string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation(ledgerTimeLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, field+" must be in format "+ledgerTimeLayout)
+	}
+	return t, nil
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IStockReservationService interface {
+	ReserveStock(ctx context.Context, orderId int64, items []*dto.ReserveStockItemDto, expiresAt sql.NullTime) ([]model.SkuStockReservation, error)
+	ConfirmReservation(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error)
+	ReleaseReservation(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error)
+	ReleaseExpired(ctx context.Context, now time.Time, limit int) (int, error)
+	FindReservedSkuIds(ctx context.Context, orderId int64) ([]int64, error)
+}
+
+// NewStockReservationService 创建库存预占服务
+func NewStockReservationService(skuRepo repository.ProductSkuRepository, reservationRepo repository.SkuStockReservationRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository, warehouseRepo repository.WarehouseRepository, allocationStrategy string) IStockReservationService {
+	return &StockReservationService{skuRepo: skuRepo, reservationRepo: reservationRepo, stockChangeRepo: stockChangeRepo,
+		warehouseStock: &warehouseStockKeeper{warehouseRepo: warehouseRepo, strategy: allocationStrategy}}
+}
+
+// StockReservationService 库存预占服务
+// 预占即扣减可售库存但不计销量，同时按分仓策略分配到仓库，确认时计入销量，释放或过期时回补库存和分仓库存，每一步都写入库存变更记录
+type StockReservationService struct {
+	skuRepo         repository.ProductSkuRepository
+	reservationRepo repository.SkuStockReservationRepository
+	stockChangeRepo repository.InventoryStockChangeRecordRepository
+	warehouseStock  *warehouseStockKeeper
+}
+
+// ReserveStock 预占库存，同一订单重复预占时返回已有的预占记录
+func (s *StockReservationService) ReserveStock(ctx context.Context, orderId int64, items []*dto.ReserveStockItemDto, expiresAt sql.NullTime) ([]model.SkuStockReservation, error) {
+	if orderId == 0 || len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id or items cannot be empty")
+	}
+	skuIds := make([]int64, 0, len(items))
+	skuQuantity := make(map[int64]uint32, len(items))
+	for _, item := range items {
+		if item == nil || item.SkuID == 0 || item.Quantity == 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid sku_id or quantity")
+		}
+		if _, ok := skuQuantity[item.SkuID]; !ok {
+			skuIds = append(skuIds, item.SkuID)
+		}
+		skuQuantity[item.SkuID] += item.Quantity
+	}
+
+	existing, err := s.reservationRepo.FindByOrderIdForUpdate(ctx, orderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query reservations: "+err.Error())
+	}
+	if len(existing) > 0 {
+		for _, item := range existing {
+			if item.Status == model.ReservationStatusHeld || item.Status == model.ReservationStatusConfirmed {
+				return existing, nil
+			}
+		}
+		return nil, status.Error(codes.FailedPrecondition, "reservation of order has been released")
+	}
+
+	skuList, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, skuIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	if len(skuList) != len(skuIds) {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+
+	reservations := make([]*model.SkuStockReservation, 0, len(skuList))
+	records := make([]*model.InventoryStockChangeRecord, 0, len(skuList))
+	for _, sku := range skuList {
+		quantity := skuQuantity[sku.ID]
+		if sku.Status != model.ProductStatusOnSale {
+			return nil, status.Error(codes.FailedPrecondition, "sku "+strconv.FormatInt(sku.ID, 10)+" is not on sale")
+		}
+		if sku.Stock < quantity {
+			return nil, status.Error(codes.FailedPrecondition, "sku "+strconv.FormatInt(sku.ID, 10)+" stock insufficient")
+		}
+		if err := s.skuRepo.ReserveInventoryById(ctx, sku.ID, quantity); err != nil {
+			if errors.Is(err, repository.ErrInsufficientStock) {
+				return nil, status.Error(codes.FailedPrecondition, "sku "+strconv.FormatInt(sku.ID, 10)+" stock insufficient")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		reservations = append(reservations, &model.SkuStockReservation{
+			OrderID:   orderId,
+			SkuID:     sku.ID,
+			Quantity:  quantity,
+			Status:    model.ReservationStatusHeld,
+			ExpiresAt: expiresAt,
+		})
+		records = append(records, &model.InventoryStockChangeRecord{
+			OrderID:     orderId,
+			SkuID:       sku.ID,
+			SourceType:  model.SourceTypeReserve,
+			Quantity:    int64(quantity),
+			BeforeStock: int64(sku.Stock),
+			AfterStock:  int64(sku.Stock - quantity),
+		})
+	}
+	// 预占时没有收货地址，就近策略按仓库优先级分配
+	if _, err := s.warehouseStock.allocate(ctx, orderId, skuIds, skuQuantity, nil); err != nil {
+		return nil, err
+	}
+	if err := s.reservationRepo.BatchCreate(ctx, reservations); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create reservations: "+err.Error())
+	}
+	if err := s.stockChangeRepo.BatchCreate(ctx, records); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+	}
+
+	result := make([]model.SkuStockReservation, 0, len(reservations))
+	for _, item := range reservations {
+		result = append(result, *item)
+	}
+	return result, nil
+}
+
+// ConfirmReservation 确认预占，库存与分仓库存在预占时已扣减，此处只计入销量
+func (s *StockReservationService) ConfirmReservation(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error) {
+	reservations, held, err := s.findHeld(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if len(held) == 0 {
+		for _, item := range reservations {
+			if item.Status != model.ReservationStatusConfirmed {
+				return nil, status.Error(codes.FailedPrecondition, "reservation of order has been released")
+			}
+		}
+		return reservations, nil
+	}
+
+	skuStock, err := s.lockSkuStock(ctx, held)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(held))
+	records := make([]*model.InventoryStockChangeRecord, 0, len(held))
+	for _, item := range held {
+		if err := s.skuRepo.IncreaseSalesById(ctx, item.SkuID, item.Quantity); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		ids = append(ids, item.ID)
+		// 库存已在预占时扣减，确认记录的前后库存相同，仅用于销量统计
+		records = append(records, &model.InventoryStockChangeRecord{
+			OrderID:     orderId,
+			SkuID:       item.SkuID,
+			SourceType:  model.SourceTypeOrderPayment,
+			Quantity:    int64(item.Quantity),
+			BeforeStock: int64(skuStock[item.SkuID]),
+			AfterStock:  int64(skuStock[item.SkuID]),
+		})
+	}
+	if _, err := s.reservationRepo.UpdateStatusByIds(ctx, ids, model.ReservationStatusHeld, model.ReservationStatusConfirmed); err != nil {
+		return nil, status.Error(codes.Internal, "failed to confirm reservations: "+err.Error())
+	}
+	if err := s.stockChangeRepo.BatchCreate(ctx, records); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+	}
+	return setReservationStatus(reservations, ids, model.ReservationStatusConfirmed), nil
+}
+
+// ReleaseReservation 释放预占并回补库存
+func (s *StockReservationService) ReleaseReservation(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error) {
+	reservations, held, err := s.findHeld(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if len(held) == 0 {
+		for _, item := range reservations {
+			if item.Status == model.ReservationStatusConfirmed {
+				return nil, status.Error(codes.FailedPrecondition, "reservation of order has been confirmed")
+			}
+		}
+		return reservations, nil
+	}
+	ids, err := s.restore(ctx, held, model.SourceTypeRelease, model.ReservationStatusReleased)
+	if err != nil {
+		return nil, err
+	}
+	return setReservationStatus(reservations, ids, model.ReservationStatusReleased), nil
+}
+
+// ReleaseExpired 释放已过期的预占，返回释放的记录数
+func (s *StockReservationService) ReleaseExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	expired, err := s.reservationRepo.FindExpiredForUpdate(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+	ids, err := s.restore(ctx, expired, model.SourceTypeExpire, model.ReservationStatusExpired)
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// FindReservedSkuIds 查询订单预占过的SKU ID，不加锁，用于在事务外按SKU加库存锁
+func (s *StockReservationService) FindReservedSkuIds(ctx context.Context, orderId int64) ([]int64, error) {
+	skuIds, err := s.reservationRepo.FindSkuIdsByOrderId(ctx, orderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query reserved skus: "+err.Error())
+	}
+	return skuIds, nil
+}
+
+// findHeld 锁定订单的预占记录并筛选出预占中的记录
+func (s *StockReservationService) findHeld(ctx context.Context, orderId int64) ([]model.SkuStockReservation, []model.SkuStockReservation, error) {
+	if orderId == 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "order_id cannot be empty")
+	}
+	reservations, err := s.reservationRepo.FindByOrderIdForUpdate(ctx, orderId)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to query reservations: "+err.Error())
+	}
+	if len(reservations) == 0 {
+		return nil, nil, status.Error(codes.NotFound, "reservation not found")
+	}
+	held := make([]model.SkuStockReservation, 0, len(reservations))
+	for _, item := range reservations {
+		if item.Status == model.ReservationStatusHeld {
+			held = append(held, item)
+		}
+	}
+	return reservations, held, nil
+}
+
+// restore 回补预占的库存，按各订单的分仓记录回补分仓库存，并更新预占状态
+func (s *StockReservationService) restore(ctx context.Context, reservations []model.SkuStockReservation, sourceType int32, toStatus uint8) ([]int64, error) {
+	skuStock, err := s.lockSkuStock(ctx, reservations)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(reservations))
+	records := make([]*model.InventoryStockChangeRecord, 0, len(reservations))
+	orderIds := make([]int64, 0, 1)
+	released := make(map[int64]map[int64]uint32)
+	for _, item := range reservations {
+		if released[item.OrderID] == nil {
+			orderIds = append(orderIds, item.OrderID)
+			released[item.OrderID] = make(map[int64]uint32)
+		}
+		released[item.OrderID][item.SkuID] += item.Quantity
+		if err := s.skuRepo.RestoreInventoryById(ctx, item.SkuID, item.Quantity); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		before := skuStock[item.SkuID]
+		skuStock[item.SkuID] = before + item.Quantity
+		ids = append(ids, item.ID)
+		records = append(records, &model.InventoryStockChangeRecord{
+			OrderID:     item.OrderID,
+			SkuID:       item.SkuID,
+			SourceType:  sourceType,
+			Quantity:    int64(item.Quantity),
+			BeforeStock: int64(before),
+			AfterStock:  int64(skuStock[item.SkuID]),
+		})
+	}
+	for _, orderId := range orderIds {
+		if _, err := s.warehouseStock.restore(ctx, orderId, released[orderId]); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.reservationRepo.UpdateStatusByIds(ctx, ids, model.ReservationStatusHeld, toStatus); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update reservations: "+err.Error())
+	}
+	if err := s.stockChangeRepo.BatchCreate(ctx, records); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+	}
+	return ids, nil
+}
+
+// lockSkuStock 锁定预占涉及的SKU，返回当前库存
+func (s *StockReservationService) lockSkuStock(ctx context.Context, reservations []model.SkuStockReservation) (map[int64]uint32, error) {
+	skuIds := make([]int64, 0, len(reservations))
+	seen := make(map[int64]struct{}, len(reservations))
+	for _, item := range reservations {
+		if _, ok := seen[item.SkuID]; ok {
+			continue
+		}
+		seen[item.SkuID] = struct{}{}
+		skuIds = append(skuIds, item.SkuID)
+	}
+	skuList, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, skuIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	skuStock := make(map[int64]uint32, len(skuList))
+	for _, sku := range skuList {
+		skuStock[sku.ID] = sku.Stock
+	}
+	return skuStock, nil
+}
+
+// setReservationStatus 同步内存中预占记录的状态
+func setReservationStatus(reservations []model.SkuStockReservation, ids []int64, toStatus uint8) []model.SkuStockReservation {
+	updated := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		updated[id] = struct{}{}
+	}
+	for i := range reservations {
+		if _, ok := updated[reservations[i].ID]; ok {
+			reservations[i].Status = toStatus
+		}
+	}
+	return reservations
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ISupplierService interface {
+	CreateSupplier(ctx context.Context, req *dto.SupplierInputDto) (*model.Supplier, error)
+	UpdateSupplier(ctx context.Context, id int64, req *dto.SupplierInputDto) (*model.Supplier, error)
+	GetSupplier(ctx context.Context, id int64) (*model.Supplier, error)
+	ListSuppliers(ctx context.Context, keyword string, page, pageSize int32) ([]model.Supplier, int64, error)
+	DeleteSupplier(ctx context.Context, id int64) error
+	LinkSku(ctx context.Context, req *dto.LinkSupplierSkuDto) (*repository.SupplierSkuInfo, error)
+	UnlinkSku(ctx context.Context, supplierId int64, skuId int64) error
+	ListSkusBySupplier(ctx context.Context, supplierId int64, page, pageSize int32) ([]*repository.SupplierSkuInfo, int64, error)
+}
+
+// NewSupplierService 创建供应商服务
+func NewSupplierService(supplierRepo repository.SupplierRepository, skuRepo repository.ProductSkuRepository) ISupplierService {
+	return &SupplierService{supplierRepo: supplierRepo, skuRepo: skuRepo}
+}
+
+// SupplierService 供应商服务，维护供应商及其与SKU的供货关系
+type SupplierService struct {
+	supplierRepo repository.SupplierRepository
+	skuRepo      repository.ProductSkuRepository
+}
+
+// CreateSupplier 创建供应商，名称不可重复
+func (s *SupplierService) CreateSupplier(ctx context.Context, req *dto.SupplierInputDto) (*model.Supplier, error) {
+	if err := validateSupplierInput(req); err != nil {
+		return nil, err
+	}
+	supplier := &model.Supplier{}
+	fillSupplier(supplier, req)
+	if err := s.checkSupplierName(ctx, supplier.Name, 0); err != nil {
+		return nil, err
+	}
+	if err := s.supplierRepo.Create(ctx, supplier); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create supplier: "+err.Error())
+	}
+	return supplier, nil
+}
+
+// UpdateSupplier 更新供应商信息
+func (s *SupplierService) UpdateSupplier(ctx context.Context, id int64, req *dto.SupplierInputDto) (*model.Supplier, error) {
+	if err := validateSupplierInput(req); err != nil {
+		return nil, err
+	}
+	supplier, err := s.GetSupplier(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	fillSupplier(supplier, req)
+	if err := s.checkSupplierName(ctx, supplier.Name, id); err != nil {
+		return nil, err
+	}
+	if err := s.supplierRepo.Update(ctx, supplier); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update supplier: "+err.Error())
+	}
+	return supplier, nil
+}
+
+// GetSupplier 获取供应商
+func (s *SupplierService) GetSupplier(ctx context.Context, id int64) (*model.Supplier, error) {
+	if id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid supplier id")
+	}
+	supplier, err := s.supplierRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query supplier: "+err.Error())
+	}
+	if supplier == nil {
+		return nil, status.Error(codes.NotFound, "supplier "+strconv.FormatInt(id, 10)+" not found")
+	}
+	return supplier, nil
+}
+
+// ListSuppliers 分页查询供应商
+func (s *SupplierService) ListSuppliers(ctx context.Context, keyword string, page, pageSize int32) ([]model.Supplier, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	suppliers, total, err := s.supplierRepo.List(ctx, strings.TrimSpace(keyword), int(page-1)*int(pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list suppliers: "+err.Error())
+	}
+	return suppliers, total, nil
+}
+
+// DeleteSupplier 删除供应商，仍关联SKU时拒绝删除
+func (s *SupplierService) DeleteSupplier(ctx context.Context, id int64) error {
+	if _, err := s.GetSupplier(ctx, id); err != nil {
+		return err
+	}
+	count, err := s.supplierRepo.CountSupplierProducts(ctx, id)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to count skus of supplier: "+err.Error())
+	}
+	if count > 0 {
+		return status.Error(codes.FailedPrecondition, "supplier is linked to "+strconv.FormatInt(count, 10)+" skus")
+	}
+	if err := s.supplierRepo.Delete(ctx, id); err != nil {
+		return status.Error(codes.Internal, "failed to delete supplier: "+err.Error())
+	}
+	return nil
+}
+
+// LinkSku 关联供应商与SKU，已关联时更新供货信息，须在事务内调用
+// 先锁定SKU行使同一SKU的关联操作串行执行，设为首选时取消该SKU其他供应商的首选，保证每个SKU至多一个首选供应商
+func (s *SupplierService) LinkSku(ctx context.Context, req *dto.LinkSupplierSkuDto) (*repository.SupplierSkuInfo, error) {
+	if req.SkuID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	if req.SupplyPrice < 0 || req.MinOrderQuantity < 0 {
+		return nil, status.Error(codes.InvalidArgument, "supply_price and min_order_quantity cannot be negative")
+	}
+	if _, err := s.GetSupplier(ctx, req.SupplierID); err != nil {
+		return nil, err
+	}
+	skus, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, []int64{req.SkuID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to lock sku: "+err.Error())
+	}
+	if len(skus) == 0 {
+		return nil, status.Error(codes.NotFound, "sku "+strconv.FormatInt(req.SkuID, 10)+" not found")
+	}
+	supplierProduct, err := s.supplierRepo.FindSupplierProduct(ctx, req.SupplierID, req.SkuID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query supplier product: "+err.Error())
+	}
+	if supplierProduct == nil {
+		supplierProduct = &model.SupplierProduct{
+			SupplierID: uint(req.SupplierID),
+			SkuID:      uint(req.SkuID),
+		}
+	}
+	supplierProduct.SupplyPrice = req.SupplyPrice
+	supplierProduct.MinOrderQuantity = int(req.MinOrderQuantity)
+	supplierProduct.IsPreferred = req.IsPreferred
+	if err := s.supplierRepo.SaveSupplierProduct(ctx, supplierProduct); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save supplier product: "+err.Error())
+	}
+	if req.IsPreferred {
+		if err := s.supplierRepo.ClearPreferred(ctx, req.SkuID, supplierProduct.ID); err != nil {
+			return nil, status.Error(codes.Internal, "failed to clear preferred supplier: "+err.Error())
+		}
+	}
+
+	sku, err := s.skuRepo.GetSkuDetailByID(ctx, req.SkuID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query sku: "+err.Error())
+	}
+	return &repository.SupplierSkuInfo{
+		SkuID:            sku.ID,
+		SkuNo:            sku.SkuNo,
+		SkuName:          sku.SkuName,
+		SpecValueText:    sku.SpecValueText,
+		Price:            sku.Price,
+		Stock:            sku.Stock,
+		Status:           sku.Status,
+		SupplyPrice:      supplierProduct.SupplyPrice,
+		MinOrderQuantity: supplierProduct.MinOrderQuantity,
+		IsPreferred:      supplierProduct.IsPreferred,
+	}, nil
+}
+
+// UnlinkSku 解除供应商与SKU的关联
+func (s *SupplierService) UnlinkSku(ctx context.Context, supplierId int64, skuId int64) error {
+	if supplierId <= 0 || skuId <= 0 {
+		return status.Error(codes.InvalidArgument, "invalid supplier_id or sku_id")
+	}
+	supplierProduct, err := s.supplierRepo.FindSupplierProduct(ctx, supplierId, skuId)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to query supplier product: "+err.Error())
+	}
+	if supplierProduct == nil {
+		return status.Error(codes.NotFound, "supplier is not linked to the sku")
+	}
+	if err := s.supplierRepo.DeleteSupplierProduct(ctx, supplierProduct.ID); err != nil {
+		return status.Error(codes.Internal, "failed to delete supplier product: "+err.Error())
+	}
+	return nil
+}
+
+// ListSkusBySupplier 分页查询供应商供应的SKU
+func (s *SupplierService) ListSkusBySupplier(ctx context.Context, supplierId int64, page, pageSize int32) ([]*repository.SupplierSkuInfo, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		return nil, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	if _, err := s.GetSupplier(ctx, supplierId); err != nil {
+		return nil, 0, err
+	}
+	skus, total, err := s.supplierRepo.ListSkusBySupplier(ctx, supplierId, int(page-1)*int(pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, "failed to list skus of supplier: "+err.Error())
+	}
+	return skus, total, nil
+}
+
+// checkSupplierName 供应商名称不可重复
+func (s *SupplierService) checkSupplierName(ctx context.Context, name string, excludeId int64) error {
+	exists, err := s.supplierRepo.ExistsName(ctx, name, excludeId)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to check supplier name: "+err.Error())
+	}
+	if exists {
+		return status.Error(codes.AlreadyExists, "supplier "+name+" already exists")
+	}
+	return nil
+}
+
+// fillSupplier 填充供应商的可修改字段
+func fillSupplier(supplier *model.Supplier, req *dto.SupplierInputDto) {
+	supplier.Name = strings.TrimSpace(req.Name)
+	supplier.ContactPerson = strings.TrimSpace(req.ContactPerson)
+	supplier.Phone = strings.TrimSpace(req.Phone)
+	supplier.Email = strings.TrimSpace(req.Email)
+	supplier.Address = strings.TrimSpace(req.Address)
+	supplier.Rating = req.Rating
+	supplier.LeadTimeDays = int(req.LeadTimeDays)
+	supplier.PaymentTerms = strings.TrimSpace(req.PaymentTerms)
+}
+
+// validateSupplierInput 校验供应商基本信息，长度与表字段一致
+func validateSupplierInput(req *dto.SupplierInputDto) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "supplier cannot be empty")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return status.Error(codes.InvalidArgument, "name cannot be empty or longer than 100")
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(req.ContactPerson)) > 50 {
+		return status.Error(codes.InvalidArgument, "contact_person cannot be longer than 50")
+	}
+	if len(strings.TrimSpace(req.Phone)) > 20 {
+		return status.Error(codes.InvalidArgument, "phone cannot be longer than 20")
+	}
+	email := strings.TrimSpace(req.Email)
+	if len(email) > 100 {
+		return status.Error(codes.InvalidArgument, "email cannot be longer than 100")
+	}
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid email")
+		}
+	}
+	if req.Rating < 0 || req.Rating > 5 {
+		return status.Error(codes.InvalidArgument, "rating must be between 0 and 5")
+	}
+	if req.LeadTimeDays < 0 {
+		return status.Error(codes.InvalidArgument, "lead_time_days cannot be negative")
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(req.PaymentTerms)) > 50 {
+		return status.Error(codes.InvalidArgument, "payment_terms cannot be longer than 50")
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"math"
+	"sort"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/allocation"
+	"github.com/zhanshen02154/product/internal/domain/model"
+)
+
+// 订单分仓策略，取值定义在allocation包
+const (
+	AllocationStrategyPriority        = allocation.StrategyPriority
+	AllocationStrategyNearest         = allocation.StrategyNearest
+	AllocationStrategySplitMinimizing = allocation.StrategySplitMinimizing
+)
+
+// earthRadiusKm 地球平均半径（千米）
+const earthRadiusKm = 6371.0
+
+// GeoPoint 经纬度坐标
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// allocateWarehouses 按策略将订单各SKU的数量分配到仓库，仓库库存不足的部分由未分仓库存满足（WarehouseID为0）
+// stocks 为启用仓库中的分仓库存，需包含仓库信息；结果中各SKU的分配按仓库选择顺序排列
+func allocateWarehouses(strategy string, stocks []model.SkuWarehouseStock, demand map[int64]uint32, destination *GeoPoint) map[int64][]dto.WarehouseAllocationDto {
+	warehouses := make(map[int64]*model.Warehouse)
+	available := make(map[int64]map[int64]uint32, len(demand))
+	for i := range stocks {
+		stock := &stocks[i]
+		if stock.Warehouse == nil || stock.Stock == 0 {
+			continue
+		}
+		warehouses[stock.WarehouseID] = stock.Warehouse
+		if available[stock.SkuID] == nil {
+			available[stock.SkuID] = make(map[int64]uint32)
+		}
+		available[stock.SkuID][stock.WarehouseID] += stock.Stock
+	}
+	skuIds := make([]int64, 0, len(demand))
+	remaining := make(map[int64]uint32, len(demand))
+	for skuId, quantity := range demand {
+		if quantity > 0 {
+			skuIds = append(skuIds, skuId)
+			remaining[skuId] = quantity
+		}
+	}
+	sort.Slice(skuIds, func(i, j int) bool { return skuIds[i] < skuIds[j] })
+
+	ranked := rankWarehouses(strategy, warehouses, destination)
+	result := make(map[int64][]dto.WarehouseAllocationDto, len(skuIds))
+	take := func(warehouseId int64, skuId int64) {
+		quantity := remaining[skuId]
+		if stock := available[skuId][warehouseId]; stock < quantity {
+			quantity = stock
+		}
+		if quantity == 0 {
+			return
+		}
+		available[skuId][warehouseId] -= quantity
+		remaining[skuId] -= quantity
+		result[skuId] = append(result[skuId], dto.WarehouseAllocationDto{
+			WarehouseID: warehouseId,
+			Quantity:    quantity,
+			Stock:       available[skuId][warehouseId],
+		})
+	}
+
+	if strategy == AllocationStrategySplitMinimizing {
+		// 贪心：每轮选择能满足剩余数量最多的仓库，能由单个仓库满足整单时只选该仓库
+		used := make(map[int64]bool, len(ranked))
+		for {
+			var best int64
+			var bestCovered uint64
+			for _, warehouseId := range ranked {
+				if used[warehouseId] {
+					continue
+				}
+				var covered uint64
+				for _, skuId := range skuIds {
+					quantity := remaining[skuId]
+					if stock := available[skuId][warehouseId]; stock < quantity {
+						quantity = stock
+					}
+					covered += uint64(quantity)
+				}
+				if covered > bestCovered {
+					best, bestCovered = warehouseId, covered
+				}
+			}
+			if bestCovered == 0 {
+				break
+			}
+			used[best] = true
+			for _, skuId := range skuIds {
+				take(best, skuId)
+			}
+		}
+	} else {
+		for _, skuId := range skuIds {
+			for _, warehouseId := range ranked {
+				if remaining[skuId] == 0 {
+					break
+				}
+				take(warehouseId, skuId)
+			}
+		}
+	}
+
+	for _, skuId := range skuIds {
+		if remaining[skuId] > 0 {
+			result[skuId] = append(result[skuId], dto.WarehouseAllocationDto{Quantity: remaining[skuId]})
+		}
+	}
+	return result
+}
+
+// rankWarehouses 按策略对仓库排序，优先级相同按ID升序
+func rankWarehouses(strategy string, warehouses map[int64]*model.Warehouse, destination *GeoPoint) []int64 {
+	ranked := make([]int64, 0, len(warehouses))
+	for id := range warehouses {
+		ranked = append(ranked, id)
+	}
+	byPriority := func(a, b *model.Warehouse) bool {
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.ID < b.ID
+	}
+	if strategy == AllocationStrategyNearest && destination != nil {
+		distances := make(map[int64]float64, len(warehouses))
+		for id, warehouse := range warehouses {
+			distances[id] = distanceKm(destination, &GeoPoint{Latitude: warehouse.Latitude, Longitude: warehouse.Longitude})
+		}
+		sort.Slice(ranked, func(i, j int) bool {
+			if distances[ranked[i]] != distances[ranked[j]] {
+				return distances[ranked[i]] < distances[ranked[j]]
+			}
+			return byPriority(warehouses[ranked[i]], warehouses[ranked[j]])
+		})
+		return ranked
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return byPriority(warehouses[ranked[i]], warehouses[ranked[j]])
+	})
+	return ranked
+}
+
+// distanceKm 两点间的球面距离（千米）
+func distanceKm(a, b *GeoPoint) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// warehouseStockReason 设置分仓库存时默认的变更原因
+const warehouseStockReason = "set warehouse stock"
+
+type IWarehouseService interface {
+	CreateWarehouse(ctx context.Context, req *dto.WarehouseInputDto) (*model.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+	SetSkuWarehouseStock(ctx context.Context, req *dto.SetSkuWarehouseStockDto) (*dto.SkuWarehouseStockDto, error)
+	GetSkuWarehouseStock(ctx context.Context, skuId int64) (*dto.SkuWarehouseStockDto, error)
+}
+
+// NewWarehouseService 创建仓库服务
+func NewWarehouseService(warehouseRepo repository.WarehouseRepository, skuRepo repository.ProductSkuRepository, stockChangeRepo repository.InventoryStockChangeRecordRepository) IWarehouseService {
+	return &WarehouseService{warehouseRepo: warehouseRepo, skuRepo: skuRepo, stockChangeRepo: stockChangeRepo}
+}
+
+// WarehouseService 仓库服务，维护仓库及SKU分仓库存
+// SKU的可售总库存仍为product_skus.stock，其中未登记到任何仓库的部分为未分仓库存
+type WarehouseService struct {
+	warehouseRepo   repository.WarehouseRepository
+	skuRepo         repository.ProductSkuRepository
+	stockChangeRepo repository.InventoryStockChangeRecordRepository
+}
+
+// CreateWarehouse 创建仓库，编码不可重复
+func (s *WarehouseService) CreateWarehouse(ctx context.Context, req *dto.WarehouseInputDto) (*model.Warehouse, error) {
+	code := strings.TrimSpace(req.Code)
+	name := strings.TrimSpace(req.Name)
+	if code == "" || utf8.RuneCountInString(code) > 32 {
+		return nil, status.Error(codes.InvalidArgument, "code must be between 1 and 32 characters")
+	}
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "name must be between 1 and 100 characters")
+	}
+	if req.Latitude < -90 || req.Latitude > 90 || req.Longitude < -180 || req.Longitude > 180 {
+		return nil, status.Error(codes.InvalidArgument, "invalid latitude or longitude")
+	}
+	exists, err := s.warehouseRepo.ExistsCode(ctx, code)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check warehouse code: "+err.Error())
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "warehouse code already exists")
+	}
+	warehouse := &model.Warehouse{
+		Code:      code,
+		Name:      name,
+		Priority:  req.Priority,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Status:    model.WarehouseStatusEnabled,
+	}
+	if err := s.warehouseRepo.Create(ctx, warehouse); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create warehouse: "+err.Error())
+	}
+	return warehouse, nil
+}
+
+// ListWarehouses 查询全部仓库
+func (s *WarehouseService) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	warehouses, err := s.warehouseRepo.List(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list warehouses: "+err.Error())
+	}
+	return warehouses, nil
+}
+
+// SetSkuWarehouseStock 设置SKU在指定仓库的库存，须在事务内调用
+// 增加的数量先从未分仓库存转入，不足的部分视为入库，总库存按入库数量增加并写入库存变更记录；
+// 减少的数量转回未分仓库存，总库存不变，实物减少应通过库存调整或盘点处理
+func (s *WarehouseService) SetSkuWarehouseStock(ctx context.Context, req *dto.SetSkuWarehouseStockDto) (*dto.SkuWarehouseStockDto, error) {
+	if req.SkuID <= 0 || req.WarehouseID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id or warehouse_id")
+	}
+	reason, err := checkAdjustOperator(req.Reason, req.OperatorID, false)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		reason = warehouseStockReason
+	}
+	warehouse, err := s.warehouseRepo.FindByID(ctx, req.WarehouseID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query warehouse: "+err.Error())
+	}
+	if warehouse == nil {
+		return nil, status.Error(codes.NotFound, "warehouse not found")
+	}
+	skuList, err := s.skuRepo.BatchGetSkuByIDsForUpdate(ctx, []int64{req.SkuID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "sku query error: "+err.Error())
+	}
+	if len(skuList) == 0 {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+	sku := skuList[0]
+
+	stock, err := s.warehouseRepo.FindSkuStockForUpdate(ctx, req.SkuID, req.WarehouseID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query warehouse stock: "+err.Error())
+	}
+	if stock == nil {
+		stock = &model.SkuWarehouseStock{SkuID: req.SkuID, WarehouseID: req.WarehouseID}
+	}
+	// received 增加的数量中未分仓库存不足、需计入总库存的部分
+	var received int64
+	if req.Stock > stock.Stock {
+		unallocated, err := s.unallocated(ctx, sku)
+		if err != nil {
+			return nil, err
+		}
+		received = int64(req.Stock-stock.Stock) - unallocated
+	}
+	if received > 0 {
+		if err := s.skuRepo.AdjustInventoryById(ctx, sku.ID, received); err != nil {
+			return nil, status.Error(codes.Internal, "failed to adjust stock: "+err.Error())
+		}
+		err = s.stockChangeRepo.BatchCreate(ctx, []*model.InventoryStockChangeRecord{{
+			SkuID:       sku.ID,
+			SourceType:  model.SourceTypeManual,
+			Quantity:    received,
+			BeforeStock: int64(sku.Stock),
+			AfterStock:  int64(sku.Stock) + received,
+			OperatorID:  req.OperatorID,
+			Reason:      withWarehouseCode(reason, warehouse.Code),
+		}})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to create stock change records: "+err.Error())
+		}
+	}
+	stock.Stock = req.Stock
+	if err := s.warehouseRepo.SaveSkuStock(ctx, stock); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save warehouse stock: "+err.Error())
+	}
+	return s.GetSkuWarehouseStock(ctx, req.SkuID)
+}
+
+// unallocated 未分仓库存，即总库存减去各仓库库存之和，分仓库存多于总库存时为0
+func (s *WarehouseService) unallocated(ctx context.Context, sku model.ProductSku) (int64, error) {
+	stocks, err := s.warehouseRepo.ListSkuStocks(ctx, sku.ID)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "failed to query warehouse stock: "+err.Error())
+	}
+	unallocated := int64(sku.Stock)
+	for _, stock := range stocks {
+		unallocated -= int64(stock.Stock)
+	}
+	if unallocated < 0 {
+		return 0, nil
+	}
+	return unallocated, nil
+}
+
+// GetSkuWarehouseStock 查询SKU的可售总库存及分仓库存
+func (s *WarehouseService) GetSkuWarehouseStock(ctx context.Context, skuId int64) (*dto.SkuWarehouseStockDto, error) {
+	if skuId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku_id")
+	}
+	sku, err := s.skuRepo.GetSkuDetailByID(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get sku detail: "+err.Error())
+	}
+	if sku == nil {
+		return nil, status.Error(codes.NotFound, "sku not found")
+	}
+	stocks, err := s.warehouseRepo.ListSkuStocks(ctx, skuId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query warehouse stock: "+err.Error())
+	}
+	result := &dto.SkuWarehouseStockDto{
+		SkuID:          skuId,
+		TotalAvailable: sku.Stock,
+		Warehouses:     make([]*dto.WarehouseStockItemDto, 0, len(stocks)),
+	}
+	for _, stock := range stocks {
+		if stock.Warehouse == nil {
+			continue
+		}
+		result.WarehouseTotal += stock.Stock
+		result.Warehouses = append(result.Warehouses, &dto.WarehouseStockItemDto{
+			WarehouseID: stock.WarehouseID,
+			Code:        stock.Warehouse.Code,
+			Name:        stock.Warehouse.Name,
+			Status:      stock.Warehouse.Status,
+			Stock:       stock.Stock,
+		})
+	}
+	return result, nil
+}
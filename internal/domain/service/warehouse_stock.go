@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// warehouseStockKeeper 分仓库存的变更，须与SKU总库存的变更在同一事务内执行
+// 预占和订单支付扣减时按策略分配并写入订单分仓记录，释放、过期和退款时按分仓记录回补，手动调整、盘点和采购收货按指定仓库变更
+type warehouseStockKeeper struct {
+	warehouseRepo repository.WarehouseRepository
+	strategy      string
+}
+
+// findWarehouse 查询变更库存的仓库，仓库ID必填
+func (k *warehouseStockKeeper) findWarehouse(ctx context.Context, warehouseId int64) (*model.Warehouse, error) {
+	if warehouseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "warehouse_id is required")
+	}
+	warehouse, err := k.warehouseRepo.FindByID(ctx, warehouseId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query warehouse: "+err.Error())
+	}
+	if warehouse == nil {
+		return nil, status.Error(codes.NotFound, "warehouse not found")
+	}
+	return warehouse, nil
+}
+
+// allocate 锁定分仓库存，按策略分配并扣减，写入订单分仓记录，返回各SKU的分配结果
+func (k *warehouseStockKeeper) allocate(ctx context.Context, orderId int64, skuIds []int64, demand map[int64]uint32, destination *GeoPoint) (map[int64][]dto.WarehouseAllocationDto, error) {
+	stocks, err := k.warehouseRepo.ListEnabledSkuStocksForUpdate(ctx, skuIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "warehouse stock query error: "+err.Error())
+	}
+	allocations := allocateWarehouses(k.strategy, stocks, demand, destination)
+
+	stockIds := make(map[[2]int64]int64, len(stocks))
+	for _, stock := range stocks {
+		stockIds[[2]int64{stock.SkuID, stock.WarehouseID}] = stock.ID
+	}
+	allocatedSkuIds := make([]int64, 0, len(allocations))
+	for skuId := range allocations {
+		allocatedSkuIds = append(allocatedSkuIds, skuId)
+	}
+	sort.Slice(allocatedSkuIds, func(i, j int) bool { return allocatedSkuIds[i] < allocatedSkuIds[j] })
+	records := make([]*model.OrderWarehouseAllocation, 0, len(allocations))
+	for _, skuId := range allocatedSkuIds {
+		for _, item := range allocations[skuId] {
+			records = append(records, &model.OrderWarehouseAllocation{
+				OrderID:     orderId,
+				SkuID:       skuId,
+				WarehouseID: item.WarehouseID,
+				Quantity:    item.Quantity,
+			})
+			if item.WarehouseID == 0 {
+				continue
+			}
+			if err := k.warehouseRepo.DeductSkuStock(ctx, stockIds[[2]int64{skuId, item.WarehouseID}], item.Quantity); err != nil {
+				return nil, status.Error(codes.Internal, "failed to deduct warehouse stock: "+err.Error())
+			}
+		}
+	}
+	if err := k.warehouseRepo.BatchCreateAllocations(ctx, records); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create warehouse allocations: "+err.Error())
+	}
+	return allocations, nil
+}
+
+// restore 按订单的分仓记录回补分仓库存，先分配的先回补，返回各SKU的回补结果
+// 没有分仓记录的数量（例如引入分仓记录之前支付的订单）回补到未分仓库存
+func (k *warehouseStockKeeper) restore(ctx context.Context, orderId int64, quantity map[int64]uint32) (map[int64][]dto.WarehouseAllocationDto, error) {
+	if len(quantity) == 0 {
+		return map[int64][]dto.WarehouseAllocationDto{}, nil
+	}
+	allocations, err := k.warehouseRepo.FindAllocationsByOrderIdForUpdate(ctx, orderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query warehouse allocations: "+err.Error())
+	}
+	remaining := make(map[int64]uint32, len(quantity))
+	for skuId, count := range quantity {
+		remaining[skuId] = count
+	}
+	result := make(map[int64][]dto.WarehouseAllocationDto, len(quantity))
+	for i := range allocations {
+		allocation := &allocations[i]
+		count := remaining[allocation.SkuID]
+		if restorable := allocation.RestorableQuantity(); restorable < count {
+			count = restorable
+		}
+		if count == 0 {
+			continue
+		}
+		var stock uint32
+		if allocation.WarehouseID > 0 {
+			if stock, err = k.change(ctx, allocation.SkuID, allocation.WarehouseID, int64(count)); err != nil {
+				return nil, err
+			}
+		}
+		if err := k.warehouseRepo.UpdateAllocationRestored(ctx, allocation.ID, allocation.RestoredQuantity+count); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update warehouse allocation: "+err.Error())
+		}
+		remaining[allocation.SkuID] -= count
+		result[allocation.SkuID] = append(result[allocation.SkuID], dto.WarehouseAllocationDto{
+			WarehouseID: allocation.WarehouseID,
+			Quantity:    count,
+			Stock:       stock,
+		})
+	}
+	for skuId, count := range remaining {
+		if count > 0 {
+			result[skuId] = append(result[skuId], dto.WarehouseAllocationDto{Quantity: count})
+		}
+	}
+	return result, nil
+}
+
+// outstanding 汇总订单各SKU尚未回补的分仓数量，按仓库首次分配的顺序排列，Stock为仓库当前库存
+func (k *warehouseStockKeeper) outstanding(ctx context.Context, orderId int64) (map[int64][]dto.WarehouseAllocationDto, error) {
+	allocations, err := k.warehouseRepo.FindAllocationsByOrderIdForUpdate(ctx, orderId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query warehouse allocations: "+err.Error())
+	}
+	result := make(map[int64][]dto.WarehouseAllocationDto)
+	index := make(map[[2]int64]int, len(allocations))
+	for i := range allocations {
+		allocation := &allocations[i]
+		count := allocation.RestorableQuantity()
+		if count == 0 {
+			continue
+		}
+		key := [2]int64{allocation.SkuID, allocation.WarehouseID}
+		if pos, ok := index[key]; ok {
+			result[allocation.SkuID][pos].Quantity += count
+			continue
+		}
+		var stock uint32
+		if allocation.WarehouseID > 0 {
+			if stock, err = k.current(ctx, allocation.SkuID, allocation.WarehouseID); err != nil {
+				return nil, err
+			}
+		}
+		index[key] = len(result[allocation.SkuID])
+		result[allocation.SkuID] = append(result[allocation.SkuID], dto.WarehouseAllocationDto{
+			WarehouseID: allocation.WarehouseID,
+			Quantity:    count,
+			Stock:       stock,
+		})
+	}
+	return result, nil
+}
+
+// current 锁定并查询SKU在指定仓库的库存，未登记时为0
+func (k *warehouseStockKeeper) current(ctx context.Context, skuId int64, warehouseId int64) (uint32, error) {
+	stock, err := k.warehouseRepo.FindSkuStockForUpdate(ctx, skuId, warehouseId)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "failed to query warehouse stock: "+err.Error())
+	}
+	if stock == nil {
+		return 0, nil
+	}
+	return stock.Stock, nil
+}
+
+// change 按差值变更SKU在指定仓库的库存，未登记时新建，变更后不能为负数，返回变更后的分仓库存
+func (k *warehouseStockKeeper) change(ctx context.Context, skuId int64, warehouseId int64, delta int64) (uint32, error) {
+	stock, err := k.warehouseRepo.FindSkuStockForUpdate(ctx, skuId, warehouseId)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "failed to query warehouse stock: "+err.Error())
+	}
+	if stock == nil {
+		stock = &model.SkuWarehouseStock{SkuID: skuId, WarehouseID: warehouseId}
+	}
+	after := int64(stock.Stock) + delta
+	if after < 0 {
+		return 0, status.Error(codes.FailedPrecondition, "stock of sku "+strconv.FormatInt(skuId, 10)+" in warehouse "+strconv.FormatInt(warehouseId, 10)+" cannot be negative")
+	}
+	stock.Stock = uint32(after)
+	if err := k.warehouseRepo.SaveSkuStock(ctx, stock); err != nil {
+		return 0, status.Error(codes.Internal, "failed to save warehouse stock: "+err.Error())
+	}
+	return stock.Stock, nil
+}
+
+// withWarehouseCode 库存变更原因后附上仓库编码
+func withWarehouseCode(reason string, code string) string {
+	return reason + " (" + code + ")"
+}
@@ -1,8 +1,12 @@
 package infrastructure
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/zhanshen02154/product/internal/infrastructure/event"
 	"github.com/zhanshen02154/product/internal/infrastructure/event/wrapper"
 
 	"github.com/Shopify/sarama"
@@ -12,6 +16,13 @@ import (
 	"go-micro.dev/v4/logger"
 )
 
+const (
+	BrokerDriverKafka    = "kafka"
+	BrokerDriverMemory   = "memory"
+	BrokerDriverNats     = "nats"
+	BrokerDriverRabbitMQ = "rabbitmq"
+)
+
 // 加载Kafka配置
 func loadKafkaConfig(conf *config.Kafka) *sarama.Config {
 	kafkaConfig := sarama.NewConfig()
@@ -43,6 +54,16 @@ func loadKafkaConfig(conf *config.Kafka) *sarama.Config {
 	return kafkaConfig
 }
 
+// kafkaPartitionInterceptor 将消息所在分区写入消息头，Kafka插件投递时会复制到broker.Message
+type kafkaPartitionInterceptor struct{}
+
+func (kafkaPartitionInterceptor) OnConsume(msg *sarama.ConsumerMessage) {
+	msg.Headers = append(msg.Headers, &sarama.RecordHeader{
+		Key:   []byte(event.PartitionHeader),
+		Value: []byte(strconv.FormatInt(int64(msg.Partition), 10)),
+	})
+}
+
 // NewKafkaBroker 创建Broker
 func NewKafkaBroker(conf *config.Kafka, opts ...broker.Option) broker.Broker {
 	// 将额外传入的 broker.Option 直接透传给 kafka.NewBroker，便于注入 AsyncProducer channels
@@ -50,6 +71,7 @@ func NewKafkaBroker(conf *config.Kafka, opts ...broker.Option) broker.Broker {
 	clusterConf := loadKafkaConfig(conf)
 	clusterConf.ClientID = "product-subscriber-client"
 	clusterConf.Consumer.Group.Rebalance.Strategy = sarama.BalanceStrategyRange
+	clusterConf.Consumer.Interceptors = []sarama.ConsumerInterceptor{kafkaPartitionInterceptor{}}
 	options := []broker.Option{
 		broker.Addrs(conf.Hosts...),
 		kafka.BrokerConfig(kafkaConf),
@@ -60,3 +82,60 @@ func NewKafkaBroker(conf *config.Kafka, opts ...broker.Option) broker.Broker {
 	options = append(options, opts...)
 	return kafka.NewBroker(options...)
 }
+
+// NewBroker 按broker.driver创建Broker，同时返回将发布回执接入侦听器的选项
+func NewBroker(conf *config.Broker) (broker.Broker, event.Option, error) {
+	switch conf.Driver {
+	case "", BrokerDriverKafka:
+		if conf.Kafka == nil {
+			return nil, nil, errors.New("kafka config is nil")
+		}
+		successChan := make(chan *sarama.ProducerMessage, conf.Kafka.ChannelBufferSize)
+		errorChan := make(chan *sarama.ProducerError, conf.Kafka.ChannelBufferSize)
+		b := NewKafkaBroker(conf.Kafka, kafka.AsyncProducer(errorChan, successChan))
+		return b, event.WithProducerChannels(successChan, errorChan), nil
+	case BrokerDriverMemory:
+		if conf.Memory == nil {
+			return nil, nil, errors.New("memory broker config is nil")
+		}
+		successChan := make(chan *sarama.ProducerMessage, conf.Memory.ChannelBufferSize)
+		errorChan := make(chan *sarama.ProducerError, conf.Memory.ChannelBufferSize)
+		b := NewMemoryBroker(
+			MemoryAsyncProducer(errorChan, successChan),
+			broker.Logger(logger.DefaultLogger),
+			broker.ErrorHandler(wrapper.ErrorHandler()),
+		)
+		return b, event.WithProducerChannels(successChan, errorChan), nil
+	case BrokerDriverNats:
+		if conf.Nats == nil || len(conf.Nats.Hosts) == 0 {
+			return nil, nil, errors.New("nats hosts is empty")
+		}
+		acks := make(chan *event.PublishAck, conf.Nats.AckBufferSize)
+		b := NewNatsBroker(
+			broker.Addrs(conf.Nats.Hosts...),
+			NatsPublishAcks(acks),
+			NatsMaxAckPending(conf.Nats.MaxAckPending),
+			NatsAckWait(time.Duration(conf.Nats.MaxProcessingTime)*time.Millisecond),
+			broker.Logger(logger.DefaultLogger),
+			broker.ErrorHandler(wrapper.ErrorHandler()),
+		)
+		return b, event.WithPublishAcks(acks), nil
+	case BrokerDriverRabbitMQ:
+		if conf.RabbitMQ == nil || conf.RabbitMQ.Url == "" {
+			return nil, nil, errors.New("rabbitmq url is empty")
+		}
+		acks := make(chan *event.PublishAck, conf.RabbitMQ.AckBufferSize)
+		b := NewRabbitMQBroker(
+			broker.Addrs(conf.RabbitMQ.Url),
+			RabbitMQPublishAcks(acks),
+			RabbitMQExchange(conf.RabbitMQ.Exchange),
+			RabbitMQPrefetchCount(conf.RabbitMQ.PrefetchCount),
+			RabbitMQConsumerTimeout(time.Duration(conf.RabbitMQ.MaxProcessingTime)*time.Millisecond),
+			broker.Logger(logger.DefaultLogger),
+			broker.ErrorHandler(wrapper.ErrorHandler()),
+		)
+		return b, event.WithPublishAcks(acks), nil
+	default:
+		return nil, nil, fmt.Errorf("unknown broker driver %s", conf.Driver)
+	}
+}
@@ -48,16 +48,18 @@ func NewServiceContext(conf *config.SysConfig, zapLogger *zap.Logger, logLevel z
 	if err != nil {
 		return nil, err
 	}
+	// 处理器重试沿用Kafka消费者的重试配置，其他驱动未配置时不重试
+	retryOpts := []retry.Option{retry.WithLogger(zapLogger)}
+	if conf.Broker.Kafka != nil && conf.Broker.Kafka.Consumer != nil {
+		retryOpts = append(retryOpts, retry.WithKafkaConsumerConfig(conf.Broker.Kafka.Consumer))
+	}
 	return &ServiceContext{
 		TxManager:   gorm2.NewGormTransactionManager(db),
 		LockManager: lockMgr,
 		Conf:        conf,
 		db:          db,
 		Dtm:         dtm.NewServer(conf.Transaction.Host),
-		RetryPolicy: retry.NewRetryPolicy(
-			retry.WithKafkaConsumerConfig(conf.Broker.Kafka.Consumer),
-			retry.WithLogger(zapLogger),
-		),
+		RetryPolicy: retry.NewRetryPolicy(retryOpts...),
 	}, nil
 }
 
@@ -151,3 +153,53 @@ func (svc *ServiceContext) NewSkuRestockAuditRepository() repository.SkuRestockA
 func (svc *ServiceContext) NewSupplierRepository() repository.SupplierRepository {
 	return gorm2.NewSupplierRepository(svc.db)
 }
+
+// NewOutboxEventRepository 创建事务发件箱仓储层
+func (svc *ServiceContext) NewOutboxEventRepository() repository.OutboxEventRepository {
+	return gorm2.NewOutboxEventRepository(svc.db)
+}
+
+// NewSkuStockReservationRepository 创建库存预占仓储层
+func (svc *ServiceContext) NewSkuStockReservationRepository() repository.SkuStockReservationRepository {
+	return gorm2.NewSkuStockReservationRepository(svc.db)
+}
+
+// NewOrderInventoryRestoreRepository 创建订单库存回补记录仓储层
+func (svc *ServiceContext) NewOrderInventoryRestoreRepository() repository.OrderInventoryRestoreRepository {
+	return gorm2.NewOrderInventoryRestoreRepository(svc.db)
+}
+
+// NewProductCategoryRepository 创建商品分类仓储层
+func (svc *ServiceContext) NewProductCategoryRepository() repository.ProductCategoryRepository {
+	return gorm2.NewProductCategoryRepository(svc.db)
+}
+
+// NewBrandRepository 创建品牌仓储层
+func (svc *ServiceContext) NewBrandRepository() repository.BrandRepository {
+	return gorm2.NewBrandRepository(svc.db)
+}
+
+// NewPurchaseOrderRepository 创建采购单仓储层
+func (svc *ServiceContext) NewPurchaseOrderRepository() repository.PurchaseOrderRepository {
+	return gorm2.NewPurchaseOrderRepository(svc.db)
+}
+
+// NewWarehouseRepository 创建仓库仓储层
+func (svc *ServiceContext) NewWarehouseRepository() repository.WarehouseRepository {
+	return gorm2.NewWarehouseRepository(svc.db)
+}
+
+// NewSkuPriceHistoryRepository 创建SKU价格变更记录仓储层
+func (svc *ServiceContext) NewSkuPriceHistoryRepository() repository.SkuPriceHistoryRepository {
+	return gorm2.NewSkuPriceHistoryRepository(svc.db)
+}
+
+// NewDeadLetterRepository 创建死信仓储层
+func (svc *ServiceContext) NewDeadLetterRepository() repository.DeadLetterRepository {
+	return gorm2.NewDeadLetterRepository(svc.db)
+}
+
+// NewProcessedEventRepository 创建已处理事件仓储层
+func (svc *ServiceContext) NewProcessedEventRepository() repository.ProcessedEventRepository {
+	return gorm2.NewProcessedEventRepository(svc.db)
+}
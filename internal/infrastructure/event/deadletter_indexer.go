@@ -0,0 +1,164 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/logger"
+)
+
+// 死信消息头
+const (
+	deadLetterErrorKey      = "x-error"
+	originTopicKey          = "x-origin-topic"
+	originTimestampKey      = "x-origin-timestamp"
+	deadLetterIdKey         = "x-dead-letter-id"
+	maxDeadLetterErrorRunes = 1000
+)
+
+// DeadLetterIndexer 死信索引器
+// 订阅各主题的<topic>DLQ，将死信写入dead_letters表，供查询、重新投递和标记处理
+type DeadLetterIndexer struct {
+	repo   repository.DeadLetterRepository
+	broker broker.Broker
+	topics []string
+	queue  string
+	subs   []broker.Subscriber
+}
+
+// NewDeadLetterIndexer 创建死信索引器，topics为原主题，订阅时自动加上死信后缀
+func NewDeadLetterIndexer(repo repository.DeadLetterRepository, b broker.Broker, topics []string, queue string) *DeadLetterIndexer {
+	deadLetterTopics := make([]string, 0, len(topics))
+	seen := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		if topic == "" {
+			continue
+		}
+		if !strings.HasSuffix(topic, deadletterSuffix) {
+			topic += deadletterSuffix
+		}
+		if !seen[topic] {
+			seen[topic] = true
+			deadLetterTopics = append(deadLetterTopics, topic)
+		}
+	}
+	return &DeadLetterIndexer{repo: repo, broker: b, topics: deadLetterTopics, queue: queue}
+}
+
+// Start 订阅死信主题，须在broker连接后调用
+func (i *DeadLetterIndexer) Start() error {
+	for _, topic := range i.topics {
+		sub, err := i.broker.Subscribe(topic, i.handle, broker.Queue(i.queue))
+		if err != nil {
+			return err
+		}
+		i.subs = append(i.subs, sub)
+		logger.Info("dead letter indexer subscribed to ", topic)
+	}
+	return nil
+}
+
+// Close 取消订阅
+func (i *DeadLetterIndexer) Close(ctx context.Context) error {
+	var err error
+	for _, sub := range i.subs {
+		if uErr := sub.Unsubscribe(); uErr != nil && err == nil {
+			err = uErr
+		}
+	}
+	i.subs = nil
+	return err
+}
+
+// handle 写入死信，重复投递的死信忽略
+func (i *DeadLetterIndexer) handle(e broker.Event) error {
+	deadLetter, err := NewDeadLetterRecord(e.Topic(), e.Message())
+	if err != nil {
+		return err
+	}
+	created, err := i.repo.Create(context.Background(), deadLetter)
+	if err != nil {
+		logger.Error("failed to index dead letter ", deadLetter.EventId, " from ", e.Topic(), ": ", err.Error())
+		return err
+	}
+	if created {
+		logger.Info("indexed dead letter ", deadLetter.EventId, " from ", e.Topic(), " error: ", deadLetter.Error)
+	}
+	return nil
+}
+
+// NewDeadLetterRecord 根据死信消息生成死信记录
+// 原主题优先取x-origin-topic，缺失时去掉死信主题的后缀；事件类型去掉死信后缀还原为原事件类型
+func NewDeadLetterRecord(topic string, msg *broker.Message) (*model.DeadLetter, error) {
+	header := msg.Header
+	if header == nil {
+		header = map[string]string{}
+	}
+	headers, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	originTopic := header[originTopicKey]
+	if originTopic == "" {
+		originTopic = strings.TrimSuffix(topic, deadletterSuffix)
+	}
+	eventId := header["Event_id"]
+	if eventId == "" {
+		eventId = header["Micro-Id"]
+	}
+	if eventId == "" {
+		eventId = uuid.New().String()
+	}
+	deadAt, convErr := strconv.ParseInt(header["Timestamp"], 10, 64)
+	if convErr != nil {
+		deadAt = time.Now().UnixMilli()
+	}
+	originTimestamp, _ := strconv.ParseInt(header[originTimestampKey], 10, 64)
+	errMsg := header[deadLetterErrorKey]
+	if utf8.RuneCountInString(errMsg) > maxDeadLetterErrorRunes {
+		errMsg = string([]rune(errMsg)[:maxDeadLetterErrorRunes])
+	}
+	return &model.DeadLetter{
+		EventId:         eventId,
+		DeadAt:          deadAt,
+		Topic:           topic,
+		OriginTopic:     originTopic,
+		EventType:       strings.TrimSuffix(header["Event-Type"], deadletterSuffix),
+		EventKey:        header[partitionKey],
+		Headers:         string(headers),
+		Payload:         msg.Body,
+		Error:           errMsg,
+		OriginTimestamp: originTimestamp,
+		Status:          model.DeadLetterStatusPending,
+	}, nil
+}
+
+// NewReplayMessage 根据死信记录还原原消息，用于重新投递到原主题
+// 去掉死信相关的消息头及原链路信息，事件类型还原为原事件类型，事件ID保持不变以便消费方幂等
+func NewReplayMessage(deadLetter *model.DeadLetter) (*broker.Message, error) {
+	header := make(map[string]string)
+	if deadLetter.Headers != "" {
+		if err := json.Unmarshal([]byte(deadLetter.Headers), &header); err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range []string{deadLetterErrorKey, originTopicKey, originTimestampKey, traceparentKey, strings.ToLower(traceparentKey)} {
+		delete(header, key)
+	}
+	header["Event-Type"] = deadLetter.EventType
+	header["Micro-Topic"] = deadLetter.OriginTopic
+	header["Timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	header[deadLetterIdKey] = strconv.FormatInt(deadLetter.ID, 10)
+	return &broker.Message{
+		Header: header,
+		Body:   deadLetter.Payload,
+	}, nil
+}
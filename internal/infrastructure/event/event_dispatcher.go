@@ -14,10 +14,13 @@ import (
 // EventDispatcher 事件分发器
 type EventDispatcher struct {
 	mu          sync.RWMutex
-	handlers    map[string]EventHandler            // 事件类型 -> 处理器映射
-	topicConfig map[string][]string                // topic -> 支持的事件类型列表
-	topicMap    map[string]string                  // 事件类型 -> topic 映射
-	consumerMap map[string]server.SubscriberOption // topic -> 消费者组配置
+	handlers    map[string]EventHandler    // 事件类型 -> 处理器映射
+	topicConfig map[string][]string        // topic -> 支持的事件类型列表
+	topicMap    map[string]string          // 事件类型 -> topic 映射
+	consumerMap map[string]string          // topic -> 消费者组
+	keyedTopics map[string]bool            // 由KeyedConsumer按Pkey消费的topic
+	middlewares []HandlerMiddleware        // 处理器中间件，先添加的在外层
+	handlerOpts map[string]*handlerOptions // 事件类型 -> 注册时指定的中间件
 }
 
 // NewEventDispatcher 创建事件分发器
@@ -26,12 +29,14 @@ func NewEventDispatcher() *EventDispatcher {
 		handlers:    make(map[string]EventHandler),
 		topicConfig: make(map[string][]string),
 		topicMap:    make(map[string]string),
-		consumerMap: make(map[string]server.SubscriberOption),
+		consumerMap: make(map[string]string),
+		keyedTopics: make(map[string]bool),
+		handlerOpts: make(map[string]*handlerOptions),
 	}
 }
 
-// RegisterHandler 注册事件处理器
-func (d *EventDispatcher) RegisterHandler(handler EventHandler, topic string, consumerGroup string) error {
+// RegisterHandler 注册事件处理器，可通过选项为该处理器追加或替换中间件
+func (d *EventDispatcher) RegisterHandler(handler EventHandler, topic string, consumerGroup string, opts ...RegisterOption) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
@@ -45,6 +50,11 @@ func (d *EventDispatcher) RegisterHandler(handler EventHandler, topic string, co
 		logger.Warnf("event handler for type '%s' already exists, will be overwritten", eventType)
 	}
 	d.handlers[eventType] = handler
+	options := &handlerOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+	d.handlerOpts[eventType] = options
 
 	// 更新 topic 配置
 	d.topicConfig[topic] = append(d.topicConfig[topic], eventType)
@@ -52,7 +62,7 @@ func (d *EventDispatcher) RegisterHandler(handler EventHandler, topic string, co
 
 	// 设置消费者组
 	if consumerGroup != "" {
-		d.consumerMap[topic] = server.SubscriberQueue(consumerGroup)
+		d.consumerMap[topic] = consumerGroup
 	}
 
 	logger.Infof("registered event handler: type=%s, topic=%s, consumerGroup=%s",
@@ -60,10 +70,17 @@ func (d *EventDispatcher) RegisterHandler(handler EventHandler, topic string, co
 	return nil
 }
 
+// Use 添加处理器中间件，作用于所有事件处理器，先添加的在外层
+func (d *EventDispatcher) Use(middlewares ...HandlerMiddleware) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.middlewares = append(d.middlewares, middlewares...)
+}
+
 // RegisterHandlers 批量注册事件处理器
-func (d *EventDispatcher) RegisterHandlers(handlers []EventHandler, topic string, consumerGroup string) error {
+func (d *EventDispatcher) RegisterHandlers(handlers []EventHandler, topic string, consumerGroup string, opts ...RegisterOption) error {
 	for _, handler := range handlers {
-		if err := d.RegisterHandler(handler, topic, consumerGroup); err != nil {
+		if err := d.RegisterHandler(handler, topic, consumerGroup, opts...); err != nil {
 			return err
 		}
 	}
@@ -78,6 +95,10 @@ func (d *EventDispatcher) Dispatch(ctx context.Context, baseEvent *event.BaseEve
 
 	d.mu.RLock()
 	handler, exists := d.handlers[baseEvent.EventType]
+	middlewares := d.middlewares
+	if options, ok := d.handlerOpts[baseEvent.EventType]; ok {
+		middlewares = options.chain(middlewares)
+	}
 	d.mu.RUnlock()
 
 	if !exists {
@@ -89,6 +110,9 @@ func (d *EventDispatcher) Dispatch(ctx context.Context, baseEvent *event.BaseEve
 	logger.Infof("dispatching event: eventType=%s",
 		baseEvent.EventType)
 
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
 	return handler.Handle(ctx, baseEvent)
 }
 
@@ -99,13 +123,17 @@ func (d *EventDispatcher) RegisterSubscribers(srv server.Server) error {
 
 	// 为每个 topic 注册一个统一的订阅器
 	for topic, eventTypes := range d.topicConfig {
+		// 按Pkey消费的 topic 由 KeyedConsumer 订阅
+		if d.keyedTopics[topic] {
+			continue
+		}
 		// 创建该 topic 的分发处理函数
 		dispatchFunc := d.createDispatchFunc(topic)
 
 		// 获取消费者组配置
 		var opts []server.SubscriberOption
-		if consumerOpt, exists := d.consumerMap[topic]; exists {
-			opts = append(opts, consumerOpt)
+		if consumerGroup, exists := d.consumerMap[topic]; exists {
+			opts = append(opts, server.SubscriberQueue(consumerGroup))
 		}
 
 		// 注册订阅器
@@ -120,6 +148,25 @@ func (d *EventDispatcher) RegisterSubscribers(srv server.Server) error {
 	return nil
 }
 
+// consumeByKey 将 topic 交给 KeyedConsumer 订阅，topic 没有注册处理器时返回false
+func (d *EventDispatcher) consumeByKey(topic string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.topicConfig[topic]; !ok {
+		logger.Warnf("no event handler registered for keyed topic %s", topic)
+		return false
+	}
+	d.keyedTopics[topic] = true
+	return true
+}
+
+// consumerGroup 获取 topic 的消费者组
+func (d *EventDispatcher) consumerGroup(topic string) string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.consumerMap[topic]
+}
+
 // createDispatchFunc 创建特定 topic 的分发函数
 func (d *EventDispatcher) createDispatchFunc(topic string) func(ctx context.Context, baseEvent *event.BaseEvent) error {
 	return func(ctx context.Context, baseEvent *event.BaseEvent) error {
@@ -149,6 +196,18 @@ func (d *EventDispatcher) GetSupportedEventTypes() []string {
 	return types
 }
 
+// GetTopics 获取已注册处理器的 topic 列表
+func (d *EventDispatcher) GetTopics() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	topics := make([]string, 0, len(d.topicConfig))
+	for topic := range d.topicConfig {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
 // GetHandler 获取指定事件类型的处理器
 func (d *EventDispatcher) GetHandler(eventType string) (EventHandler, bool) {
 	d.mu.RLock()
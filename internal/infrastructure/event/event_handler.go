@@ -2,7 +2,6 @@ package event
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/zhanshen02154/product/internal/domain/event"
 	"google.golang.org/protobuf/proto"
@@ -19,16 +18,7 @@ type EventHandler interface {
 // EventHandlerFunc 事件处理函数类型
 type EventHandlerFunc func(ctx context.Context, baseEvent *event.BaseEvent) error
 
-// UnmarshalPayload 反序列化 Payload 到目标消息
+// UnmarshalPayload 反序列化 Payload 到目标消息，旧版本的内容按默认结构注册表升级
 func UnmarshalPayload[T proto.Message](baseEvent *event.BaseEvent, target T) error {
-	if baseEvent == nil {
-		return fmt.Errorf("base event is nil")
-	}
-	if len(baseEvent.Payload) == 0 {
-		return fmt.Errorf("payload is empty")
-	}
-	if err := proto.Unmarshal(baseEvent.Payload, target); err != nil {
-		return fmt.Errorf("failed to unmarshal payload: %w", err)
-	}
-	return nil
+	return DefaultSchemaRegistry.Decode(baseEvent, target)
 }
@@ -17,21 +17,45 @@ type genericEventHandler[T proto.Message] struct {
 	eventType   string
 	handlerFunc GenericHandlerFunc[T]
 	newMessage  func() T // 创建新消息实例的工厂函数
+	registry    *SchemaRegistry
+}
+
+// GenericHandlerOption 通用事件处理器选项
+type GenericHandlerOption func(*genericHandlerOptions)
+
+type genericHandlerOptions struct {
+	registry *SchemaRegistry
+}
+
+// WithSchemaRegistry 指定解码事件内容使用的结构注册表，默认使用DefaultSchemaRegistry
+func WithSchemaRegistry(registry *SchemaRegistry) GenericHandlerOption {
+	return func(o *genericHandlerOptions) {
+		if registry != nil {
+			o.registry = registry
+		}
+	}
 }
 
 // NewGenericHandler 创建通用事件处理器
 // eventType: 事件类型（如 "order.OnPaymentSuccess"）
 // handlerFunc: 处理函数
 // newMessage: 创建具体事件消息的工厂函数（如 func() proto.Message { return &order.OnPaymentSuccess{} }）
+// 旧版本的事件内容按结构注册表升级为当前消息类型后再交给处理函数
 func NewGenericHandler[T proto.Message](
 	eventType string,
 	handlerFunc GenericHandlerFunc[T],
 	newMessage func() T,
+	opts ...GenericHandlerOption,
 ) EventHandler {
+	options := genericHandlerOptions{registry: DefaultSchemaRegistry}
+	for _, o := range opts {
+		o(&options)
+	}
 	return &genericEventHandler[T]{
 		eventType:   eventType,
 		handlerFunc: handlerFunc,
 		newMessage:  newMessage,
+		registry:    options.registry,
 	}
 }
 
@@ -42,9 +66,9 @@ func (h *genericEventHandler[T]) EventType() string {
 
 // Handle 处理事件
 func (h *genericEventHandler[T]) Handle(ctx context.Context, baseEvent *event.BaseEvent) error {
-	// 反序列化 Payload
+	// 反序列化 Payload，旧版本升级为当前版本
 	msg := h.newMessage()
-	if err := UnmarshalPayload(baseEvent, msg); err != nil {
+	if err := h.registry.Decode(baseEvent, msg); err != nil {
 		return fmt.Errorf("failed to unmarshal event payload: %w", err)
 	}
 
@@ -0,0 +1,62 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/infrastructure/event/monitor"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
+	"github.com/zhanshen02154/product/internal/infrastructure/worker"
+	metadata2 "github.com/zhanshen02154/product/pkg/metadata"
+	"go-micro.dev/v4/logger"
+)
+
+// NewIdempotencyMiddleware 消费端幂等中间件
+// 按Event_id和处理器去重，处理记录与处理器在同一事务中写入：处理失败时记录随事务回滚，事件可以重新处理
+// 分发器中每个事件类型只有一个处理器，处理器名称取事件类型；没有Event_id的事件不去重
+func NewIdempotencyMiddleware(repo repository.ProcessedEventRepository, txManager transaction.TransactionManager) HandlerMiddleware {
+	return func(next EventHandler) EventHandler {
+		return NewHandlerAdapter(next.EventType(), func(ctx context.Context, baseEvent *event.BaseEvent) error {
+			eventId, ok := metadata2.GetEventId(ctx)
+			if !ok || eventId == "" {
+				return next.Handle(ctx, baseEvent)
+			}
+			return txManager.Execute(ctx, func(txCtx context.Context) error {
+				created, err := repo.Create(txCtx, &model.ProcessedEvent{
+					EventId:   eventId,
+					Handler:   next.EventType(),
+					EventType: baseEvent.EventType,
+				})
+				if err != nil {
+					return err
+				}
+				if !created {
+					monitor.DuplicateEventsSkipped.WithLabelValues(baseEvent.EventType).Inc()
+					logger.Infof("event %s already processed by %s, skipped", eventId, next.EventType())
+					return nil
+				}
+				return next.Handle(txCtx, baseEvent)
+			})
+		})
+	}
+}
+
+// NewProcessedEventCleaner 定期清理保留期之前的处理记录
+// 保留期应长于消息可能被重复投递的时间窗口（broker保留期、死信重新投递等）
+func NewProcessedEventCleaner(repo repository.ProcessedEventRepository, interval time.Duration, retention time.Duration) *worker.PeriodicWorker {
+	return worker.NewPeriodicWorker("processed-event-cleaner", interval, func(ctx context.Context) error {
+		before := time.Now().Add(-retention)
+		for {
+			rows, err := repo.DeleteBefore(ctx, before, 1000)
+			if err != nil {
+				return err
+			}
+			if rows < 1000 || ctx.Err() != nil {
+				return nil
+			}
+		}
+	})
+}
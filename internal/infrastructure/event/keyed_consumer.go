@@ -0,0 +1,321 @@
+package event
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/codec"
+	"go-micro.dev/v4/codec/json"
+	protocodec "go-micro.dev/v4/codec/proto"
+	"go-micro.dev/v4/logger"
+	"go-micro.dev/v4/metadata"
+	"go-micro.dev/v4/server"
+)
+
+// PartitionHeader 消息所在分区，由Broker在投递时写入消息头；没有分区的Broker不写入，整个主题视为一个分区
+const PartitionHeader = "Micro-Partition"
+
+type keyedConsumerOptions struct {
+	concurrency int
+	bufferSize  int
+	wrappers    []server.SubscriberWrapper
+}
+
+type KeyedConsumerOption func(*keyedConsumerOptions)
+
+// KeyedConsumer 按Pkey并发消费
+// 相同Pkey的消息按到达顺序串行处理，不同Pkey并发处理；消息只在同一分区内之前到达的消息全部处理完后才确认，
+// 因此各分区提交的偏移量之前不会有未处理的消息，某个分区的慢消息也不会阻塞其他分区的确认。重平衡时未确认的消息会重新投递，由幂等中间件去重
+type KeyedConsumer struct {
+	dispatcher *EventDispatcher
+	broker     broker.Broker
+	topics     []string
+	opts       keyedConsumerOptions
+	workers    chan struct{}
+	pending    chan struct{}
+	subs       []broker.Subscriber
+	wg         sync.WaitGroup
+}
+
+// keyedTask 待处理的消息
+type keyedTask struct {
+	event     broker.Event
+	partition string
+	done      bool
+}
+
+// keyedStream 一个订阅内的处理进度，keys记录各Pkey待处理的消息，
+// partitions按分区记录到达顺序，每个分区的偏移量独立提交，各自按顺序确认
+type keyedStream struct {
+	mu         sync.Mutex
+	topic      string
+	keys       map[string][]*keyedTask
+	partitions map[string][]*keyedTask
+	seq        int64
+}
+
+// keyedEvent 交给ErrorHandler的消息，确认由KeyedConsumer按顺序完成
+type keyedEvent struct {
+	broker.Event
+	err error
+}
+
+func (e *keyedEvent) Ack() error {
+	return nil
+}
+
+func (e *keyedEvent) Error() error {
+	return e.err
+}
+
+// keyedMessage 实现server.Message，使订阅包装器对按键消费的消息同样生效
+type keyedMessage struct {
+	topic       string
+	contentType string
+	payload     interface{}
+	header      map[string]string
+	body        []byte
+}
+
+func (m *keyedMessage) Topic() string {
+	return m.topic
+}
+
+func (m *keyedMessage) Payload() interface{} {
+	return m.payload
+}
+
+func (m *keyedMessage) ContentType() string {
+	return m.contentType
+}
+
+func (m *keyedMessage) Header() map[string]string {
+	return m.header
+}
+
+func (m *keyedMessage) Body() []byte {
+	return m.body
+}
+
+func (m *keyedMessage) Codec() codec.Reader {
+	return nil
+}
+
+// NewKeyedConsumer 创建按键消费者，topics不再由RegisterSubscribers注册到服务，须在其之前创建
+func NewKeyedConsumer(dispatcher *EventDispatcher, b broker.Broker, topics []string, opts ...KeyedConsumerOption) *KeyedConsumer {
+	c := &KeyedConsumer{
+		dispatcher: dispatcher,
+		broker:     b,
+		opts: keyedConsumerOptions{
+			concurrency: 16,
+			bufferSize:  1000,
+		},
+	}
+	for _, o := range opts {
+		o(&c.opts)
+	}
+	for _, topic := range topics {
+		if topic != "" && dispatcher.consumeByKey(topic) {
+			c.topics = append(c.topics, topic)
+		}
+	}
+	c.workers = make(chan struct{}, c.opts.concurrency)
+	c.pending = make(chan struct{}, c.opts.bufferSize)
+	return c
+}
+
+// Start 订阅，须在broker连接后调用
+func (c *KeyedConsumer) Start() error {
+	for _, topic := range c.topics {
+		stream := &keyedStream{topic: topic, keys: make(map[string][]*keyedTask), partitions: make(map[string][]*keyedTask)}
+		opts := []broker.SubscribeOption{broker.DisableAutoAck()}
+		if group := c.dispatcher.consumerGroup(topic); group != "" {
+			opts = append(opts, broker.Queue(group))
+		}
+		sub, err := c.broker.Subscribe(topic, func(e broker.Event) error {
+			c.enqueue(stream, e)
+			return nil
+		}, opts...)
+		if err != nil {
+			return err
+		}
+		c.subs = append(c.subs, sub)
+		logger.Info("keyed consumer subscribed to ", topic)
+	}
+	return nil
+}
+
+// Close 取消订阅并等待已接收的消息处理完成
+func (c *KeyedConsumer) Close(ctx context.Context) error {
+	var err error
+	for _, sub := range c.subs {
+		if uErr := sub.Unsubscribe(); uErr != nil && err == nil {
+			err = uErr
+		}
+	}
+	c.subs = nil
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueue 加入对应Pkey的队列，该Pkey没有正在处理的消息时启动处理；待处理消息达到上限时阻塞拉取
+func (c *KeyedConsumer) enqueue(stream *keyedStream, e broker.Event) {
+	c.pending <- struct{}{}
+	c.wg.Add(1)
+	task := &keyedTask{event: e, partition: e.Message().Header[PartitionHeader]}
+
+	stream.mu.Lock()
+	stream.seq++
+	key := e.Message().Header[partitionKey]
+	if key == "" {
+		// 无Pkey的消息没有顺序要求
+		key = "#" + strconv.FormatInt(stream.seq, 10)
+	}
+	stream.partitions[task.partition] = append(stream.partitions[task.partition], task)
+	queue, running := stream.keys[key]
+	stream.keys[key] = append(queue, task)
+	stream.mu.Unlock()
+
+	if !running {
+		go c.run(stream, key)
+	}
+}
+
+// run 按顺序处理同一Pkey的消息，队列为空时退出
+func (c *KeyedConsumer) run(stream *keyedStream, key string) {
+	for {
+		stream.mu.Lock()
+		task := stream.keys[key][0]
+		stream.mu.Unlock()
+
+		c.workers <- struct{}{}
+		c.handle(stream.topic, task.event)
+		<-c.workers
+
+		stream.mu.Lock()
+		task.done = true
+		queue := stream.keys[key][1:]
+		if len(queue) == 0 {
+			delete(stream.keys, key)
+		} else {
+			stream.keys[key] = queue
+		}
+		acked := c.ack(stream, task.partition)
+		stream.mu.Unlock()
+
+		for i := 0; i < acked; i++ {
+			<-c.pending
+			c.wg.Done()
+		}
+		if len(queue) == 0 {
+			return
+		}
+	}
+}
+
+// ack 按到达顺序确认分区内已处理完的消息，遇到未处理完的消息即停止，其他分区不受影响，调用方须持有锁
+func (c *KeyedConsumer) ack(stream *keyedStream, partition string) int {
+	tasks := stream.partitions[partition]
+	n := 0
+	for n < len(tasks) && tasks[n].done {
+		if err := tasks[n].event.Ack(); err != nil {
+			logger.Errorf("failed to ack message of %s: %s", stream.topic, err.Error())
+		}
+		n++
+	}
+	if n == len(tasks) {
+		delete(stream.partitions, partition)
+	} else {
+		stream.partitions[partition] = tasks[n:]
+	}
+	return n
+}
+
+// handle 解码并分发，失败的消息交给broker的ErrorHandler转入死信队列
+func (c *KeyedConsumer) handle(topic string, e broker.Event) {
+	err := c.dispatch(topic, e.Message())
+	if err == nil {
+		return
+	}
+	if eh := c.broker.Options().ErrorHandler; eh != nil {
+		_ = eh(&keyedEvent{Event: e, err: err})
+	} else {
+		logger.Errorf("failed to handle message of %s: %s", topic, err.Error())
+	}
+}
+
+// dispatch 与服务端订阅器一致，消息头作为metadata，经订阅包装器后交给事件分发器
+func (c *KeyedConsumer) dispatch(topic string, msg *broker.Message) error {
+	if msg.Header == nil {
+		msg.Header = make(map[string]string)
+	}
+	ct := msg.Header["Content-Type"]
+	baseEvent := &event.BaseEvent{}
+	var unmarshaler codec.Marshaler = protocodec.Marshaler{}
+	if strings.Contains(ct, "json") {
+		unmarshaler = json.Marshaler{}
+	}
+	if err := unmarshaler.Unmarshal(msg.Body, baseEvent); err != nil {
+		return err
+	}
+
+	hdr := make(map[string]string, len(msg.Header))
+	for k, v := range msg.Header {
+		hdr[k] = v
+	}
+	delete(hdr, "Content-Type")
+	ctx := metadata.NewContext(context.Background(), hdr)
+
+	dispatchFunc := c.dispatcher.createDispatchFunc(topic)
+	fn := func(ctx context.Context, m server.Message) error {
+		return dispatchFunc(ctx, m.Payload().(*event.BaseEvent))
+	}
+	for i := len(c.opts.wrappers); i > 0; i-- {
+		fn = c.opts.wrappers[i-1](fn)
+	}
+	return fn(ctx, &keyedMessage{
+		topic:       topic,
+		contentType: ct,
+		payload:     baseEvent,
+		header:      msg.Header,
+		body:        msg.Body,
+	})
+}
+
+// WithKeyedConcurrency 同时处理的消息数量上限
+func WithKeyedConcurrency(concurrency int) KeyedConsumerOption {
+	return func(o *keyedConsumerOptions) {
+		if concurrency > 0 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+// WithKeyedBufferSize 已拉取未确认的消息数量上限，达到上限时暂停拉取
+func WithKeyedBufferSize(size int) KeyedConsumerOption {
+	return func(o *keyedConsumerOptions) {
+		if size > 0 {
+			o.bufferSize = size
+		}
+	}
+}
+
+// WithKeyedSubscriberWrappers 订阅包装器，一般传入服务端的SubWrappers
+func WithKeyedSubscriberWrappers(wrappers ...server.SubscriberWrapper) KeyedConsumerOption {
+	return func(o *keyedConsumerOptions) {
+		o.wrappers = append(o.wrappers, wrappers...)
+	}
+}
@@ -10,6 +10,7 @@ import (
 // Listener 事件总线
 type Listener interface {
 	Publish(ctx context.Context, topic string, event proto.Message, key string, eventType string) error
+	PublishRaw(ctx context.Context, topic string, payload []byte, key string, eventType string) error
 	Register(topic string, c client.Client) bool
 	UnRegister(topic string) bool
 	Close()
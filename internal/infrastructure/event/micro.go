@@ -19,8 +19,9 @@ import (
 )
 
 const (
-	partitionKey   = "Pkey"
-	traceparentKey = "Traceparent"
+	partitionKey     = "Pkey"
+	traceparentKey   = "Traceparent"
+	schemaVersionKey = "Schema_version"
 )
 
 // 事件侦听器
@@ -30,6 +31,7 @@ type microListener struct {
 	eventPublisher sync.Map
 	successChan    chan *sarama.ProducerMessage
 	errorChan      chan *sarama.ProducerError
+	ackChan        <-chan *PublishAck
 	wg             sync.WaitGroup
 	quitChan       chan struct{}
 	// started 用于防止重复 Start
@@ -39,9 +41,20 @@ type microListener struct {
 
 // Publish 发布
 func (l *microListener) Publish(ctx context.Context, topic string, msg proto.Message, key string, eventType string) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return l.PublishRaw(ctx, topic, b, key, eventType)
+}
+
+// PublishRaw 发布已序列化的事件内容
+func (l *microListener) PublishRaw(ctx context.Context, topic string, payload []byte, key string, eventType string) error {
 	if pub, ok := l.eventPublisher.Load(topic); ok {
 		if e, assertOk := pub.(micro.Event); assertOk {
+			schemaVersion := getSchemaVersion(ctx, eventType)
 			ctx = metadata.Set(ctx, "Event-Type", eventType)
+			ctx = metadata.Set(ctx, schemaVersionKey, strconv.FormatInt(int64(schemaVersion), 10))
 			// 将key放到metadata
 			if key != "" {
 				if _, ok := metadata.Get(ctx, partitionKey); !ok {
@@ -49,12 +62,12 @@ func (l *microListener) Publish(ctx context.Context, topic string, msg proto.Mes
 				}
 			}
 			eventMsg := &event.BaseEvent{
-				Timestamp: time.Now().UnixMilli(),
-				EventType: eventType,
-				Payload:   make([]byte, 0),
+				Timestamp:       time.Now().UnixMilli(),
+				EventType:       eventType,
+				Payload:         payload,
+				SchemaVersion:   schemaVersion,
+				ProducerVersion: l.opts.version,
 			}
-			b, _ := proto.Marshal(msg)
-			eventMsg.Payload = b
 			return e.Publish(ctx, eventMsg, client.PublishContext(ctx))
 		} else {
 			return errors.New("invalid event")
@@ -96,6 +109,7 @@ func (l *microListener) Close() {
 	if l.quitChan != nil {
 		close(l.quitChan)
 	}
+	l.wg.Wait()
 
 	// 在所有 handler goroutine 退出后，再把引用置为 nil，避免竞争条件
 	if l.quitChan != nil {
@@ -121,7 +135,10 @@ func (l *microListener) watchKafkaPipeline() {
 	l.wg.Add(2)
 	go l.handleSuccess()
 	go l.handleErrors()
-
+	if l.ackChan != nil {
+		l.wg.Add(1)
+		go l.handleAcks()
+	}
 }
 
 // handleSuccess 处理发布成功的逻辑
@@ -160,24 +177,48 @@ func (l *microListener) handleErrors() {
 	}
 }
 
+// handleAcks 处理非Kafka驱动的发布回执
+func (l *microListener) handleAcks() {
+	defer l.wg.Done()
+	for {
+		select {
+		case ack, ok := <-l.ackChan:
+			if !ok {
+				return
+			}
+			if ack != nil {
+				l.callback(ack.Message, ack.Partition, ack.Offset, ack.Err)
+			}
+		case <-l.quitChan:
+			logger.Info("Acks handler received stop signal.")
+			return
+		}
+	}
+}
+
 // 处理回调信息
 func (l *microListener) handleCallback(sg *sarama.ProducerMessage, err error) {
 	if sg == nil || sg.Metadata == nil {
 		return
 	}
 	msg, ok := sg.Metadata.(*broker.Message)
-	if !ok || msg == nil {
+	if !ok {
 		return
 	}
-	if msg.Header == nil {
+	l.callback(msg, sg.Partition, sg.Offset, err)
+}
+
+// callback 执行发布回调包装器链
+func (l *microListener) callback(msg *broker.Message, partition int32, offset int64, err error) {
+	if msg == nil || msg.Header == nil {
 		return
 	}
 	if v, ok := msg.Header[traceparentKey]; ok {
 		msg.Header[strings.ToLower(traceparentKey)] = v
 	}
 	ctx := metadata.NewContext(context.Background(), msg.Header)
-	ctx = context.WithValue(ctx, partitionContextKey{}, sg.Partition)
-	ctx = context.WithValue(ctx, offsetKey{}, sg.Offset)
+	ctx = context.WithValue(ctx, partitionContextKey{}, partition)
+	ctx = context.WithValue(ctx, offsetKey{}, offset)
 
 	fn := func(ctx context.Context, msg *broker.Message, err error) {
 		if topic, ok := msg.Header["Micro-Topic"]; ok {
@@ -0,0 +1,114 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/infrastructure/event/monitor"
+	"github.com/zhanshen02154/product/internal/infrastructure/retry"
+	"go-micro.dev/v4/logger"
+)
+
+// HandlerMiddleware 事件处理器中间件
+type HandlerMiddleware func(next EventHandler) EventHandler
+
+// RegisterOption 注册事件处理器选项
+type RegisterOption func(*handlerOptions)
+
+type handlerOptions struct {
+	middlewares []HandlerMiddleware
+	override    bool
+}
+
+// WithMiddlewares 为该处理器追加中间件，位于分发器中间件的内层
+func WithMiddlewares(middlewares ...HandlerMiddleware) RegisterOption {
+	return func(o *handlerOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// OverrideMiddlewares 该处理器使用指定的中间件，不再使用分发器中间件
+func OverrideMiddlewares(middlewares ...HandlerMiddleware) RegisterOption {
+	return func(o *handlerOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+		o.override = true
+	}
+}
+
+// chain 合并分发器中间件与注册时指定的中间件
+func (o *handlerOptions) chain(middlewares []HandlerMiddleware) []HandlerMiddleware {
+	if o.override {
+		return o.middlewares
+	}
+	if len(o.middlewares) == 0 {
+		return middlewares
+	}
+	merged := make([]HandlerMiddleware, 0, len(middlewares)+len(o.middlewares))
+	merged = append(merged, middlewares...)
+	return append(merged, o.middlewares...)
+}
+
+// NewRecoveryMiddleware 捕获处理器的panic并转为错误，由broker错误处理转入死信队列
+func NewRecoveryMiddleware() HandlerMiddleware {
+	return func(next EventHandler) EventHandler {
+		return NewHandlerAdapter(next.EventType(), func(ctx context.Context, baseEvent *event.BaseEvent) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Errorf("panic in event handler %s: %v\n%s", next.EventType(), r, debug.Stack())
+					err = fmt.Errorf("panic in event handler %s: %v", next.EventType(), r)
+				}
+			}()
+			return next.Handle(ctx, baseEvent)
+		})
+	}
+}
+
+// NewTimeoutMiddleware 限制处理器的执行时间，eventTimeouts按事件类型覆盖默认超时，超时为0表示不限制
+// 超时通过context传递，处理器须使用传入的context访问数据库等外部资源
+func NewTimeoutMiddleware(timeout time.Duration, eventTimeouts map[string]time.Duration) HandlerMiddleware {
+	return func(next EventHandler) EventHandler {
+		return NewHandlerAdapter(next.EventType(), func(ctx context.Context, baseEvent *event.BaseEvent) error {
+			d := timeout
+			if v, ok := eventTimeouts[baseEvent.EventType]; ok {
+				d = v
+			}
+			if d <= 0 {
+				return next.Handle(ctx, baseEvent)
+			}
+			timeoutCtx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return next.Handle(timeoutCtx, baseEvent)
+		})
+	}
+}
+
+// NewRetryMiddleware 按重试策略重试失败的处理器，参数错误等永久性错误不重试
+// 应位于幂等中间件外层，使每次重试都在新的事务中执行
+func NewRetryMiddleware(policy retry.Policy) HandlerMiddleware {
+	return func(next EventHandler) EventHandler {
+		return NewHandlerAdapter(next.EventType(), func(ctx context.Context, baseEvent *event.BaseEvent) error {
+			return policy.Execute(ctx, func() error {
+				return next.Handle(ctx, baseEvent)
+			})
+		})
+	}
+}
+
+// NewMetricsMiddleware 按事件类型统计处理耗时及结果
+func NewMetricsMiddleware() HandlerMiddleware {
+	return func(next EventHandler) EventHandler {
+		return NewHandlerAdapter(next.EventType(), func(ctx context.Context, baseEvent *event.BaseEvent) error {
+			start := time.Now()
+			err := next.Handle(ctx, baseEvent)
+			result := "success"
+			if err != nil {
+				result = "failure"
+			}
+			monitor.EventHandleDuration.WithLabelValues(baseEvent.EventType, result).Observe(time.Since(start).Seconds())
+			return err
+		})
+	}
+}
@@ -7,10 +7,12 @@ import (
 )
 
 var (
-	metricPrefix         = "kafka_"
-	MessageProducedCount *prometheus.CounterVec
-	ProduceDuration      *prometheus.HistogramVec
-	MessagesInFlight     *prometheus.GaugeVec
+	metricPrefix           = "kafka_"
+	MessageProducedCount   *prometheus.CounterVec
+	ProduceDuration        *prometheus.HistogramVec
+	MessagesInFlight       *prometheus.GaugeVec
+	DuplicateEventsSkipped *prometheus.CounterVec
+	EventHandleDuration    *prometheus.HistogramVec
 )
 
 func init() {
@@ -36,7 +38,22 @@ func init() {
 		}, []string{"topic", "service", "version"})
 	}
 
-	prometheus.MustRegister(MessageProducedCount, ProduceDuration, MessagesInFlight)
+	if DuplicateEventsSkipped == nil {
+		DuplicateEventsSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: metricPrefix + "duplicate_events_skipped_count",
+			Help: "kafka consumed events skipped as already processed by event type",
+		}, []string{"event_type"})
+	}
+
+	if EventHandleDuration == nil {
+		EventHandleDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    metricPrefix + "event_handle_duration_seconds",
+			Help:    "kafka consumed event handle duration by event type",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"event_type", "status"})
+	}
+
+	prometheus.MustRegister(MessageProducedCount, ProduceDuration, MessagesInFlight, DuplicateEventsSkipped, EventHandleDuration)
 }
 
 type monitorOptions struct {
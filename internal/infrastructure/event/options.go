@@ -20,6 +20,8 @@ type partitionContextKey struct{}
 
 type offsetKey struct{}
 
+type schemaVersionContextKey struct{}
+
 type Option func(listener *microListener)
 
 // 获取分区
@@ -38,6 +40,19 @@ func getOffset(ctx context.Context) int64 {
 	return -1
 }
 
+// withSchemaVersion 指定发布事件的结构版本，发件箱事件使用写入时的版本
+func withSchemaVersion(ctx context.Context, version int32) context.Context {
+	return context.WithValue(ctx, schemaVersionContextKey{}, version)
+}
+
+// getSchemaVersion 获取发布事件的结构版本，未指定时使用注册表中的最新版本
+func getSchemaVersion(ctx context.Context, eventType string) int32 {
+	if val, ok := ctx.Value(schemaVersionContextKey{}).(int32); ok && val > 0 {
+		return val
+	}
+	return DefaultSchemaRegistry.LatestVersion(eventType)
+}
+
 // WrapPublishCallback 应用包装器
 func WrapPublishCallback(opts ...PublishCallbackWrapper) Option {
 	return func(listener *microListener) {
@@ -61,6 +76,23 @@ func WithProducerChannels(success chan *sarama.ProducerMessage, errc chan *saram
 	}
 }
 
+// PublishAck 与消息中间件无关的发布回执，Kafka以外的驱动通过它接入发布回调
+type PublishAck struct {
+	Message   *broker.Message
+	Partition int32 // 分区，驱动没有分区时为-1
+	Offset    int64 // 偏移量或序号，驱动不返回时为-1
+	Err       error
+}
+
+// WithPublishAcks 注入驱动的发布回执通道
+func WithPublishAcks(acks <-chan *PublishAck) Option {
+	return func(l *microListener) {
+		if acks != nil {
+			l.ackChan = acks
+		}
+	}
+}
+
 // WithServiceName 名称
 func WithServiceName(name string) Option {
 	return func(l *microListener) {
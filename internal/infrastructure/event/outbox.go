@@ -0,0 +1,225 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
+	"github.com/zhanshen02154/product/internal/infrastructure/worker"
+	metadata2 "github.com/zhanshen02154/product/pkg/metadata"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/logger"
+	"go-micro.dev/v4/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// outboxIdKey 发件箱ID，随消息头传递到发布回调
+const outboxIdKey = "Outbox_id"
+
+type outboxOptions struct {
+	pollInterval   time.Duration
+	batchSize      int
+	maxRetries     int
+	publishTimeout time.Duration
+	retention      time.Duration
+}
+
+type OutboxOption func(*outboxOptions)
+
+// OutboxRelay 发件箱中继
+// 轮询outbox_events表，将待发布事件交给Listener发布，发布结果由NewOutboxWrapper回写
+type OutboxRelay struct {
+	repo      repository.OutboxEventRepository
+	txManager transaction.TransactionManager
+	eb        Listener
+	opts      outboxOptions
+	relay     *worker.PeriodicWorker
+	cleaner   *worker.PeriodicWorker
+}
+
+// NewOutboxRelay 创建发件箱中继
+func NewOutboxRelay(repo repository.OutboxEventRepository, txManager transaction.TransactionManager, eb Listener, opts ...OutboxOption) *OutboxRelay {
+	r := &OutboxRelay{
+		repo:      repo,
+		txManager: txManager,
+		eb:        eb,
+		opts: outboxOptions{
+			pollInterval:   time.Second,
+			batchSize:      100,
+			maxRetries:     10,
+			publishTimeout: 30 * time.Second,
+			retention:      72 * time.Hour,
+		},
+	}
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	r.relay = worker.NewPeriodicWorker("outbox-relay", r.opts.pollInterval, r.dispatch)
+	r.cleaner = worker.NewPeriodicWorker("outbox-cleaner", time.Hour, r.cleanup)
+	return r
+}
+
+// Start 启动
+func (r *OutboxRelay) Start() {
+	r.relay.Start()
+	r.cleaner.Start()
+}
+
+// Close 关闭
+func (r *OutboxRelay) Close(ctx context.Context) error {
+	err := r.relay.Close(ctx)
+	if cErr := r.cleaner.Close(ctx); cErr != nil && err == nil {
+		err = cErr
+	}
+	return err
+}
+
+// dispatch 锁定一批待发布事件并发布
+// 锁定与标记在同一事务内完成，多实例部署时不会重复领取
+func (r *OutboxRelay) dispatch(ctx context.Context) error {
+	var events []model.OutboxEvent
+	err := r.txManager.Execute(ctx, func(txCtx context.Context) error {
+		var err error
+		events, err = r.repo.FindPendingForUpdate(txCtx, time.Now().Add(-r.opts.publishTimeout), r.opts.batchSize)
+		if err != nil {
+			return err
+		}
+		ids := make([]int64, 0, len(events))
+		for _, item := range events {
+			ids = append(ids, item.ID)
+		}
+		return r.repo.MarkPublishing(txCtx, ids)
+	})
+	if err != nil {
+		return err
+	}
+	for i := range events {
+		item := &events[i]
+		pubCtx := metadata.NewContext(context.Background(), metadata.Metadata{
+			outboxIdKey: strconv.FormatInt(item.ID, 10),
+		})
+		pubCtx = metadata2.WithEventId(pubCtx, item.EventId)
+		pubCtx = withSchemaVersion(pubCtx, item.SchemaVersion)
+		if err := r.eb.PublishRaw(pubCtx, item.Topic, item.Payload, item.EventKey, item.EventType); err != nil {
+			logger.Error("failed to publish outbox event ", item.EventId, " error: ", err.Error())
+			if mErr := r.repo.MarkFailed(ctx, item.ID, err.Error(), r.opts.maxRetries); mErr != nil {
+				logger.Error("failed to mark outbox event ", item.EventId, " failed: ", mErr.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// cleanup 清理保留期之前已发布的事件
+func (r *OutboxRelay) cleanup(ctx context.Context) error {
+	before := time.Now().Add(-r.opts.retention)
+	for {
+		rows, err := r.repo.DeleteSentBefore(ctx, before, 1000)
+		if err != nil {
+			return err
+		}
+		if rows < 1000 || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// NewOutboxWrapper 根据发布回调更新发件箱状态
+func NewOutboxWrapper(repo repository.OutboxEventRepository, maxRetries int) PublishCallbackWrapper {
+	return func(next PublishCallbackFunc) PublishCallbackFunc {
+		return func(ctx context.Context, msg *broker.Message, err error) {
+			next(ctx, msg, err)
+			if strings.HasSuffix(msg.Header["Micro-Topic"], deadletterSuffix) {
+				return
+			}
+			val, ok := msg.Header[outboxIdKey]
+			if !ok {
+				return
+			}
+			id, convErr := strconv.ParseInt(val, 10, 64)
+			if convErr != nil {
+				return
+			}
+			if err == nil {
+				if mErr := repo.MarkSent(context.Background(), id); mErr != nil {
+					logger.Error("failed to mark outbox event ", val, " sent: ", mErr.Error())
+				}
+			} else {
+				if mErr := repo.MarkFailed(context.Background(), id, err.Error(), maxRetries); mErr != nil {
+					logger.Error("failed to mark outbox event ", val, " failed: ", mErr.Error())
+				}
+			}
+		}
+	}
+}
+
+// NewOutboxEvent 序列化事件并生成发件箱记录
+func NewOutboxEvent(topic string, msg proto.Message, key string, eventType string) (*model.OutboxEvent, error) {
+	if topic == "" || eventType == "" {
+		return nil, errors.New("topic and event type cannot be empty")
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &model.OutboxEvent{
+		EventId:       uuid.New().String(),
+		Topic:         topic,
+		EventType:     eventType,
+		EventKey:      key,
+		Payload:       b,
+		SchemaVersion: DefaultSchemaRegistry.LatestVersion(eventType),
+		Status:        model.OutboxStatusPending,
+	}, nil
+}
+
+// WithOutboxPollInterval 轮询间隔
+func WithOutboxPollInterval(interval time.Duration) OutboxOption {
+	return func(o *outboxOptions) {
+		if interval > 0 {
+			o.pollInterval = interval
+		}
+	}
+}
+
+// WithOutboxBatchSize 每次领取的事件数量
+func WithOutboxBatchSize(size int) OutboxOption {
+	return func(o *outboxOptions) {
+		if size > 0 {
+			o.batchSize = size
+		}
+	}
+}
+
+// WithOutboxMaxRetries 最大重试次数
+func WithOutboxMaxRetries(retries int) OutboxOption {
+	return func(o *outboxOptions) {
+		if retries > 0 {
+			o.maxRetries = retries
+		}
+	}
+}
+
+// WithOutboxPublishTimeout 发布中状态超过该时间仍未回调则重新发布
+func WithOutboxPublishTimeout(timeout time.Duration) OutboxOption {
+	return func(o *outboxOptions) {
+		if timeout > 0 {
+			o.publishTimeout = timeout
+		}
+	}
+}
+
+// WithOutboxRetention 已发布事件的保留时间
+func WithOutboxRetention(retention time.Duration) OutboxOption {
+	return func(o *outboxOptions) {
+		if retention > 0 {
+			o.retention = retention
+		}
+	}
+}
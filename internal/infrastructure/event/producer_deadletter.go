@@ -2,11 +2,14 @@ package event
 
 import (
 	"context"
+	"errors"
 	"github.com/go-micro/plugins/v4/wrapper/trace/opentelemetry"
 	"github.com/zhanshen02154/product/internal/config"
+	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/infrastructure/event/monitor"
 	"go-micro.dev/v4/broker"
 	"go-micro.dev/v4/logger"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"strconv"
@@ -23,6 +26,7 @@ type deadletterOptions struct {
 		traceProvider trace.TracerProvider
 		service       string
 		version       string
+		broker        broker.Broker
 	}
 }
 
@@ -43,6 +47,10 @@ func NewDeadletterWrapper(opts ...DeadLetterOption) PublishCallbackWrapper {
 			if strings.HasSuffix(msg.Header["Micro-Topic"], deadletterSuffix) {
 				return
 			}
+			// 发件箱事件由中继重试，失败记录保留在outbox_events
+			if _, ok := msg.Header[outboxIdKey]; ok {
+				return
+			}
 			spanOpts := []trace.SpanStartOption{
 				trace.WithSpanKind(trace.SpanKindProducer),
 			}
@@ -50,25 +58,28 @@ func NewDeadletterWrapper(opts ...DeadLetterOption) PublishCallbackWrapper {
 			newCtx, span := opentelemetry.StartSpanFromContext(ctx, dlqOptions.opts.traceProvider, "Pub to dead letter topic "+topic, spanOpts...)
 			defer span.End()
 			header := make(map[string]string)
-			header["x-origin-topic"] = msg.Header["Micro-Topic"]
-			header["Event-Type"] = msg.Header["Event-Type"] + deadletterSuffix
-			header["x-error"] = err.Error()
-			header["x-origin-timestamp"] = msg.Header["Timestamp"]
 			for k, v := range msg.Header {
 				if k == "Timestamp" || k == traceparentKey {
 					continue
 				}
 				header[k] = v
 			}
+			header[originTopicKey] = msg.Header["Micro-Topic"]
+			header["Event-Type"] = msg.Header["Event-Type"] + deadletterSuffix
+			header[deadLetterErrorKey] = err.Error()
+			header[originTimestampKey] = msg.Header["Timestamp"]
 			header["Timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
 			header["Micro-Topic"] = topic
 			header["Source"] = dlqOptions.opts.service
-			header["Schema_version"] = dlqOptions.opts.version
 			dlMsg := broker.Message{
 				Header: header,
 				Body:   msg.Body,
 			}
-			if pErr := broker.Publish(topic, &dlMsg, broker.PublishContext(newCtx)); pErr != nil {
+			b := dlqOptions.opts.broker
+			if b == nil {
+				b = broker.DefaultBroker
+			}
+			if pErr := b.Publish(topic, &dlMsg, broker.PublishContext(newCtx)); pErr != nil {
 				logger.Error("Failed to publish dead letter topic " + topic + " error: " + pErr.Error())
 				span.SetStatus(codes.Error, pErr.Error())
 				span.RecordError(pErr)
@@ -80,6 +91,54 @@ func NewDeadletterWrapper(opts ...DeadLetterOption) PublishCallbackWrapper {
 	}
 }
 
+// DeadLetterReplayer 死信重新投递
+type DeadLetterReplayer interface {
+	// Replay 将死信还原为原消息并发布到原主题
+	Replay(ctx context.Context, deadLetter *model.DeadLetter) error
+}
+
+// deadLetterReplayer 通过broker直接发布原消息，不经过事件总线重新封装
+type deadLetterReplayer struct {
+	opts deadletterOptions
+}
+
+// NewDeadLetterReplayer 创建死信重新投递器，未指定broker时使用默认broker
+func NewDeadLetterReplayer(opts ...DeadLetterOption) DeadLetterReplayer {
+	r := &deadLetterReplayer{}
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	return r
+}
+
+// Replay 重新投递到原主题，发布结果仍由发布回调处理，再次失败时会重新进入死信队列
+func (r *deadLetterReplayer) Replay(ctx context.Context, deadLetter *model.DeadLetter) error {
+	if deadLetter.OriginTopic == "" {
+		return errors.New("origin topic of dead letter " + strconv.FormatInt(deadLetter.ID, 10) + " is empty")
+	}
+	msg, err := NewReplayMessage(deadLetter)
+	if err != nil {
+		return err
+	}
+	b := r.opts.opts.broker
+	if b == nil {
+		b = broker.DefaultBroker
+	}
+	tracerProvider := r.opts.opts.traceProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	newCtx, span := opentelemetry.StartSpanFromContext(ctx, tracerProvider, "Replay dead letter to topic "+deadLetter.OriginTopic, trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
+	if pErr := b.Publish(deadLetter.OriginTopic, msg, broker.PublishContext(newCtx)); pErr != nil {
+		span.SetStatus(codes.Error, pErr.Error())
+		span.RecordError(pErr)
+		return pErr
+	}
+	monitor.MessagesInFlight.WithLabelValues(deadLetter.OriginTopic, r.opts.opts.service, r.opts.opts.version).Inc()
+	return nil
+}
+
 func WithTracer(tracerProvider trace.TracerProvider) DeadLetterOption {
 	return func(d *deadletterOptions) {
 		d.opts.traceProvider = tracerProvider
@@ -93,3 +152,10 @@ func WithServiceInfo(info *config.ServiceInfo) DeadLetterOption {
 		o.opts.version = info.Version
 	}
 }
+
+// WithBroker 指定发布死信使用的broker，未指定时使用默认broker
+func WithBroker(b broker.Broker) DeadLetterOption {
+	return func(o *deadletterOptions) {
+		o.opts.broker = b
+	}
+}
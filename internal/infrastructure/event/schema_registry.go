@@ -0,0 +1,158 @@
+package event
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// defaultSchemaVersion 未声明结构版本的事件按版本1处理
+const defaultSchemaVersion int32 = 1
+
+// Upcaster 将某一版本的事件内容升级为下一版本
+type Upcaster func(msg proto.Message) (proto.Message, error)
+
+// EventSchema 事件某一版本的结构
+type EventSchema struct {
+	EventType string
+	Version   int32
+	// Descriptor 该版本事件内容的消息结构，已生成Go类型的按生成类型解码，否则按动态消息解码
+	Descriptor protoreflect.MessageDescriptor
+	// Upcast 升级到下一版本，最新版本不需要
+	Upcast Upcaster
+}
+
+// SchemaRegistry 事件结构注册表
+// 按事件类型和结构版本登记消息结构及升级函数，解码时将旧版本逐级升级为最新版本；未登记的事件类型按原样解码
+type SchemaRegistry struct {
+	mu      sync.RWMutex
+	schemas map[string]map[int32]*EventSchema // 事件类型 -> 版本 -> 结构
+	latest  map[string]int32                  // 事件类型 -> 最新版本
+}
+
+// DefaultSchemaRegistry 默认事件结构注册表，发布事件和通用事件处理器默认使用
+var DefaultSchemaRegistry = NewSchemaRegistry()
+
+// NewSchemaRegistry 创建事件结构注册表
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{
+		schemas: make(map[string]map[int32]*EventSchema),
+		latest:  make(map[string]int32),
+	}
+}
+
+// RegisterSchema 向默认注册表登记事件结构
+func RegisterSchema(schema EventSchema) error {
+	return DefaultSchemaRegistry.Register(schema)
+}
+
+// Register 登记事件结构，同一事件类型的同一版本只能登记一次
+func (r *SchemaRegistry) Register(schema EventSchema) error {
+	if schema.EventType == "" {
+		return fmt.Errorf("event type cannot be empty")
+	}
+	if schema.Version < defaultSchemaVersion {
+		return fmt.Errorf("invalid schema version %d of event %s", schema.Version, schema.EventType)
+	}
+	if schema.Descriptor == nil {
+		return fmt.Errorf("descriptor of event %s version %d cannot be nil", schema.EventType, schema.Version)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions, ok := r.schemas[schema.EventType]
+	if !ok {
+		versions = make(map[int32]*EventSchema)
+		r.schemas[schema.EventType] = versions
+	}
+	if _, exists := versions[schema.Version]; exists {
+		return fmt.Errorf("schema of event %s version %d already registered", schema.EventType, schema.Version)
+	}
+	versions[schema.Version] = &schema
+	if schema.Version > r.latest[schema.EventType] {
+		r.latest[schema.EventType] = schema.Version
+	}
+	return nil
+}
+
+// LatestVersion 获取事件的最新结构版本，未登记的事件类型返回版本1
+func (r *SchemaRegistry) LatestVersion(eventType string) int32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if version, ok := r.latest[eventType]; ok {
+		return version
+	}
+	return defaultSchemaVersion
+}
+
+// Decode 将事件内容解码到target，旧版本的内容逐级升级到最新版本
+// 事件类型未登记时直接解码；版本高于最新版本时返回错误，由死信队列保存待升级后重新投递
+func (r *SchemaRegistry) Decode(baseEvent *event.BaseEvent, target proto.Message) error {
+	if baseEvent == nil {
+		return fmt.Errorf("base event is nil")
+	}
+	if len(baseEvent.Payload) == 0 {
+		return fmt.Errorf("payload is empty")
+	}
+	version := baseEvent.SchemaVersion
+	if version == 0 {
+		version = defaultSchemaVersion
+	}
+
+	r.mu.RLock()
+	versions, registered := r.schemas[baseEvent.EventType]
+	latest := r.latest[baseEvent.EventType]
+	r.mu.RUnlock()
+	if !registered || version == latest {
+		if err := proto.Unmarshal(baseEvent.Payload, target); err != nil {
+			return fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+		return nil
+	}
+	if version > latest {
+		return fmt.Errorf("unsupported schema version %d of event %s, latest version is %d", version, baseEvent.EventType, latest)
+	}
+
+	schema, ok := versions[version]
+	if !ok {
+		return fmt.Errorf("schema of event %s version %d is not registered", baseEvent.EventType, version)
+	}
+	msg := newSchemaMessage(schema.Descriptor)
+	if err := proto.Unmarshal(baseEvent.Payload, msg); err != nil {
+		return fmt.Errorf("failed to unmarshal payload of version %d: %w", version, err)
+	}
+	for v := version; v < latest; v++ {
+		schema, ok := versions[v]
+		if !ok || schema.Upcast == nil {
+			return fmt.Errorf("no upcaster from version %d of event %s", v, baseEvent.EventType)
+		}
+		upcasted, err := schema.Upcast(msg)
+		if err != nil {
+			return fmt.Errorf("failed to upcast event %s from version %d: %w", baseEvent.EventType, v, err)
+		}
+		msg = upcasted
+	}
+
+	// 升级结果与target不一定是同一Go类型（如动态消息），经序列化转换
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal upcasted payload: %w", err)
+	}
+	if err := proto.Unmarshal(b, target); err != nil {
+		return fmt.Errorf("failed to unmarshal upcasted payload: %w", err)
+	}
+	return nil
+}
+
+// newSchemaMessage 创建消息结构对应的消息，已生成Go类型的使用生成类型以便升级函数类型断言
+func newSchemaMessage(descriptor protoreflect.MessageDescriptor) proto.Message {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(descriptor.FullName()); err == nil {
+		return mt.New().Interface()
+	}
+	return dynamicpb.NewMessage(descriptor)
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"fmt"
+
+	"github.com/zhanshen02154/product/internal/domain/event/order"
+	productEvent "github.com/zhanshen02154/product/internal/domain/event/product"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func init() {
+	if err := RegisterEventSchemas(DefaultSchemaRegistry); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterEventSchemas 登记本服务发布和订阅的事件结构
+// 新增字段或调整结构时登记新版本，并为上一版本提供升级函数
+func RegisterEventSchemas(r *SchemaRegistry) error {
+	paymentSuccessV1, err := paymentSuccessV1Descriptor()
+	if err != nil {
+		return err
+	}
+	schemas := []EventSchema{
+		// 版本2增加收货地址经纬度，用于就近分仓
+		{EventType: "OnPaymentSuccess", Version: 1, Descriptor: paymentSuccessV1, Upcast: upcastByWireFormat(func() proto.Message { return &order.OnPaymentSuccess{} })},
+		{EventType: "OnPaymentSuccess", Version: 2, Descriptor: (&order.OnPaymentSuccess{}).ProtoReflect().Descriptor()},
+		{EventType: "OnOrderRefunded", Version: 1, Descriptor: (&order.OnOrderRefunded{}).ProtoReflect().Descriptor()},
+		{EventType: "OnOrderCancelled", Version: 1, Descriptor: (&order.OnOrderCancelled{}).ProtoReflect().Descriptor()},
+		{EventType: "OnInventoryDeductSuccess", Version: 1, Descriptor: (&productEvent.OnInventoryDeductSuccess{}).ProtoReflect().Descriptor()},
+		{EventType: "OnInventoryRestored", Version: 1, Descriptor: (&productEvent.OnInventoryRestored{}).ProtoReflect().Descriptor()},
+		{EventType: "OnRestockApproved", Version: 1, Descriptor: (&productEvent.OnRestockApproved{}).ProtoReflect().Descriptor()},
+		{EventType: "OnRestockRejected", Version: 1, Descriptor: (&productEvent.OnRestockRejected{}).ProtoReflect().Descriptor()},
+		{EventType: "OnStockReceived", Version: 1, Descriptor: (&productEvent.OnStockReceived{}).ProtoReflect().Descriptor()},
+		{EventType: "OnStockAdjusted", Version: 1, Descriptor: (&productEvent.OnStockAdjusted{}).ProtoReflect().Descriptor()},
+		{EventType: "OnSkuPriceChanged", Version: 1, Descriptor: (&productEvent.OnSkuPriceChanged{}).ProtoReflect().Descriptor()},
+		{EventType: "OnProductStatusChanged", Version: 1, Descriptor: (&productEvent.OnProductStatusChanged{}).ProtoReflect().Descriptor()},
+	}
+	for _, schema := range schemas {
+		if err := r.Register(schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paymentSuccessV1Descriptor 版本1的支付成功事件：没有收货地址经纬度
+func paymentSuccessV1Descriptor() (protoreflect.MessageDescriptor, error) {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   typ.Enum(),
+			Label:  label.Enum(),
+		}
+	}
+	details := field("OrderDetails", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+	details.TypeName = proto.String("." + string((&order.OrderDetail{}).ProtoReflect().Descriptor().FullName()))
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("order/order_event_v1.proto"),
+		Package:    proto.String("order.event.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{order.File_order_order_event_proto.Path()},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("OnPaymentSuccess"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("OrderId", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+				details,
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OnPaymentSuccess v1 descriptor: %w", err)
+	}
+	return file.Messages().Get(0), nil
+}
+
+// upcastByWireFormat 只新增字段的版本升级，字段编号不变，按序列化结果转换为下一版本
+func upcastByWireFormat(next func() proto.Message) Upcaster {
+	return func(msg proto.Message) (proto.Message, error) {
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		upcasted := next()
+		if err := proto.Unmarshal(b, upcasted); err != nil {
+			return nil, err
+		}
+		return upcasted, nil
+	}
+}
@@ -48,7 +48,7 @@ func ErrorHandler() broker.Handler {
 		if err != nil {
 			logger.Errorf("failed to publish to %s, error: %s", topic, err.Error())
 		} else {
-			monitor.MessagesInFlight.WithLabelValues(topic, event.Message().Header["Source"], event.Message().Header["Schema_version"]).Inc()
+			monitor.MessagesInFlight.WithLabelValues(topic, event.Message().Header["Source"], event.Message().Header["Producer_version"]).Inc()
 		}
 		if err := event.Ack(); err != nil {
 			logger.Errorf("failed to ack to %s, error: %s", topic, err.Error())
@@ -63,6 +63,8 @@ func (w *deadLetterHandler) publishDeadLetter(ctx context.Context, topic string,
 	for k, v := range msg.Header {
 		header[k] = v
 	}
+	header["x-origin-topic"] = msg.Header["Micro-Topic"]
+	header["x-origin-timestamp"] = msg.Header["Timestamp"]
 	header["Timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
 	header["Micro-Topic"] = topic
 	dlMsg := broker.Message{
@@ -25,10 +25,15 @@ func (w *MetaDataWrapper) Publish(ctx context.Context, msg client.Message, opts
 		md = make(map[string]string)
 	}
 	md["Trace_id"] = metadata2.GetTraceIdFromSpan(ctx)
-	md["Event_id"] = uuid.New().String()
+	if eventId, ok := metadata2.GetPublishEventId(ctx); ok {
+		md["Event_id"] = eventId
+	} else {
+		md["Event_id"] = uuid.New().String()
+	}
 	md["Timestamp"] = strconv.FormatInt(startTime.UnixMilli(), 10)
 	md["Source"] = w.serviceName
-	md["Schema_version"] = w.serviceVersion
+	// Schema_version为事件内容的结构版本，由事件总线按注册表设置
+	md["Producer_version"] = w.serviceVersion
 	ctx = metadata.NewContext(ctx, md)
 	return w.Client.Publish(ctx, msg, opts...)
 }
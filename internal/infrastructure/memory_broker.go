@@ -0,0 +1,481 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/google/uuid"
+	"github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/logger"
+)
+
+const (
+	memoryPartitionKey       = "Pkey"
+	memoryDefaultPartitions  = 3
+	memoryDefaultAckCapacity = 1024
+)
+
+type memoryAsyncProducerKey struct{}
+
+type memoryPartitionsKey struct{}
+
+type memoryPublishInterceptorKey struct{}
+
+// memoryAsyncProducer 发布结果回写的通道
+type memoryAsyncProducer struct {
+	successes chan<- *sarama.ProducerMessage
+	errors    chan<- *sarama.ProducerError
+}
+
+// MemoryPublishInterceptor 发布拦截函数，返回错误时消息不写入主题，按发布失败处理
+type MemoryPublishInterceptor func(topic string, msg *broker.Message) error
+
+// MemoryAsyncProducer 与kafka.AsyncProducer一致，发布结果写入success/error通道，未设置时同步返回发布错误
+func MemoryAsyncProducer(errors chan<- *sarama.ProducerError, successes chan<- *sarama.ProducerMessage) broker.Option {
+	return setBrokerOption(memoryAsyncProducerKey{}, &memoryAsyncProducer{successes: successes, errors: errors})
+}
+
+// MemoryPartitions 每个主题的分区数量
+func MemoryPartitions(partitions int32) broker.Option {
+	return setBrokerOption(memoryPartitionsKey{}, partitions)
+}
+
+// WithMemoryPublishInterceptor 注入发布拦截函数，用于模拟发布失败
+func WithMemoryPublishInterceptor(fn MemoryPublishInterceptor) broker.Option {
+	return setBrokerOption(memoryPublishInterceptorKey{}, fn)
+}
+
+func setBrokerOption(k, v interface{}) broker.Option {
+	return func(o *broker.Options) {
+		if o.Context == nil {
+			o.Context = context.Background()
+		}
+		o.Context = context.WithValue(o.Context, k, v)
+	}
+}
+
+// waitGroupDone 等待WaitGroup完成，timeout先到时返回false
+func waitGroupDone(wg *sync.WaitGroup, timeout <-chan time.Time) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-timeout:
+		return false
+	}
+}
+
+// memoryBroker 进程内Broker，模拟Kafka的分区、消费者组及异步发布回执，用于不依赖Kafka的测试
+type memoryBroker struct {
+	mu        sync.RWMutex
+	opts      broker.Options
+	connected bool
+	topics    map[string]*memoryTopic
+	acks      chan *memoryAck
+	quit      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// memoryAck 发布回执
+type memoryAck struct {
+	msg *sarama.ProducerMessage
+	err error
+}
+
+// memoryTopic 主题，消息按分区保存，消费者组记录各分区的消费位置
+type memoryTopic struct {
+	name       string
+	partitions []*memoryPartition
+	groups     map[string]*memoryGroup
+}
+
+// memoryPartition 分区日志
+type memoryPartition struct {
+	mu      sync.Mutex
+	records []*broker.Message
+	notify  chan struct{}
+}
+
+// memoryGroup 消费者组，每个分区同一时刻只由一个消费者按顺序消费
+type memoryGroup struct {
+	mu          sync.Mutex
+	name        string
+	offsets     []int64
+	subscribers []*memorySubscriber
+	notify      chan struct{}
+}
+
+type memorySubscriber struct {
+	topic   *memoryTopic
+	group   *memoryGroup
+	handler broker.Handler
+	opts    broker.SubscribeOptions
+}
+
+// memoryEvent 消费的消息
+type memoryEvent struct {
+	topic string
+	msg   *broker.Message
+	err   error
+}
+
+func (e *memoryEvent) Topic() string {
+	return e.topic
+}
+
+func (e *memoryEvent) Message() *broker.Message {
+	return e.msg
+}
+
+func (e *memoryEvent) Ack() error {
+	return nil
+}
+
+func (e *memoryEvent) Error() error {
+	return e.err
+}
+
+func (s *memorySubscriber) Options() broker.SubscribeOptions {
+	return s.opts
+}
+
+func (s *memorySubscriber) Topic() string {
+	return s.topic.name
+}
+
+// Unsubscribe 取消订阅，组内无消费者时暂停消费并保留消费位置
+func (s *memorySubscriber) Unsubscribe() error {
+	g := s.group
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for i, sub := range g.subscribers {
+		if sub == s {
+			g.subscribers = append(g.subscribers[:i], g.subscribers[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (b *memoryBroker) Init(opts ...broker.Option) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, o := range opts {
+		o(&b.opts)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Options() broker.Options {
+	return b.opts
+}
+
+func (b *memoryBroker) Address() string {
+	return "memory"
+}
+
+// Connect 连接，启动发布回执的转发
+func (b *memoryBroker) Connect() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.connected {
+		return nil
+	}
+	b.quit = make(chan struct{})
+	if producer := b.asyncProducer(); producer != nil {
+		b.acks = make(chan *memoryAck, memoryDefaultAckCapacity)
+		b.wg.Add(1)
+		go b.forwardAcks(producer, b.acks, b.quit)
+	}
+	b.connected = true
+	return nil
+}
+
+// Disconnect 断开连接，停止所有消费者组
+func (b *memoryBroker) Disconnect() error {
+	b.mu.Lock()
+	if !b.connected {
+		b.mu.Unlock()
+		return nil
+	}
+	for _, t := range b.topics {
+		t.groups = make(map[string]*memoryGroup)
+	}
+	close(b.quit)
+	b.connected = false
+	b.mu.Unlock()
+	b.wg.Wait()
+	return nil
+}
+
+// Publish 按Pkey哈希写入分区，异步模式下通过回执通道返回发布结果
+func (b *memoryBroker) Publish(topic string, msg *broker.Message, opts ...broker.PublishOption) error {
+	b.mu.Lock()
+	if !b.connected {
+		b.mu.Unlock()
+		return errors.New("memory broker not connected")
+	}
+	acks := b.acks
+	quit := b.quit
+	producerMsg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Value:     sarama.ByteEncoder(msg.Body),
+		Metadata:  msg,
+		Partition: -1,
+		Offset:    -1,
+	}
+	if key, ok := msg.Header[memoryPartitionKey]; ok && key != "" {
+		producerMsg.Key = sarama.StringEncoder(key)
+	}
+
+	var err error
+	if interceptor, ok := b.opts.Context.Value(memoryPublishInterceptorKey{}).(MemoryPublishInterceptor); ok && interceptor != nil {
+		err = interceptor(topic, msg)
+	}
+	if err == nil {
+		t := b.getTopic(topic)
+		partition := b.partition(msg, int32(len(t.partitions)))
+		producerMsg.Partition = partition
+		producerMsg.Offset = t.partitions[partition].append(copyMemoryMessage(msg))
+	}
+	b.mu.Unlock()
+
+	if acks == nil {
+		return err
+	}
+	select {
+	case acks <- &memoryAck{msg: producerMsg, err: err}:
+	case <-quit:
+		return errors.New("memory broker disconnected")
+	}
+	return nil
+}
+
+// Subscribe 订阅，Queue相同的订阅者属于同一消费者组，新建的消费者组从最早的消息开始消费
+func (b *memoryBroker) Subscribe(topic string, handler broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	options := broker.SubscribeOptions{
+		AutoAck: true,
+		Queue:   uuid.New().String(),
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.connected {
+		return nil, errors.New("memory broker not connected")
+	}
+	t := b.getTopic(topic)
+	g, ok := t.groups[options.Queue]
+	if !ok {
+		g = &memoryGroup{
+			name:    options.Queue,
+			offsets: make([]int64, len(t.partitions)),
+			notify:  make(chan struct{}),
+		}
+		t.groups[options.Queue] = g
+		for i := range t.partitions {
+			b.wg.Add(1)
+			go b.consume(t, g, int32(i), b.quit)
+		}
+	}
+	sub := &memorySubscriber{
+		topic:   t,
+		group:   g,
+		handler: handler,
+		opts:    options,
+	}
+	g.mu.Lock()
+	g.subscribers = append(g.subscribers, sub)
+	close(g.notify)
+	g.notify = make(chan struct{})
+	g.mu.Unlock()
+	return sub, nil
+}
+
+func (b *memoryBroker) String() string {
+	return "memory"
+}
+
+// getTopic 获取主题，不存在时创建，调用方须持有锁
+func (b *memoryBroker) getTopic(name string) *memoryTopic {
+	if t, ok := b.topics[name]; ok {
+		return t
+	}
+	partitions := int32(memoryDefaultPartitions)
+	if v, ok := b.opts.Context.Value(memoryPartitionsKey{}).(int32); ok && v > 0 {
+		partitions = v
+	}
+	t := &memoryTopic{
+		name:       name,
+		partitions: make([]*memoryPartition, partitions),
+		groups:     make(map[string]*memoryGroup),
+	}
+	for i := range t.partitions {
+		t.partitions[i] = &memoryPartition{notify: make(chan struct{})}
+	}
+	b.topics[name] = t
+	return t
+}
+
+// partition 与sarama的哈希分区器一致，相同Pkey的消息写入同一分区，无Pkey时写入0号分区
+func (b *memoryBroker) partition(msg *broker.Message, partitions int32) int32 {
+	key, ok := msg.Header[memoryPartitionKey]
+	if !ok || key == "" {
+		return 0
+	}
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(key))
+	partition := int32(hasher.Sum32()) % partitions
+	if partition < 0 {
+		partition = -partition
+	}
+	return partition
+}
+
+func (b *memoryBroker) asyncProducer() *memoryAsyncProducer {
+	if b.opts.Context == nil {
+		return nil
+	}
+	producer, ok := b.opts.Context.Value(memoryAsyncProducerKey{}).(*memoryAsyncProducer)
+	if !ok || producer.errors == nil {
+		return nil
+	}
+	return producer
+}
+
+// forwardAcks 按发布顺序将回执写入success/error通道
+func (b *memoryBroker) forwardAcks(producer *memoryAsyncProducer, acks chan *memoryAck, quit chan struct{}) {
+	defer b.wg.Done()
+	for {
+		select {
+		case ack := <-acks:
+			if ack.err != nil {
+				select {
+				case producer.errors <- &sarama.ProducerError{Msg: ack.msg, Err: ack.err}:
+				case <-quit:
+					return
+				}
+				continue
+			}
+			if producer.successes == nil {
+				continue
+			}
+			select {
+			case producer.successes <- ack.msg:
+			case <-quit:
+				return
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// consume 按顺序消费分区，处理失败时交给ErrorHandler并继续消费后续消息
+func (b *memoryBroker) consume(t *memoryTopic, g *memoryGroup, partition int32, quit chan struct{}) {
+	defer b.wg.Done()
+	p := t.partitions[partition]
+	for {
+		g.mu.Lock()
+		offset := g.offsets[partition]
+		g.mu.Unlock()
+		msg, notify := p.get(offset)
+		if msg == nil {
+			select {
+			case <-notify:
+				continue
+			case <-quit:
+				return
+			}
+		}
+		g.mu.Lock()
+		if len(g.subscribers) == 0 {
+			notify = g.notify
+			g.mu.Unlock()
+			select {
+			case <-notify:
+				continue
+			case <-quit:
+				return
+			}
+		}
+		sub := g.subscribers[int(partition)%len(g.subscribers)]
+		g.mu.Unlock()
+		msg.Header["Micro-Topic"] = t.name
+		msg.Header[event.PartitionHeader] = strconv.FormatInt(int64(partition), 10)
+		if _, ok := msg.Header["Content-Type"]; !ok {
+			msg.Header["Content-Type"] = "application/json"
+		}
+
+		evt := &memoryEvent{topic: t.name, msg: msg}
+		if err := sub.handler(evt); err != nil {
+			evt.err = err
+			if eh := b.opts.ErrorHandler; eh != nil {
+				_ = eh(evt)
+			} else {
+				logger.Errorf("[memory]: subscriber error: %v", err)
+			}
+		}
+		g.mu.Lock()
+		g.offsets[partition] = offset + 1
+		g.mu.Unlock()
+	}
+}
+
+// append 写入分区并唤醒等待的消费者，返回消息的偏移量
+func (p *memoryPartition) append(msg *broker.Message) int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.records = append(p.records, msg)
+	close(p.notify)
+	p.notify = make(chan struct{})
+	return int64(len(p.records) - 1)
+}
+
+// get 读取偏移量处的消息，不存在时返回等待新消息的通道，消费者拿到的是消息副本
+func (p *memoryPartition) get(offset int64) (*broker.Message, chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if offset >= int64(len(p.records)) {
+		return nil, p.notify
+	}
+	return copyMemoryMessage(p.records[offset]), nil
+}
+
+// copyMemoryMessage 复制消息，模拟经过网络传输后的独立副本
+func copyMemoryMessage(msg *broker.Message) *broker.Message {
+	header := make(map[string]string, len(msg.Header))
+	for k, v := range msg.Header {
+		header[k] = v
+	}
+	body := make([]byte, len(msg.Body))
+	copy(body, msg.Body)
+	return &broker.Message{Header: header, Body: body}
+}
+
+// NewMemoryBroker 创建进程内Broker
+func NewMemoryBroker(opts ...broker.Option) broker.Broker {
+	options := broker.Options{
+		Context: context.Background(),
+		Logger:  logger.DefaultLogger,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+	return &memoryBroker{
+		opts:   options,
+		topics: make(map[string]*memoryTopic),
+	}
+}
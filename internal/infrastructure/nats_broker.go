@@ -0,0 +1,445 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/logger"
+)
+
+const (
+	natsDefaultAckCapacity   = 1024
+	natsDisconnectAckTimeout = 5 * time.Second
+)
+
+type natsPublishAcksKey struct{}
+
+type natsMaxAckPendingKey struct{}
+
+type natsAckWaitKey struct{}
+
+// NatsPublishAcks 发布确认写入回执通道，未设置时同步等待确认并返回发布错误
+func NatsPublishAcks(acks chan<- *event.PublishAck) broker.Option {
+	return setBrokerOption(natsPublishAcksKey{}, acks)
+}
+
+// NatsMaxAckPending 每个消费者已投递未确认的消息数量上限
+func NatsMaxAckPending(n int) broker.Option {
+	return setBrokerOption(natsMaxAckPendingKey{}, n)
+}
+
+// NatsAckWait 消息投递后未确认时服务端重新投递的等待时间
+func NatsAckWait(d time.Duration) broker.Option {
+	return setBrokerOption(natsAckWaitKey{}, d)
+}
+
+// natsBroker 基于NATS JetStream的Broker，每个主题对应一个同名流，消费者组对应持久化的推送消费者
+type natsBroker struct {
+	mu        sync.RWMutex
+	opts      broker.Options
+	conn      *nats.Conn
+	js        nats.JetStreamContext
+	connected bool
+	streamsMu sync.Mutex
+	streams   map[string]string
+	pending   chan *natsPendingPublish
+	closing   chan struct{}
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	sending   sync.WaitGroup
+}
+
+// natsPendingPublish 等待服务端确认的发布
+type natsPendingPublish struct {
+	msg    *broker.Message
+	future nats.PubAckFuture
+}
+
+type natsSubscriber struct {
+	topic string
+	sub   *nats.Subscription
+	opts  broker.SubscribeOptions
+}
+
+// natsEvent 消费的消息，Ack只向服务端确认一次
+type natsEvent struct {
+	topic string
+	msg   *broker.Message
+	raw   *nats.Msg
+	once  sync.Once
+	err   error
+}
+
+func (e *natsEvent) Topic() string {
+	return e.topic
+}
+
+func (e *natsEvent) Message() *broker.Message {
+	return e.msg
+}
+
+func (e *natsEvent) Ack() error {
+	var err error
+	e.once.Do(func() {
+		err = e.raw.Ack()
+	})
+	return err
+}
+
+func (e *natsEvent) Error() error {
+	return e.err
+}
+
+// nak 处理失败且没有ErrorHandler时让服务端重新投递
+func (e *natsEvent) nak() error {
+	var err error
+	e.once.Do(func() {
+		err = e.raw.Nak()
+	})
+	return err
+}
+
+func (s *natsSubscriber) Options() broker.SubscribeOptions {
+	return s.opts
+}
+
+func (s *natsSubscriber) Topic() string {
+	return s.topic
+}
+
+// Unsubscribe 取消订阅，持久化消费者保留在服务端，消费位置不丢失
+func (s *natsSubscriber) Unsubscribe() error {
+	return s.sub.Unsubscribe()
+}
+
+func (b *natsBroker) Init(opts ...broker.Option) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, o := range opts {
+		o(&b.opts)
+	}
+	return nil
+}
+
+func (b *natsBroker) Options() broker.Options {
+	return b.opts
+}
+
+func (b *natsBroker) Address() string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.conn != nil {
+		return b.conn.ConnectedUrl()
+	}
+	return strings.Join(b.opts.Addrs, ",")
+}
+
+// Connect 连接并创建JetStream上下文，异步模式下启动发布确认的转发
+func (b *natsBroker) Connect() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.connected {
+		return nil
+	}
+	addrs := b.opts.Addrs
+	if len(addrs) == 0 {
+		addrs = []string{nats.DefaultURL}
+	}
+	conn, err := nats.Connect(strings.Join(addrs, ","), nats.Name("product"))
+	if err != nil {
+		return err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	b.conn = conn
+	b.js = js
+	b.quit = make(chan struct{})
+	if acks := b.publishAcks(); acks != nil {
+		b.pending = make(chan *natsPendingPublish, natsDefaultAckCapacity)
+		b.closing = make(chan struct{})
+		b.wg.Add(1)
+		go b.forwardAcks(acks, b.pending, b.closing, b.quit)
+	}
+	b.connected = true
+	return nil
+}
+
+// Disconnect 等待已发布消息的确认转发完成后断开连接
+func (b *natsBroker) Disconnect() error {
+	b.mu.Lock()
+	if !b.connected {
+		b.mu.Unlock()
+		return nil
+	}
+	b.connected = false
+	conn := b.conn
+	closing, quit := b.closing, b.quit
+	b.pending = nil
+	b.closing = nil
+	b.mu.Unlock()
+
+	// 最多等待一段时间，让正在发布的消息进入待确认队列、已发布消息的确认进入回执通道
+	timeout := time.After(natsDisconnectAckTimeout)
+	if waitGroupDone(&b.sending, timeout) && closing != nil {
+		close(closing)
+		waitGroupDone(&b.wg, timeout)
+	}
+	close(quit)
+	b.sending.Wait()
+	b.wg.Wait()
+	err := conn.Drain()
+	if errors.Is(err, nats.ErrConnectionClosed) {
+		return nil
+	}
+	return err
+}
+
+// Publish 发布到主题对应的流，异步模式下确认结果通过回执通道返回
+// 等待写入待确认队列时不持有锁，避免回执通道阻塞时Disconnect无法获取写锁
+func (b *natsBroker) Publish(topic string, msg *broker.Message, opts ...broker.PublishOption) error {
+	m := nats.NewMsg(topic)
+	for k, v := range msg.Header {
+		m.Header.Set(k, v)
+	}
+	m.Data = msg.Body
+
+	b.mu.RLock()
+	if !b.connected {
+		b.mu.RUnlock()
+		return errors.New("nats broker not connected")
+	}
+	pending, quit := b.pending, b.quit
+	if pending != nil {
+		b.sending.Add(1)
+		defer b.sending.Done()
+	}
+	js := b.js
+	_, err := b.ensureStream(topic)
+	b.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if pending == nil {
+		_, err := js.PublishMsg(m)
+		return err
+	}
+	future, err := js.PublishMsgAsync(m)
+	if err != nil {
+		return err
+	}
+	select {
+	case pending <- &natsPendingPublish{msg: msg, future: future}:
+	case <-quit:
+		return errors.New("nats broker disconnected")
+	}
+	return nil
+}
+
+// Subscribe 订阅，Queue相同的订阅者共享同一个持久化消费者，未设置Queue时使用临时消费者
+func (b *natsBroker) Subscribe(topic string, handler broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	options := broker.SubscribeOptions{
+		AutoAck: true,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.connected {
+		return nil, errors.New("nats broker not connected")
+	}
+	stream, err := b.ensureStream(topic)
+	if err != nil {
+		return nil, err
+	}
+	cb := func(m *nats.Msg) {
+		b.handle(topic, m, handler, options)
+	}
+
+	var sub *nats.Subscription
+	if options.Queue == "" {
+		subOpts := []nats.SubOpt{nats.BindStream(stream), nats.ManualAck(), nats.DeliverAll()}
+		if ackWait := b.ackWait(); ackWait > 0 {
+			subOpts = append(subOpts, nats.AckWait(ackWait))
+		}
+		sub, err = b.js.Subscribe(topic, cb, subOpts...)
+	} else {
+		durable := natsName(options.Queue)
+		if err = b.ensureConsumer(stream, durable, topic, options.Queue); err != nil {
+			return nil, err
+		}
+		sub, err = b.js.QueueSubscribe(topic, options.Queue, cb, nats.Bind(stream, durable), nats.ManualAck())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &natsSubscriber{topic: topic, sub: sub, opts: options}, nil
+}
+
+func (b *natsBroker) String() string {
+	return "nats"
+}
+
+// ensureStream 获取主题对应的流，不存在时创建，返回流名称
+func (b *natsBroker) ensureStream(topic string) (string, error) {
+	b.streamsMu.Lock()
+	defer b.streamsMu.Unlock()
+	if name, ok := b.streams[topic]; ok {
+		return name, nil
+	}
+	name := natsName(topic)
+	_, err := b.js.StreamInfo(name)
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		_, err = b.js.AddStream(&nats.StreamConfig{
+			Name:     name,
+			Subjects: []string{topic},
+			Storage:  nats.FileStorage,
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+	b.streams[topic] = name
+	return name, nil
+}
+
+// ensureConsumer 创建消费者组对应的持久化消费者，新建的消费者从最早的消息开始消费
+func (b *natsBroker) ensureConsumer(stream, durable, topic, queue string) error {
+	_, err := b.js.ConsumerInfo(stream, durable)
+	if err == nil || !errors.Is(err, nats.ErrConsumerNotFound) {
+		return err
+	}
+	maxAckPending, _ := b.opts.Context.Value(natsMaxAckPendingKey{}).(int)
+	_, err = b.js.AddConsumer(stream, &nats.ConsumerConfig{
+		Durable:        durable,
+		DeliverSubject: b.conn.NewInbox(),
+		DeliverGroup:   queue,
+		DeliverPolicy:  nats.DeliverAllPolicy,
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  topic,
+		MaxAckPending:  maxAckPending,
+		AckWait:        b.ackWait(),
+	})
+	return err
+}
+
+// handle 处理消息，失败时交给ErrorHandler，没有ErrorHandler时由服务端重新投递
+func (b *natsBroker) handle(topic string, m *nats.Msg, handler broker.Handler, options broker.SubscribeOptions) {
+	header := make(map[string]string, len(m.Header)+2)
+	for k := range m.Header {
+		header[k] = m.Header.Get(k)
+	}
+	header["Micro-Topic"] = topic
+	if _, ok := header["Content-Type"]; !ok {
+		header["Content-Type"] = "application/json"
+	}
+	evt := &natsEvent{topic: topic, msg: &broker.Message{Header: header, Body: m.Data}, raw: m}
+	err := handler(evt)
+	if err == nil {
+		if options.AutoAck {
+			if err := evt.Ack(); err != nil {
+				logger.Errorf("[nats]: failed to ack message: %v", err)
+			}
+		}
+		return
+	}
+	evt.err = err
+	if eh := b.opts.ErrorHandler; eh != nil {
+		_ = eh(evt)
+		return
+	}
+	logger.Errorf("[nats]: subscriber error: %v", err)
+	if err := evt.nak(); err != nil {
+		logger.Errorf("[nats]: failed to nak message: %v", err)
+	}
+}
+
+func (b *natsBroker) ackWait() time.Duration {
+	ackWait, _ := b.opts.Context.Value(natsAckWaitKey{}).(time.Duration)
+	return ackWait
+}
+
+func (b *natsBroker) publishAcks() chan<- *event.PublishAck {
+	if b.opts.Context == nil {
+		return nil
+	}
+	acks, _ := b.opts.Context.Value(natsPublishAcksKey{}).(chan<- *event.PublishAck)
+	return acks
+}
+
+// forwardAcks 按发布顺序等待服务端确认，转换为发布回执，确认中的流序号作为偏移量
+// closing关闭后转发完待确认队列中剩余的消息后退出
+func (b *natsBroker) forwardAcks(acks chan<- *event.PublishAck, pending chan *natsPendingPublish, closing, quit chan struct{}) {
+	defer b.wg.Done()
+	for {
+		select {
+		case p := <-pending:
+			if !b.forwardAck(acks, p, quit) {
+				return
+			}
+		case <-closing:
+			for {
+				select {
+				case p := <-pending:
+					if !b.forwardAck(acks, p, quit) {
+						return
+					}
+				default:
+					return
+				}
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// forwardAck 等待单条消息的确认并写入回执通道，quit关闭时返回false
+func (b *natsBroker) forwardAck(acks chan<- *event.PublishAck, p *natsPendingPublish, quit chan struct{}) bool {
+	ack := &event.PublishAck{Message: p.msg, Partition: -1, Offset: -1}
+	select {
+	case pa := <-p.future.Ok():
+		ack.Offset = int64(pa.Sequence)
+	case err := <-p.future.Err():
+		ack.Err = err
+	case <-quit:
+		return false
+	}
+	select {
+	case acks <- ack:
+		return true
+	case <-quit:
+		return false
+	}
+}
+
+// natsName 流和消费者名称不能包含 . * > 和空格
+func natsName(s string) string {
+	return strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_").Replace(s)
+}
+
+// NewNatsBroker 创建NATS JetStream Broker
+func NewNatsBroker(opts ...broker.Option) broker.Broker {
+	options := broker.Options{
+		Context: context.Background(),
+		Logger:  logger.DefaultLogger,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+	return &natsBroker{
+		opts:    options,
+		streams: make(map[string]string),
+	}
+}
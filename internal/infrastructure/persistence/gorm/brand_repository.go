@@ -0,0 +1,102 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type BrandRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// FindByID 根据ID查询品牌
+func (r *BrandRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.ProductBrand, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var brand model.ProductBrand
+	if err := db.Where("id = ?", id).First(&brand).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &brand, nil
+}
+
+// ExistsName 品牌名称是否已存在
+func (r *BrandRepositoryImpl) ExistsName(ctx context.Context, name string, excludeID int64) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Model(&model.ProductBrand{}).Where("brand_name = ? AND id <> ?", name, excludeID).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Create 创建品牌
+func (r *BrandRepositoryImpl) Create(ctx context.Context, brand *model.ProductBrand) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(brand).Error
+}
+
+// Update 更新品牌名称和Logo
+func (r *BrandRepositoryImpl) Update(ctx context.Context, brand *model.ProductBrand) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.ProductBrand{ID: brand.ID}).Select("brand_name", "logo").Updates(brand).Error
+}
+
+// Delete 软删除品牌
+func (r *BrandRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.ProductBrand{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"is_deleted": true, "deleted_at": time.Now()}).Error
+}
+
+// List 分页查询品牌
+func (r *BrandRepositoryImpl) List(ctx context.Context, keyword string, offset, limit int) ([]model.ProductBrand, int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var brands []model.ProductBrand
+	var total int64
+
+	query := db.Model(&model.ProductBrand{})
+	if keyword != "" {
+		query = query.Where("brand_name LIKE ?", "%"+keyword+"%")
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Order("id ASC").Offset(offset).Limit(limit).Find(&brands).Error; err != nil {
+		return nil, 0, err
+	}
+	return brands, total, nil
+}
+
+// CountActiveProducts 统计品牌下上架的商品和SKU数量
+func (r *BrandRepositoryImpl) CountActiveProducts(ctx context.Context, brandIDs []int64) ([]repository.BrandProductStat, error) {
+	if len(brandIDs) == 0 {
+		return []repository.BrandProductStat{}, nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	var stats []repository.BrandProductStat
+	err := db.Model(&model.Product{}).
+		Select("products.brand_id AS brand_id, COUNT(DISTINCT products.id) AS product_count, COUNT(product_skus.id) AS sku_count").
+		Joins("LEFT JOIN product_skus ON product_skus.product_id = products.id AND product_skus.deleted_at IS NULL AND product_skus.status = ?", model.ProductStatusOnSale).
+		Where("products.brand_id IN ? AND products.status = ?", brandIDs, model.ProductStatusOnSale).
+		Group("products.brand_id").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// NewBrandRepository 创建品牌仓储实例
+func NewBrandRepository(db *gorm.DB) repository.BrandRepository {
+	return &BrandRepositoryImpl{db: db}
+}
@@ -0,0 +1,101 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeadLetterRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// Create 写入死信，重复消费同一条死信时忽略
+func (r *DeadLetterRepositoryImpl) Create(ctx context.Context, deadLetter *model.DeadLetter) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(deadLetter)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindByID 根据ID查询死信
+func (r *DeadLetterRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.DeadLetter, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var deadLetter model.DeadLetter
+	if err := db.Where("id = ?", id).First(&deadLetter).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &deadLetter, nil
+}
+
+// FindByIDsForUpdate 锁定指定的死信
+func (r *DeadLetterRepositoryImpl) FindByIDsForUpdate(ctx context.Context, ids []int64) ([]model.DeadLetter, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	var deadLetters []model.DeadLetter
+	err := db.Model(&model.DeadLetter{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).
+		Order("id ASC").
+		Find(&deadLetters).Error
+	if err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// ListByCursor 按ID倒序游标分页查询死信
+func (r *DeadLetterRepositoryImpl) ListByCursor(ctx context.Context, filter *repository.DeadLetterFilter, cursor int64, limit int) ([]model.DeadLetter, error) {
+	db := GetDBFromContext(ctx, r.db)
+
+	query := db.Model(&model.DeadLetter{}).Omit("payload", "headers")
+	if filter.Topic != "" {
+		query = query.Where("topic = ?", filter.Topic)
+	}
+	if filter.EventType != "" {
+		query = query.Where("event_type = ?", filter.EventType)
+	}
+	if filter.Status > 0 {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+
+	var deadLetters []model.DeadLetter
+	if err := query.Order("id DESC").Limit(limit).Find(&deadLetters).Error; err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// UpdateStatus 更新死信的处理状态
+func (r *DeadLetterRepositoryImpl) UpdateStatus(ctx context.Context, deadLetter *model.DeadLetter) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.DeadLetter{}).
+		Where("id = ?", deadLetter.ID).
+		Updates(map[string]interface{}{
+			"status":       deadLetter.Status,
+			"replay_count": deadLetter.ReplayCount,
+			"replayed_at":  deadLetter.ReplayedAt,
+			"handled_at":   deadLetter.HandledAt,
+			"operator_id":  deadLetter.OperatorID,
+			"reason":       deadLetter.Reason,
+		}).Error
+}
+
+// NewDeadLetterRepository 创建死信仓储实例
+func NewDeadLetterRepository(db *gorm.DB) repository.DeadLetterRepository {
+	return &DeadLetterRepositoryImpl{db: db}
+}
@@ -2,6 +2,7 @@ package gorm
 
 import (
 	"context"
+	"errors"
 	"math"
 	"time"
 
@@ -96,6 +97,123 @@ func (r *InventoryStockChangeRecordRepositoryImpl) GetDailySales(ctx context.Con
 	return results, nil
 }
 
+// SumQuantityByOrderId 按SKU汇总订单指定来源类型的变更数量
+func (r *InventoryStockChangeRecordRepositoryImpl) SumQuantityByOrderId(ctx context.Context, orderId int64, sourceType int32) (map[int64]int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+
+	var rows []struct {
+		SkuID    int64
+		Quantity int64
+	}
+	err := db.Model(&model.InventoryStockChangeRecord{}).
+		Select("sku_id, COALESCE(SUM(quantity), 0) as quantity").
+		Where("order_id = ?", orderId).
+		Where("source_type = ?", sourceType).
+		Group("sku_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		result[row.SkuID] = row.Quantity
+	}
+	return result, nil
+}
+
+// ListByCursor 按ID倒序查询ID小于游标的变更记录，游标为0时从最新记录开始
+func (r *InventoryStockChangeRecordRepositoryImpl) ListByCursor(ctx context.Context, filter *repository.StockChangeFilter, cursor int64, limit int) ([]model.InventoryStockChangeRecord, error) {
+	db := GetDBFromContext(ctx, r.db)
+
+	query := db.Model(&model.InventoryStockChangeRecord{})
+	if filter.SkuID > 0 {
+		query = query.Where("sku_id = ?", filter.SkuID)
+	}
+	if filter.OrderID > 0 {
+		query = query.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.PurchaseOrderID > 0 {
+		query = query.Where("purchase_order_id = ?", filter.PurchaseOrderID)
+	}
+	if filter.SourceType > 0 {
+		query = query.Where("source_type = ?", filter.SourceType)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at <= ?", filter.EndTime)
+	}
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+
+	var records []model.InventoryStockChangeRecord
+	if err := query.Order("id DESC").Limit(limit).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// FindLatestAtOrBefore 查询SKU在指定时间及之前的最后一条变更记录，同一时间按ID取最后写入的记录
+func (r *InventoryStockChangeRecordRepositoryImpl) FindLatestAtOrBefore(ctx context.Context, skuID int64, at time.Time) (*model.InventoryStockChangeRecord, error) {
+	db := GetDBFromContext(ctx, r.db)
+
+	var record model.InventoryStockChangeRecord
+	err := db.Where("sku_id = ? AND created_at <= ?", skuID, at).
+		Order("created_at DESC").
+		Order("id DESC").
+		Take(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// FindEarliestAfter 查询SKU在指定时间之后的第一条变更记录
+func (r *InventoryStockChangeRecordRepositoryImpl) FindEarliestAfter(ctx context.Context, skuID int64, at time.Time) (*model.InventoryStockChangeRecord, error) {
+	db := GetDBFromContext(ctx, r.db)
+
+	var record model.InventoryStockChangeRecord
+	err := db.Where("sku_id = ? AND created_at > ?", skuID, at).
+		Order("created_at ASC").
+		Order("id ASC").
+		Take(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// FindLatestBySkuIDs 查询各SKU最后写入的一条变更记录，没有记录的SKU不在结果中
+func (r *InventoryStockChangeRecordRepositoryImpl) FindLatestBySkuIDs(ctx context.Context, skuIDs []int64) (map[int64]*model.InventoryStockChangeRecord, error) {
+	result := make(map[int64]*model.InventoryStockChangeRecord, len(skuIDs))
+	if len(skuIDs) == 0 {
+		return result, nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+
+	latestIds := db.Model(&model.InventoryStockChangeRecord{}).
+		Select("MAX(id)").
+		Where("sku_id IN ?", skuIDs).
+		Group("sku_id")
+	var records []model.InventoryStockChangeRecord
+	if err := db.Where("id IN (?)", latestIds).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	for i := range records {
+		result[records[i].SkuID] = &records[i]
+	}
+	return result, nil
+}
+
 // NewInventoryStockChangeRecordRepository 创建库存变更记录仓储实例
 func NewInventoryStockChangeRecordRepository(db *gorm.DB) repository.InventoryStockChangeRecordRepository {
 	return &InventoryStockChangeRecordRepositoryImpl{db: db}
@@ -0,0 +1,41 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrderInventoryRestoreRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// ExistsByRestoreKey 检查回补是否已处理过
+func (r *OrderInventoryRestoreRepositoryImpl) ExistsByRestoreKey(ctx context.Context, restoreKey string) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Model(&model.OrderInventoryRestore{}).Where("restore_key = ?", restoreKey).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Create 创建回补记录，唯一键冲突时说明已回补过
+// 并发处理同一回补时后到的插入会等待先到的事务结束，先到的事务提交后返回false
+func (r *OrderInventoryRestoreRepositoryImpl) Create(ctx context.Context, restore *model.OrderInventoryRestore) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(restore)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// NewOrderInventoryRestoreRepository 创建订单库存回补记录仓储实例
+func NewOrderInventoryRestoreRepository(db *gorm.DB) repository.OrderInventoryRestoreRepository {
+	return &OrderInventoryRestoreRepositoryImpl{db: db}
+}
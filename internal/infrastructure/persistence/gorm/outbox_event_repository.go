@@ -0,0 +1,89 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// Create 写入发件箱
+func (r *OutboxEventRepositoryImpl) Create(ctx context.Context, outboxEvent *model.OutboxEvent) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(outboxEvent).Error
+}
+
+// FindPendingForUpdate 锁定待发布及发布超时的事件
+// 发布中的事件超过staleBefore仍未收到回调（如Pod在回调前退出）则重新发布
+func (r *OutboxEventRepositoryImpl) FindPendingForUpdate(ctx context.Context, staleBefore time.Time, limit int) ([]model.OutboxEvent, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var events []model.OutboxEvent
+	err := db.Model(&model.OutboxEvent{}).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? OR (status = ? AND updated_at < ?)", model.OutboxStatusPending, model.OutboxStatusPublishing, staleBefore).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// MarkPublishing 标记为发布中
+func (r *OutboxEventRepositoryImpl) MarkPublishing(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":     model.OutboxStatusPublishing,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// MarkSent 标记为已发布
+func (r *OutboxEventRepositoryImpl) MarkSent(ctx context.Context, id int64) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.OutboxEvent{}).
+		Where("id = ? AND status <> ?", id, model.OutboxStatusSent).
+		Updates(map[string]interface{}{
+			"status":       model.OutboxStatusSent,
+			"published_at": time.Now(),
+			"last_error":   "",
+		}).Error
+}
+
+// MarkFailed 记录发布失败
+// MySQL按书写顺序执行SET子句，status须在retries之前计算
+func (r *OutboxEventRepositoryImpl) MarkFailed(ctx context.Context, id int64, reason string, maxRetries int) error {
+	if len(reason) > 500 {
+		reason = reason[:500]
+	}
+	db := GetDBFromContext(ctx, r.db)
+	return db.Exec("UPDATE outbox_events SET status = CASE WHEN retries + 1 >= ? THEN ? ELSE ? END, retries = retries + 1, last_error = ?, updated_at = ? WHERE id = ? AND status <> ?",
+		maxRetries, model.OutboxStatusFailed, model.OutboxStatusPending, reason, time.Now(), id, model.OutboxStatusSent).Error
+}
+
+// DeleteSentBefore 清理已发布的事件
+func (r *OutboxEventRepositoryImpl) DeleteSentBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	tx := db.Where("status = ? AND published_at < ?", model.OutboxStatusSent, before).
+		Limit(limit).
+		Delete(&model.OutboxEvent{})
+	return tx.RowsAffected, tx.Error
+}
+
+// NewOutboxEventRepository 创建事务发件箱仓储实例
+func NewOutboxEventRepository(db *gorm.DB) repository.OutboxEventRepository {
+	return &OutboxEventRepositoryImpl{db: db}
+}
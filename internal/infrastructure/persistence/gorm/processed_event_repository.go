@@ -0,0 +1,40 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProcessedEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// Create 记录事件已处理，唯一键冲突时说明已处理过
+// 并发投递同一事件时后到的插入会等待先到的事务结束，先到的事务回滚后可以继续处理
+func (r *ProcessedEventRepositoryImpl) Create(ctx context.Context, processedEvent *model.ProcessedEvent) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(processedEvent)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteBefore 清理指定时间之前的处理记录
+func (r *ProcessedEventRepositoryImpl) DeleteBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	tx := db.Where("created_at < ?", before).
+		Limit(limit).
+		Delete(&model.ProcessedEvent{})
+	return tx.RowsAffected, tx.Error
+}
+
+// NewProcessedEventRepository 创建已处理事件仓储实例
+func NewProcessedEventRepository(db *gorm.DB) repository.ProcessedEventRepository {
+	return &ProcessedEventRepositoryImpl{db: db}
+}
@@ -0,0 +1,103 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type ProductCategoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// FindByID 根据ID查询分类
+func (r *ProductCategoryRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.ProductCategory, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var category model.ProductCategory
+	if err := db.Where("id = ?", id).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// FindAll 查询全部分类
+func (r *ProductCategoryRepositoryImpl) FindAll(ctx context.Context) ([]model.ProductCategory, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var categories []model.ProductCategory
+	if err := db.Order("level ASC, id ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// ExistsSiblingName 同一父分类下是否已存在同名分类
+func (r *ProductCategoryRepositoryImpl) ExistsSiblingName(ctx context.Context, parentID int64, name string, excludeID int64) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Model(&model.ProductCategory{}).
+		Where("parent_id = ? AND category_name = ? AND id <> ?", parentID, name, excludeID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Create 创建分类
+func (r *ProductCategoryRepositoryImpl) Create(ctx context.Context, category *model.ProductCategory) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(category).Error
+}
+
+// UpdateName 修改分类名称
+func (r *ProductCategoryRepositoryImpl) UpdateName(ctx context.Context, id int64, name string) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.ProductCategory{}).Where("id = ?", id).Update("category_name", name).Error
+}
+
+// UpdateParent 修改父分类和层级
+func (r *ProductCategoryRepositoryImpl) UpdateParent(ctx context.Context, id int64, parentID int64, level int) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.ProductCategory{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"parent_id": parentID, "level": level}).Error
+}
+
+// ShiftLevel 批量调整分类层级
+func (r *ProductCategoryRepositoryImpl) ShiftLevel(ctx context.Context, ids []int64, delta int) error {
+	if len(ids) == 0 || delta == 0 {
+		return nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.ProductCategory{}).
+		Where("id IN ?", ids).
+		Update("level", gorm.Expr("level + ?", delta)).Error
+}
+
+// CountChildren 统计子分类数量
+func (r *ProductCategoryRepositoryImpl) CountChildren(ctx context.Context, id int64) (int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Model(&model.ProductCategory{}).Where("parent_id = ?", id).Count(&count).Error
+	return count, err
+}
+
+// Delete 软删除分类
+func (r *ProductCategoryRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.ProductCategory{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"is_deleted": true, "deleted_at": time.Now()}).Error
+}
+
+// NewProductCategoryRepository 创建商品分类仓储实例
+func NewProductCategoryRepository(db *gorm.DB) repository.ProductCategoryRepository {
+	return &ProductCategoryRepositoryImpl{db: db}
+}
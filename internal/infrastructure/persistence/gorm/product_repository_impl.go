@@ -3,6 +3,8 @@ package gorm
 import (
 	"context"
 	"errors"
+	"time"
+
 	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/domain/repository"
 	"gorm.io/gorm"
@@ -75,30 +77,210 @@ func (u *ProductRepository) DeductProductInventory(ctx context.Context, id int64
 	return nil
 }
 
-// DeductProductSizeInvetoryRevert 扣减指定规格产品的库存补偿
-func (u *ProductRepository) DeductProductSizeInvetoryRevert(ctx context.Context, id int64, num int64) error {
+// FindProductDetailByID 查询商品详情，包括规格、规格值、SKU和SKU图片
+func (u *ProductRepository) FindProductDetailByID(ctx context.Context, id int64) (*model.Product, error) {
 	db := GetDBFromContext(ctx, u.db)
-	tx := db.Model(model.ProductSize{}).Where("id = ?", id).Update("stock", gorm.Expr("stock + ?", num))
+	var product model.Product
+	byDisplayOrder := func(db *gorm.DB) *gorm.DB {
+		return db.Order("display_order ASC, id ASC")
+	}
+	err := db.Model(&model.Product{}).
+		Where("id = ?", id).
+		Preload("Specs", byDisplayOrder).
+		Preload("Specs.SpecValues", byDisplayOrder).
+		Preload("Skus", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Preload("Skus.Images", byDisplayOrder).
+		First(&product).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// ExistsProductNo 商品编号是否已被使用，已删除的商品仍占用编号
+func (u *ProductRepository) ExistsProductNo(ctx context.Context, productNo string) (bool, error) {
+	db := GetDBFromContext(ctx, u.db)
+	var count int64
+	err := db.Unscoped().Model(&model.Product{}).Where("product_no = ?", productNo).Count(&count).Error
+	return count > 0, err
+}
+
+// UpdateProduct 更新商品基本信息，商品编号和状态不在此处修改，商品不存在时返回repository.ErrProductNotFound
+func (u *ProductRepository) UpdateProduct(ctx context.Context, product *model.Product) error {
+	db := GetDBFromContext(ctx, u.db)
+	tx := db.Model(&model.Product{ID: product.ID}).
+		Select("product_name", "category_id", "brand_id", "main_image", "description").
+		Updates(product)
 	if err := tx.Error; err != nil {
 		return err
 	}
 	if tx.RowsAffected == 0 {
-		return errors.New("failed to reduce stock")
+		return repository.ErrProductNotFound
 	}
 	return nil
 }
 
-// DeductProductInventoryRevert 扣减产品的库存补偿
-func (u *ProductRepository) DeductProductInventoryRevert(ctx context.Context, id int64, num int64) error {
+// ListProducts 分页查询商品列表
+func (u *ProductRepository) ListProducts(ctx context.Context, filter *repository.ProductListFilter, offset, limit int) ([]model.Product, int64, error) {
 	db := GetDBFromContext(ctx, u.db)
-	tx := db.Model(model.Product{}).Where("id = ?", id).Update("stock", gorm.Expr("stock + ?", num))
+	var products []model.Product
+	var total int64
+
+	query := db.Model(&model.Product{})
+	if filter != nil {
+		if filter.Keyword != "" {
+			keyword := "%" + filter.Keyword + "%"
+			query = query.Where("product_name LIKE ? OR product_no LIKE ?", keyword, keyword)
+		}
+		if filter.CategoryID > 0 {
+			query = query.Where("category_id = ?", filter.CategoryID)
+		}
+		if filter.BrandID > 0 {
+			query = query.Where("brand_id = ?", filter.BrandID)
+		}
+		if len(filter.Statuses) > 0 {
+			query = query.Where("status IN ?", filter.Statuses)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Offset(offset).Limit(limit).Order("id DESC").Find(&products).Error; err != nil {
+		return nil, 0, err
+	}
+	return products, total, nil
+}
+
+// FindProductStatusForUpdate 锁定商品并返回状态及定时上架信息，商品不存在时返回nil
+func (u *ProductRepository) FindProductStatusForUpdate(ctx context.Context, id int64) (*model.Product, error) {
+	db := GetDBFromContext(ctx, u.db)
+	var product model.Product
+	err := db.Model(&model.Product{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "status", "on_sale_at", "on_sale_sku_ids").
+		Where("id = ?", id).
+		First(&product).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// UpdateProductStatus 更新商品状态及定时上架信息
+func (u *ProductRepository) UpdateProductStatus(ctx context.Context, product *model.Product) error {
+	db := GetDBFromContext(ctx, u.db)
+	return db.Model(&model.Product{ID: product.ID}).
+		Select("status", "on_sale_at", "on_sale_sku_ids").
+		Updates(product).Error
+}
+
+// ListDueOnSaleProductIDs 按ID升序查询定时上架时间已到的商品ID
+func (u *ProductRepository) ListDueOnSaleProductIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error) {
+	db := GetDBFromContext(ctx, u.db)
+	var ids []int64
+	err := db.Model(&model.Product{}).
+		Where("on_sale_at IS NOT NULL AND on_sale_at <= ?", now).
+		Where("id > ?", afterId).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DeleteProduct 软删除商品及其规格、规格值和SKU，商品不存在时返回repository.ErrProductNotFound
+func (u *ProductRepository) DeleteProduct(ctx context.Context, id int64) error {
+	db := GetDBFromContext(ctx, u.db)
+	deleted := map[string]interface{}{"is_deleted": true, "deleted_at": time.Now()}
+	tx := db.Model(&model.Product{}).Where("id = ?", id).Updates(deleted)
 	if err := tx.Error; err != nil {
 		return err
 	}
 	if tx.RowsAffected == 0 {
-		return errors.New("failed to reduce stock")
+		return repository.ErrProductNotFound
 	}
-	return nil
+	specIds := db.Model(&model.ProductSpec{}).Select("id").Where("product_id = ?", id)
+	if err := db.Model(&model.SpecValue{}).Where("spec_id IN (?)", specIds).Updates(deleted).Error; err != nil {
+		return err
+	}
+	if err := db.Model(&model.ProductSpec{}).Where("product_id = ?", id).Updates(deleted).Error; err != nil {
+		return err
+	}
+	return db.Model(&model.ProductSku{}).Where("product_id = ?", id).Update("deleted_at", deleted["deleted_at"]).Error
+}
+
+// FindSpecsByProductID 查询商品的全部规格及规格值，包括已删除的，用于规格矩阵比对
+func (u *ProductRepository) FindSpecsByProductID(ctx context.Context, productID int64) ([]model.ProductSpec, error) {
+	db := GetDBFromContext(ctx, u.db)
+	var specs []model.ProductSpec
+	err := db.Unscoped().Model(&model.ProductSpec{}).
+		Where("product_id = ?", productID).
+		Preload("SpecValues", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Order("id ASC")
+		}).
+		Order("id ASC").
+		Find(&specs).Error
+	if err != nil {
+		return nil, err
+	}
+	return specs, nil
+}
+
+// SaveSpec 创建或更新规格，不保存规格值
+func (u *ProductRepository) SaveSpec(ctx context.Context, spec *model.ProductSpec) error {
+	db := GetDBFromContext(ctx, u.db)
+	return db.Unscoped().Omit(clause.Associations).Save(spec).Error
+}
+
+// SaveSpecValue 创建或更新规格值
+func (u *ProductRepository) SaveSpecValue(ctx context.Context, value *model.SpecValue) error {
+	db := GetDBFromContext(ctx, u.db)
+	return db.Unscoped().Omit(clause.Associations).Save(value).Error
+}
+
+// CountByCategoryIds 统计引用了指定分类的商品数量
+func (u *ProductRepository) CountByCategoryIds(ctx context.Context, categoryIds []int64) (int64, error) {
+	if len(categoryIds) == 0 {
+		return 0, nil
+	}
+	db := GetDBFromContext(ctx, u.db)
+	var count int64
+	err := db.Model(&model.Product{}).Where("category_id IN ?", categoryIds).Count(&count).Error
+	return count, err
+}
+
+// CountByBrandId 统计引用了指定品牌的商品数量
+func (u *ProductRepository) CountByBrandId(ctx context.Context, brandId int64) (int64, error) {
+	db := GetDBFromContext(ctx, u.db)
+	var count int64
+	err := db.Model(&model.Product{}).Where("brand_id = ?", brandId).Count(&count).Error
+	return count, err
+}
+
+// ReassignBrand 将品牌下的商品改为另一个品牌，productIds为空时迁移全部商品，toBrandId为0时解除品牌关联
+func (u *ProductRepository) ReassignBrand(ctx context.Context, fromBrandId, toBrandId int64, productIds []int64) (int64, error) {
+	db := GetDBFromContext(ctx, u.db)
+	var brandId interface{}
+	if toBrandId > 0 {
+		brandId = toBrandId
+	}
+	query := db.Model(&model.Product{}).Where("brand_id = ?", fromBrandId)
+	if len(productIds) > 0 {
+		query = query.Where("id IN ?", productIds)
+	}
+	tx := query.Update("brand_id", brandId)
+	return tx.RowsAffected, tx.Error
 }
 
 func (u *ProductRepository) FindSkusByids(ctx context.Context, ids []int64) ([]model.ProductSku, error) {
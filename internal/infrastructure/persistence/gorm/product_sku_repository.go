@@ -6,6 +6,7 @@ import (
 	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/domain/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProductSkuRepositoryImpl struct {
@@ -27,7 +28,7 @@ func (s *ProductSkuRepositoryImpl) BatchGetSkuByIDsWithFields(ctx context.Contex
 	err := db.Model(model.ProductSku{}).
 		Select("id", "product_id", "stock", "stock_warn").
 		Where("id IN ?", skuIDs).
-		Where("status = ?", 1). // 只查询上架状态的SKU
+		Where("status = ?", model.ProductStatusOnSale). // 只查询上架状态的SKU
 		Find(&results).Error
 
 	if err != nil {
@@ -37,11 +38,11 @@ func (s *ProductSkuRepositoryImpl) BatchGetSkuByIDsWithFields(ctx context.Contex
 	return results, nil
 }
 
-// DeductInventoryById 根据ID扣减库存并增加销量
+// DeductInventoryById 根据ID扣减库存并增加销量，库存不足时返回repository.ErrInsufficientStock，SKU不存在时返回gorm.ErrRecordNotFound
 func (s *ProductSkuRepositoryImpl) DeductInventoryById(ctx context.Context, id int64, count uint32) error {
 	db := GetDBFromContext(ctx, s.db)
 	tx := db.Model(model.ProductSku{}).
-		Where("id = ?", id).
+		Where("id = ? AND stock >= ?", id, count).
 		Updates(map[string]interface{}{
 			"stock": gorm.Expr("stock - ?", count),
 			"sales": gorm.Expr("sales + ?", count),
@@ -50,7 +51,14 @@ func (s *ProductSkuRepositoryImpl) DeductInventoryById(ctx context.Context, id i
 		return err
 	}
 	if tx.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+		var exists int64
+		if err := db.Model(model.ProductSku{}).Where("id = ?", id).Count(&exists).Error; err != nil {
+			return err
+		}
+		if exists == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return repository.ErrInsufficientStock
 	}
 	return nil
 }
@@ -93,7 +101,7 @@ func (s *ProductSkuRepositoryImpl) BatchGetSkuInventoryInfo(ctx context.Context,
 	err := db.Model(model.ProductSku{}).
 		Select("id", "sku_no", "sku_name", "stock", "stock_warn").
 		Where("id IN ?", skuIDs).
-		Where("status = ?", 1). // 只查询上架状态的SKU
+		Where("status = ?", model.ProductStatusOnSale). // 只查询上架状态的SKU
 		Find(&results).Error
 
 	if err != nil {
@@ -123,6 +131,256 @@ func (s *ProductSkuRepositoryImpl) GetSkuStockBySkuNo(ctx context.Context, skuNo
 	return &result, nil
 }
 
+// BatchGetSkuByIDsForUpdate 按ID顺序锁定SKU并返回库存信息，用于需要准确记录变更前后库存的场景
+func (s *ProductSkuRepositoryImpl) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	if len(skuIDs) == 0 {
+		return []model.ProductSku{}, nil
+	}
+	db := GetDBFromContext(ctx, s.db)
+	var results []model.ProductSku
+	err := db.Model(model.ProductSku{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "product_id", "stock", "stock_warn", "status").
+		Where("id IN ?", skuIDs).
+		Order("id ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ReserveInventoryById 预占库存，仅扣减库存不计销量，库存不足时返回repository.ErrInsufficientStock
+func (s *ProductSkuRepositoryImpl) ReserveInventoryById(ctx context.Context, id int64, count uint32) error {
+	db := GetDBFromContext(ctx, s.db)
+	tx := db.Model(model.ProductSku{}).
+		Where("id = ? AND stock >= ?", id, count).
+		Update("stock", gorm.Expr("stock - ?", count))
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return repository.ErrInsufficientStock
+	}
+	return nil
+}
+
+// AdjustInventoryById 按差值调整库存，调整后库存为负数时返回repository.ErrInsufficientStock
+func (s *ProductSkuRepositoryImpl) AdjustInventoryById(ctx context.Context, id int64, delta int64) error {
+	db := GetDBFromContext(ctx, s.db).Model(model.ProductSku{}).Where("id = ?", id)
+	if delta < 0 {
+		db = db.Where("stock >= ?", -delta)
+	}
+	tx := db.Update("stock", gorm.Expr("stock + ?", delta))
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return repository.ErrInsufficientStock
+	}
+	return nil
+}
+
+// ListSkuStockAfterID 按ID升序查询ID大于afterId的SKU库存，包含下架的SKU
+func (s *ProductSkuRepositoryImpl) ListSkuStockAfterID(ctx context.Context, afterId int64, limit int) ([]model.ProductSku, error) {
+	db := GetDBFromContext(ctx, s.db)
+	var results []model.ProductSku
+	err := db.Model(model.ProductSku{}).
+		Select("id", "stock", "stock_warn").
+		Where("id > ?", afterId).
+		Order("id ASC").
+		Limit(limit).
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// FindSkuPriceForUpdate 锁定SKU并返回价格信息，不存在时返回nil
+func (s *ProductSkuRepositoryImpl) FindSkuPriceForUpdate(ctx context.Context, id int64) (*model.ProductSku, error) {
+	db := GetDBFromContext(ctx, s.db)
+	var result model.ProductSku
+	err := db.Model(model.ProductSku{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "product_id", "price", "market_price").
+		Where("id = ?", id).
+		First(&result).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdatePriceById 更新SKU价格及市场价
+func (s *ProductSkuRepositoryImpl) UpdatePriceById(ctx context.Context, id int64, price float64, marketPrice *float64) error {
+	db := GetDBFromContext(ctx, s.db)
+	return db.Model(model.ProductSku{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"price":        price,
+		"market_price": marketPrice,
+	}).Error
+}
+
+// FindSkuStatusByProductIDForUpdate 按ID顺序锁定商品的SKU并返回状态
+func (s *ProductSkuRepositoryImpl) FindSkuStatusByProductIDForUpdate(ctx context.Context, productID int64) ([]model.ProductSku, error) {
+	db := GetDBFromContext(ctx, s.db)
+	var results []model.ProductSku
+	err := db.Model(model.ProductSku{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "product_id", "status").
+		Where("product_id = ?", productID).
+		Order("id ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// RestoreInventoryById 回补库存
+func (s *ProductSkuRepositoryImpl) RestoreInventoryById(ctx context.Context, id int64, count uint32) error {
+	db := GetDBFromContext(ctx, s.db)
+	tx := db.Model(model.ProductSku{}).
+		Where("id = ?", id).
+		Update("stock", gorm.Expr("stock + ?", count))
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// IncreaseSalesById 增加销量（预占确认时库存已扣减）
+func (s *ProductSkuRepositoryImpl) IncreaseSalesById(ctx context.Context, id int64, count uint32) error {
+	db := GetDBFromContext(ctx, s.db)
+	tx := db.Model(model.ProductSku{}).
+		Where("id = ?", id).
+		Update("sales", gorm.Expr("sales + ?", count))
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RefundInventoryById 退款回补库存并扣减销量，销量不会小于0
+func (s *ProductSkuRepositoryImpl) RefundInventoryById(ctx context.Context, id int64, count uint32) error {
+	db := GetDBFromContext(ctx, s.db)
+	tx := db.Model(model.ProductSku{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"stock": gorm.Expr("stock + ?", count),
+			"sales": gorm.Expr("IF(sales >= ?, sales - ?, 0)", count, count),
+		})
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// BatchCreateSkus 批量创建SKU，同时创建SKU图片
+func (s *ProductSkuRepositoryImpl) BatchCreateSkus(ctx context.Context, skus []*model.ProductSku) error {
+	if len(skus) == 0 {
+		return nil
+	}
+	db := GetDBFromContext(ctx, s.db)
+	return db.Create(skus).Error
+}
+
+// FindSkusByProductID 查询商品下的全部SKU，包括图片
+func (s *ProductSkuRepositoryImpl) FindSkusByProductID(ctx context.Context, productID int64) ([]model.ProductSku, error) {
+	db := GetDBFromContext(ctx, s.db)
+	var results []model.ProductSku
+	err := db.Model(model.ProductSku{}).
+		Where("product_id = ?", productID).
+		Preload("Images", func(db *gorm.DB) *gorm.DB {
+			return db.Order("display_order ASC, id ASC")
+		}).
+		Order("id ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// UpdateSkuInfo 更新SKU的名称、价格、预警值和主图，不修改库存
+func (s *ProductSkuRepositoryImpl) UpdateSkuInfo(ctx context.Context, sku *model.ProductSku) error {
+	db := GetDBFromContext(ctx, s.db)
+	tx := db.Model(&model.ProductSku{ID: sku.ID}).
+		Select("sku_name", "price", "market_price", "stock_warn", "main_image").
+		Updates(sku)
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ReplaceSkuImages 替换SKU的全部图片
+func (s *ProductSkuRepositoryImpl) ReplaceSkuImages(ctx context.Context, skuID int64, images []*model.SkuImage) error {
+	db := GetDBFromContext(ctx, s.db)
+	if err := db.Where("sku_id = ?", skuID).Delete(&model.SkuImage{}).Error; err != nil {
+		return err
+	}
+	if len(images) == 0 {
+		return nil
+	}
+	for _, image := range images {
+		image.SkuID = uint(skuID)
+	}
+	return db.Create(images).Error
+}
+
+// UpdateSkuStatusByIds 批量更新SKU状态
+func (s *ProductSkuRepositoryImpl) UpdateSkuStatusByIds(ctx context.Context, ids []int64, status int8) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	db := GetDBFromContext(ctx, s.db)
+	return db.Model(model.ProductSku{}).Where("id IN ?", ids).Update("status", status).Error
+}
+
+// ListSkusByCategoryIds 分页查询指定分类下未删除商品的SKU
+func (s *ProductSkuRepositoryImpl) ListSkusByCategoryIds(ctx context.Context, categoryIds []int64, offset, limit int) ([]model.ProductSku, int64, error) {
+	if len(categoryIds) == 0 {
+		return []model.ProductSku{}, 0, nil
+	}
+	db := GetDBFromContext(ctx, s.db)
+	var results []model.ProductSku
+	var total int64
+
+	query := db.Model(&model.ProductSku{}).
+		Joins("JOIN products ON products.id = product_skus.product_id AND products.deleted_at IS NULL").
+		Where("products.category_id IN ?", categoryIds)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Select("product_skus.*").
+		Preload("Images", func(db *gorm.DB) *gorm.DB {
+			return db.Order("display_order ASC, id ASC")
+		}).
+		Order("product_skus.id ASC").
+		Offset(offset).Limit(limit).
+		Find(&results).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
 // NewProductSkuRepository 创建商品SKU表仓储层
 func NewProductSkuRepository(db *gorm.DB) repository.ProductSkuRepository {
 	return &ProductSkuRepositoryImpl{db: db}
@@ -0,0 +1,108 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PurchaseOrderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// Create 创建采购单及其明细，明细关联的补货记录一并写入
+func (r *PurchaseOrderRepositoryImpl) Create(ctx context.Context, order *model.PurchaseOrder) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(order).Error
+}
+
+// FindByID 根据ID查询采购单及其明细
+func (r *PurchaseOrderRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.PurchaseOrder, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var order model.PurchaseOrder
+	err := db.Preload("Lines", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("id ASC")
+	}).Preload("Lines.Restocks", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("id ASC")
+	}).Where("id = ?", id).First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// FindByIDForUpdate 锁定采购单并查询其明细
+func (r *PurchaseOrderRepositoryImpl) FindByIDForUpdate(ctx context.Context, id int64) (*model.PurchaseOrder, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var order model.PurchaseOrder
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := db.Preload("Restocks", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("id ASC")
+	}).Where("purchase_order_id = ?", id).Order("id ASC").Find(&order.Lines).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// UpdateStatus 更新采购单状态
+func (r *PurchaseOrderRepositoryImpl) UpdateStatus(ctx context.Context, id int64, status uint8) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.PurchaseOrder{}).Where("id = ?", id).Update("status", status).Error
+}
+
+// UpdateLineReceived 更新明细的已收货数量
+func (r *PurchaseOrderRepositoryImpl) UpdateLineReceived(ctx context.Context, lineID int64, receivedQuantity int32) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.PurchaseOrderLine{}).Where("id = ?", lineID).Update("received_quantity", receivedQuantity).Error
+}
+
+// CountOpenLinesByRestockID 统计包含补货记录且未收满的明细数量
+func (r *PurchaseOrderRepositoryImpl) CountOpenLinesByRestockID(ctx context.Context, restockID int64) (int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Table(model.PurchaseOrderLineRestock{}.TableName()+" AS r").
+		Joins("JOIN "+model.PurchaseOrderLine{}.TableName()+" AS l ON l.id = r.purchase_order_line_id").
+		Where("r.restock_id = ? AND l.received_quantity < l.quantity", restockID).
+		Count(&count).Error
+	return count, err
+}
+
+// List 分页查询采购单
+func (r *PurchaseOrderRepositoryImpl) List(ctx context.Context, filter *repository.PurchaseOrderListFilter, offset, limit int) ([]model.PurchaseOrder, int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var orders []model.PurchaseOrder
+	var total int64
+
+	query := db.Model(&model.PurchaseOrder{})
+	if filter.SupplierID > 0 {
+		query = query.Where("supplier_id = ?", filter.SupplierID)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&orders).Error; err != nil {
+		return nil, 0, err
+	}
+	return orders, total, nil
+}
+
+// NewPurchaseOrderRepository 创建采购单仓储实例
+func NewPurchaseOrderRepository(db *gorm.DB) repository.PurchaseOrderRepository {
+	return &PurchaseOrderRepositoryImpl{db: db}
+}
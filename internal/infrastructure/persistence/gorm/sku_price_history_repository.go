@@ -0,0 +1,143 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type SkuPriceHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// Create 创建价格变更记录
+func (r *SkuPriceHistoryRepositoryImpl) Create(ctx context.Context, history *model.SkuPriceHistory) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(history).Error
+}
+
+// FindByID 根据ID查询价格变更记录
+func (r *SkuPriceHistoryRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.SkuPriceHistory, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var history model.SkuPriceHistory
+	if err := db.Where("id = ?", id).First(&history).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &history, nil
+}
+
+// Update 更新价格变更记录
+func (r *SkuPriceHistoryRepositoryImpl) Update(ctx context.Context, history *model.SkuPriceHistory) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Save(history).Error
+}
+
+// ListOpenBySkuID 查询SKU待生效的调价及尚未恢复原价的限时调价，按生效时间升序
+func (r *SkuPriceHistoryRepositoryImpl) ListOpenBySkuID(ctx context.Context, skuID int64) ([]model.SkuPriceHistory, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var results []model.SkuPriceHistory
+	err := db.Where("sku_id = ? AND change_type = ?", skuID, model.PriceChangeTypeScheduled).
+		Where(db.Where("status = ?", model.PriceChangeStatusPending).
+			Or("status = ? AND effective_to IS NOT NULL", model.PriceChangeStatusApplied)).
+		Order("effective_from ASC").
+		Order("id ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ListDueSkuIDs 查询在指定时间前有待生效或待恢复原价记录的SKU，按ID升序返回ID大于afterId的SKU
+func (r *SkuPriceHistoryRepositoryImpl) ListDueSkuIDs(ctx context.Context, now time.Time, afterId int64, limit int) ([]int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var skuIds []int64
+	err := db.Model(&model.SkuPriceHistory{}).
+		Distinct("sku_id").
+		Where("sku_id > ?", afterId).
+		Where(db.Where("status = ? AND effective_from <= ?", model.PriceChangeStatusPending, now).
+			Or("status = ? AND change_type = ? AND effective_to IS NOT NULL AND effective_to <= ?",
+				model.PriceChangeStatusApplied, model.PriceChangeTypeScheduled, now)).
+		Order("sku_id ASC").
+		Limit(limit).
+		Pluck("sku_id", &skuIds).Error
+	if err != nil {
+		return nil, err
+	}
+	return skuIds, nil
+}
+
+// ListBySkuID 分页查询SKU的价格变更记录，按ID倒序
+func (r *SkuPriceHistoryRepositoryImpl) ListBySkuID(ctx context.Context, skuID int64, offset, limit int) ([]model.SkuPriceHistory, int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var results []model.SkuPriceHistory
+	var total int64
+	query := db.Model(&model.SkuPriceHistory{}).Where("sku_id = ?", skuID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&results).Error; err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
+// MaxAppliedSeq 查询SKU已生效记录的最大生效序号，没有时返回0
+func (r *SkuPriceHistoryRepositoryImpl) MaxAppliedSeq(ctx context.Context, skuID int64) (int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var seq int64
+	err := db.Model(&model.SkuPriceHistory{}).
+		Where("sku_id = ?", skuID).
+		Select("COALESCE(MAX(applied_seq), 0)").
+		Scan(&seq).Error
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// FindLatestAppliedAtOrBefore 查询SKU在指定时间及之前最后生效的记录，不存在时返回nil
+func (r *SkuPriceHistoryRepositoryImpl) FindLatestAppliedAtOrBefore(ctx context.Context, skuID int64, at time.Time) (*model.SkuPriceHistory, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var history model.SkuPriceHistory
+	err := db.Where("sku_id = ? AND applied_at <= ?", skuID, at).
+		Order("applied_at DESC").
+		Order("applied_seq DESC").
+		First(&history).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &history, nil
+}
+
+// FindEarliestAppliedAfter 查询SKU在指定时间之后最早生效的记录，不存在时返回nil
+func (r *SkuPriceHistoryRepositoryImpl) FindEarliestAppliedAfter(ctx context.Context, skuID int64, at time.Time) (*model.SkuPriceHistory, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var history model.SkuPriceHistory
+	err := db.Where("sku_id = ? AND applied_at > ?", skuID, at).
+		Order("applied_at ASC").
+		Order("applied_seq ASC").
+		First(&history).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &history, nil
+}
+
+// NewSkuPriceHistoryRepository 创建SKU价格变更记录仓储实例
+func NewSkuPriceHistoryRepository(db *gorm.DB) repository.SkuPriceHistoryRepository {
+	return &SkuPriceHistoryRepositoryImpl{db: db}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/domain/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type SkuRestockRepositoryImpl struct {
@@ -157,6 +158,48 @@ func (r *SkuRestockRepositoryImpl) GetByApplicationNo(ctx context.Context, appli
 	return &record, nil
 }
 
+// GetByApplicationNoForUpdate 根据业务流水号查询并锁定补货记录
+func (r *SkuRestockRepositoryImpl) GetByApplicationNoForUpdate(ctx context.Context, applicationNo string) (*model.SkuRestockRecord, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var record model.SkuRestockRecord
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("application_no = ? AND deleted_at IS NULL", applicationNo).
+		First(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// ListOrderableByIDsForUpdate 按ID顺序锁定审核通过且未订满的补货记录
+func (r *SkuRestockRepositoryImpl) ListOrderableByIDsForUpdate(ctx context.Context, ids []int64) ([]model.SkuRestockRecord, error) {
+	if len(ids) == 0 {
+		return []model.SkuRestockRecord{}, nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	var records []model.SkuRestockRecord
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ? AND status IN ? AND deleted_at IS NULL", ids, []uint8{model.RestockStatusPending, model.RestockStatusPartial}).
+		Where("EXISTS (SELECT 1 FROM sku_restock_audit WHERE sku_restock_audit.restock_id = sku_restock_records.id "+
+			"AND sku_restock_audit.audit_status = ? AND sku_restock_audit.deleted_at IS NULL)", model.AuditStatusApproved).
+		Order("id ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// UpdateOrdered 更新已订货数量和补货状态
+func (r *SkuRestockRepositoryImpl) UpdateOrdered(ctx context.Context, id int64, orderedQuantity int32, status uint8) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.SkuRestockRecord{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"ordered_quantity": orderedQuantity, "status": status}).Error
+}
+
 // SkuRestockAuditRepositoryImpl 补货审核记录仓储实现
 type SkuRestockAuditRepositoryImpl struct {
 	db *gorm.DB
@@ -0,0 +1,87 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SkuStockReservationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// BatchCreate 批量创建预占记录
+func (r *SkuStockReservationRepositoryImpl) BatchCreate(ctx context.Context, reservations []*model.SkuStockReservation) error {
+	if len(reservations) == 0 {
+		return nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	return db.CreateInBatches(reservations, 100).Error
+}
+
+// FindByOrderIdForUpdate 锁定订单的全部预占记录
+func (r *SkuStockReservationRepositoryImpl) FindByOrderIdForUpdate(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var reservations []model.SkuStockReservation
+	err := db.Model(&model.SkuStockReservation{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderId).
+		Order("sku_id ASC").
+		Find(&reservations).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// FindSkuIdsByOrderId 查询订单预占过的SKU ID，不加锁
+func (r *SkuStockReservationRepositoryImpl) FindSkuIdsByOrderId(ctx context.Context, orderId int64) ([]int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var skuIds []int64
+	err := db.Model(&model.SkuStockReservation{}).
+		Where("order_id = ?", orderId).
+		Distinct().
+		Order("sku_id ASC").
+		Pluck("sku_id", &skuIds).Error
+	if err != nil {
+		return nil, err
+	}
+	return skuIds, nil
+}
+
+// FindExpiredForUpdate 锁定已过期仍处于预占中的记录
+func (r *SkuStockReservationRepositoryImpl) FindExpiredForUpdate(ctx context.Context, now time.Time, limit int) ([]model.SkuStockReservation, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var reservations []model.SkuStockReservation
+	err := db.Model(&model.SkuStockReservation{}).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND expires_at IS NOT NULL AND expires_at <= ?", model.ReservationStatusHeld, now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&reservations).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// UpdateStatusByIds 更新预占记录状态
+func (r *SkuStockReservationRepositoryImpl) UpdateStatusByIds(ctx context.Context, ids []int64, fromStatus uint8, toStatus uint8) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	tx := db.Model(&model.SkuStockReservation{}).
+		Where("id IN ? AND status = ?", ids, fromStatus).
+		Update("status", toStatus)
+	return tx.RowsAffected, tx.Error
+}
+
+// NewSkuStockReservationRepository 创建库存预占仓储实例
+func NewSkuStockReservationRepository(db *gorm.DB) repository.SkuStockReservationRepository {
+	return &SkuStockReservationRepositoryImpl{db: db}
+}
@@ -2,6 +2,8 @@ package gorm
 
 import (
 	"context"
+	"errors"
+
 	"github.com/zhanshen02154/product/internal/domain/model"
 	"github.com/zhanshen02154/product/internal/domain/repository"
 	"gorm.io/gorm"
@@ -56,6 +58,135 @@ func (r *SupplierRepositoryImpl) GetSupplierInfoBySkuID(ctx context.Context, sku
 	return result, nil
 }
 
+// FindByID 根据ID查询供应商
+func (r *SupplierRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.Supplier, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var supplier model.Supplier
+	if err := db.Where("id = ?", id).First(&supplier).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+// ExistsName 供应商名称是否已存在
+func (r *SupplierRepositoryImpl) ExistsName(ctx context.Context, name string, excludeID int64) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Model(&model.Supplier{}).Where("name = ? AND id <> ?", name, excludeID).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Create 创建供应商
+func (r *SupplierRepositoryImpl) Create(ctx context.Context, supplier *model.Supplier) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(supplier).Error
+}
+
+// Update 更新供应商信息
+func (r *SupplierRepositoryImpl) Update(ctx context.Context, supplier *model.Supplier) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.Supplier{ID: supplier.ID}).
+		Select("name", "contact_person", "phone", "email", "address", "rating", "lead_time_days", "payment_terms").
+		Updates(supplier).Error
+}
+
+// Delete 软删除供应商
+func (r *SupplierRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Where("id = ?", id).Delete(&model.Supplier{}).Error
+}
+
+// List 分页查询供应商
+func (r *SupplierRepositoryImpl) List(ctx context.Context, keyword string, offset, limit int) ([]model.Supplier, int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var suppliers []model.Supplier
+	var total int64
+
+	query := db.Model(&model.Supplier{})
+	if keyword != "" {
+		query = query.Where("name LIKE ?", "%"+keyword+"%")
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Order("id ASC").Offset(offset).Limit(limit).Find(&suppliers).Error; err != nil {
+		return nil, 0, err
+	}
+	return suppliers, total, nil
+}
+
+// CountSupplierProducts 统计供应商关联的SKU数量
+func (r *SupplierRepositoryImpl) CountSupplierProducts(ctx context.Context, supplierID int64) (int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	err := db.Model(&model.SupplierProduct{}).Where("supplier_id = ?", supplierID).Count(&count).Error
+	return count, err
+}
+
+// FindSupplierProduct 查询供应商与SKU的关联
+func (r *SupplierRepositoryImpl) FindSupplierProduct(ctx context.Context, supplierID int64, skuID int64) (*model.SupplierProduct, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var supplierProduct model.SupplierProduct
+	err := db.Where("supplier_id = ? AND sku_id = ?", supplierID, skuID).First(&supplierProduct).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &supplierProduct, nil
+}
+
+// SaveSupplierProduct 创建或更新供应商与SKU的关联
+func (r *SupplierRepositoryImpl) SaveSupplierProduct(ctx context.Context, supplierProduct *model.SupplierProduct) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Omit("Supplier").Save(supplierProduct).Error
+}
+
+// DeleteSupplierProduct 删除供应商与SKU的关联
+func (r *SupplierRepositoryImpl) DeleteSupplierProduct(ctx context.Context, id int64) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Where("id = ?", id).Delete(&model.SupplierProduct{}).Error
+}
+
+// ClearPreferred 取消SKU除excludeID外其他关联的首选标记
+func (r *SupplierRepositoryImpl) ClearPreferred(ctx context.Context, skuID int64, excludeID int64) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.SupplierProduct{}).
+		Where("sku_id = ? AND id <> ? AND is_preferred = ?", skuID, excludeID, true).
+		Update("is_preferred", false).Error
+}
+
+// ListSkusBySupplier 分页查询供应商供应的SKU，已删除的SKU不返回
+func (r *SupplierRepositoryImpl) ListSkusBySupplier(ctx context.Context, supplierID int64, offset, limit int) ([]*repository.SupplierSkuInfo, int64, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var total int64
+	result := make([]*repository.SupplierSkuInfo, 0)
+
+	query := db.Model(&model.SupplierProduct{}).
+		Joins("JOIN product_skus ON product_skus.id = supplier_products.sku_id AND product_skus.deleted_at IS NULL").
+		Where("supplier_products.supplier_id = ?", supplierID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Select("product_skus.id AS sku_id, product_skus.sku_no, product_skus.sku_name, product_skus.spec_value_text, " +
+		"product_skus.price, product_skus.stock, product_skus.status, supplier_products.supply_price, " +
+		"supplier_products.min_order_quantity, supplier_products.is_preferred").
+		Order("supplier_products.sku_id ASC").
+		Offset(offset).Limit(limit).
+		Scan(&result).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
 // NewSupplierRepository 创建供应商仓储实例
 func NewSupplierRepository(db *gorm.DB) repository.SupplierRepository {
 	return &SupplierRepositoryImpl{db: db}
@@ -14,8 +14,18 @@ type GormTransactionManager struct {
 
 type txKey struct{}
 
+// Execute 在事务内执行fn，ctx中已有事务时加入该事务，以保存点隔离本次执行
+// 最外层事务提交或回滚后执行transaction.AfterCompletion登记的回调
 func (gtm *GormTransactionManager) Execute(ctx context.Context, fn func(txCtx context.Context) error) error {
-	return gtm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	db := gtm.db.WithContext(ctx)
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		db = tx
+	} else {
+		var complete func()
+		ctx, complete = transaction.WithCompletion(ctx)
+		defer complete()
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
 		txCtx := context.WithValue(ctx, txKey{}, tx)
 		return fn(txCtx)
 	})
@@ -50,6 +60,8 @@ func (gtm *GormTransactionManager) ExecuteWithBarrier(ctx context.Context, fn fu
 		Context:                  ctx,
 		CreateBatchSize:          2000,
 	})
+	ctx, complete := transaction.WithCompletion(ctx)
+	defer complete()
 	return barrier.CallWithDB(sqlDb, func(tx1 *sql.Tx) error {
 		session.Statement.ConnPool = tx1
 		txCtx := context.WithValue(ctx, txKey{}, session)
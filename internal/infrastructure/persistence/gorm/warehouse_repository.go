@@ -0,0 +1,158 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WarehouseRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// FindByID 根据ID查询仓库
+func (r *WarehouseRepositoryImpl) FindByID(ctx context.Context, id int64) (*model.Warehouse, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var warehouse model.Warehouse
+	if err := db.Where("id = ?", id).First(&warehouse).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &warehouse, nil
+}
+
+// ExistsCode 仓库编码是否已存在
+func (r *WarehouseRepositoryImpl) ExistsCode(ctx context.Context, code string) (bool, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var count int64
+	if err := db.Model(&model.Warehouse{}).Where("code = ?", code).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Create 创建仓库
+func (r *WarehouseRepositoryImpl) Create(ctx context.Context, warehouse *model.Warehouse) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Create(warehouse).Error
+}
+
+// List 查询全部仓库，按优先级升序
+func (r *WarehouseRepositoryImpl) List(ctx context.Context) ([]model.Warehouse, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var warehouses []model.Warehouse
+	if err := db.Order("priority ASC").Order("id ASC").Find(&warehouses).Error; err != nil {
+		return nil, err
+	}
+	return warehouses, nil
+}
+
+// ListSkuStocks 查询SKU在各仓库的库存（包含仓库信息）
+func (r *WarehouseRepositoryImpl) ListSkuStocks(ctx context.Context, skuID int64) ([]model.SkuWarehouseStock, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var stocks []model.SkuWarehouseStock
+	err := db.Preload("Warehouse").
+		Where("sku_id = ?", skuID).
+		Order("warehouse_id ASC").
+		Find(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+	return stocks, nil
+}
+
+// ListEnabledSkuStocksForUpdate 锁定并查询SKU在启用仓库中的库存（包含仓库信息）
+func (r *WarehouseRepositoryImpl) ListEnabledSkuStocksForUpdate(ctx context.Context, skuIDs []int64) ([]model.SkuWarehouseStock, error) {
+	if len(skuIDs) == 0 {
+		return []model.SkuWarehouseStock{}, nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	var stocks []model.SkuWarehouseStock
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Warehouse").
+		Where("sku_id IN ?", skuIDs).
+		Where("warehouse_id IN (?)", db.Model(&model.Warehouse{}).Select("id").Where("status = ?", model.WarehouseStatusEnabled)).
+		Order("id ASC").
+		Find(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+	return stocks, nil
+}
+
+// FindSkuStockForUpdate 锁定并查询SKU在指定仓库的库存，不存在时返回nil
+func (r *WarehouseRepositoryImpl) FindSkuStockForUpdate(ctx context.Context, skuID int64, warehouseID int64) (*model.SkuWarehouseStock, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var stock model.SkuWarehouseStock
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("sku_id = ? AND warehouse_id = ?", skuID, warehouseID).
+		First(&stock).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &stock, nil
+}
+
+// SaveSkuStock 创建或更新分仓库存
+func (r *WarehouseRepositoryImpl) SaveSkuStock(ctx context.Context, stock *model.SkuWarehouseStock) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Omit("Warehouse").Save(stock).Error
+}
+
+// DeductSkuStock 扣减分仓库存，库存不足时返回repository.ErrInsufficientStock
+func (r *WarehouseRepositoryImpl) DeductSkuStock(ctx context.Context, id int64, count uint32) error {
+	db := GetDBFromContext(ctx, r.db)
+	tx := db.Model(&model.SkuWarehouseStock{}).
+		Where("id = ? AND stock >= ?", id, count).
+		Update("stock", gorm.Expr("stock - ?", count))
+	if err := tx.Error; err != nil {
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		return repository.ErrInsufficientStock
+	}
+	return nil
+}
+
+// BatchCreateAllocations 批量写入订单分仓记录
+func (r *WarehouseRepositoryImpl) BatchCreateAllocations(ctx context.Context, allocations []*model.OrderWarehouseAllocation) error {
+	if len(allocations) == 0 {
+		return nil
+	}
+	db := GetDBFromContext(ctx, r.db)
+	return db.CreateInBatches(allocations, 100).Error
+}
+
+// FindAllocationsByOrderIdForUpdate 锁定并查询订单的分仓记录，按ID升序
+func (r *WarehouseRepositoryImpl) FindAllocationsByOrderIdForUpdate(ctx context.Context, orderID int64) ([]model.OrderWarehouseAllocation, error) {
+	db := GetDBFromContext(ctx, r.db)
+	var allocations []model.OrderWarehouseAllocation
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ?", orderID).
+		Order("id ASC").
+		Find(&allocations).Error
+	if err != nil {
+		return nil, err
+	}
+	return allocations, nil
+}
+
+// UpdateAllocationRestored 更新分仓记录的已回补数量
+func (r *WarehouseRepositoryImpl) UpdateAllocationRestored(ctx context.Context, id int64, restoredQuantity uint32) error {
+	db := GetDBFromContext(ctx, r.db)
+	return db.Model(&model.OrderWarehouseAllocation{}).Where("id = ?", id).Update("restored_quantity", restoredQuantity).Error
+}
+
+// NewWarehouseRepository 创建仓库仓储实例
+func NewWarehouseRepository(db *gorm.DB) repository.WarehouseRepository {
+	return &WarehouseRepositoryImpl{db: db}
+}
@@ -1,8 +1,46 @@
 package transaction
 
-import "context"
+import (
+	"context"
+	"sync"
+)
 
 type TransactionManager interface {
 	Execute(ctx context.Context, fn func(txCtx context.Context) error) error
 	ExecuteWithBarrier(ctx context.Context, fn func(txCtx context.Context) error) error
 }
+
+type completionKey struct{}
+
+// completion 最外层事务结束后执行的回调
+type completion struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// WithCompletion 开启最外层事务时调用，返回的函数须在事务提交或回滚后调用，按登记的逆序执行回调
+func WithCompletion(ctx context.Context) (context.Context, func()) {
+	c := &completion{}
+	return context.WithValue(ctx, completionKey{}, c), func() {
+		c.mu.Lock()
+		fns := c.fns
+		c.fns = nil
+		c.mu.Unlock()
+		for i := len(fns) - 1; i >= 0; i-- {
+			fns[i]()
+		}
+	}
+}
+
+// AfterCompletion 登记在最外层事务结束后执行的回调，嵌套事务（保存点）结束时不执行
+// ctx不在事务中时返回false，由调用方自行执行
+func AfterCompletion(ctx context.Context, fn func()) bool {
+	c, ok := ctx.Value(completionKey{}).(*completion)
+	if !ok {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fns = append(c.fns, fn)
+	return true
+}
@@ -0,0 +1,439 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/logger"
+)
+
+const (
+	rabbitmqDefaultExchange      = "product"
+	rabbitmqDefaultAckCapacity   = 1024
+	rabbitmqDisconnectAckTimeout = 5 * time.Second
+)
+
+type rabbitmqPublishAcksKey struct{}
+
+type rabbitmqExchangeKey struct{}
+
+type rabbitmqPrefetchCountKey struct{}
+
+type rabbitmqConsumerTimeoutKey struct{}
+
+// RabbitMQPublishAcks 发布确认写入回执通道，未设置时同步等待确认并返回发布错误
+func RabbitMQPublishAcks(acks chan<- *event.PublishAck) broker.Option {
+	return setBrokerOption(rabbitmqPublishAcksKey{}, acks)
+}
+
+// RabbitMQExchange 发布和订阅使用的topic类型交换机
+func RabbitMQExchange(name string) broker.Option {
+	return setBrokerOption(rabbitmqExchangeKey{}, name)
+}
+
+// RabbitMQPrefetchCount 每个消费者已投递未确认的消息数量上限
+func RabbitMQPrefetchCount(n int) broker.Option {
+	return setBrokerOption(rabbitmqPrefetchCountKey{}, n)
+}
+
+// RabbitMQConsumerTimeout 持久化队列的消息投递后未确认时，服务端关闭消费通道并重新入队的等待时间
+func RabbitMQConsumerTimeout(d time.Duration) broker.Option {
+	return setBrokerOption(rabbitmqConsumerTimeoutKey{}, d)
+}
+
+// rabbitmqBroker 基于RabbitMQ的Broker，主题作为路由键发布到topic交换机，发布通道开启confirm模式
+type rabbitmqBroker struct {
+	mu        sync.RWMutex
+	opts      broker.Options
+	conn      *amqp.Connection
+	channel   *amqp.Channel
+	connected bool
+	pending   chan *rabbitmqPendingPublish
+	closing   chan struct{}
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	sending   sync.WaitGroup
+}
+
+// rabbitmqPendingPublish 等待服务端确认的发布
+type rabbitmqPendingPublish struct {
+	msg     *broker.Message
+	confirm *amqp.DeferredConfirmation
+}
+
+type rabbitmqSubscriber struct {
+	topic   string
+	tag     string
+	channel *amqp.Channel
+	opts    broker.SubscribeOptions
+	done    chan struct{}
+}
+
+// rabbitmqEvent 消费的消息，Ack只向服务端确认一次
+type rabbitmqEvent struct {
+	topic    string
+	msg      *broker.Message
+	delivery amqp.Delivery
+	once     sync.Once
+	err      error
+}
+
+func (e *rabbitmqEvent) Topic() string {
+	return e.topic
+}
+
+func (e *rabbitmqEvent) Message() *broker.Message {
+	return e.msg
+}
+
+func (e *rabbitmqEvent) Ack() error {
+	var err error
+	e.once.Do(func() {
+		err = e.delivery.Ack(false)
+	})
+	return err
+}
+
+func (e *rabbitmqEvent) Error() error {
+	return e.err
+}
+
+// nack 处理失败且没有ErrorHandler时重新入队
+func (e *rabbitmqEvent) nack() error {
+	var err error
+	e.once.Do(func() {
+		err = e.delivery.Nack(false, true)
+	})
+	return err
+}
+
+func (s *rabbitmqSubscriber) Options() broker.SubscribeOptions {
+	return s.opts
+}
+
+func (s *rabbitmqSubscriber) Topic() string {
+	return s.topic
+}
+
+// Unsubscribe 取消消费并等待已投递的消息处理完成，持久化队列保留在服务端
+func (s *rabbitmqSubscriber) Unsubscribe() error {
+	err := s.channel.Cancel(s.tag, false)
+	<-s.done
+	if cErr := s.channel.Close(); err == nil && !errors.Is(cErr, amqp.ErrClosed) {
+		err = cErr
+	}
+	return err
+}
+
+func (b *rabbitmqBroker) Init(opts ...broker.Option) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, o := range opts {
+		o(&b.opts)
+	}
+	return nil
+}
+
+func (b *rabbitmqBroker) Options() broker.Options {
+	return b.opts
+}
+
+func (b *rabbitmqBroker) Address() string {
+	if len(b.opts.Addrs) > 0 {
+		return b.opts.Addrs[0]
+	}
+	return ""
+}
+
+// Connect 连接并声明交换机，发布通道开启confirm模式，异步模式下启动发布确认的转发
+func (b *rabbitmqBroker) Connect() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.connected {
+		return nil
+	}
+	if len(b.opts.Addrs) == 0 {
+		return errors.New("rabbitmq url is empty")
+	}
+	conn, err := amqp.Dial(b.opts.Addrs[0])
+	if err != nil {
+		return err
+	}
+	ch, err := conn.Channel()
+	if err == nil {
+		err = ch.Confirm(false)
+	}
+	if err == nil {
+		err = ch.ExchangeDeclare(b.exchange(), amqp.ExchangeTopic, true, false, false, false, nil)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	b.conn = conn
+	b.channel = ch
+	b.quit = make(chan struct{})
+	if acks := b.publishAcks(); acks != nil {
+		b.pending = make(chan *rabbitmqPendingPublish, rabbitmqDefaultAckCapacity)
+		b.closing = make(chan struct{})
+		b.wg.Add(1)
+		go b.forwardAcks(acks, b.pending, b.closing, b.quit)
+	}
+	b.connected = true
+	return nil
+}
+
+// Disconnect 等待已发布消息的确认转发完成后断开连接
+func (b *rabbitmqBroker) Disconnect() error {
+	b.mu.Lock()
+	if !b.connected {
+		b.mu.Unlock()
+		return nil
+	}
+	b.connected = false
+	conn := b.conn
+	closing, quit := b.closing, b.quit
+	b.pending = nil
+	b.closing = nil
+	b.mu.Unlock()
+
+	// 最多等待一段时间，让正在发布的消息进入待确认队列、已发布消息的确认进入回执通道
+	timeout := time.After(rabbitmqDisconnectAckTimeout)
+	if waitGroupDone(&b.sending, timeout) && closing != nil {
+		close(closing)
+		waitGroupDone(&b.wg, timeout)
+	}
+	close(quit)
+	b.sending.Wait()
+	b.wg.Wait()
+	if err := conn.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		return err
+	}
+	return nil
+}
+
+// Publish 以主题为路由键发布，异步模式下确认结果通过回执通道返回
+// 等待写入待确认队列时不持有锁，避免回执通道阻塞时Disconnect无法获取写锁
+func (b *rabbitmqBroker) Publish(topic string, msg *broker.Message, opts ...broker.PublishOption) error {
+	headers := make(amqp.Table, len(msg.Header))
+	for k, v := range msg.Header {
+		headers[k] = v
+	}
+	publishing := amqp.Publishing{
+		Headers:      headers,
+		ContentType:  msg.Header["Content-Type"],
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         msg.Body,
+	}
+
+	b.mu.RLock()
+	if !b.connected {
+		b.mu.RUnlock()
+		return errors.New("rabbitmq broker not connected")
+	}
+	pending, quit := b.pending, b.quit
+	if pending != nil {
+		b.sending.Add(1)
+		defer b.sending.Done()
+	}
+	confirm, err := b.channel.PublishWithDeferredConfirmWithContext(context.Background(), b.exchange(), topic, false, false, publishing)
+	b.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if pending == nil {
+		if !confirm.Wait() {
+			return fmt.Errorf("rabbitmq nacked message %d", confirm.DeliveryTag)
+		}
+		return nil
+	}
+	select {
+	case pending <- &rabbitmqPendingPublish{msg: msg, confirm: confirm}:
+	case <-quit:
+		return errors.New("rabbitmq broker disconnected")
+	}
+	return nil
+}
+
+// Subscribe 订阅，每个消费者组对应“主题.Queue”的持久化队列，未设置Queue时使用独占的临时队列
+func (b *rabbitmqBroker) Subscribe(topic string, handler broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	options := broker.SubscribeOptions{
+		AutoAck: true,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.connected {
+		return nil, errors.New("rabbitmq broker not connected")
+	}
+	ch, err := b.conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	queue, durable, exclusive := "", false, true
+	if options.Queue != "" {
+		queue, durable, exclusive = topic+"."+options.Queue, true, false
+	}
+	tag := uuid.New().String()
+	var deliveries <-chan amqp.Delivery
+	var args amqp.Table
+	if timeout, _ := b.opts.Context.Value(rabbitmqConsumerTimeoutKey{}).(time.Duration); durable && timeout > 0 {
+		args = amqp.Table{"x-consumer-timeout": timeout.Milliseconds()}
+	}
+	q, err := ch.QueueDeclare(queue, durable, !durable, exclusive, false, args)
+	if err == nil {
+		err = ch.QueueBind(q.Name, topic, b.exchange(), false, nil)
+	}
+	if err == nil {
+		if prefetch, _ := b.opts.Context.Value(rabbitmqPrefetchCountKey{}).(int); prefetch > 0 {
+			err = ch.Qos(prefetch, 0, false)
+		}
+	}
+	if err == nil {
+		deliveries, err = ch.Consume(q.Name, tag, false, false, false, false, nil)
+	}
+	if err != nil {
+		_ = ch.Close()
+		return nil, err
+	}
+
+	sub := &rabbitmqSubscriber{
+		topic:   topic,
+		tag:     tag,
+		channel: ch,
+		opts:    options,
+		done:    make(chan struct{}),
+	}
+	go func() {
+		defer close(sub.done)
+		for d := range deliveries {
+			b.handle(topic, d, handler, options)
+		}
+	}()
+	return sub, nil
+}
+
+func (b *rabbitmqBroker) String() string {
+	return "rabbitmq"
+}
+
+// handle 处理消息，失败时交给ErrorHandler，没有ErrorHandler时重新入队
+func (b *rabbitmqBroker) handle(topic string, d amqp.Delivery, handler broker.Handler, options broker.SubscribeOptions) {
+	header := make(map[string]string, len(d.Headers)+2)
+	for k, v := range d.Headers {
+		header[k] = fmt.Sprint(v)
+	}
+	header["Micro-Topic"] = topic
+	if _, ok := header["Content-Type"]; !ok {
+		header["Content-Type"] = "application/json"
+		if d.ContentType != "" {
+			header["Content-Type"] = d.ContentType
+		}
+	}
+	evt := &rabbitmqEvent{topic: topic, msg: &broker.Message{Header: header, Body: d.Body}, delivery: d}
+	err := handler(evt)
+	if err == nil {
+		if options.AutoAck {
+			if err := evt.Ack(); err != nil {
+				logger.Errorf("[rabbitmq]: failed to ack message: %v", err)
+			}
+		}
+		return
+	}
+	evt.err = err
+	if eh := b.opts.ErrorHandler; eh != nil {
+		_ = eh(evt)
+		return
+	}
+	logger.Errorf("[rabbitmq]: subscriber error: %v", err)
+	if err := evt.nack(); err != nil {
+		logger.Errorf("[rabbitmq]: failed to nack message: %v", err)
+	}
+}
+
+func (b *rabbitmqBroker) exchange() string {
+	if name, ok := b.opts.Context.Value(rabbitmqExchangeKey{}).(string); ok && name != "" {
+		return name
+	}
+	return rabbitmqDefaultExchange
+}
+
+func (b *rabbitmqBroker) publishAcks() chan<- *event.PublishAck {
+	if b.opts.Context == nil {
+		return nil
+	}
+	acks, _ := b.opts.Context.Value(rabbitmqPublishAcksKey{}).(chan<- *event.PublishAck)
+	return acks
+}
+
+// forwardAcks 按发布顺序等待服务端确认，转换为发布回执，投递标签作为偏移量
+// closing关闭后转发完待确认队列中剩余的消息后退出
+func (b *rabbitmqBroker) forwardAcks(acks chan<- *event.PublishAck, pending chan *rabbitmqPendingPublish, closing, quit chan struct{}) {
+	defer b.wg.Done()
+	for {
+		select {
+		case p := <-pending:
+			if !b.forwardAck(acks, p, quit) {
+				return
+			}
+		case <-closing:
+			for {
+				select {
+				case p := <-pending:
+					if !b.forwardAck(acks, p, quit) {
+						return
+					}
+				default:
+					return
+				}
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// forwardAck 等待单条消息的确认并写入回执通道，quit关闭时返回false
+func (b *rabbitmqBroker) forwardAck(acks chan<- *event.PublishAck, p *rabbitmqPendingPublish, quit chan struct{}) bool {
+	ack := &event.PublishAck{Message: p.msg, Partition: -1, Offset: int64(p.confirm.DeliveryTag)}
+	select {
+	case <-p.confirm.Done():
+		if !p.confirm.Acked() {
+			ack.Err = fmt.Errorf("rabbitmq nacked message %d", p.confirm.DeliveryTag)
+		}
+	case <-quit:
+		return false
+	}
+	select {
+	case acks <- ack:
+		return true
+	case <-quit:
+		return false
+	}
+}
+
+// NewRabbitMQBroker 创建RabbitMQ Broker，Addrs的第一个地址为连接URL
+func NewRabbitMQBroker(opts ...broker.Option) broker.Broker {
+	options := broker.Options{
+		Context: context.Background(),
+		Logger:  logger.DefaultLogger,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+	return &rabbitmqBroker{
+		opts: options,
+	}
+}
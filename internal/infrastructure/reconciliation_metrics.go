@@ -0,0 +1,47 @@
+package infrastructure
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// 库存对账指标，每轮对账结束后更新
+var (
+	ReconciliationCheckedSkus = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_reconciliation_checked_skus",
+		Help: "number of skus checked by the last inventory reconciliation run",
+	})
+	ReconciliationMismatchedSkus = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_reconciliation_mismatched_skus",
+		Help: "number of skus whose stock differs from the last ledger entry",
+	})
+	ReconciliationUntrackedSkus = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_reconciliation_untracked_skus",
+		Help: "number of skus without any ledger entry",
+	})
+	ReconciliationDriftUnits = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_reconciliation_drift_units",
+		Help: "sum of absolute stock drift across mismatched skus",
+	})
+	ReconciliationCorrectedCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "inventory_reconciliation_corrected_count",
+		Help: "corrective ledger entries written by inventory reconciliation",
+	})
+	ReconciliationRunCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "inventory_reconciliation_run_count",
+		Help: "inventory reconciliation runs by status",
+	}, []string{"status"})
+	ReconciliationLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_reconciliation_last_success_timestamp_seconds",
+		Help: "unix time of the last successful inventory reconciliation run",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		ReconciliationCheckedSkus,
+		ReconciliationMismatchedSkus,
+		ReconciliationUntrackedSkus,
+		ReconciliationDriftUnits,
+		ReconciliationCorrectedCount,
+		ReconciliationRunCount,
+		ReconciliationLastSuccess,
+	)
+}
@@ -36,6 +36,7 @@ func (r *exponentialBackOff) Execute(ctx context.Context, fn func() error) error
 			if r.isPermanentError(err) {
 				return backoff.Permanent(err)
 			}
+			return err
 		}
 
 		return nil
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go-micro.dev/v4/logger"
+)
+
+// TaskFunc 周期任务
+type TaskFunc func(ctx context.Context) error
+
+// PeriodicWorker 周期执行任务的后台协程
+// 生命周期由框架的AfterStart/BeforeStop管理
+type PeriodicWorker struct {
+	name     string
+	interval time.Duration
+	task     TaskFunc
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	cancel   context.CancelFunc
+	started  bool
+}
+
+// NewPeriodicWorker 创建周期任务
+func NewPeriodicWorker(name string, interval time.Duration, task TaskFunc) *PeriodicWorker {
+	return &PeriodicWorker{
+		name:     name,
+		interval: interval,
+		task:     task,
+	}
+}
+
+// Start 启动
+func (w *PeriodicWorker) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.started {
+		return
+	}
+	w.started = true
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.wg.Add(1)
+	go w.run(ctx)
+	logger.Info("worker ", w.name, " started")
+}
+
+// Close 关闭并等待正在执行的任务结束
+func (w *PeriodicWorker) Close(ctx context.Context) error {
+	w.mu.Lock()
+	if !w.started {
+		w.mu.Unlock()
+		return nil
+	}
+	w.started = false
+	w.cancel()
+	w.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		logger.Info("worker ", w.name, " stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *PeriodicWorker) run(ctx context.Context) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.execute(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// execute 执行一次任务，panic不影响后续调度
+func (w *PeriodicWorker) execute(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("worker %s panic: %v", w.name, r)
+		}
+	}()
+	if err := w.task(ctx); err != nil && ctx.Err() == nil {
+		logger.Error("worker ", w.name, " failed: ", err.Error())
+	}
+}
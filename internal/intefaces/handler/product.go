@@ -173,14 +173,15 @@ func (h *ProductHandler) CreateRestockApply(ctx context.Context, req *product.Cr
 	// 构建响应
 	if response.RestockRecord != nil {
 		resp.RestockRecord = &product.RestockRecordInfo{
-			Id:           response.RestockRecord.ID,
-			UserId:       response.RestockRecord.UserID,
-			SkuId:        response.RestockRecord.SkuID,
-			Quantity:     response.RestockRecord.Quantity,
-			Reason:       response.RestockRecord.Reason,
-			Status:       uint32(response.RestockRecord.Status),
-			FailedReason: response.RestockRecord.FailedReason,
-			CreatedAt:    response.RestockRecord.CreatedAt,
+			Id:            response.RestockRecord.ID,
+			UserId:        response.RestockRecord.UserID,
+			SkuId:         response.RestockRecord.SkuID,
+			Quantity:      response.RestockRecord.Quantity,
+			Reason:        response.RestockRecord.Reason,
+			Status:        uint32(response.RestockRecord.Status),
+			FailedReason:  response.RestockRecord.FailedReason,
+			CreatedAt:     response.RestockRecord.CreatedAt,
+			ApplicationNo: response.RestockRecord.ApplicationNo,
 		}
 	}
 
@@ -196,6 +197,42 @@ func (h *ProductHandler) CreateRestockApply(ctx context.Context, req *product.Cr
 	return nil
 }
 
+// ApproveRestockApply
+//
+//	@Description: 审核通过补货申请
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ApproveRestockApply(ctx context.Context, req *product.ApproveRestockApplyRequest, resp *product.ApproveRestockApplyResponse) error {
+	response, err := h.ProductApplicationService.ApproveRestockApply(ctx, req.ApplicationNo, req.AuditUserId)
+	if err != nil {
+		return err
+	}
+	resp.RestockRecord = response.RestockRecord
+	resp.Audit = response.Audit
+	return nil
+}
+
+// RejectRestockApply
+//
+//	@Description: 驳回补货申请
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) RejectRestockApply(ctx context.Context, req *product.RejectRestockApplyRequest, resp *product.RejectRestockApplyResponse) error {
+	response, err := h.ProductApplicationService.RejectRestockApply(ctx, req.ApplicationNo, req.AuditUserId, req.Reason)
+	if err != nil {
+		return err
+	}
+	resp.RestockRecord = response.RestockRecord
+	resp.Audit = response.Audit
+	return nil
+}
+
 // GetRestockApplyInfo
 //
 //	@Description: 获取补货申请信息
@@ -302,6 +339,1265 @@ func (h *ProductHandler) GetSkuDailySales(ctx context.Context, req *product.GetS
 	return nil
 }
 
+// ReserveStock
+//
+//	@Description: 预占库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ReserveStock(ctx context.Context, req *product.ReserveStockRequest, resp *product.ReserveStockResponse) error {
+	reserveDto := &dto.ReserveStockDto{
+		OrderID:    req.OrderId,
+		Items:      make([]*dto.ReserveStockItemDto, 0, len(req.Items)),
+		TtlSeconds: req.TtlSeconds,
+	}
+	for _, item := range req.Items {
+		reserveDto.Items = append(reserveDto.Items, &dto.ReserveStockItemDto{
+			SkuID:    item.SkuId,
+			Quantity: item.Quantity,
+		})
+	}
+	response, err := h.ProductApplicationService.ReserveStock(ctx, reserveDto)
+	if err != nil {
+		return err
+	}
+	resp.Reservations = response.Reservations
+	return nil
+}
+
+// ConfirmReservation
+//
+//	@Description: 确认预占
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ConfirmReservation(ctx context.Context, req *product.ConfirmReservationRequest, resp *product.ConfirmReservationResponse) error {
+	response, err := h.ProductApplicationService.ConfirmReservation(ctx, req.OrderId)
+	if err != nil {
+		return err
+	}
+	resp.Reservations = response.Reservations
+	return nil
+}
+
+// ReleaseReservation
+//
+//	@Description: 释放预占
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ReleaseReservation(ctx context.Context, req *product.ReleaseReservationRequest, resp *product.ReleaseReservationResponse) error {
+	response, err := h.ProductApplicationService.ReleaseReservation(ctx, req.OrderId)
+	if err != nil {
+		return err
+	}
+	resp.Reservations = response.Reservations
+	return nil
+}
+
+// TryDeductSku
+//
+//	@Description: TCC Try，预占SKU库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) TryDeductSku(ctx context.Context, req *product.TccDeductSkuRequest, resp *product.TccDeductSkuResponse) error {
+	reserveDto := &dto.ReserveStockDto{
+		OrderID: req.OrderId,
+		Items:   make([]*dto.ReserveStockItemDto, 0, len(req.Items)),
+	}
+	for _, item := range req.Items {
+		reserveDto.Items = append(reserveDto.Items, &dto.ReserveStockItemDto{
+			SkuID:    item.SkuId,
+			Quantity: item.Quantity,
+		})
+	}
+	return h.ProductApplicationService.TryDeductSku(ctx, reserveDto)
+}
+
+// ConfirmDeductSku
+//
+//	@Description: TCC Confirm，确认扣减SKU库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ConfirmDeductSku(ctx context.Context, req *product.TccDeductSkuRequest, resp *product.TccDeductSkuResponse) error {
+	return h.ProductApplicationService.ConfirmDeductSku(ctx, req.OrderId)
+}
+
+// CancelDeductSku
+//
+//	@Description: TCC Cancel，释放预占的SKU库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CancelDeductSku(ctx context.Context, req *product.TccDeductSkuRequest, resp *product.TccDeductSkuResponse) error {
+	return h.ProductApplicationService.CancelDeductSku(ctx, req.OrderId)
+}
+
+// CreateProduct
+//
+//	@Description: 创建商品
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreateProduct(ctx context.Context, req *product.CreateProductRequest, resp *product.CreateProductResponse) error {
+	response, err := h.ProductApplicationService.CreateProduct(ctx, toProductInputDto(req.Product))
+	if err != nil {
+		return err
+	}
+	resp.Product = response.Product
+	return nil
+}
+
+// CreateProductWithSkus
+//
+//	@Description: 创建商品并按规格矩阵生成SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreateProductWithSkus(ctx context.Context, req *product.CreateProductWithSkusRequest, resp *product.CreateProductWithSkusResponse) error {
+	createDto := &dto.CreateProductWithSkusDto{
+		Product:    toProductInputDto(req.Product),
+		Specs:      toSpecInputDtos(req.Specs),
+		DefaultSku: toSkuInputDto(req.DefaultSku),
+		Skus:       toSkuInputDtos(req.Skus),
+	}
+	response, err := h.ProductApplicationService.CreateProductWithSkus(ctx, createDto)
+	if err != nil {
+		return err
+	}
+	resp.Product = response.Product
+	return nil
+}
+
+// UpdateProduct
+//
+//	@Description: 更新商品及SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) UpdateProduct(ctx context.Context, req *product.UpdateProductRequest, resp *product.UpdateProductResponse) error {
+	updateDto := &dto.UpdateProductDto{
+		ID:      req.Id,
+		Product: toProductInputDto(req.Product),
+		Skus:    make([]*dto.SkuUpdateDto, 0, len(req.Skus)),
+	}
+	for _, sku := range req.Skus {
+		updateDto.Skus = append(updateDto.Skus, &dto.SkuUpdateDto{
+			ID:          sku.Id,
+			SkuName:     sku.SkuName,
+			Price:       sku.Price,
+			MarketPrice: sku.MarketPrice,
+			StockWarn:   sku.StockWarn,
+			MainImage:   sku.MainImage,
+			Images:      sku.Images,
+		})
+	}
+	response, err := h.ProductApplicationService.UpdateProduct(ctx, updateDto)
+	if err != nil {
+		return err
+	}
+	resp.Product = response.Product
+	return nil
+}
+
+// GetProduct
+//
+//	@Description: 获取商品详情
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetProduct(ctx context.Context, req *product.GetProductRequest, resp *product.GetProductResponse) error {
+	response, err := h.ProductApplicationService.GetProduct(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	resp.Product = response.Product
+	return nil
+}
+
+// ListProducts
+//
+//	@Description: 分页查询商品
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListProducts(ctx context.Context, req *product.ListProductsRequest, resp *product.ListProductsResponse) error {
+	response, err := h.ProductApplicationService.ListProducts(ctx, &dto.ListProductsDto{
+		Keyword:    req.Keyword,
+		CategoryID: req.CategoryId,
+		BrandID:    req.BrandId,
+		Statuses:   req.Statuses,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Products = response.Products
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// DeleteProduct
+//
+//	@Description: 删除商品
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) DeleteProduct(ctx context.Context, req *product.DeleteProductRequest, resp *product.DeleteProductResponse) error {
+	return h.ProductApplicationService.DeleteProduct(ctx, req.Id)
+}
+
+// PreviewSkuMatrix
+//
+//	@Description: 预览规格矩阵的变更
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) PreviewSkuMatrix(ctx context.Context, req *product.SkuMatrixRequest, resp *product.SkuMatrixResponse) error {
+	response, err := h.ProductApplicationService.PreviewSkuMatrix(ctx, toSkuMatrixDto(req))
+	if err != nil {
+		return err
+	}
+	resp.Create = response.Create
+	resp.Disable = response.Disable
+	resp.Keep = response.Keep
+	return nil
+}
+
+// ApplySkuMatrix
+//
+//	@Description: 应用规格矩阵，新增SKU并下架规格组合已不存在的SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ApplySkuMatrix(ctx context.Context, req *product.SkuMatrixRequest, resp *product.SkuMatrixResponse) error {
+	response, err := h.ProductApplicationService.ApplySkuMatrix(ctx, toSkuMatrixDto(req))
+	if err != nil {
+		return err
+	}
+	resp.Create = response.Create
+	resp.Disable = response.Disable
+	resp.Keep = response.Keep
+	return nil
+}
+
+// CreateCategory
+//
+//	@Description: 创建分类
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreateCategory(ctx context.Context, req *product.CreateCategoryRequest, resp *product.CreateCategoryResponse) error {
+	response, err := h.ProductApplicationService.CreateCategory(ctx, req.CategoryName, req.ParentId)
+	if err != nil {
+		return err
+	}
+	resp.Category = response.Category
+	return nil
+}
+
+// RenameCategory
+//
+//	@Description: 修改分类名称
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) RenameCategory(ctx context.Context, req *product.RenameCategoryRequest, resp *product.RenameCategoryResponse) error {
+	response, err := h.ProductApplicationService.RenameCategory(ctx, req.Id, req.CategoryName)
+	if err != nil {
+		return err
+	}
+	resp.Category = response.Category
+	return nil
+}
+
+// MoveCategory
+//
+//	@Description: 移动分类
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) MoveCategory(ctx context.Context, req *product.MoveCategoryRequest, resp *product.MoveCategoryResponse) error {
+	response, err := h.ProductApplicationService.MoveCategory(ctx, req.Id, req.ParentId)
+	if err != nil {
+		return err
+	}
+	resp.Category = response.Category
+	return nil
+}
+
+// DeleteCategory
+//
+//	@Description: 删除分类
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) DeleteCategory(ctx context.Context, req *product.DeleteCategoryRequest, resp *product.DeleteCategoryResponse) error {
+	return h.ProductApplicationService.DeleteCategory(ctx, req.Id)
+}
+
+// GetCategoryTree
+//
+//	@Description: 获取分类树
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetCategoryTree(ctx context.Context, req *product.GetCategoryTreeRequest, resp *product.GetCategoryTreeResponse) error {
+	response, err := h.ProductApplicationService.GetCategoryTree(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Categories = response.Categories
+	return nil
+}
+
+// ListCategorySkus
+//
+//	@Description: 分页查询分类及其子分类下的SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListCategorySkus(ctx context.Context, req *product.ListCategorySkusRequest, resp *product.ListCategorySkusResponse) error {
+	response, err := h.ProductApplicationService.ListCategorySkus(ctx, req.CategoryId, req.Page, req.PageSize)
+	if err != nil {
+		return err
+	}
+	resp.Skus = response.Skus
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// CreateBrand
+//
+//	@Description: 创建品牌
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreateBrand(ctx context.Context, req *product.CreateBrandRequest, resp *product.CreateBrandResponse) error {
+	response, err := h.ProductApplicationService.CreateBrand(ctx, req.BrandName, req.Logo)
+	if err != nil {
+		return err
+	}
+	resp.Brand = response.Brand
+	return nil
+}
+
+// UpdateBrand
+//
+//	@Description: 更新品牌名称和Logo
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) UpdateBrand(ctx context.Context, req *product.UpdateBrandRequest, resp *product.UpdateBrandResponse) error {
+	response, err := h.ProductApplicationService.UpdateBrand(ctx, req.Id, req.BrandName, req.Logo)
+	if err != nil {
+		return err
+	}
+	resp.Brand = response.Brand
+	return nil
+}
+
+// GetBrand
+//
+//	@Description: 获取品牌及其上架的商品、SKU数量
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetBrand(ctx context.Context, req *product.GetBrandRequest, resp *product.GetBrandResponse) error {
+	response, err := h.ProductApplicationService.GetBrand(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	resp.Brand = response.Brand
+	return nil
+}
+
+// ListBrands
+//
+//	@Description: 分页查询品牌及其上架的商品、SKU数量
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListBrands(ctx context.Context, req *product.ListBrandsRequest, resp *product.ListBrandsResponse) error {
+	response, err := h.ProductApplicationService.ListBrands(ctx, req.Keyword, req.Page, req.PageSize)
+	if err != nil {
+		return err
+	}
+	resp.Brands = response.Brands
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// DeleteBrand
+//
+//	@Description: 删除品牌，仍被商品引用时拒绝删除
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) DeleteBrand(ctx context.Context, req *product.DeleteBrandRequest, resp *product.DeleteBrandResponse) error {
+	return h.ProductApplicationService.DeleteBrand(ctx, req.Id)
+}
+
+// ReassignBrandProducts
+//
+//	@Description: 批量迁移品牌下的商品
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ReassignBrandProducts(ctx context.Context, req *product.ReassignBrandProductsRequest, resp *product.ReassignBrandProductsResponse) error {
+	response, err := h.ProductApplicationService.ReassignBrandProducts(ctx, &dto.ReassignBrandProductsDto{
+		FromBrandID: req.FromBrandId,
+		ToBrandID:   req.ToBrandId,
+		ProductIDs:  req.ProductIds,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Affected = response.Affected
+	return nil
+}
+
+// CreateSupplier
+//
+//	@Description: 创建供应商
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreateSupplier(ctx context.Context, req *product.CreateSupplierRequest, resp *product.CreateSupplierResponse) error {
+	response, err := h.ProductApplicationService.CreateSupplier(ctx, toSupplierInputDto(req.Supplier))
+	if err != nil {
+		return err
+	}
+	resp.Supplier = response.Supplier
+	return nil
+}
+
+// UpdateSupplier
+//
+//	@Description: 更新供应商信息
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) UpdateSupplier(ctx context.Context, req *product.UpdateSupplierRequest, resp *product.UpdateSupplierResponse) error {
+	response, err := h.ProductApplicationService.UpdateSupplier(ctx, req.Id, toSupplierInputDto(req.Supplier))
+	if err != nil {
+		return err
+	}
+	resp.Supplier = response.Supplier
+	return nil
+}
+
+// GetSupplier
+//
+//	@Description: 获取供应商
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetSupplier(ctx context.Context, req *product.GetSupplierRequest, resp *product.GetSupplierResponse) error {
+	response, err := h.ProductApplicationService.GetSupplier(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	resp.Supplier = response.Supplier
+	return nil
+}
+
+// ListSuppliers
+//
+//	@Description: 分页查询供应商
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListSuppliers(ctx context.Context, req *product.ListSuppliersRequest, resp *product.ListSuppliersResponse) error {
+	response, err := h.ProductApplicationService.ListSuppliers(ctx, req.Keyword, req.Page, req.PageSize)
+	if err != nil {
+		return err
+	}
+	resp.Suppliers = response.Suppliers
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// DeleteSupplier
+//
+//	@Description: 删除供应商，仍关联SKU时拒绝删除
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) DeleteSupplier(ctx context.Context, req *product.DeleteSupplierRequest, resp *product.DeleteSupplierResponse) error {
+	return h.ProductApplicationService.DeleteSupplier(ctx, req.Id)
+}
+
+// LinkSupplierSku
+//
+//	@Description: 关联供应商与SKU，已关联时更新供货信息
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) LinkSupplierSku(ctx context.Context, req *product.LinkSupplierSkuRequest, resp *product.LinkSupplierSkuResponse) error {
+	response, err := h.ProductApplicationService.LinkSupplierSku(ctx, &dto.LinkSupplierSkuDto{
+		SupplierID:       req.SupplierId,
+		SkuID:            req.SkuId,
+		SupplyPrice:      req.SupplyPrice,
+		MinOrderQuantity: req.MinOrderQuantity,
+		IsPreferred:      req.IsPreferred,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Item = response.Item
+	return nil
+}
+
+// UnlinkSupplierSku
+//
+//	@Description: 解除供应商与SKU的关联
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) UnlinkSupplierSku(ctx context.Context, req *product.UnlinkSupplierSkuRequest, resp *product.UnlinkSupplierSkuResponse) error {
+	return h.ProductApplicationService.UnlinkSupplierSku(ctx, req.SupplierId, req.SkuId)
+}
+
+// ListSkusBySupplier
+//
+//	@Description: 分页查询供应商供应的SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListSkusBySupplier(ctx context.Context, req *product.ListSkusBySupplierRequest, resp *product.ListSkusBySupplierResponse) error {
+	response, err := h.ProductApplicationService.ListSkusBySupplier(ctx, req.SupplierId, req.Page, req.PageSize)
+	if err != nil {
+		return err
+	}
+	resp.Skus = response.Skus
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// CreatePurchaseOrders
+//
+//	@Description: 根据审核通过的补货记录按供应商生成采购单
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreatePurchaseOrders(ctx context.Context, req *product.CreatePurchaseOrdersRequest, resp *product.CreatePurchaseOrdersResponse) error {
+	items := make([]*dto.PurchaseOrderItemDto, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, &dto.PurchaseOrderItemDto{
+			RestockID: item.RestockId,
+			Quantity:  item.Quantity,
+		})
+	}
+	response, err := h.ProductApplicationService.CreatePurchaseOrders(ctx, &dto.CreatePurchaseOrdersDto{
+		Items:      items,
+		OperatorID: req.OperatorId,
+		Remark:     req.Remark,
+	})
+	if err != nil {
+		return err
+	}
+	resp.PurchaseOrders = response.PurchaseOrders
+	return nil
+}
+
+// GetPurchaseOrder
+//
+//	@Description: 获取采购单及其明细
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetPurchaseOrder(ctx context.Context, req *product.GetPurchaseOrderRequest, resp *product.GetPurchaseOrderResponse) error {
+	response, err := h.ProductApplicationService.GetPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	resp.PurchaseOrder = response.PurchaseOrder
+	return nil
+}
+
+// ListPurchaseOrders
+//
+//	@Description: 分页查询采购单
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListPurchaseOrders(ctx context.Context, req *product.ListPurchaseOrdersRequest, resp *product.ListPurchaseOrdersResponse) error {
+	response, err := h.ProductApplicationService.ListPurchaseOrders(ctx, &dto.ListPurchaseOrdersDto{
+		SupplierID: req.SupplierId,
+		Statuses:   req.Statuses,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		return err
+	}
+	resp.PurchaseOrders = response.PurchaseOrders
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// ReceiveGoods
+//
+//	@Description: 采购收货，按明细增加SKU库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ReceiveGoods(ctx context.Context, req *product.ReceiveGoodsRequest, resp *product.ReceiveGoodsResponse) error {
+	items := make([]*dto.ReceiveGoodsItemDto, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item == nil {
+			continue
+		}
+		items = append(items, &dto.ReceiveGoodsItemDto{
+			LineID:   item.LineId,
+			Quantity: item.Quantity,
+		})
+	}
+	response, err := h.ProductApplicationService.ReceiveGoods(ctx, &dto.ReceiveGoodsDto{
+		PurchaseOrderID: req.PurchaseOrderId,
+		WarehouseID:     req.WarehouseId,
+		Items:           items,
+		OperatorID:      req.OperatorId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.PurchaseOrder = response.PurchaseOrder
+	return nil
+}
+
+// AdjustStock
+//
+//	@Description: 手动调整SKU库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) AdjustStock(ctx context.Context, req *product.AdjustStockRequest, resp *product.AdjustStockResponse) error {
+	response, err := h.ProductApplicationService.AdjustStock(ctx, &dto.AdjustStockDto{
+		SkuID:       req.SkuId,
+		WarehouseID: req.WarehouseId,
+		Delta:       req.Delta,
+		Reason:      req.Reason,
+		OperatorID:  req.OperatorId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Result = response.Result
+	return nil
+}
+
+// SubmitStocktake
+//
+//	@Description: 提交盘点结果，按差异调整库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) SubmitStocktake(ctx context.Context, req *product.SubmitStocktakeRequest, resp *product.SubmitStocktakeResponse) error {
+	items := make([]*dto.StocktakeItemDto, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item == nil {
+			continue
+		}
+		items = append(items, &dto.StocktakeItemDto{
+			SkuID:           item.SkuId,
+			CountedQuantity: item.CountedQuantity,
+		})
+	}
+	response, err := h.ProductApplicationService.SubmitStocktake(ctx, &dto.StocktakeDto{
+		WarehouseID: req.WarehouseId,
+		Items:       items,
+		Reason:      req.Reason,
+		OperatorID:  req.OperatorId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Results = response.Results
+	return nil
+}
+
+// ListStockChanges
+//
+//	@Description: 游标分页查询库存流水
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListStockChanges(ctx context.Context, req *product.ListStockChangesRequest, resp *product.ListStockChangesResponse) error {
+	response, err := h.ProductApplicationService.ListStockChanges(ctx, &dto.ListStockChangesDto{
+		SkuID:           req.SkuId,
+		OrderID:         req.OrderId,
+		PurchaseOrderID: req.PurchaseOrderId,
+		SourceType:      req.SourceType,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		Cursor:          req.Cursor,
+		Limit:           req.Limit,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Records = response.Records
+	resp.NextCursor = response.NextCursor
+	return nil
+}
+
+// GetStockAtTime
+//
+//	@Description: 根据库存流水推算SKU在指定时刻的库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetStockAtTime(ctx context.Context, req *product.GetStockAtTimeRequest, resp *product.GetStockAtTimeResponse) error {
+	response, err := h.ProductApplicationService.GetStockAtTime(ctx, req.SkuId, req.At)
+	if err != nil {
+		return err
+	}
+	resp.SkuId = response.SkuId
+	resp.Stock = response.Stock
+	resp.RecordId = response.RecordId
+	return nil
+}
+
+// GetReconciliationReport
+//
+//	@Description: 分批对账SKU库存与库存流水
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetReconciliationReport(ctx context.Context, req *product.GetReconciliationReportRequest, resp *product.GetReconciliationReportResponse) error {
+	response, err := h.ProductApplicationService.GetReconciliationReport(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return err
+	}
+	resp.Checked = response.Checked
+	resp.Untracked = response.Untracked
+	resp.Mismatches = response.Mismatches
+	resp.NextCursor = response.NextCursor
+	return nil
+}
+
+// CorrectStockLedger
+//
+//	@Description: 为库存与流水不一致的SKU写入修正流水
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CorrectStockLedger(ctx context.Context, req *product.CorrectStockLedgerRequest, resp *product.CorrectStockLedgerResponse) error {
+	response, err := h.ProductApplicationService.CorrectStockLedger(ctx, req.SkuIds, req.OperatorId)
+	if err != nil {
+		return err
+	}
+	resp.Corrected = response.Corrected
+	return nil
+}
+
+// CreateWarehouse
+//
+//	@Description: 创建仓库
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) CreateWarehouse(ctx context.Context, req *product.CreateWarehouseRequest, resp *product.CreateWarehouseResponse) error {
+	response, err := h.ProductApplicationService.CreateWarehouse(ctx, &dto.WarehouseInputDto{
+		Code:      req.Code,
+		Name:      req.Name,
+		Priority:  req.Priority,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Warehouse = response.Warehouse
+	return nil
+}
+
+// ListWarehouses
+//
+//	@Description: 查询仓库列表
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListWarehouses(ctx context.Context, req *product.ListWarehousesRequest, resp *product.ListWarehousesResponse) error {
+	response, err := h.ProductApplicationService.ListWarehouses(ctx)
+	if err != nil {
+		return err
+	}
+	resp.Warehouses = response.Warehouses
+	return nil
+}
+
+// SetSkuWarehouseStock
+//
+//	@Description: 设置SKU分仓库存，增加的数量先从未分仓库存转入，不足的部分计入可售总库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) SetSkuWarehouseStock(ctx context.Context, req *product.SetSkuWarehouseStockRequest, resp *product.SetSkuWarehouseStockResponse) error {
+	response, err := h.ProductApplicationService.SetSkuWarehouseStock(ctx, &dto.SetSkuWarehouseStockDto{
+		SkuID:       req.SkuId,
+		WarehouseID: req.WarehouseId,
+		Stock:       req.Stock,
+		Reason:      req.Reason,
+		OperatorID:  req.OperatorId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Stock = response.Stock
+	return nil
+}
+
+// GetSkuWarehouseStock
+//
+//	@Description: 查询SKU可售总库存及分仓库存
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetSkuWarehouseStock(ctx context.Context, req *product.GetSkuWarehouseStockRequest, resp *product.GetSkuWarehouseStockResponse) error {
+	response, err := h.ProductApplicationService.GetSkuWarehouseStock(ctx, req.SkuId)
+	if err != nil {
+		return err
+	}
+	resp.Stock = response.Stock
+	return nil
+}
+
+// SchedulePriceChange
+//
+//	@Description: 计划调价，生效时间为空时立即生效
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) SchedulePriceChange(ctx context.Context, req *product.SchedulePriceChangeRequest, resp *product.SchedulePriceChangeResponse) error {
+	response, err := h.ProductApplicationService.SchedulePriceChange(ctx, &dto.SchedulePriceChangeDto{
+		SkuID:         req.SkuId,
+		Price:         req.Price,
+		MarketPrice:   req.MarketPrice,
+		EffectiveFrom: req.EffectiveFrom,
+		EffectiveTo:   req.EffectiveTo,
+		Reason:        req.Reason,
+		OperatorID:    req.OperatorId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Change = response.Change
+	return nil
+}
+
+// GetSkuPriceAt
+//
+//	@Description: 查询SKU在指定时刻的价格，供订单核对下单价格
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetSkuPriceAt(ctx context.Context, req *product.GetSkuPriceAtRequest, resp *product.GetSkuPriceAtResponse) error {
+	response, err := h.ProductApplicationService.GetSkuPriceAt(ctx, req.SkuId, req.At)
+	if err != nil {
+		return err
+	}
+	resp.SkuId = response.SkuId
+	resp.Price = response.Price
+	resp.MarketPrice = response.MarketPrice
+	resp.ChangeId = response.ChangeId
+	return nil
+}
+
+// ListSkuPriceHistory
+//
+//	@Description: 分页查询SKU价格变更记录
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListSkuPriceHistory(ctx context.Context, req *product.ListSkuPriceHistoryRequest, resp *product.ListSkuPriceHistoryResponse) error {
+	response, err := h.ProductApplicationService.ListSkuPriceHistory(ctx, req.SkuId, req.Page, req.PageSize)
+	if err != nil {
+		return err
+	}
+	resp.Changes = response.Changes
+	resp.Total = response.Total
+	resp.Page = response.Page
+	resp.PageSize = response.PageSize
+	return nil
+}
+
+// PublishProduct
+//
+//	@Description: 上架商品及SKU，可设置定时上架
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) PublishProduct(ctx context.Context, req *product.PublishProductRequest, resp *product.PublishProductResponse) error {
+	response, err := h.ProductApplicationService.PublishProduct(ctx, &dto.PublishProductDto{
+		ProductID:  req.ProductId,
+		SkuIDs:     req.SkuIds,
+		OnSaleAt:   req.OnSaleAt,
+		OperatorID: req.OperatorId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Change = response.Change
+	return nil
+}
+
+// UnpublishProduct
+//
+//	@Description: 下架商品，上架中的SKU一并下架
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) UnpublishProduct(ctx context.Context, req *product.UnpublishProductRequest, resp *product.UnpublishProductResponse) error {
+	response, err := h.ProductApplicationService.UnpublishProduct(ctx, &dto.ProductStatusDto{
+		ProductID:  req.ProductId,
+		OperatorID: req.OperatorId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Change = response.Change
+	return nil
+}
+
+// UnpublishSku
+//
+//	@Description: 下架单个SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) UnpublishSku(ctx context.Context, req *product.UnpublishSkuRequest, resp *product.UnpublishSkuResponse) error {
+	response, err := h.ProductApplicationService.UnpublishSku(ctx, &dto.UnpublishSkuDto{
+		SkuID:      req.SkuId,
+		OperatorID: req.OperatorId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Change = response.Change
+	return nil
+}
+
+// ArchiveProduct
+//
+//	@Description: 归档商品及其所有SKU
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ArchiveProduct(ctx context.Context, req *product.ArchiveProductRequest, resp *product.ArchiveProductResponse) error {
+	response, err := h.ProductApplicationService.ArchiveProduct(ctx, &dto.ProductStatusDto{
+		ProductID:  req.ProductId,
+		OperatorID: req.OperatorId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Change = response.Change
+	return nil
+}
+
+// ListDeadLetters
+//
+//	@Description: 游标分页查询死信
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ListDeadLetters(ctx context.Context, req *product.ListDeadLettersRequest, resp *product.ListDeadLettersResponse) error {
+	response, err := h.ProductApplicationService.ListDeadLetters(ctx, &dto.ListDeadLettersDto{
+		Topic:     req.Topic,
+		EventType: req.EventType,
+		Status:    req.Status,
+		Cursor:    req.Cursor,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		return err
+	}
+	resp.DeadLetters = response.DeadLetters
+	resp.NextCursor = response.NextCursor
+	return nil
+}
+
+// GetDeadLetter
+//
+//	@Description: 查询死信详情，包含原消息头和消息体
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) GetDeadLetter(ctx context.Context, req *product.GetDeadLetterRequest, resp *product.GetDeadLetterResponse) error {
+	response, err := h.ProductApplicationService.GetDeadLetter(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	resp.DeadLetter = response.DeadLetter
+	return nil
+}
+
+// ReplayDeadLetters
+//
+//	@Description: 将死信重新投递到原主题
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ReplayDeadLetters(ctx context.Context, req *product.ReplayDeadLettersRequest, resp *product.ReplayDeadLettersResponse) error {
+	response, err := h.ProductApplicationService.ReplayDeadLetters(ctx, &dto.ReplayDeadLettersDto{
+		IDs:        req.Ids,
+		OperatorID: req.OperatorId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Results = response.Results
+	return nil
+}
+
+// ResolveDeadLetters
+//
+//	@Description: 标记死信已解决或已丢弃
+//	@receiver h
+//	@param ctx
+//	@param req
+//	@param resp
+//	@return error
+func (h *ProductHandler) ResolveDeadLetters(ctx context.Context, req *product.ResolveDeadLettersRequest, resp *product.ResolveDeadLettersResponse) error {
+	response, err := h.ProductApplicationService.ResolveDeadLetters(ctx, &dto.ResolveDeadLettersDto{
+		IDs:        req.Ids,
+		Status:     req.Status,
+		OperatorID: req.OperatorId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	resp.DeadLetters = response.DeadLetters
+	return nil
+}
+
+// toProductInputDto 转换商品基本信息
+func toProductInputDto(req *product.ProductInput) *dto.ProductInputDto {
+	if req == nil {
+		return nil
+	}
+	return &dto.ProductInputDto{
+		ProductNo:   req.ProductNo,
+		ProductName: req.ProductName,
+		CategoryID:  req.CategoryId,
+		BrandID:     req.BrandId,
+		MainImage:   req.MainImage,
+		Description: req.Description,
+		Status:      req.Status,
+	}
+}
+
+// toSupplierInputDto 转换供应商基本信息
+func toSupplierInputDto(req *product.SupplierInput) *dto.SupplierInputDto {
+	if req == nil {
+		return nil
+	}
+	return &dto.SupplierInputDto{
+		Name:          req.Name,
+		ContactPerson: req.ContactPerson,
+		Phone:         req.Phone,
+		Email:         req.Email,
+		Address:       req.Address,
+		Rating:        req.Rating,
+		LeadTimeDays:  req.LeadTimeDays,
+		PaymentTerms:  req.PaymentTerms,
+	}
+}
+
+// toSkuInputDto 转换SKU属性
+func toSkuInputDto(req *product.SkuInput) *dto.SkuInputDto {
+	if req == nil {
+		return nil
+	}
+	return &dto.SkuInputDto{
+		SpecValues:  req.SpecValues,
+		SkuName:     req.SkuName,
+		Price:       req.Price,
+		MarketPrice: req.MarketPrice,
+		Stock:       req.Stock,
+		StockWarn:   req.StockWarn,
+		MainImage:   req.MainImage,
+		Images:      req.Images,
+	}
+}
+
+// toSpecInputDtos 转换规格矩阵
+func toSpecInputDtos(specs []*product.SpecInput) []*dto.SpecInputDto {
+	result := make([]*dto.SpecInputDto, 0, len(specs))
+	for _, spec := range specs {
+		specDto := &dto.SpecInputDto{
+			SpecName: spec.SpecName,
+			Values:   make([]*dto.SpecValueInputDto, 0, len(spec.Values)),
+		}
+		for _, value := range spec.Values {
+			specDto.Values = append(specDto.Values, &dto.SpecValueInputDto{
+				ValueName:  value.ValueName,
+				ValueImage: value.ValueImage,
+			})
+		}
+		result = append(result, specDto)
+	}
+	return result
+}
+
+// toSkuInputDtos 转换单独指定属性的SKU
+func toSkuInputDtos(skus []*product.SkuInput) []*dto.SkuInputDto {
+	result := make([]*dto.SkuInputDto, 0, len(skus))
+	for _, sku := range skus {
+		result = append(result, toSkuInputDto(sku))
+	}
+	return result
+}
+
+// toSkuMatrixDto 转换规格矩阵请求
+func toSkuMatrixDto(req *product.SkuMatrixRequest) *dto.SkuMatrixDto {
+	return &dto.SkuMatrixDto{
+		ProductID:  req.ProductId,
+		Specs:      toSpecInputDtos(req.Specs),
+		DefaultSku: toSkuInputDto(req.DefaultSku),
+		Skus:       toSkuInputDtos(req.Skus),
+	}
+}
+
 // NewProductHandler 创建Handler
 func NewProductHandler(appService service.IProductApplicationService) product.ProductHandler {
 	return &ProductHandler{
@@ -0,0 +1,104 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/application/service"
+	"github.com/zhanshen02154/product/internal/domain/event/order"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 订单退款/取消事件类型常量
+const (
+	EventTypeOnOrderRefunded  = "OnOrderRefunded"
+	EventTypeOnOrderCancelled = "OnOrderCancelled"
+)
+
+// OrderEventHandler 订单事件处理器接口
+type OrderEventHandler interface {
+	OnOrderRefunded(ctx context.Context, req *order.OnOrderRefunded) error
+	OnOrderCancelled(ctx context.Context, req *order.OnOrderCancelled) error
+}
+
+// orderEventHandlerImpl 订单事件处理器实现类
+type orderEventHandlerImpl struct {
+	productAppService service.IProductApplicationService
+}
+
+// NewOrderEventHandler 新建Handler
+func NewOrderEventHandler(appService service.IProductApplicationService) OrderEventHandler {
+	return &orderEventHandlerImpl{productAppService: appService}
+}
+
+// OnOrderRefunded 订单退款，回补已扣减的库存
+func (h *orderEventHandlerImpl) OnOrderRefunded(ctx context.Context, req *order.OnOrderRefunded) error {
+	if req.OrderId == 0 || req.RefundId == 0 || len(req.OrderDetails) == 0 {
+		return status.Error(codes.InvalidArgument, "orderId, refundId or products cannot be empty")
+	}
+	return h.productAppService.DeductInvetoryRevert(ctx, &dto.OrderInventoryRestoreDto{
+		OrderID:    req.OrderId,
+		Reason:     model.RestoreReasonRefund,
+		RestoreKey: model.RestoreReasonRefund + "-" + strconv.FormatInt(req.OrderId, 10) + "-" + strconv.FormatInt(req.RefundId, 10),
+		Sku:        toRestoreItems(req.OrderDetails),
+	})
+}
+
+// OnOrderCancelled 订单取消，释放预占并回补已扣减的库存
+func (h *orderEventHandlerImpl) OnOrderCancelled(ctx context.Context, req *order.OnOrderCancelled) error {
+	if req.OrderId == 0 {
+		return status.Error(codes.InvalidArgument, "orderId cannot be empty")
+	}
+	return h.productAppService.DeductInvetoryRevert(ctx, &dto.OrderInventoryRestoreDto{
+		OrderID:    req.OrderId,
+		Reason:     model.RestoreReasonCancel,
+		RestoreKey: model.RestoreReasonCancel + "-" + strconv.FormatInt(req.OrderId, 10),
+		Sku:        toRestoreItems(req.OrderDetails),
+	})
+}
+
+// AsEventHandlers 将 OrderEventHandler 转换为 EventHandler 列表，用于注册到 EventDispatcher
+func (h *orderEventHandlerImpl) AsEventHandlers() []event2.EventHandler {
+	return []event2.EventHandler{
+		event2.NewGenericHandler(
+			EventTypeOnOrderRefunded,
+			h.OnOrderRefunded,
+			func() *order.OnOrderRefunded { return &order.OnOrderRefunded{} },
+		),
+		event2.NewGenericHandler(
+			EventTypeOnOrderCancelled,
+			h.OnOrderCancelled,
+			func() *order.OnOrderCancelled { return &order.OnOrderCancelled{} },
+		),
+	}
+}
+
+// RegisterToDispatcher 注册到事件分发器
+func (h *orderEventHandlerImpl) RegisterToDispatcher(dispatcher *event2.EventDispatcher) error {
+	handlers := h.AsEventHandlers()
+	for _, handler := range handlers {
+		if err := dispatcher.RegisterHandler(handler, TopicOrderEvents, ConsumerGroupProduct); err != nil {
+			return fmt.Errorf("failed to register handler %s: %w", handler.EventType(), err)
+		}
+		logger.Infof("registered order event handler: %s", handler.EventType())
+	}
+	return nil
+}
+
+// toRestoreItems 转换订单SKU
+func toRestoreItems(details []*order.OrderDetail) []*dto.OrderInventoryRestoreItem {
+	items := make([]*dto.OrderInventoryRestoreItem, 0, len(details))
+	for _, detail := range details {
+		items = append(items, &dto.OrderInventoryRestoreItem{
+			SkuID:    detail.SkuId,
+			Quantity: detail.Quantity,
+		})
+	}
+	return items
+}
@@ -7,6 +7,8 @@ import (
 
 const eventIdKey = "Event_id"
 
+type eventIdContextKey struct{}
+
 // GetEventId 获取事件ID
 func GetEventId(ctx context.Context) (string, bool) {
 	return metadata.Get(ctx, eventIdKey)
@@ -20,3 +22,15 @@ func GetValueFromMetadata(ctx context.Context, key string) string {
 	}
 	return val
 }
+
+// WithEventId 指定发布事件时使用的事件ID，未指定时由发布方生成
+// 使用context值而非metadata，避免订阅端收到的事件ID被带到新发布的事件中
+func WithEventId(ctx context.Context, eventId string) context.Context {
+	return context.WithValue(ctx, eventIdContextKey{}, eventId)
+}
+
+// GetPublishEventId 获取发布事件时指定的事件ID
+func GetPublishEventId(ctx context.Context) (string, bool) {
+	eventId, ok := ctx.Value(eventIdContextKey{}).(string)
+	return eventId, ok && eventId != ""
+}
@@ -744,7 +744,7 @@ type GetSkuStockBySkuNoResponse struct {
 	SkuCode       string                 `protobuf:"bytes,1,opt,name=sku_code,json=skuCode,proto3" json:"sku_code,omitempty"`        // SKU编号
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // SKU名称
 	Stock         uint32                 `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`                          // 当前库存
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                        // 商品状态（1上架 0下架）
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                        // 商品状态（1上架 0下架 2草稿 3已归档）
 	StockWarn     uint32                 `protobuf:"varint,5,opt,name=stock_warn,json=stockWarn,proto3" json:"stock_warn,omitempty"` // 库存预警值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -940,14 +940,15 @@ func (x *CreateRestockApplyResponse) GetSkuInfo() *SkuBasicInfo {
 // 补货记录信息
 type RestockRecordInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // 补货记录ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                  // 用户ID
-	SkuId         uint64                 `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                     // SKU ID
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 补货数量
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                 // 补货原因
-	Status        uint32                 `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                                // 补货状态：1=待订货 2=部分订货 3=已订货 4=失败
-	FailedReason  string                 `protobuf:"bytes,7,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"` // 失败原因
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // 创建时间
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 补货记录ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
+	SkuId         uint64                 `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                        // SKU ID
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                               // 补货数量
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 补货原因
+	Status        uint32                 `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                                   // 补货状态：1=待订货 2=部分订货 3=已订货 4=失败 5=已到货
+	FailedReason  string                 `protobuf:"bytes,7,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`    // 失败原因
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`             // 创建时间
+	ApplicationNo string                 `protobuf:"bytes,9,opt,name=application_no,json=applicationNo,proto3" json:"application_no,omitempty"` // 业务流水号
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1038,6 +1039,13 @@ func (x *RestockRecordInfo) GetCreatedAt() string {
 	return ""
 }
 
+func (x *RestockRecordInfo) GetApplicationNo() string {
+	if x != nil {
+		return x.ApplicationNo
+	}
+	return ""
+}
+
 // SKU基本信息
 type SkuBasicInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1833,7 +1841,7 @@ type GetRestockApplyInfoResponse struct {
 	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                        // SKU ID
 	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                               // 补货数量
 	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 补货原因
-	Status        uint32                 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                   // 状态（补货状态：1=待订货 2=部分订货 3=已订货 4=失败）
+	Status        uint32                 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                                   // 状态（补货状态：1=待订货 2=部分订货 3=已订货 4=失败 5=已到货）
 	ApplicationNo string                 `protobuf:"bytes,6,opt,name=application_no,json=applicationNo,proto3" json:"application_no,omitempty"` // 业务流水号
 	Audit         *RestockAuditInfo      `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`                                      // 最新一条审核信息
 	unknownFields protoimpl.UnknownFields
@@ -1919,181 +1927,10179 @@ func (x *GetRestockApplyInfoResponse) GetAudit() *RestockAuditInfo {
 	return nil
 }
 
-var File_product_product_proto protoreflect.FileDescriptor
+// 审核通过补货申请请求
+type ApproveRestockApplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationNo string                 `protobuf:"bytes,1,opt,name=application_no,json=applicationNo,proto3" json:"application_no,omitempty"` // 业务流水号
+	AuditUserId   uint32                 `protobuf:"varint,2,opt,name=audit_user_id,json=auditUserId,proto3" json:"audit_user_id,omitempty"`    // 审核用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_product_product_proto_rawDesc = "" +
-	"\n" +
-	"\x15product/product.proto\x12\x10go.micro.service\"\xe7\x01\n" +
-	"\vProductInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
-	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1f\n" +
-	"\vproduct_sku\x18\x03 \x01(\tR\n" +
-	"productSku\x12#\n" +
-	"\rproduct_price\x18\x04 \x01(\x01R\fproductPrice\x12/\n" +
-	"\x13product_description\x18\x05 \x01(\tR\x12productDescription\x12.\n" +
-	"\x13product_category_id\x18\x06 \x01(\x03R\x11productCategoryId\"0\n" +
-	"\x0fResponseProduct\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\"3\n" +
-	"\x1aGetProductSkuDetailRequest\x12\x15\n" +
-	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\"\xbb\x04\n" +
-	"\x1bGetProductSkuDetailResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x15\n" +
-	"\x06sku_no\x18\x03 \x01(\tR\x05skuNo\x12\x19\n" +
-	"\bsku_name\x18\x04 \x01(\tR\askuName\x12$\n" +
-	"\x0espec_value_ids\x18\x05 \x01(\tR\fspecValueIds\x12&\n" +
-	"\x0fspec_value_text\x18\x06 \x01(\tR\rspecValueText\x12\x14\n" +
-	"\x05price\x18\a \x01(\x01R\x05price\x12!\n" +
-	"\fmarket_price\x18\b \x01(\x01R\vmarketPrice\x12\x14\n" +
-	"\x05stock\x18\t \x01(\rR\x05stock\x12\x1d\n" +
-	"\n" +
-	"stock_warn\x18\n" +
-	" \x01(\rR\tstockWarn\x12\x14\n" +
-	"\x05sales\x18\v \x01(\x05R\x05sales\x12\x1d\n" +
-	"\n" +
-	"main_image\x18\f \x01(\tR\tmainImage\x12\x16\n" +
-	"\x06status\x18\r \x01(\x05R\x06status\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12<\n" +
-	"\aproduct\x18\x10 \x01(\v2\".go.micro.service.ProductBasicInfoR\aproduct\x126\n" +
-	"\x06images\x18\x11 \x03(\v2\x1e.go.micro.service.SkuImageInfoR\x06images\"\xa5\x01\n" +
-	"\x10ProductBasicInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
-	"\n" +
-	"product_no\x18\x02 \x01(\tR\tproductNo\x12!\n" +
-	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1d\n" +
-	"\n" +
-	"main_image\x18\x04 \x01(\tR\tmainImage\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"y\n" +
-	"\fSkuImageInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
-	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12\x17\n" +
-	"\ais_main\x18\x03 \x01(\bR\x06isMain\x12#\n" +
-	"\rdisplay_order\x18\x04 \x01(\x05R\fdisplayOrder\"Z\n" +
-	"!CheckSkuInventoryThresholdRequest\x12\x17\n" +
-	"\asku_ids\x18\x01 \x03(\x03R\x06skuIds\x12\x1c\n" +
-	"\tthreshold\x18\x02 \x01(\rR\tthreshold\"\xbb\x01\n" +
-	"\x17SkuInventoryCheckResult\x12\x15\n" +
-	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x15\n" +
-	"\x06sku_no\x18\x02 \x01(\tR\x05skuNo\x12\x19\n" +
-	"\bsku_name\x18\x03 \x01(\tR\askuName\x12\x14\n" +
-	"\x05stock\x18\x04 \x01(\rR\x05stock\x12\x1c\n" +
-	"\tthreshold\x18\x05 \x01(\rR\tthreshold\x12#\n" +
-	"\ris_sufficient\x18\x06 \x01(\bR\fisSufficient\"i\n" +
-	"\"CheckSkuInventoryThresholdResponse\x12C\n" +
-	"\aresults\x18\x01 \x03(\v2).go.micro.service.SkuInventoryCheckResultR\aresults\"6\n" +
-	"\x19GetSkuStockBySkuNoRequest\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\"\x98\x01\n" +
-	"\x1aGetSkuStockBySkuNoResponse\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05stock\x18\x03 \x01(\rR\x05stock\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1d\n" +
-	"\n" +
-	"stock_warn\x18\x05 \x01(\rR\tstockWarn\"\x7f\n" +
-	"\x19CreateRestockApplyRequest\x12\x15\n" +
-	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa3\x01\n" +
-	"\x1aCreateRestockApplyResponse\x12J\n" +
-	"\x0erestock_record\x18\x01 \x01(\v2#.go.micro.service.RestockRecordInfoR\rrestockRecord\x129\n" +
-	"\bsku_info\x18\x02 \x01(\v2\x1e.go.micro.service.SkuBasicInfoR\askuInfo\"\xe3\x01\n" +
-	"\x11RestockRecordInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x15\n" +
-	"\x06sku_id\x18\x03 \x01(\x04R\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\rR\x06status\x12#\n" +
-	"\rfailed_reason\x18\a \x01(\tR\ffailedReason\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\b \x01(\tR\tcreatedAt\"x\n" +
-	"\fSkuBasicInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
-	"\x06sku_no\x18\x02 \x01(\tR\x05skuNo\x12\x19\n" +
-	"\bsku_name\x18\x03 \x01(\tR\askuName\x12&\n" +
-	"\x0fspec_value_text\x18\x04 \x01(\tR\rspecValueText\"o\n" +
-	"\x18GetSkuSalesVolumeRequest\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x1d\n" +
-	"\n" +
-	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x81\x01\n" +
-	"\x19GetSkuSalesVolumeResponse\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12!\n" +
-	"\fsales_volume\x18\x02 \x01(\x03R\vsalesVolume\x12&\n" +
-	"\x0fdaily_avg_sales\x18\x03 \x01(\x01R\rdailyAvgSales\"3\n" +
-	"\x16GetSupplierInfoRequest\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\"\xfd\x01\n" +
-	"\x10SupplierInfoItem\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x1f\n" +
-	"\vsupplier_id\x18\x02 \x01(\x03R\n" +
-	"supplierId\x12!\n" +
-	"\fsupply_price\x18\x03 \x01(\x01R\vsupplyPrice\x12,\n" +
-	"\x12min_order_quantity\x18\x04 \x01(\x05R\x10minOrderQuantity\x12!\n" +
-	"\fis_preferred\x18\x05 \x01(\bR\visPreferred\x129\n" +
-	"\bsupplier\x18\x06 \x01(\v2\x1d.go.micro.service.SupplierDTOR\bsupplier\"\x81\x02\n" +
-	"\vSupplierDTO\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
-	"\x0econtact_person\x18\x03 \x01(\tR\rcontactPerson\x12\x14\n" +
-	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
-	"\x05email\x18\x05 \x01(\tR\x05email\x12\x18\n" +
-	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x16\n" +
-	"\x06rating\x18\a \x01(\x01R\x06rating\x12$\n" +
-	"\x0elead_time_days\x18\b \x01(\x05R\fleadTimeDays\x12#\n" +
-	"\rpayment_terms\x18\t \x01(\tR\fpaymentTerms\"[\n" +
-	"\x17GetSupplierInfoResponse\x12@\n" +
-	"\tsuppliers\x18\x01 \x03(\v2\".go.micro.service.SupplierInfoItemR\tsuppliers\"n\n" +
-	"\x17GetSkuDailySalesRequest\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x03 \x01(\tR\aendDate\"b\n" +
-	"\x0eDailySalesItem\x12\x19\n" +
-	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12!\n" +
-	"\fsales_volume\x18\x02 \x01(\x03R\vsalesVolume\x12\x12\n" +
-	"\x04date\x18\x03 \x01(\tR\x04date\"]\n" +
-	"\x18GetSkuDailySalesResponse\x12A\n" +
-	"\vdaily_sales\x18\x01 \x03(\v2 .go.micro.service.DailySalesItemR\n" +
-	"dailySales\"\\\n" +
-	"\x1aGetRestockApplyInfoRequest\x12%\n" +
-	"\x0eapplication_no\x18\x01 \x01(\tR\rapplicationNo\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xf6\x01\n" +
-	"\x10RestockAuditInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
-	"\n" +
-	"restock_id\x18\x02 \x01(\x03R\trestockId\x12\"\n" +
-	"\raudit_user_id\x18\x03 \x01(\rR\vauditUserId\x12!\n" +
-	"\faudit_status\x18\x04 \x01(\rR\vauditStatus\x12.\n" +
-	"\x13audit_failed_reason\x18\x05 \x01(\tR\x11auditFailedReason\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xf1\x01\n" +
-	"\x1bGetRestockApplyInfoResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\rR\x06status\x12%\n" +
-	"\x0eapplication_no\x18\x06 \x01(\tR\rapplicationNo\x128\n" +
-	"\x05audit\x18\a \x01(\v2\".go.micro.service.RestockAuditInfoR\x05audit2\x80\b\n" +
-	"\aProduct\x12P\n" +
-	"\n" +
-	"AddProduct\x12\x1d.go.micro.service.ProductInfo\x1a!.go.micro.service.ResponseProduct\"\x00\x12t\n" +
-	"\x13GetProductSkuDetail\x12,.go.micro.service.GetProductSkuDetailRequest\x1a-.go.micro.service.GetProductSkuDetailResponse\"\x00\x12\x89\x01\n" +
-	"\x1aCheckSkuInventoryThreshold\x123.go.micro.service.CheckSkuInventoryThresholdRequest\x1a4.go.micro.service.CheckSkuInventoryThresholdResponse\"\x00\x12q\n" +
-	"\x12GetSkuStockBySkuNo\x12+.go.micro.service.GetSkuStockBySkuNoRequest\x1a,.go.micro.service.GetSkuStockBySkuNoResponse\"\x00\x12q\n" +
-	"\x12CreateRestockApply\x12+.go.micro.service.CreateRestockApplyRequest\x1a,.go.micro.service.CreateRestockApplyResponse\"\x00\x12n\n" +
-	"\x11GetSkuSalesVolume\x12*.go.micro.service.GetSkuSalesVolumeRequest\x1a+.go.micro.service.GetSkuSalesVolumeResponse\"\x00\x12h\n" +
-	"\x0fGetSupplierInfo\x12(.go.micro.service.GetSupplierInfoRequest\x1a).go.micro.service.GetSupplierInfoResponse\"\x00\x12t\n" +
-	"\x13GetRestockApplyInfo\x12,.go.micro.service.GetRestockApplyInfoRequest\x1a-.go.micro.service.GetRestockApplyInfoResponse\"\x00\x12k\n" +
-	"\x10GetSkuDailySales\x12).go.micro.service.GetSkuDailySalesRequest\x1a*.go.micro.service.GetSkuDailySalesResponse\"\x00B\x11Z\x0f./proto/productb\x06proto3"
+func (x *ApproveRestockApplyRequest) Reset() {
+	*x = ApproveRestockApplyRequest{}
+	mi := &file_product_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRestockApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRestockApplyRequest) ProtoMessage() {}
+
+func (x *ApproveRestockApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRestockApplyRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRestockApplyRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApproveRestockApplyRequest) GetApplicationNo() string {
+	if x != nil {
+		return x.ApplicationNo
+	}
+	return ""
+}
+
+func (x *ApproveRestockApplyRequest) GetAuditUserId() uint32 {
+	if x != nil {
+		return x.AuditUserId
+	}
+	return 0
+}
+
+// 审核通过补货申请响应
+type ApproveRestockApplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestockRecord *RestockRecordInfo     `protobuf:"bytes,1,opt,name=restock_record,json=restockRecord,proto3" json:"restock_record,omitempty"` // 补货记录信息
+	Audit         *RestockAuditInfo      `protobuf:"bytes,2,opt,name=audit,proto3" json:"audit,omitempty"`                                      // 审核信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRestockApplyResponse) Reset() {
+	*x = ApproveRestockApplyResponse{}
+	mi := &file_product_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRestockApplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRestockApplyResponse) ProtoMessage() {}
+
+func (x *ApproveRestockApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRestockApplyResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRestockApplyResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApproveRestockApplyResponse) GetRestockRecord() *RestockRecordInfo {
+	if x != nil {
+		return x.RestockRecord
+	}
+	return nil
+}
+
+func (x *ApproveRestockApplyResponse) GetAudit() *RestockAuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// 驳回补货申请请求
+type RejectRestockApplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationNo string                 `protobuf:"bytes,1,opt,name=application_no,json=applicationNo,proto3" json:"application_no,omitempty"` // 业务流水号
+	AuditUserId   uint32                 `protobuf:"varint,2,opt,name=audit_user_id,json=auditUserId,proto3" json:"audit_user_id,omitempty"`    // 审核用户ID
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 驳回原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRestockApplyRequest) Reset() {
+	*x = RejectRestockApplyRequest{}
+	mi := &file_product_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRestockApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRestockApplyRequest) ProtoMessage() {}
+
+func (x *RejectRestockApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRestockApplyRequest.ProtoReflect.Descriptor instead.
+func (*RejectRestockApplyRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RejectRestockApplyRequest) GetApplicationNo() string {
+	if x != nil {
+		return x.ApplicationNo
+	}
+	return ""
+}
+
+func (x *RejectRestockApplyRequest) GetAuditUserId() uint32 {
+	if x != nil {
+		return x.AuditUserId
+	}
+	return 0
+}
+
+func (x *RejectRestockApplyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 驳回补货申请响应
+type RejectRestockApplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestockRecord *RestockRecordInfo     `protobuf:"bytes,1,opt,name=restock_record,json=restockRecord,proto3" json:"restock_record,omitempty"` // 补货记录信息
+	Audit         *RestockAuditInfo      `protobuf:"bytes,2,opt,name=audit,proto3" json:"audit,omitempty"`                                      // 审核信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRestockApplyResponse) Reset() {
+	*x = RejectRestockApplyResponse{}
+	mi := &file_product_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRestockApplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRestockApplyResponse) ProtoMessage() {}
+
+func (x *RejectRestockApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRestockApplyResponse.ProtoReflect.Descriptor instead.
+func (*RejectRestockApplyResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RejectRestockApplyResponse) GetRestockRecord() *RestockRecordInfo {
+	if x != nil {
+		return x.RestockRecord
+	}
+	return nil
+}
+
+func (x *RejectRestockApplyResponse) GetAudit() *RestockAuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// 预占库存的SKU
+type ReserveStockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"` // SKU ID
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`        // 预占数量（必须大于0）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockItem) Reset() {
+	*x = ReserveStockItem{}
+	mi := &file_product_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockItem) ProtoMessage() {}
+
+func (x *ReserveStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockItem.ProtoReflect.Descriptor instead.
+func (*ReserveStockItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReserveStockItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ReserveStockItem) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 预占库存请求
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`          // 订单ID
+	Items         []*ReserveStockItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                              // 预占的SKU列表
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 预占有效期（秒），为0时使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_product_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReserveStockRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetItems() []*ReserveStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// 库存预占信息
+type StockReservationInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // ID
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`      // 订单ID
+	SkuId         int64                  `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`            // SKU ID
+	Quantity      uint32                 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // 预占数量
+	Status        uint32                 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`                       // 状态：1=预占中 2=已确认 3=已释放 4=已过期
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 过期时间，为空则不过期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReservationInfo) Reset() {
+	*x = StockReservationInfo{}
+	mi := &file_product_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservationInfo) ProtoMessage() {}
+
+func (x *StockReservationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservationInfo.ProtoReflect.Descriptor instead.
+func (*StockReservationInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StockReservationInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockReservationInfo) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *StockReservationInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockReservationInfo) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockReservationInfo) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *StockReservationInfo) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// 预占库存响应
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Reservations  []*StockReservationInfo `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"` // 预占记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_product_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReserveStockResponse) GetReservations() []*StockReservationInfo {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// 确认预占请求
+type ConfirmReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReservationRequest) Reset() {
+	*x = ConfirmReservationRequest{}
+	mi := &file_product_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationRequest) ProtoMessage() {}
+
+func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConfirmReservationRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+// 确认预占响应
+type ConfirmReservationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Reservations  []*StockReservationInfo `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"` // 预占记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReservationResponse) Reset() {
+	*x = ConfirmReservationResponse{}
+	mi := &file_product_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationResponse) ProtoMessage() {}
+
+func (x *ConfirmReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfirmReservationResponse) GetReservations() []*StockReservationInfo {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// 释放预占请求
+type ReleaseReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_product_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReleaseReservationRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+// 释放预占响应
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Reservations  []*StockReservationInfo `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"` // 预占记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_product_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReleaseReservationResponse) GetReservations() []*StockReservationInfo {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// TCC扣减SKU库存请求，Try/Confirm/Cancel使用相同的请求
+type TccDeductSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
+	Items         []*ReserveStockItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                     // 扣减的SKU列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TccDeductSkuRequest) Reset() {
+	*x = TccDeductSkuRequest{}
+	mi := &file_product_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TccDeductSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TccDeductSkuRequest) ProtoMessage() {}
+
+func (x *TccDeductSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TccDeductSkuRequest.ProtoReflect.Descriptor instead.
+func (*TccDeductSkuRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TccDeductSkuRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *TccDeductSkuRequest) GetItems() []*ReserveStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// TCC扣减SKU库存响应
+type TccDeductSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TccDeductSkuResponse) Reset() {
+	*x = TccDeductSkuResponse{}
+	mi := &file_product_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TccDeductSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TccDeductSkuResponse) ProtoMessage() {}
+
+func (x *TccDeductSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TccDeductSkuResponse.ProtoReflect.Descriptor instead.
+func (*TccDeductSkuResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{40}
+}
+
+// 商品基本信息输入
+type ProductInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductNo     string                 `protobuf:"bytes,1,opt,name=product_no,json=productNo,proto3" json:"product_no,omitempty"`       // 商品编号
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 商品名称
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`   // 分类ID
+	BrandId       int64                  `protobuf:"varint,4,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`            // 品牌ID，为0时不关联品牌
+	MainImage     string                 `protobuf:"bytes,5,opt,name=main_image,json=mainImage,proto3" json:"main_image,omitempty"`       // 主图
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                    // 商品描述
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                             // 状态：0-下架 1-上架 2-草稿 3-已归档
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductInput) Reset() {
+	*x = ProductInput{}
+	mi := &file_product_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductInput) ProtoMessage() {}
+
+func (x *ProductInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductInput.ProtoReflect.Descriptor instead.
+func (*ProductInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ProductInput) GetProductNo() string {
+	if x != nil {
+		return x.ProductNo
+	}
+	return ""
+}
+
+func (x *ProductInput) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductInput) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ProductInput) GetBrandId() int64 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *ProductInput) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *ProductInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductInput) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 规格值输入
+type SpecValueInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ValueName     string                 `protobuf:"bytes,1,opt,name=value_name,json=valueName,proto3" json:"value_name,omitempty"`    // 属性值
+	ValueImage    string                 `protobuf:"bytes,2,opt,name=value_image,json=valueImage,proto3" json:"value_image,omitempty"` // 属性值图片
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecValueInput) Reset() {
+	*x = SpecValueInput{}
+	mi := &file_product_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecValueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecValueInput) ProtoMessage() {}
+
+func (x *SpecValueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecValueInput.ProtoReflect.Descriptor instead.
+func (*SpecValueInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SpecValueInput) GetValueName() string {
+	if x != nil {
+		return x.ValueName
+	}
+	return ""
+}
+
+func (x *SpecValueInput) GetValueImage() string {
+	if x != nil {
+		return x.ValueImage
+	}
+	return ""
+}
+
+// 规格输入，规格与规格值的顺序即显示顺序
+type SpecInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpecName      string                 `protobuf:"bytes,1,opt,name=spec_name,json=specName,proto3" json:"spec_name,omitempty"` // 规格名称
+	Values        []*SpecValueInput      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`                     // 规格值列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecInput) Reset() {
+	*x = SpecInput{}
+	mi := &file_product_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecInput) ProtoMessage() {}
+
+func (x *SpecInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecInput.ProtoReflect.Descriptor instead.
+func (*SpecInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SpecInput) GetSpecName() string {
+	if x != nil {
+		return x.SpecName
+	}
+	return ""
+}
+
+func (x *SpecInput) GetValues() []*SpecValueInput {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// SKU输入
+type SkuInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpecValues    []string               `protobuf:"bytes,1,rep,name=spec_values,json=specValues,proto3" json:"spec_values,omitempty"`      // 规格值名称，按规格顺序排列，用于匹配规格组合
+	SkuName       string                 `protobuf:"bytes,2,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`               // SKU名称，为空时由商品名称和规格值生成
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                                // 价格
+	MarketPrice   float64                `protobuf:"fixed64,4,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"` // 市场价
+	Stock         uint32                 `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`                                 // 初始库存
+	StockWarn     uint32                 `protobuf:"varint,6,opt,name=stock_warn,json=stockWarn,proto3" json:"stock_warn,omitempty"`        // 库存预警值
+	MainImage     string                 `protobuf:"bytes,7,opt,name=main_image,json=mainImage,proto3" json:"main_image,omitempty"`         // SKU主图
+	Images        []string               `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`                                // SKU图片，第一张为主图
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuInput) Reset() {
+	*x = SkuInput{}
+	mi := &file_product_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuInput) ProtoMessage() {}
+
+func (x *SkuInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuInput.ProtoReflect.Descriptor instead.
+func (*SkuInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SkuInput) GetSpecValues() []string {
+	if x != nil {
+		return x.SpecValues
+	}
+	return nil
+}
+
+func (x *SkuInput) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SkuInput) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuInput) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *SkuInput) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SkuInput) GetStockWarn() uint32 {
+	if x != nil {
+		return x.StockWarn
+	}
+	return 0
+}
+
+func (x *SkuInput) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *SkuInput) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// 创建商品请求
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductInput          `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // 商品信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_product_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateProductRequest) GetProduct() *ProductInput {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// 创建商品响应
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductDetail         `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // 商品详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_product_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateProductResponse) GetProduct() *ProductDetail {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// 创建商品并按规格矩阵生成SKU请求
+type CreateProductWithSkusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductInput          `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`                         // 商品信息
+	Specs         []*SpecInput           `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`                             // 规格矩阵
+	DefaultSku    *SkuInput              `protobuf:"bytes,3,opt,name=default_sku,json=defaultSku,proto3" json:"default_sku,omitempty"` // 默认SKU属性，未单独指定的规格组合使用该属性，名称始终按规格值生成
+	Skus          []*SkuInput            `protobuf:"bytes,4,rep,name=skus,proto3" json:"skus,omitempty"`                               // 单独指定属性的规格组合
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductWithSkusRequest) Reset() {
+	*x = CreateProductWithSkusRequest{}
+	mi := &file_product_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductWithSkusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductWithSkusRequest) ProtoMessage() {}
+
+func (x *CreateProductWithSkusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductWithSkusRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductWithSkusRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateProductWithSkusRequest) GetProduct() *ProductInput {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *CreateProductWithSkusRequest) GetSpecs() []*SpecInput {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *CreateProductWithSkusRequest) GetDefaultSku() *SkuInput {
+	if x != nil {
+		return x.DefaultSku
+	}
+	return nil
+}
+
+func (x *CreateProductWithSkusRequest) GetSkus() []*SkuInput {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// 创建商品并按规格矩阵生成SKU响应
+type CreateProductWithSkusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductDetail         `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // 商品详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductWithSkusResponse) Reset() {
+	*x = CreateProductWithSkusResponse{}
+	mi := &file_product_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductWithSkusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductWithSkusResponse) ProtoMessage() {}
+
+func (x *CreateProductWithSkusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductWithSkusResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductWithSkusResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateProductWithSkusResponse) GetProduct() *ProductDetail {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// 更新SKU输入，库存不在此处修改
+type SkuUpdateInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                       // SKU ID
+	SkuName       string                 `protobuf:"bytes,2,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`               // SKU名称
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                                // 价格
+	MarketPrice   float64                `protobuf:"fixed64,4,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"` // 市场价
+	StockWarn     uint32                 `protobuf:"varint,5,opt,name=stock_warn,json=stockWarn,proto3" json:"stock_warn,omitempty"`        // 库存预警值
+	MainImage     string                 `protobuf:"bytes,6,opt,name=main_image,json=mainImage,proto3" json:"main_image,omitempty"`         // SKU主图
+	Images        []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`                                // SKU图片，整体替换，第一张为主图
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuUpdateInput) Reset() {
+	*x = SkuUpdateInput{}
+	mi := &file_product_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuUpdateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuUpdateInput) ProtoMessage() {}
+
+func (x *SkuUpdateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuUpdateInput.ProtoReflect.Descriptor instead.
+func (*SkuUpdateInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SkuUpdateInput) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkuUpdateInput) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SkuUpdateInput) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuUpdateInput) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *SkuUpdateInput) GetStockWarn() uint32 {
+	if x != nil {
+		return x.StockWarn
+	}
+	return 0
+}
+
+func (x *SkuUpdateInput) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *SkuUpdateInput) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// 更新商品请求
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // 商品ID
+	Product       *ProductInput          `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"` // 商品信息，商品编号和状态不可修改
+	Skus          []*SkuUpdateInput      `protobuf:"bytes,3,rep,name=skus,proto3" json:"skus,omitempty"`       // 需要更新的SKU
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetProduct() *ProductInput {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetSkus() []*SkuUpdateInput {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// 更新商品响应
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductDetail         `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // 商品详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_product_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateProductResponse) GetProduct() *ProductDetail {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// 获取商品请求
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 商品ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取商品响应
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductDetail         `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // 商品详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_product_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetProductResponse) GetProduct() *ProductDetail {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// 商品列表请求
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                          // 关键字，匹配商品名称或编号
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 分类ID
+	BrandId       int64                  `protobuf:"varint,3,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`          // 品牌ID
+	Statuses      []int32                `protobuf:"varint,4,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`                // 状态筛选，为空时不过滤
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`                               // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListProductsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetBrandId() int64 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetStatuses() []int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 商品列表响应
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductDetail       `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                  // 商品列表，不含规格和SKU
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListProductsResponse) GetProducts() []*ProductDetail {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 删除商品请求
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 商品ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_product_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除商品响应
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_product_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{57}
+}
+
+// 商品详情
+type ProductDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                     // 商品ID
+	ProductNo     string                 `protobuf:"bytes,2,opt,name=product_no,json=productNo,proto3" json:"product_no,omitempty"`       // 商品编号
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 商品名称
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`   // 分类ID
+	BrandId       int64                  `protobuf:"varint,5,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`            // 品牌ID
+	MainImage     string                 `protobuf:"bytes,6,opt,name=main_image,json=mainImage,proto3" json:"main_image,omitempty"`       // 主图
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`                    // 商品描述
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`                             // 状态：0-下架 1-上架 2-草稿 3-已归档
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // 创建时间
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`      // 更新时间
+	Specs         []*ProductSpecInfo     `protobuf:"bytes,11,rep,name=specs,proto3" json:"specs,omitempty"`                               // 规格
+	Skus          []*ProductSkuInfo      `protobuf:"bytes,12,rep,name=skus,proto3" json:"skus,omitempty"`                                 // SKU
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductDetail) Reset() {
+	*x = ProductDetail{}
+	mi := &file_product_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDetail) ProtoMessage() {}
+
+func (x *ProductDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDetail.ProtoReflect.Descriptor instead.
+func (*ProductDetail) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProductDetail) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductDetail) GetProductNo() string {
+	if x != nil {
+		return x.ProductNo
+	}
+	return ""
+}
+
+func (x *ProductDetail) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductDetail) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ProductDetail) GetBrandId() int64 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *ProductDetail) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *ProductDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductDetail) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProductDetail) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductDetail) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProductDetail) GetSpecs() []*ProductSpecInfo {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *ProductDetail) GetSkus() []*ProductSkuInfo {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// 商品规格信息
+type ProductSpecInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 规格ID
+	SpecName      string                 `protobuf:"bytes,2,opt,name=spec_name,json=specName,proto3" json:"spec_name,omitempty"`              // 规格名称
+	DisplayOrder  int32                  `protobuf:"varint,3,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"` // 显示顺序
+	Values        []*SpecValueInfo       `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`                                  // 规格值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSpecInfo) Reset() {
+	*x = ProductSpecInfo{}
+	mi := &file_product_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSpecInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSpecInfo) ProtoMessage() {}
+
+func (x *ProductSpecInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSpecInfo.ProtoReflect.Descriptor instead.
+func (*ProductSpecInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ProductSpecInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductSpecInfo) GetSpecName() string {
+	if x != nil {
+		return x.SpecName
+	}
+	return ""
+}
+
+func (x *ProductSpecInfo) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *ProductSpecInfo) GetValues() []*SpecValueInfo {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 规格值信息
+type SpecValueInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 规格值ID
+	ValueName     string                 `protobuf:"bytes,2,opt,name=value_name,json=valueName,proto3" json:"value_name,omitempty"`           // 属性值
+	ValueImage    string                 `protobuf:"bytes,3,opt,name=value_image,json=valueImage,proto3" json:"value_image,omitempty"`        // 属性值图片
+	DisplayOrder  int32                  `protobuf:"varint,4,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"` // 显示顺序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecValueInfo) Reset() {
+	*x = SpecValueInfo{}
+	mi := &file_product_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecValueInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecValueInfo) ProtoMessage() {}
+
+func (x *SpecValueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecValueInfo.ProtoReflect.Descriptor instead.
+func (*SpecValueInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SpecValueInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecValueInfo) GetValueName() string {
+	if x != nil {
+		return x.ValueName
+	}
+	return ""
+}
+
+func (x *SpecValueInfo) GetValueImage() string {
+	if x != nil {
+		return x.ValueImage
+	}
+	return ""
+}
+
+func (x *SpecValueInfo) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+// 商品SKU信息
+type ProductSkuInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                             // SKU ID
+	SkuNo         string                 `protobuf:"bytes,2,opt,name=sku_no,json=skuNo,proto3" json:"sku_no,omitempty"`                           // SKU编号
+	SkuName       string                 `protobuf:"bytes,3,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`                     // SKU名称
+	SpecValueIds  string                 `protobuf:"bytes,4,opt,name=spec_value_ids,json=specValueIds,proto3" json:"spec_value_ids,omitempty"`    // 规格值ID组合
+	SpecValueText string                 `protobuf:"bytes,5,opt,name=spec_value_text,json=specValueText,proto3" json:"spec_value_text,omitempty"` // 规格值文本
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`                                      // 价格
+	MarketPrice   float64                `protobuf:"fixed64,7,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`       // 市场价
+	Stock         uint32                 `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`                                       // 库存
+	StockWarn     uint32                 `protobuf:"varint,9,opt,name=stock_warn,json=stockWarn,proto3" json:"stock_warn,omitempty"`              // 库存预警值
+	Sales         int32                  `protobuf:"varint,10,opt,name=sales,proto3" json:"sales,omitempty"`                                      // 销量
+	MainImage     string                 `protobuf:"bytes,11,opt,name=main_image,json=mainImage,proto3" json:"main_image,omitempty"`              // SKU主图
+	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`                                    // 状态：0-下架 1-上架 2-草稿 3-已归档
+	Images        []*SkuImageInfo        `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`                                     // SKU图片
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSkuInfo) Reset() {
+	*x = ProductSkuInfo{}
+	mi := &file_product_product_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSkuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSkuInfo) ProtoMessage() {}
+
+func (x *ProductSkuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSkuInfo.ProtoReflect.Descriptor instead.
+func (*ProductSkuInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ProductSkuInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetSkuNo() string {
+	if x != nil {
+		return x.SkuNo
+	}
+	return ""
+}
+
+func (x *ProductSkuInfo) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *ProductSkuInfo) GetSpecValueIds() string {
+	if x != nil {
+		return x.SpecValueIds
+	}
+	return ""
+}
+
+func (x *ProductSkuInfo) GetSpecValueText() string {
+	if x != nil {
+		return x.SpecValueText
+	}
+	return ""
+}
+
+func (x *ProductSkuInfo) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetStockWarn() uint32 {
+	if x != nil {
+		return x.StockWarn
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetSales() int32 {
+	if x != nil {
+		return x.Sales
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *ProductSkuInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProductSkuInfo) GetImages() []*SkuImageInfo {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// 规格矩阵请求，预览与应用使用相同的请求
+type SkuMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`   // 商品ID
+	Specs         []*SpecInput           `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`                             // 商品完整的目标规格，未列出的规格和规格值将被删除
+	DefaultSku    *SkuInput              `protobuf:"bytes,3,opt,name=default_sku,json=defaultSku,proto3" json:"default_sku,omitempty"` // 新增SKU的默认属性，存在新增SKU时必填
+	Skus          []*SkuInput            `protobuf:"bytes,4,rep,name=skus,proto3" json:"skus,omitempty"`                               // 单独指定属性的新增SKU
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuMatrixRequest) Reset() {
+	*x = SkuMatrixRequest{}
+	mi := &file_product_product_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuMatrixRequest) ProtoMessage() {}
+
+func (x *SkuMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuMatrixRequest.ProtoReflect.Descriptor instead.
+func (*SkuMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SkuMatrixRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SkuMatrixRequest) GetSpecs() []*SpecInput {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *SkuMatrixRequest) GetDefaultSku() *SkuInput {
+	if x != nil {
+		return x.DefaultSku
+	}
+	return nil
+}
+
+func (x *SkuMatrixRequest) GetSkus() []*SkuInput {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// 规格矩阵中的SKU
+type SkuMatrixItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                          // SKU ID，预览新增SKU时为0
+	SkuNo         string                 `protobuf:"bytes,2,opt,name=sku_no,json=skuNo,proto3" json:"sku_no,omitempty"`                           // SKU编号，预览包含新增规格值的SKU时为空
+	SkuName       string                 `protobuf:"bytes,3,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`                     // SKU名称
+	SpecValueIds  string                 `protobuf:"bytes,4,opt,name=spec_value_ids,json=specValueIds,proto3" json:"spec_value_ids,omitempty"`    // 规格值ID组合
+	SpecValueText string                 `protobuf:"bytes,5,opt,name=spec_value_text,json=specValueText,proto3" json:"spec_value_text,omitempty"` // 规格值文本
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`                                      // 价格
+	Stock         uint32                 `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`                                       // 库存
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`                                     // 状态：0-下架 1-上架 2-草稿 3-已归档
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuMatrixItem) Reset() {
+	*x = SkuMatrixItem{}
+	mi := &file_product_product_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuMatrixItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuMatrixItem) ProtoMessage() {}
+
+func (x *SkuMatrixItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuMatrixItem.ProtoReflect.Descriptor instead.
+func (*SkuMatrixItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SkuMatrixItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuMatrixItem) GetSkuNo() string {
+	if x != nil {
+		return x.SkuNo
+	}
+	return ""
+}
+
+func (x *SkuMatrixItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SkuMatrixItem) GetSpecValueIds() string {
+	if x != nil {
+		return x.SpecValueIds
+	}
+	return ""
+}
+
+func (x *SkuMatrixItem) GetSpecValueText() string {
+	if x != nil {
+		return x.SpecValueText
+	}
+	return ""
+}
+
+func (x *SkuMatrixItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuMatrixItem) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SkuMatrixItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 规格矩阵响应
+type SkuMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Create        []*SkuMatrixItem       `protobuf:"bytes,1,rep,name=create,proto3" json:"create,omitempty"`   // 新增的SKU
+	Disable       []*SkuMatrixItem       `protobuf:"bytes,2,rep,name=disable,proto3" json:"disable,omitempty"` // 规格组合已不存在、下架的SKU
+	Keep          []*SkuMatrixItem       `protobuf:"bytes,3,rep,name=keep,proto3" json:"keep,omitempty"`       // 保持不变的SKU
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuMatrixResponse) Reset() {
+	*x = SkuMatrixResponse{}
+	mi := &file_product_product_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuMatrixResponse) ProtoMessage() {}
+
+func (x *SkuMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuMatrixResponse.ProtoReflect.Descriptor instead.
+func (*SkuMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SkuMatrixResponse) GetCreate() []*SkuMatrixItem {
+	if x != nil {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *SkuMatrixResponse) GetDisable() []*SkuMatrixItem {
+	if x != nil {
+		return x.Disable
+	}
+	return nil
+}
+
+func (x *SkuMatrixResponse) GetKeep() []*SkuMatrixItem {
+	if x != nil {
+		return x.Keep
+	}
+	return nil
+}
+
+// 分类信息
+type CategoryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // 分类ID
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // 分类名称
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`            // 父分类ID，一级分类为0
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                                  // 分类层级
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryInfo) Reset() {
+	*x = CategoryInfo{}
+	mi := &file_product_product_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfo) ProtoMessage() {}
+
+func (x *CategoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfo.ProtoReflect.Descriptor instead.
+func (*CategoryInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CategoryInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// 分类树节点
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // 分类ID
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // 分类名称
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`            // 父分类ID
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`                                  // 分类层级
+	Children      []*CategoryNode        `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`                             // 子分类
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_product_product_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CategoryNode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryNode) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// 创建分类请求
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // 分类名称
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`            // 父分类ID，为0时创建一级分类
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateCategoryRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+// 创建分类响应
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *CategoryInfo          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *CategoryInfo {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// 修改分类名称请求
+type RenameCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // 分类ID
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // 新名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryRequest) Reset() {
+	*x = RenameCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryRequest) ProtoMessage() {}
+
+func (x *RenameCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RenameCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RenameCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameCategoryRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+// 修改分类名称响应
+type RenameCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *CategoryInfo          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryResponse) Reset() {
+	*x = RenameCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryResponse) ProtoMessage() {}
+
+func (x *RenameCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryResponse.ProtoReflect.Descriptor instead.
+func (*RenameCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RenameCategoryResponse) GetCategory() *CategoryInfo {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// 移动分类请求
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // 分类ID
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 新的父分类ID，为0时移动为一级分类
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MoveCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+// 移动分类响应
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *CategoryInfo          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MoveCategoryResponse) GetCategory() *CategoryInfo {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// 删除分类请求
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 分类ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除分类响应
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{74}
+}
+
+// 获取分类树请求
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_product_product_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{75}
+}
+
+// 获取分类树响应
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryNode        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // 一级分类
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_product_product_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetCategoryTreeResponse) GetCategories() []*CategoryNode {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// 查询分类下SKU请求
+type ListCategorySkusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 分类ID，包含全部子分类
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategorySkusRequest) Reset() {
+	*x = ListCategorySkusRequest{}
+	mi := &file_product_product_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategorySkusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategorySkusRequest) ProtoMessage() {}
+
+func (x *ListCategorySkusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategorySkusRequest.ProtoReflect.Descriptor instead.
+func (*ListCategorySkusRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListCategorySkusRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListCategorySkusRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCategorySkusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询分类下SKU响应
+type ListCategorySkusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []*ProductSkuInfo      `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`                          // SKU列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategorySkusResponse) Reset() {
+	*x = ListCategorySkusResponse{}
+	mi := &file_product_product_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategorySkusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategorySkusResponse) ProtoMessage() {}
+
+func (x *ListCategorySkusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategorySkusResponse.ProtoReflect.Descriptor instead.
+func (*ListCategorySkusResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListCategorySkusResponse) GetSkus() []*ProductSkuInfo {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *ListCategorySkusResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCategorySkusResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCategorySkusResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 品牌信息
+type BrandInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                             // 品牌ID
+	BrandName          string                 `protobuf:"bytes,2,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`                               // 品牌名称
+	Logo               string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`                                                          // 品牌Logo
+	ActiveProductCount int64                  `protobuf:"varint,4,opt,name=active_product_count,json=activeProductCount,proto3" json:"active_product_count,omitempty"` // 上架的商品数量
+	ActiveSkuCount     int64                  `protobuf:"varint,5,opt,name=active_sku_count,json=activeSkuCount,proto3" json:"active_sku_count,omitempty"`             // 上架商品下上架的SKU数量
+	CreatedAt          string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                               // 创建时间
+	UpdatedAt          string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                               // 更新时间
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BrandInfo) Reset() {
+	*x = BrandInfo{}
+	mi := &file_product_product_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandInfo) ProtoMessage() {}
+
+func (x *BrandInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandInfo.ProtoReflect.Descriptor instead.
+func (*BrandInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BrandInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrandInfo) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *BrandInfo) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BrandInfo) GetActiveProductCount() int64 {
+	if x != nil {
+		return x.ActiveProductCount
+	}
+	return 0
+}
+
+func (x *BrandInfo) GetActiveSkuCount() int64 {
+	if x != nil {
+		return x.ActiveSkuCount
+	}
+	return 0
+}
+
+func (x *BrandInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BrandInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 创建品牌请求
+type CreateBrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandName     string                 `protobuf:"bytes,1,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"` // 品牌名称
+	Logo          string                 `protobuf:"bytes,2,opt,name=logo,proto3" json:"logo,omitempty"`                            // 品牌Logo
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBrandRequest) Reset() {
+	*x = CreateBrandRequest{}
+	mi := &file_product_product_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBrandRequest) ProtoMessage() {}
+
+func (x *CreateBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBrandRequest.ProtoReflect.Descriptor instead.
+func (*CreateBrandRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateBrandRequest) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *CreateBrandRequest) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+// 创建品牌响应
+type CreateBrandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brand         *BrandInfo             `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBrandResponse) Reset() {
+	*x = CreateBrandResponse{}
+	mi := &file_product_product_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBrandResponse) ProtoMessage() {}
+
+func (x *CreateBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBrandResponse.ProtoReflect.Descriptor instead.
+func (*CreateBrandResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateBrandResponse) GetBrand() *BrandInfo {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+// 更新品牌请求
+type UpdateBrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // 品牌ID
+	BrandName     string                 `protobuf:"bytes,2,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"` // 品牌名称
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`                            // 品牌Logo，为空时清除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBrandRequest) Reset() {
+	*x = UpdateBrandRequest{}
+	mi := &file_product_product_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBrandRequest) ProtoMessage() {}
+
+func (x *UpdateBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBrandRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBrandRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateBrandRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBrandRequest) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *UpdateBrandRequest) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+// 更新品牌响应
+type UpdateBrandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brand         *BrandInfo             `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBrandResponse) Reset() {
+	*x = UpdateBrandResponse{}
+	mi := &file_product_product_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBrandResponse) ProtoMessage() {}
+
+func (x *UpdateBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBrandResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBrandResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateBrandResponse) GetBrand() *BrandInfo {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+// 获取品牌请求
+type GetBrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 品牌ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBrandRequest) Reset() {
+	*x = GetBrandRequest{}
+	mi := &file_product_product_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBrandRequest) ProtoMessage() {}
+
+func (x *GetBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBrandRequest.ProtoReflect.Descriptor instead.
+func (*GetBrandRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetBrandRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取品牌响应
+type GetBrandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brand         *BrandInfo             `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBrandResponse) Reset() {
+	*x = GetBrandResponse{}
+	mi := &file_product_product_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBrandResponse) ProtoMessage() {}
+
+func (x *GetBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBrandResponse.ProtoReflect.Descriptor instead.
+func (*GetBrandResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetBrandResponse) GetBrand() *BrandInfo {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+// 查询品牌列表请求
+type ListBrandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                    // 品牌名称关键字
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrandsRequest) Reset() {
+	*x = ListBrandsRequest{}
+	mi := &file_product_product_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrandsRequest) ProtoMessage() {}
+
+func (x *ListBrandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrandsRequest.ProtoReflect.Descriptor instead.
+func (*ListBrandsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListBrandsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListBrandsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBrandsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询品牌列表响应
+type ListBrandsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brands        []*BrandInfo           `protobuf:"bytes,1,rep,name=brands,proto3" json:"brands,omitempty"`                      // 品牌列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrandsResponse) Reset() {
+	*x = ListBrandsResponse{}
+	mi := &file_product_product_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrandsResponse) ProtoMessage() {}
+
+func (x *ListBrandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrandsResponse.ProtoReflect.Descriptor instead.
+func (*ListBrandsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListBrandsResponse) GetBrands() []*BrandInfo {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *ListBrandsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListBrandsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBrandsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 删除品牌请求
+type DeleteBrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 品牌ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBrandRequest) Reset() {
+	*x = DeleteBrandRequest{}
+	mi := &file_product_product_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrandRequest) ProtoMessage() {}
+
+func (x *DeleteBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrandRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBrandRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeleteBrandRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除品牌响应
+type DeleteBrandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBrandResponse) Reset() {
+	*x = DeleteBrandResponse{}
+	mi := &file_product_product_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrandResponse) ProtoMessage() {}
+
+func (x *DeleteBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrandResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBrandResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{89}
+}
+
+// 批量迁移品牌下的商品请求
+type ReassignBrandProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromBrandId   int64                  `protobuf:"varint,1,opt,name=from_brand_id,json=fromBrandId,proto3" json:"from_brand_id,omitempty"`   // 原品牌ID
+	ToBrandId     int64                  `protobuf:"varint,2,opt,name=to_brand_id,json=toBrandId,proto3" json:"to_brand_id,omitempty"`         // 目标品牌ID，为0时解除品牌关联
+	ProductIds    []int64                `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // 指定迁移的商品ID，为空时迁移原品牌下的全部商品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignBrandProductsRequest) Reset() {
+	*x = ReassignBrandProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignBrandProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignBrandProductsRequest) ProtoMessage() {}
+
+func (x *ReassignBrandProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignBrandProductsRequest.ProtoReflect.Descriptor instead.
+func (*ReassignBrandProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ReassignBrandProductsRequest) GetFromBrandId() int64 {
+	if x != nil {
+		return x.FromBrandId
+	}
+	return 0
+}
+
+func (x *ReassignBrandProductsRequest) GetToBrandId() int64 {
+	if x != nil {
+		return x.ToBrandId
+	}
+	return 0
+}
+
+func (x *ReassignBrandProductsRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+// 批量迁移品牌下的商品响应
+type ReassignBrandProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      int64                  `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"` // 迁移的商品数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignBrandProductsResponse) Reset() {
+	*x = ReassignBrandProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignBrandProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignBrandProductsResponse) ProtoMessage() {}
+
+func (x *ReassignBrandProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignBrandProductsResponse.ProtoReflect.Descriptor instead.
+func (*ReassignBrandProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ReassignBrandProductsResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 供应商基本信息
+type SupplierInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // 名称
+	ContactPerson string                 `protobuf:"bytes,2,opt,name=contact_person,json=contactPerson,proto3" json:"contact_person,omitempty"` // 联系人
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`                                      // 联系电话
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                                      // 电子邮件
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`                                  // 地址
+	Rating        float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`                                  // 供应商评级，0-5
+	LeadTimeDays  int32                  `protobuf:"varint,7,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"` // 交货周期（天）
+	PaymentTerms  string                 `protobuf:"bytes,8,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"`    // 支付条款
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierInput) Reset() {
+	*x = SupplierInput{}
+	mi := &file_product_product_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierInput) ProtoMessage() {}
+
+func (x *SupplierInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierInput.ProtoReflect.Descriptor instead.
+func (*SupplierInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SupplierInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SupplierInput) GetContactPerson() string {
+	if x != nil {
+		return x.ContactPerson
+	}
+	return ""
+}
+
+func (x *SupplierInput) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SupplierInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SupplierInput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SupplierInput) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SupplierInput) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *SupplierInput) GetPaymentTerms() string {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return ""
+}
+
+// 创建供应商请求
+type CreateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *SupplierInput         `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierRequest) Reset() {
+	*x = CreateSupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierRequest) ProtoMessage() {}
+
+func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateSupplierRequest) GetSupplier() *SupplierInput {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+// 创建供应商响应
+type CreateSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *SupplierDTO           `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierResponse) Reset() {
+	*x = CreateSupplierResponse{}
+	mi := &file_product_product_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierResponse) ProtoMessage() {}
+
+func (x *CreateSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierResponse.ProtoReflect.Descriptor instead.
+func (*CreateSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateSupplierResponse) GetSupplier() *SupplierDTO {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+// 更新供应商请求
+type UpdateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 供应商ID
+	Supplier      *SupplierInput         `protobuf:"bytes,2,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSupplierRequest) Reset() {
+	*x = UpdateSupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSupplierRequest) ProtoMessage() {}
+
+func (x *UpdateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateSupplierRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSupplierRequest) GetSupplier() *SupplierInput {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+// 更新供应商响应
+type UpdateSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *SupplierDTO           `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSupplierResponse) Reset() {
+	*x = UpdateSupplierResponse{}
+	mi := &file_product_product_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSupplierResponse) ProtoMessage() {}
+
+func (x *UpdateSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSupplierResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateSupplierResponse) GetSupplier() *SupplierDTO {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+// 获取供应商请求
+type GetSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 供应商ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupplierRequest) Reset() {
+	*x = GetSupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupplierRequest) ProtoMessage() {}
+
+func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupplierRequest.ProtoReflect.Descriptor instead.
+func (*GetSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetSupplierRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取供应商响应
+type GetSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *SupplierDTO           `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupplierResponse) Reset() {
+	*x = GetSupplierResponse{}
+	mi := &file_product_product_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupplierResponse) ProtoMessage() {}
+
+func (x *GetSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupplierResponse.ProtoReflect.Descriptor instead.
+func (*GetSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetSupplierResponse) GetSupplier() *SupplierDTO {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+// 查询供应商列表请求
+type ListSuppliersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                    // 供应商名称关键字
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_product_product_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersRequest) ProtoMessage() {}
+
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListSuppliersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListSuppliersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSuppliersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询供应商列表响应
+type ListSuppliersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*SupplierDTO         `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`                // 供应商列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_product_product_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*SupplierDTO {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+func (x *ListSuppliersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSuppliersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSuppliersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 删除供应商请求
+type DeleteSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 供应商ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSupplierRequest) Reset() {
+	*x = DeleteSupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSupplierRequest) ProtoMessage() {}
+
+func (x *DeleteSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSupplierRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteSupplierRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 删除供应商响应
+type DeleteSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSupplierResponse) Reset() {
+	*x = DeleteSupplierResponse{}
+	mi := &file_product_product_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSupplierResponse) ProtoMessage() {}
+
+func (x *DeleteSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSupplierResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{102}
+}
+
+// 供应商供应的SKU
+type SupplierSkuItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId       int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`                      // 供应商ID
+	SkuId            int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                                     // SKU ID
+	SkuNo            string                 `protobuf:"bytes,3,opt,name=sku_no,json=skuNo,proto3" json:"sku_no,omitempty"`                                      // SKU编号
+	SkuName          string                 `protobuf:"bytes,4,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`                                // SKU名称
+	SpecValueText    string                 `protobuf:"bytes,5,opt,name=spec_value_text,json=specValueText,proto3" json:"spec_value_text,omitempty"`            // 规格文本
+	Price            float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`                                                 // 售价
+	Stock            uint32                 `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`                                                  // 库存
+	Status           int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`                                                // SKU状态
+	SupplyPrice      float64                `protobuf:"fixed64,9,opt,name=supply_price,json=supplyPrice,proto3" json:"supply_price,omitempty"`                  // 供应价
+	MinOrderQuantity int32                  `protobuf:"varint,10,opt,name=min_order_quantity,json=minOrderQuantity,proto3" json:"min_order_quantity,omitempty"` // 最小起订量
+	IsPreferred      bool                   `protobuf:"varint,11,opt,name=is_preferred,json=isPreferred,proto3" json:"is_preferred,omitempty"`                  // 是否首选
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SupplierSkuItem) Reset() {
+	*x = SupplierSkuItem{}
+	mi := &file_product_product_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierSkuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierSkuItem) ProtoMessage() {}
+
+func (x *SupplierSkuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierSkuItem.ProtoReflect.Descriptor instead.
+func (*SupplierSkuItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SupplierSkuItem) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetSkuNo() string {
+	if x != nil {
+		return x.SkuNo
+	}
+	return ""
+}
+
+func (x *SupplierSkuItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SupplierSkuItem) GetSpecValueText() string {
+	if x != nil {
+		return x.SpecValueText
+	}
+	return ""
+}
+
+func (x *SupplierSkuItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetSupplyPrice() float64 {
+	if x != nil {
+		return x.SupplyPrice
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetMinOrderQuantity() int32 {
+	if x != nil {
+		return x.MinOrderQuantity
+	}
+	return 0
+}
+
+func (x *SupplierSkuItem) GetIsPreferred() bool {
+	if x != nil {
+		return x.IsPreferred
+	}
+	return false
+}
+
+// 关联供应商与SKU请求，已关联时更新供货信息
+type LinkSupplierSkuRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId       int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`                     // 供应商ID
+	SkuId            int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                                    // SKU ID
+	SupplyPrice      float64                `protobuf:"fixed64,3,opt,name=supply_price,json=supplyPrice,proto3" json:"supply_price,omitempty"`                 // 供应价
+	MinOrderQuantity int32                  `protobuf:"varint,4,opt,name=min_order_quantity,json=minOrderQuantity,proto3" json:"min_order_quantity,omitempty"` // 最小起订量
+	IsPreferred      bool                   `protobuf:"varint,5,opt,name=is_preferred,json=isPreferred,proto3" json:"is_preferred,omitempty"`                  // 是否首选，设为首选时取消该SKU其他供应商的首选
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LinkSupplierSkuRequest) Reset() {
+	*x = LinkSupplierSkuRequest{}
+	mi := &file_product_product_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSupplierSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSupplierSkuRequest) ProtoMessage() {}
+
+func (x *LinkSupplierSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSupplierSkuRequest.ProtoReflect.Descriptor instead.
+func (*LinkSupplierSkuRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *LinkSupplierSkuRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *LinkSupplierSkuRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *LinkSupplierSkuRequest) GetSupplyPrice() float64 {
+	if x != nil {
+		return x.SupplyPrice
+	}
+	return 0
+}
+
+func (x *LinkSupplierSkuRequest) GetMinOrderQuantity() int32 {
+	if x != nil {
+		return x.MinOrderQuantity
+	}
+	return 0
+}
+
+func (x *LinkSupplierSkuRequest) GetIsPreferred() bool {
+	if x != nil {
+		return x.IsPreferred
+	}
+	return false
+}
+
+// 关联供应商与SKU响应
+type LinkSupplierSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *SupplierSkuItem       `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkSupplierSkuResponse) Reset() {
+	*x = LinkSupplierSkuResponse{}
+	mi := &file_product_product_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSupplierSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSupplierSkuResponse) ProtoMessage() {}
+
+func (x *LinkSupplierSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSupplierSkuResponse.ProtoReflect.Descriptor instead.
+func (*LinkSupplierSkuResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *LinkSupplierSkuResponse) GetItem() *SupplierSkuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// 解除供应商与SKU关联请求
+type UnlinkSupplierSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"` // 供应商ID
+	SkuId         int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                // SKU ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkSupplierSkuRequest) Reset() {
+	*x = UnlinkSupplierSkuRequest{}
+	mi := &file_product_product_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkSupplierSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSupplierSkuRequest) ProtoMessage() {}
+
+func (x *UnlinkSupplierSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSupplierSkuRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkSupplierSkuRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UnlinkSupplierSkuRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *UnlinkSupplierSkuRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+// 解除供应商与SKU关联响应
+type UnlinkSupplierSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkSupplierSkuResponse) Reset() {
+	*x = UnlinkSupplierSkuResponse{}
+	mi := &file_product_product_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkSupplierSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSupplierSkuResponse) ProtoMessage() {}
+
+func (x *UnlinkSupplierSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSupplierSkuResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkSupplierSkuResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{107}
+}
+
+// 查询供应商供应的SKU请求
+type ListSkusBySupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"` // 供应商ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkusBySupplierRequest) Reset() {
+	*x = ListSkusBySupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkusBySupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkusBySupplierRequest) ProtoMessage() {}
+
+func (x *ListSkusBySupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkusBySupplierRequest.ProtoReflect.Descriptor instead.
+func (*ListSkusBySupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListSkusBySupplierRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *ListSkusBySupplierRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSkusBySupplierRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询供应商供应的SKU响应
+type ListSkusBySupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []*SupplierSkuItem     `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`                          // SKU列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkusBySupplierResponse) Reset() {
+	*x = ListSkusBySupplierResponse{}
+	mi := &file_product_product_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkusBySupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkusBySupplierResponse) ProtoMessage() {}
+
+func (x *ListSkusBySupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkusBySupplierResponse.ProtoReflect.Descriptor instead.
+func (*ListSkusBySupplierResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListSkusBySupplierResponse) GetSkus() []*SupplierSkuItem {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *ListSkusBySupplierResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSkusBySupplierResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSkusBySupplierResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 采购单明细
+type PurchaseOrderLineInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                        // ID
+	SkuId             int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                                     // SKU ID
+	RestockIds        []int64                `protobuf:"varint,3,rep,packed,name=restock_ids,json=restockIds,proto3" json:"restock_ids,omitempty"`               // 关联的补货记录ID
+	RequestedQuantity int32                  `protobuf:"varint,4,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"` // 补货申请数量
+	Quantity          int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                                            // 订货数量，按最小起订量取整
+	ReceivedQuantity  int32                  `protobuf:"varint,6,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`    // 已收货数量
+	UnitPrice         float64                `protobuf:"fixed64,7,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`                        // 供应价
+	Amount            float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`                                               // 金额
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLineInfo) Reset() {
+	*x = PurchaseOrderLineInfo{}
+	mi := &file_product_product_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLineInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLineInfo) ProtoMessage() {}
+
+func (x *PurchaseOrderLineInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLineInfo.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLineInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PurchaseOrderLineInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineInfo) GetRestockIds() []int64 {
+	if x != nil {
+		return x.RestockIds
+	}
+	return nil
+}
+
+func (x *PurchaseOrderLineInfo) GetRequestedQuantity() int32 {
+	if x != nil {
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineInfo) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineInfo) GetReceivedQuantity() int32 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineInfo) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineInfo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 采购单信息
+type PurchaseOrderInfo struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Id              int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                   // 采购单ID
+	PurchaseOrderNo string                   `protobuf:"bytes,2,opt,name=purchase_order_no,json=purchaseOrderNo,proto3" json:"purchase_order_no,omitempty"` // 采购单号
+	SupplierId      int64                    `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`                 // 供应商ID
+	Status          uint32                   `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                                           // 状态：1=已下单 2=部分收货 3=已收货
+	TotalQuantity   int32                    `protobuf:"varint,5,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`        // 订货总数量
+	TotalAmount     float64                  `protobuf:"fixed64,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`             // 订货总金额
+	OperatorId      int32                    `protobuf:"varint,7,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`                 // 操作人ID
+	Remark          string                   `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`                                            // 备注
+	CreatedAt       string                   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // 创建时间
+	UpdatedAt       string                   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                    // 更新时间
+	Lines           []*PurchaseOrderLineInfo `protobuf:"bytes,11,rep,name=lines,proto3" json:"lines,omitempty"`                                             // 明细，列表查询时为空
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderInfo) Reset() {
+	*x = PurchaseOrderInfo{}
+	mi := &file_product_product_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderInfo) ProtoMessage() {}
+
+func (x *PurchaseOrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderInfo.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PurchaseOrderInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrderInfo) GetPurchaseOrderNo() string {
+	if x != nil {
+		return x.PurchaseOrderNo
+	}
+	return ""
+}
+
+func (x *PurchaseOrderInfo) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderInfo) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PurchaseOrderInfo) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderInfo) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *PurchaseOrderInfo) GetOperatorId() int32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *PurchaseOrderInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrderInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrderInfo) GetLines() []*PurchaseOrderLineInfo {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// 需要订货的补货记录
+type PurchaseOrderItemInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestockId     int64                  `protobuf:"varint,1,opt,name=restock_id,json=restockId,proto3" json:"restock_id,omitempty"` // 补货记录ID，须已审核通过
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                    // 本次订货数量，为0时订满剩余数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderItemInput) Reset() {
+	*x = PurchaseOrderItemInput{}
+	mi := &file_product_product_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderItemInput) ProtoMessage() {}
+
+func (x *PurchaseOrderItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderItemInput.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderItemInput) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *PurchaseOrderItemInput) GetRestockId() int64 {
+	if x != nil {
+		return x.RestockId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderItemInput) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 生成采购单请求
+type CreatePurchaseOrdersRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*PurchaseOrderItemInput `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                              // 需要订货的补货记录
+	OperatorId    int32                     `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
+	Remark        string                    `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`                            // 备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrdersRequest) Reset() {
+	*x = CreatePurchaseOrdersRequest{}
+	mi := &file_product_product_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreatePurchaseOrdersRequest) GetItems() []*PurchaseOrderItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreatePurchaseOrdersRequest) GetOperatorId() int32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrdersRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 生成采购单响应
+type CreatePurchaseOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrderInfo   `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"` // 按供应商生成的采购单
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrdersResponse) Reset() {
+	*x = CreatePurchaseOrdersResponse{}
+	mi := &file_product_product_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *CreatePurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreatePurchaseOrdersResponse) GetPurchaseOrders() []*PurchaseOrderInfo {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+// 获取采购单请求
+type GetPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 采购单ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseOrderRequest) Reset() {
+	*x = GetPurchaseOrderRequest{}
+	mi := &file_product_product_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *GetPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetPurchaseOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取采购单响应
+type GetPurchaseOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrderInfo     `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseOrderResponse) Reset() {
+	*x = GetPurchaseOrderResponse{}
+	mi := &file_product_product_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseOrderResponse) ProtoMessage() {}
+
+func (x *GetPurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetPurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrderInfo {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+// 查询采购单列表请求
+type ListPurchaseOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"` // 供应商ID，为0时不限
+	Statuses      []int32                `protobuf:"varint,2,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`                // 状态，为空时不限
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                               // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_product_product_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListPurchaseOrdersRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersRequest) GetStatuses() []int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListPurchaseOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询采购单列表响应
+type ListPurchaseOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrderInfo   `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"` // 采购单列表
+	Total          int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                        // 总数
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                          // 页码
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                  // 每页数量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_product_product_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListPurchaseOrdersResponse) GetPurchaseOrders() []*PurchaseOrderInfo {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+func (x *ListPurchaseOrdersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 采购单明细的收货数量
+type ReceiveGoodsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        int64                  `protobuf:"varint,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"` // 采购单明细ID
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`           // 本次收货数量，不能超过未收货数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveGoodsItem) Reset() {
+	*x = ReceiveGoodsItem{}
+	mi := &file_product_product_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveGoodsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveGoodsItem) ProtoMessage() {}
+
+func (x *ReceiveGoodsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveGoodsItem.ProtoReflect.Descriptor instead.
+func (*ReceiveGoodsItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ReceiveGoodsItem) GetLineId() int64 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *ReceiveGoodsItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 采购收货请求
+type ReceiveGoodsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId int64                  `protobuf:"varint,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"` // 采购单ID
+	Items           []*ReceiveGoodsItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                               // 收货明细
+	OperatorId      int32                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`                  // 操作人ID
+	WarehouseId     int64                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`               // 收货仓库ID，必填
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceiveGoodsRequest) Reset() {
+	*x = ReceiveGoodsRequest{}
+	mi := &file_product_product_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveGoodsRequest) ProtoMessage() {}
+
+func (x *ReceiveGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ReceiveGoodsRequest) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *ReceiveGoodsRequest) GetItems() []*ReceiveGoodsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReceiveGoodsRequest) GetOperatorId() int32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ReceiveGoodsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+// 采购收货响应
+type ReceiveGoodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrderInfo     `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"` // 收货后的采购单
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveGoodsResponse) Reset() {
+	*x = ReceiveGoodsResponse{}
+	mi := &file_product_product_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveGoodsResponse) ProtoMessage() {}
+
+func (x *ReceiveGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveGoodsResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ReceiveGoodsResponse) GetPurchaseOrder() *PurchaseOrderInfo {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+// 单个SKU的库存调整结果
+type StockAdjustResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkuId          int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                            // SKU ID
+	Delta          int64                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`                                         // 调整数量，正数增加、负数减少
+	BeforeStock    uint32                 `protobuf:"varint,3,opt,name=before_stock,json=beforeStock,proto3" json:"before_stock,omitempty"`          // 调整前库存
+	AfterStock     uint32                 `protobuf:"varint,4,opt,name=after_stock,json=afterStock,proto3" json:"after_stock,omitempty"`             // 调整后库存
+	WarehouseId    int64                  `protobuf:"varint,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`          // 调整的仓库ID
+	WarehouseStock uint32                 `protobuf:"varint,6,opt,name=warehouse_stock,json=warehouseStock,proto3" json:"warehouse_stock,omitempty"` // 调整后的分仓库存
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockAdjustResult) Reset() {
+	*x = StockAdjustResult{}
+	mi := &file_product_product_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustResult) ProtoMessage() {}
+
+func (x *StockAdjustResult) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAdjustResult.ProtoReflect.Descriptor instead.
+func (*StockAdjustResult) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *StockAdjustResult) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockAdjustResult) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockAdjustResult) GetBeforeStock() uint32 {
+	if x != nil {
+		return x.BeforeStock
+	}
+	return 0
+}
+
+func (x *StockAdjustResult) GetAfterStock() uint32 {
+	if x != nil {
+		return x.AfterStock
+	}
+	return 0
+}
+
+func (x *StockAdjustResult) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockAdjustResult) GetWarehouseStock() uint32 {
+	if x != nil {
+		return x.WarehouseStock
+	}
+	return 0
+}
+
+// 手动调整库存请求
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                   // SKU ID
+	Delta         int64                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`                                // 调整数量，正数增加、负数减少，不能为0
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                               // 调整原因，必填
+	OperatorId    int64                  `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`    // 操作人ID，必填
+	WarehouseId   int64                  `protobuf:"varint,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // 调整的仓库ID，必填，分仓库存与可售总库存同时调整
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_product_product_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AdjustStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+// 手动调整库存响应
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *StockAdjustResult     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"` // 调整结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_product_product_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *AdjustStockResponse) GetResult() *StockAdjustResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 盘点的SKU实盘数量
+type StocktakeItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SkuId           int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                               // SKU ID
+	CountedQuantity uint32                 `protobuf:"varint,2,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"` // 实盘数量
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StocktakeItem) Reset() {
+	*x = StocktakeItem{}
+	mi := &file_product_product_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeItem) ProtoMessage() {}
+
+func (x *StocktakeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeItem.ProtoReflect.Descriptor instead.
+func (*StocktakeItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *StocktakeItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StocktakeItem) GetCountedQuantity() uint32 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+// 提交盘点结果请求
+type SubmitStocktakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StocktakeItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                 // 盘点明细，同一SKU只能出现一次
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                               // 盘点备注，可选
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`    // 操作人ID，必填
+	WarehouseId   int64                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // 盘点的仓库ID，必填，实盘数量为该仓库的库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitStocktakeRequest) Reset() {
+	*x = SubmitStocktakeRequest{}
+	mi := &file_product_product_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitStocktakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitStocktakeRequest) ProtoMessage() {}
+
+func (x *SubmitStocktakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitStocktakeRequest.ProtoReflect.Descriptor instead.
+func (*SubmitStocktakeRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SubmitStocktakeRequest) GetItems() []*StocktakeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SubmitStocktakeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubmitStocktakeRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SubmitStocktakeRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+// 提交盘点结果响应
+type SubmitStocktakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*StockAdjustResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 各SKU的盘点差异，差异为0的SKU库存不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitStocktakeResponse) Reset() {
+	*x = SubmitStocktakeResponse{}
+	mi := &file_product_product_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitStocktakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitStocktakeResponse) ProtoMessage() {}
+
+func (x *SubmitStocktakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitStocktakeResponse.ProtoReflect.Descriptor instead.
+func (*SubmitStocktakeResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SubmitStocktakeResponse) GetResults() []*StockAdjustResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 库存变更记录
+type StockChangeInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // 记录ID
+	OrderId         int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                            // 订单ID
+	SkuId           int64                  `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                                  // SKU ID
+	SourceType      int32                  `protobuf:"varint,4,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`                   // 来源类型：1=订单支付 2=退款 3=手动调整/盘点 4=预占 5=释放预占 6=预占过期 7=初始库存 8=采购收货
+	Quantity        int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                                         // 变更数量
+	BeforeStock     int64                  `protobuf:"varint,6,opt,name=before_stock,json=beforeStock,proto3" json:"before_stock,omitempty"`                // 变更前库存
+	AfterStock      int64                  `protobuf:"varint,7,opt,name=after_stock,json=afterStock,proto3" json:"after_stock,omitempty"`                   // 变更后库存
+	OperatorId      int64                  `protobuf:"varint,8,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`                   // 操作人ID
+	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`                                              // 变更原因
+	CreatedAt       string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                      // 变更时间
+	PurchaseOrderId int64                  `protobuf:"varint,11,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"` // 采购单ID，采购收货时填写
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockChangeInfo) Reset() {
+	*x = StockChangeInfo{}
+	mi := &file_product_product_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockChangeInfo) ProtoMessage() {}
+
+func (x *StockChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockChangeInfo.ProtoReflect.Descriptor instead.
+func (*StockChangeInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *StockChangeInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetSourceType() int32 {
+	if x != nil {
+		return x.SourceType
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetBeforeStock() int64 {
+	if x != nil {
+		return x.BeforeStock
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetAfterStock() int64 {
+	if x != nil {
+		return x.AfterStock
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *StockChangeInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockChangeInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockChangeInfo) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+// 查询库存流水请求，按记录ID倒序返回
+type ListStockChangesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SkuId           int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                                 // SKU ID，0表示不过滤
+	OrderId         int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                           // 订单ID，0表示不过滤
+	SourceType      int32                  `protobuf:"varint,3,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`                  // 来源类型，0表示不过滤
+	StartTime       string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                      // 开始时间（包含），格式：2006-01-02 15:04:05
+	EndTime         string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                            // 结束时间（包含），格式：2006-01-02 15:04:05
+	Cursor          int64                  `protobuf:"varint,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                                            // 游标，首页传0，之后传上一页返回的next_cursor
+	Limit           int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                              // 每页数量，1-100
+	PurchaseOrderId int64                  `protobuf:"varint,8,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"` // 采购单ID，0表示不过滤
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListStockChangesRequest) Reset() {
+	*x = ListStockChangesRequest{}
+	mi := &file_product_product_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockChangesRequest) ProtoMessage() {}
+
+func (x *ListStockChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListStockChangesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListStockChangesRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ListStockChangesRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ListStockChangesRequest) GetSourceType() int32 {
+	if x != nil {
+		return x.SourceType
+	}
+	return 0
+}
+
+func (x *ListStockChangesRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ListStockChangesRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ListStockChangesRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListStockChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListStockChangesRequest) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+// 查询库存流水响应
+type ListStockChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*StockChangeInfo     `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`                          // 库存变更记录
+	NextCursor    int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标，0表示没有更多记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockChangesResponse) Reset() {
+	*x = ListStockChangesResponse{}
+	mi := &file_product_product_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockChangesResponse) ProtoMessage() {}
+
+func (x *ListStockChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListStockChangesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListStockChangesResponse) GetRecords() []*StockChangeInfo {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ListStockChangesResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// 查询SKU历史库存请求
+type GetStockAtTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"` // SKU ID
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`                     // 查询时刻，格式：2006-01-02 15:04:05
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAtTimeRequest) Reset() {
+	*x = GetStockAtTimeRequest{}
+	mi := &file_product_product_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAtTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAtTimeRequest) ProtoMessage() {}
+
+func (x *GetStockAtTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAtTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetStockAtTimeRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetStockAtTimeRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetStockAtTimeRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+// 查询SKU历史库存响应
+type GetStockAtTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`          // SKU ID
+	Stock         int64                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`                       // 该时刻的库存
+	RecordId      int64                  `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"` // 推算所依据的变更记录ID，0表示没有变更记录，取当前库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAtTimeResponse) Reset() {
+	*x = GetStockAtTimeResponse{}
+	mi := &file_product_product_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAtTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAtTimeResponse) ProtoMessage() {}
+
+func (x *GetStockAtTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAtTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetStockAtTimeResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetStockAtTimeResponse) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetStockAtTimeResponse) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *GetStockAtTimeResponse) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+// 库存与流水不一致的SKU
+type StockMismatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                        // SKU ID
+	Stock         uint32                 `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`                                     // 当前库存
+	LedgerStock   int64                  `protobuf:"varint,3,opt,name=ledger_stock,json=ledgerStock,proto3" json:"ledger_stock,omitempty"`      // 最后一条流水的变更后库存
+	Drift         int64                  `protobuf:"varint,4,opt,name=drift,proto3" json:"drift,omitempty"`                                     // 当前库存 - 流水库存
+	LastRecordId  int64                  `protobuf:"varint,5,opt,name=last_record_id,json=lastRecordId,proto3" json:"last_record_id,omitempty"` // 最后一条流水ID
+	Corrected     bool                   `protobuf:"varint,6,opt,name=corrected,proto3" json:"corrected,omitempty"`                             // 是否已写入修正流水
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMismatch) Reset() {
+	*x = StockMismatch{}
+	mi := &file_product_product_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMismatch) ProtoMessage() {}
+
+func (x *StockMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMismatch.ProtoReflect.Descriptor instead.
+func (*StockMismatch) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StockMismatch) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockMismatch) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *StockMismatch) GetLedgerStock() int64 {
+	if x != nil {
+		return x.LedgerStock
+	}
+	return 0
+}
+
+func (x *StockMismatch) GetDrift() int64 {
+	if x != nil {
+		return x.Drift
+	}
+	return 0
+}
+
+func (x *StockMismatch) GetLastRecordId() int64 {
+	if x != nil {
+		return x.LastRecordId
+	}
+	return 0
+}
+
+func (x *StockMismatch) GetCorrected() bool {
+	if x != nil {
+		return x.Corrected
+	}
+	return false
+}
+
+// 库存对账报告请求，按SKU ID升序分批对账
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        int64                  `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // 游标，首批传0，之后传上一批返回的next_cursor
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // 每批对账的SKU数量，1-1000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_product_product_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetReconciliationReportRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 库存对账报告响应
+type GetReconciliationReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`                         // 对账的SKU数量
+	Untracked     int32                  `protobuf:"varint,2,opt,name=untracked,proto3" json:"untracked,omitempty"`                     // 没有任何流水的SKU数量，不参与对账
+	Mismatches    []*StockMismatch       `protobuf:"bytes,3,rep,name=mismatches,proto3" json:"mismatches,omitempty"`                    // 不一致的SKU
+	NextCursor    int64                  `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一批游标，0表示已对账完所有SKU
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportResponse) Reset() {
+	*x = GetReconciliationReportResponse{}
+	mi := &file_product_product_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportResponse) ProtoMessage() {}
+
+func (x *GetReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetReconciliationReportResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportResponse) GetUntracked() int32 {
+	if x != nil {
+		return x.Untracked
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportResponse) GetMismatches() []*StockMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *GetReconciliationReportResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// 写入对账修正流水请求
+type CorrectStockLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []int64                `protobuf:"varint,1,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`      // SKU ID列表，最多100个
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrectStockLedgerRequest) Reset() {
+	*x = CorrectStockLedgerRequest{}
+	mi := &file_product_product_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectStockLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectStockLedgerRequest) ProtoMessage() {}
+
+func (x *CorrectStockLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectStockLedgerRequest.ProtoReflect.Descriptor instead.
+func (*CorrectStockLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CorrectStockLedgerRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *CorrectStockLedgerRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// 写入对账修正流水响应
+type CorrectStockLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Corrected     []*StockMismatch       `protobuf:"bytes,1,rep,name=corrected,proto3" json:"corrected,omitempty"` // 已修正的SKU，已一致或没有流水的SKU不在其中
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrectStockLedgerResponse) Reset() {
+	*x = CorrectStockLedgerResponse{}
+	mi := &file_product_product_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectStockLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectStockLedgerResponse) ProtoMessage() {}
+
+func (x *CorrectStockLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectStockLedgerResponse.ProtoReflect.Descriptor instead.
+func (*CorrectStockLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CorrectStockLedgerResponse) GetCorrected() []*StockMismatch {
+	if x != nil {
+		return x.Corrected
+	}
+	return nil
+}
+
+// 仓库信息
+type WarehouseInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                               // 仓库ID
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                            // 仓库编码
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // 仓库名称
+	Priority      int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`                   // 分配优先级，数值越小越优先
+	Latitude      float64                `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`                  // 纬度
+	Longitude     float64                `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`                // 经度
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                       // 状态：0=停用 1=启用
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseInfo) Reset() {
+	*x = WarehouseInfo{}
+	mi := &file_product_product_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseInfo) ProtoMessage() {}
+
+func (x *WarehouseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseInfo.ProtoReflect.Descriptor instead.
+func (*WarehouseInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *WarehouseInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WarehouseInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *WarehouseInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarehouseInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *WarehouseInfo) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *WarehouseInfo) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *WarehouseInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *WarehouseInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 创建仓库请求
+type CreateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`             // 仓库编码，不可重复
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // 仓库名称
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`    // 分配优先级，数值越小越优先
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`   // 纬度
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"` // 经度
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_product_product_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *CreateWarehouseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateWarehouseRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateWarehouseRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// 创建仓库响应
+type CreateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *WarehouseInfo         `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"` // 仓库信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_product_product_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *WarehouseInfo {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// 查询仓库列表请求
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_product_product_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{141}
+}
+
+// 查询仓库列表响应
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*WarehouseInfo       `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"` // 按优先级升序的仓库列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_product_product_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*WarehouseInfo {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// SKU在单个仓库的库存
+type WarehouseStockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // 仓库ID
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                   // 仓库编码
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                   // 仓库名称
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                              // 仓库状态：0=停用 1=启用
+	Stock         uint32                 `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`                                // 分仓库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseStockItem) Reset() {
+	*x = WarehouseStockItem{}
+	mi := &file_product_product_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStockItem) ProtoMessage() {}
+
+func (x *WarehouseStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStockItem.ProtoReflect.Descriptor instead.
+func (*WarehouseStockItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *WarehouseStockItem) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *WarehouseStockItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarehouseStockItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+// SKU可售总库存及分仓库存
+type SkuWarehouseStockInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkuId          int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                            // SKU ID
+	TotalAvailable uint32                 `protobuf:"varint,2,opt,name=total_available,json=totalAvailable,proto3" json:"total_available,omitempty"` // 可售总库存
+	WarehouseTotal uint32                 `protobuf:"varint,3,opt,name=warehouse_total,json=warehouseTotal,proto3" json:"warehouse_total,omitempty"` // 各仓库库存之和
+	Warehouses     []*WarehouseStockItem  `protobuf:"bytes,4,rep,name=warehouses,proto3" json:"warehouses,omitempty"`                                // 分仓库存
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SkuWarehouseStockInfo) Reset() {
+	*x = SkuWarehouseStockInfo{}
+	mi := &file_product_product_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuWarehouseStockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuWarehouseStockInfo) ProtoMessage() {}
+
+func (x *SkuWarehouseStockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuWarehouseStockInfo.ProtoReflect.Descriptor instead.
+func (*SkuWarehouseStockInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SkuWarehouseStockInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuWarehouseStockInfo) GetTotalAvailable() uint32 {
+	if x != nil {
+		return x.TotalAvailable
+	}
+	return 0
+}
+
+func (x *SkuWarehouseStockInfo) GetWarehouseTotal() uint32 {
+	if x != nil {
+		return x.WarehouseTotal
+	}
+	return 0
+}
+
+func (x *SkuWarehouseStockInfo) GetWarehouses() []*WarehouseStockItem {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// 设置SKU分仓库存请求
+type SetSkuWarehouseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                   // SKU ID
+	WarehouseId   int64                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // 仓库ID
+	Stock         uint32                 `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`                                // 分仓库存，可售总库存按差值同步调整
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                               // 变更原因，可选
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`    // 操作人ID，必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuWarehouseStockRequest) Reset() {
+	*x = SetSkuWarehouseStockRequest{}
+	mi := &file_product_product_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuWarehouseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuWarehouseStockRequest) ProtoMessage() {}
+
+func (x *SetSkuWarehouseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuWarehouseStockRequest.ProtoReflect.Descriptor instead.
+func (*SetSkuWarehouseStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SetSkuWarehouseStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SetSkuWarehouseStockRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *SetSkuWarehouseStockRequest) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SetSkuWarehouseStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetSkuWarehouseStockRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// 设置SKU分仓库存响应
+type SetSkuWarehouseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *SkuWarehouseStockInfo `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"` // 设置后的库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkuWarehouseStockResponse) Reset() {
+	*x = SetSkuWarehouseStockResponse{}
+	mi := &file_product_product_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkuWarehouseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkuWarehouseStockResponse) ProtoMessage() {}
+
+func (x *SetSkuWarehouseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkuWarehouseStockResponse.ProtoReflect.Descriptor instead.
+func (*SetSkuWarehouseStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *SetSkuWarehouseStockResponse) GetStock() *SkuWarehouseStockInfo {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+// 查询SKU分仓库存请求
+type GetSkuWarehouseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"` // SKU ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuWarehouseStockRequest) Reset() {
+	*x = GetSkuWarehouseStockRequest{}
+	mi := &file_product_product_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuWarehouseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuWarehouseStockRequest) ProtoMessage() {}
+
+func (x *GetSkuWarehouseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuWarehouseStockRequest.ProtoReflect.Descriptor instead.
+func (*GetSkuWarehouseStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetSkuWarehouseStockRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+// 查询SKU分仓库存响应
+type GetSkuWarehouseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *SkuWarehouseStockInfo `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"` // 可售总库存及分仓库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuWarehouseStockResponse) Reset() {
+	*x = GetSkuWarehouseStockResponse{}
+	mi := &file_product_product_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuWarehouseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuWarehouseStockResponse) ProtoMessage() {}
+
+func (x *GetSkuWarehouseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuWarehouseStockResponse.ProtoReflect.Descriptor instead.
+func (*GetSkuWarehouseStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetSkuWarehouseStockResponse) GetStock() *SkuWarehouseStockInfo {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+// SKU价格变更记录
+type SkuPriceChangeInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // 记录ID
+	SkuId               int64                  `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                                              // SKU ID
+	ChangeType          int32                  `protobuf:"varint,3,opt,name=change_type,json=changeType,proto3" json:"change_type,omitempty"`                               // 类型：1=计划调价 2=限时调价到期恢复原价
+	Price               float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`                                                          // 价格
+	MarketPrice         float64                `protobuf:"fixed64,5,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`                           // 市场价，0表示未设置或不修改
+	PreviousPrice       float64                `protobuf:"fixed64,6,opt,name=previous_price,json=previousPrice,proto3" json:"previous_price,omitempty"`                     // 生效前价格，生效后才有值
+	PreviousMarketPrice float64                `protobuf:"fixed64,7,opt,name=previous_market_price,json=previousMarketPrice,proto3" json:"previous_market_price,omitempty"` // 生效前市场价
+	EffectiveFrom       string                 `protobuf:"bytes,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`                       // 生效时间
+	EffectiveTo         string                 `protobuf:"bytes,9,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`                             // 结束时间，为空表示长期有效
+	Status              int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`                                                        // 状态：0=待生效 1=已生效 2=已恢复原价
+	RevertOf            int64                  `protobuf:"varint,11,opt,name=revert_of,json=revertOf,proto3" json:"revert_of,omitempty"`                                    // 恢复原价对应的调价记录ID
+	AppliedAt           string                 `protobuf:"bytes,12,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`                                  // 实际生效时间
+	OperatorId          int64                  `protobuf:"varint,13,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`                              // 操作人ID
+	Reason              string                 `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`                                                         // 调价原因
+	CreatedAt           string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                  // 创建时间
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SkuPriceChangeInfo) Reset() {
+	*x = SkuPriceChangeInfo{}
+	mi := &file_product_product_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuPriceChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuPriceChangeInfo) ProtoMessage() {}
+
+func (x *SkuPriceChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuPriceChangeInfo.ProtoReflect.Descriptor instead.
+func (*SkuPriceChangeInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SkuPriceChangeInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetPreviousPrice() float64 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetPreviousMarketPrice() float64 {
+	if x != nil {
+		return x.PreviousMarketPrice
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *SkuPriceChangeInfo) GetEffectiveTo() string {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *SkuPriceChangeInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetRevertOf() int64 {
+	if x != nil {
+		return x.RevertOf
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetAppliedAt() string {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return ""
+}
+
+func (x *SkuPriceChangeInfo) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SkuPriceChangeInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SkuPriceChangeInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 计划调价请求
+type SchedulePriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                        // SKU ID
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`                                    // 价格，最多两位小数
+	MarketPrice   float64                `protobuf:"fixed64,3,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`     // 市场价，为0时不修改
+	EffectiveFrom string                 `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // 生效时间，格式 2006-01-02 15:04:05，为空时立即生效
+	EffectiveTo   string                 `protobuf:"bytes,5,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`       // 结束时间，到期后恢复生效前的价格，为空表示长期有效
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 调价原因，可选
+	OperatorId    int64                  `protobuf:"varint,7,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`         // 操作人ID，必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_product_product_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SchedulePriceChangeRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveTo() string {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// 计划调价响应
+type SchedulePriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *SkuPriceChangeInfo    `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 调价记录，立即生效时状态为已生效
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_product_product_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
+
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SchedulePriceChangeResponse) GetChange() *SkuPriceChangeInfo {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// 查询SKU在指定时刻的价格请求
+type GetSkuPriceAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"` // SKU ID
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`                     // 时刻，格式 2006-01-02 15:04:05
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuPriceAtRequest) Reset() {
+	*x = GetSkuPriceAtRequest{}
+	mi := &file_product_product_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuPriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuPriceAtRequest) ProtoMessage() {}
+
+func (x *GetSkuPriceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuPriceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetSkuPriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetSkuPriceAtRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetSkuPriceAtRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+// 查询SKU在指定时刻的价格响应
+type GetSkuPriceAtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                    // SKU ID
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`                                // 价格
+	MarketPrice   float64                `protobuf:"fixed64,3,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"` // 市场价，0表示未设置
+	ChangeId      int64                  `protobuf:"varint,4,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`           // 该时刻生效的价格变更记录ID，0表示没有变更记录覆盖该时刻
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkuPriceAtResponse) Reset() {
+	*x = GetSkuPriceAtResponse{}
+	mi := &file_product_product_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkuPriceAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkuPriceAtResponse) ProtoMessage() {}
+
+func (x *GetSkuPriceAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkuPriceAtResponse.ProtoReflect.Descriptor instead.
+func (*GetSkuPriceAtResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetSkuPriceAtResponse) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetSkuPriceAtResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GetSkuPriceAtResponse) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *GetSkuPriceAtResponse) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+// 查询SKU价格变更记录请求
+type ListSkuPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`          // SKU ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkuPriceHistoryRequest) Reset() {
+	*x = ListSkuPriceHistoryRequest{}
+	mi := &file_product_product_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkuPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkuPriceHistoryRequest) ProtoMessage() {}
+
+func (x *ListSkuPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkuPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListSkuPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ListSkuPriceHistoryRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ListSkuPriceHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSkuPriceHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询SKU价格变更记录响应
+type ListSkuPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*SkuPriceChangeInfo  `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`                    // 变更记录，按ID倒序
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkuPriceHistoryResponse) Reset() {
+	*x = ListSkuPriceHistoryResponse{}
+	mi := &file_product_product_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkuPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkuPriceHistoryResponse) ProtoMessage() {}
+
+func (x *ListSkuPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkuPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListSkuPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ListSkuPriceHistoryResponse) GetChanges() []*SkuPriceChangeInfo {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListSkuPriceHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSkuPriceHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSkuPriceHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// SKU状态变化
+type SkuStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                // SKU ID
+	FromStatus    int32                  `protobuf:"varint,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // 原状态
+	ToStatus      int32                  `protobuf:"varint,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`       // 新状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuStatusChange) Reset() {
+	*x = SkuStatusChange{}
+	mi := &file_product_product_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuStatusChange) ProtoMessage() {}
+
+func (x *SkuStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuStatusChange.ProtoReflect.Descriptor instead.
+func (*SkuStatusChange) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SkuStatusChange) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuStatusChange) GetFromStatus() int32 {
+	if x != nil {
+		return x.FromStatus
+	}
+	return 0
+}
+
+func (x *SkuStatusChange) GetToStatus() int32 {
+	if x != nil {
+		return x.ToStatus
+	}
+	return 0
+}
+
+// 商品及其SKU的状态变化
+type ProductStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`    // 商品ID
+	FromStatus    int32                  `protobuf:"varint,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // 原状态：0-下架 1-上架 2-草稿 3-已归档
+	ToStatus      int32                  `protobuf:"varint,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`       // 新状态，商品状态未变时与原状态相同
+	OnSaleAt      string                 `protobuf:"bytes,4,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`      // 定时上架时间，仅设置定时上架时返回
+	Skus          []*SkuStatusChange     `protobuf:"bytes,5,rep,name=skus,proto3" json:"skus,omitempty"`                                // 状态发生变化的SKU
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStatusChange) Reset() {
+	*x = ProductStatusChange{}
+	mi := &file_product_product_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStatusChange) ProtoMessage() {}
+
+func (x *ProductStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStatusChange.ProtoReflect.Descriptor instead.
+func (*ProductStatusChange) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ProductStatusChange) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductStatusChange) GetFromStatus() int32 {
+	if x != nil {
+		return x.FromStatus
+	}
+	return 0
+}
+
+func (x *ProductStatusChange) GetToStatus() int32 {
+	if x != nil {
+		return x.ToStatus
+	}
+	return 0
+}
+
+func (x *ProductStatusChange) GetOnSaleAt() string {
+	if x != nil {
+		return x.OnSaleAt
+	}
+	return ""
+}
+
+func (x *ProductStatusChange) GetSkus() []*SkuStatusChange {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// 上架商品请求
+type PublishProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`    // 商品ID
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`      // 一并上架的SKU，为空时上架草稿状态的SKU
+	OnSaleAt      string                 `protobuf:"bytes,3,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`      // 定时上架时间，格式 2006-01-02 15:04:05，为空时立即上架
+	OperatorId    int64                  `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                            // 原因，可选
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishProductRequest) Reset() {
+	*x = PublishProductRequest{}
+	mi := &file_product_product_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishProductRequest) ProtoMessage() {}
+
+func (x *PublishProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishProductRequest.ProtoReflect.Descriptor instead.
+func (*PublishProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *PublishProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PublishProductRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *PublishProductRequest) GetOnSaleAt() string {
+	if x != nil {
+		return x.OnSaleAt
+	}
+	return ""
+}
+
+func (x *PublishProductRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *PublishProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 上架商品响应
+type PublishProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ProductStatusChange   `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 状态变化
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishProductResponse) Reset() {
+	*x = PublishProductResponse{}
+	mi := &file_product_product_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishProductResponse) ProtoMessage() {}
+
+func (x *PublishProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishProductResponse.ProtoReflect.Descriptor instead.
+func (*PublishProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *PublishProductResponse) GetChange() *ProductStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// 下架商品请求
+type UnpublishProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`    // 商品ID
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                            // 原因，可选
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishProductRequest) Reset() {
+	*x = UnpublishProductRequest{}
+	mi := &file_product_product_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishProductRequest) ProtoMessage() {}
+
+func (x *UnpublishProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishProductRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *UnpublishProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UnpublishProductRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UnpublishProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 下架商品响应
+type UnpublishProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ProductStatusChange   `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 状态变化，上架中的SKU一并下架
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishProductResponse) Reset() {
+	*x = UnpublishProductResponse{}
+	mi := &file_product_product_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishProductResponse) ProtoMessage() {}
+
+func (x *UnpublishProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishProductResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *UnpublishProductResponse) GetChange() *ProductStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// 下架SKU请求
+type UnpublishSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                // SKU ID
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                            // 原因，可选
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishSkuRequest) Reset() {
+	*x = UnpublishSkuRequest{}
+	mi := &file_product_product_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishSkuRequest) ProtoMessage() {}
+
+func (x *UnpublishSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishSkuRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishSkuRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *UnpublishSkuRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *UnpublishSkuRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UnpublishSkuRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 下架SKU响应
+type UnpublishSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ProductStatusChange   `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 状态变化
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishSkuResponse) Reset() {
+	*x = UnpublishSkuResponse{}
+	mi := &file_product_product_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishSkuResponse) ProtoMessage() {}
+
+func (x *UnpublishSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishSkuResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishSkuResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *UnpublishSkuResponse) GetChange() *ProductStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// 归档商品请求
+type ArchiveProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`    // 商品ID
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                            // 原因，可选
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductRequest) Reset() {
+	*x = ArchiveProductRequest{}
+	mi := &file_product_product_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductRequest) ProtoMessage() {}
+
+func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ArchiveProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ArchiveProductRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ArchiveProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 归档商品响应
+type ArchiveProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ProductStatusChange   `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // 状态变化，所有SKU一并归档
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductResponse) Reset() {
+	*x = ArchiveProductResponse{}
+	mi := &file_product_product_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductResponse) ProtoMessage() {}
+
+func (x *ArchiveProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ArchiveProductResponse) GetChange() *ProductStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// 死信信息
+type DeadLetterInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                     // 死信ID
+	EventId         string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                                             // 事件ID
+	Topic           string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`                                                                                // 死信主题
+	OriginTopic     string                 `protobuf:"bytes,4,opt,name=origin_topic,json=originTopic,proto3" json:"origin_topic,omitempty"`                                                 // 原主题
+	EventType       string                 `protobuf:"bytes,5,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`                                                       // 原事件类型
+	EventKey        string                 `protobuf:"bytes,6,opt,name=event_key,json=eventKey,proto3" json:"event_key,omitempty"`                                                          // 分区键
+	Error           string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                                                                                // 失败原因
+	OriginTimestamp int64                  `protobuf:"varint,8,opt,name=origin_timestamp,json=originTimestamp,proto3" json:"origin_timestamp,omitempty"`                                    // 原消息发布时间（毫秒时间戳）
+	DeadAt          int64                  `protobuf:"varint,9,opt,name=dead_at,json=deadAt,proto3" json:"dead_at,omitempty"`                                                               // 进入死信队列的时间（毫秒时间戳）
+	Status          int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`                                                                            // 状态：1=待处理 2=已重新投递 3=已解决 4=已丢弃
+	ReplayCount     int32                  `protobuf:"varint,11,opt,name=replay_count,json=replayCount,proto3" json:"replay_count,omitempty"`                                               // 重新投递次数
+	ReplayedAt      string                 `protobuf:"bytes,12,opt,name=replayed_at,json=replayedAt,proto3" json:"replayed_at,omitempty"`                                                   // 最后一次重新投递时间
+	HandledAt       string                 `protobuf:"bytes,13,opt,name=handled_at,json=handledAt,proto3" json:"handled_at,omitempty"`                                                      // 解决或丢弃的时间
+	OperatorId      int64                  `protobuf:"varint,14,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`                                                  // 最后操作人ID
+	Reason          string                 `protobuf:"bytes,15,opt,name=reason,proto3" json:"reason,omitempty"`                                                                             // 处理原因
+	CreatedAt       string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                      // 索引时间
+	Headers         map[string]string      `protobuf:"bytes,17,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 原消息头，仅详情返回
+	Payload         []byte                 `protobuf:"bytes,18,opt,name=payload,proto3" json:"payload,omitempty"`                                                                           // 原消息体，仅详情返回
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeadLetterInfo) Reset() {
+	*x = DeadLetterInfo{}
+	mi := &file_product_product_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterInfo) ProtoMessage() {}
+
+func (x *DeadLetterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterInfo.ProtoReflect.Descriptor instead.
+func (*DeadLetterInfo) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *DeadLetterInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetterInfo) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetOriginTopic() string {
+	if x != nil {
+		return x.OriginTopic
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetEventKey() string {
+	if x != nil {
+		return x.EventKey
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetOriginTimestamp() int64 {
+	if x != nil {
+		return x.OriginTimestamp
+	}
+	return 0
+}
+
+func (x *DeadLetterInfo) GetDeadAt() int64 {
+	if x != nil {
+		return x.DeadAt
+	}
+	return 0
+}
+
+func (x *DeadLetterInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DeadLetterInfo) GetReplayCount() int32 {
+	if x != nil {
+		return x.ReplayCount
+	}
+	return 0
+}
+
+func (x *DeadLetterInfo) GetReplayedAt() string {
+	if x != nil {
+		return x.ReplayedAt
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetHandledAt() string {
+	if x != nil {
+		return x.HandledAt
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *DeadLetterInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeadLetterInfo) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DeadLetterInfo) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 查询死信请求，按死信ID倒序返回
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`                          // 死信主题，空表示不过滤
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // 原事件类型，空表示不过滤
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                       // 状态，0表示不过滤
+	Cursor        int64                  `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                       // 游标，首页传0，之后传上一页返回的next_cursor
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                         // 每页数量，1-100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_product_product_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ListDeadLettersRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 查询死信响应
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters   []*DeadLetterInfo      `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"` // 死信，不含消息头和消息体
+	NextCursor    int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`   // 下一页游标，0表示没有更多记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_product_product_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetterInfo {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// 查询死信详情请求
+type GetDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 死信ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterRequest) Reset() {
+	*x = GetDeadLetterRequest{}
+	mi := &file_product_product_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterRequest) ProtoMessage() {}
+
+func (x *GetDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetDeadLetterRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 查询死信详情响应
+type GetDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter    *DeadLetterInfo        `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"` // 死信详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetterResponse) Reset() {
+	*x = GetDeadLetterResponse{}
+	mi := &file_product_product_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterResponse) ProtoMessage() {}
+
+func (x *GetDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetDeadLetterResponse) GetDeadLetter() *DeadLetterInfo {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+// 重新投递死信请求
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                          // 死信ID，最多100个
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_product_product_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *ReplayDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+// 死信重新投递结果
+type DeadLetterReplayResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`             // 死信ID
+	Replayed      bool                   `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"` // 是否已提交到broker
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`        // 投递失败原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterReplayResult) Reset() {
+	*x = DeadLetterReplayResult{}
+	mi := &file_product_product_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterReplayResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterReplayResult) ProtoMessage() {}
+
+func (x *DeadLetterReplayResult) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterReplayResult.ProtoReflect.Descriptor instead.
+func (*DeadLetterReplayResult) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *DeadLetterReplayResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetterReplayResult) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *DeadLetterReplayResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 重新投递死信响应
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*DeadLetterReplayResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 各死信的投递结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_product_product_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ReplayDeadLettersResponse) GetResults() []*DeadLetterReplayResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 标记死信已解决或已丢弃请求
+type ResolveDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                          // 死信ID，最多100个
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                           // 目标状态：3=已解决 4=已丢弃
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID，必填
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                            // 原因，可选
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDeadLettersRequest) Reset() {
+	*x = ResolveDeadLettersRequest{}
+	mi := &file_product_product_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDeadLettersRequest) ProtoMessage() {}
+
+func (x *ResolveDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ResolveDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ResolveDeadLettersRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ResolveDeadLettersRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ResolveDeadLettersRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 标记死信已解决或已丢弃响应
+type ResolveDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters   []*DeadLetterInfo      `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"` // 处理后的死信
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDeadLettersResponse) Reset() {
+	*x = ResolveDeadLettersResponse{}
+	mi := &file_product_product_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDeadLettersResponse) ProtoMessage() {}
+
+func (x *ResolveDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ResolveDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ResolveDeadLettersResponse) GetDeadLetters() []*DeadLetterInfo {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+var File_product_product_proto protoreflect.FileDescriptor
+
+const file_product_product_proto_rawDesc = "" +
+	"\n" +
+	"\x15product/product.proto\x12\x10go.micro.service\"\xe7\x01\n" +
+	"\vProductInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1f\n" +
+	"\vproduct_sku\x18\x03 \x01(\tR\n" +
+	"productSku\x12#\n" +
+	"\rproduct_price\x18\x04 \x01(\x01R\fproductPrice\x12/\n" +
+	"\x13product_description\x18\x05 \x01(\tR\x12productDescription\x12.\n" +
+	"\x13product_category_id\x18\x06 \x01(\x03R\x11productCategoryId\"0\n" +
+	"\x0fResponseProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"3\n" +
+	"\x1aGetProductSkuDetailRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\"\xbb\x04\n" +
+	"\x1bGetProductSkuDetailResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x15\n" +
+	"\x06sku_no\x18\x03 \x01(\tR\x05skuNo\x12\x19\n" +
+	"\bsku_name\x18\x04 \x01(\tR\askuName\x12$\n" +
+	"\x0espec_value_ids\x18\x05 \x01(\tR\fspecValueIds\x12&\n" +
+	"\x0fspec_value_text\x18\x06 \x01(\tR\rspecValueText\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\b \x01(\x01R\vmarketPrice\x12\x14\n" +
+	"\x05stock\x18\t \x01(\rR\x05stock\x12\x1d\n" +
+	"\n" +
+	"stock_warn\x18\n" +
+	" \x01(\rR\tstockWarn\x12\x14\n" +
+	"\x05sales\x18\v \x01(\x05R\x05sales\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\f \x01(\tR\tmainImage\x12\x16\n" +
+	"\x06status\x18\r \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12<\n" +
+	"\aproduct\x18\x10 \x01(\v2\".go.micro.service.ProductBasicInfoR\aproduct\x126\n" +
+	"\x06images\x18\x11 \x03(\v2\x1e.go.micro.service.SkuImageInfoR\x06images\"\xa5\x01\n" +
+	"\x10ProductBasicInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_no\x18\x02 \x01(\tR\tproductNo\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\x04 \x01(\tR\tmainImage\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"y\n" +
+	"\fSkuImageInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12\x17\n" +
+	"\ais_main\x18\x03 \x01(\bR\x06isMain\x12#\n" +
+	"\rdisplay_order\x18\x04 \x01(\x05R\fdisplayOrder\"Z\n" +
+	"!CheckSkuInventoryThresholdRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\x03R\x06skuIds\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\rR\tthreshold\"\xbb\x01\n" +
+	"\x17SkuInventoryCheckResult\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x15\n" +
+	"\x06sku_no\x18\x02 \x01(\tR\x05skuNo\x12\x19\n" +
+	"\bsku_name\x18\x03 \x01(\tR\askuName\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\rR\x05stock\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\rR\tthreshold\x12#\n" +
+	"\ris_sufficient\x18\x06 \x01(\bR\fisSufficient\"i\n" +
+	"\"CheckSkuInventoryThresholdResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).go.micro.service.SkuInventoryCheckResultR\aresults\"6\n" +
+	"\x19GetSkuStockBySkuNoRequest\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\"\x98\x01\n" +
+	"\x1aGetSkuStockBySkuNoResponse\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05stock\x18\x03 \x01(\rR\x05stock\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"stock_warn\x18\x05 \x01(\rR\tstockWarn\"\x7f\n" +
+	"\x19CreateRestockApplyRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa3\x01\n" +
+	"\x1aCreateRestockApplyResponse\x12J\n" +
+	"\x0erestock_record\x18\x01 \x01(\v2#.go.micro.service.RestockRecordInfoR\rrestockRecord\x129\n" +
+	"\bsku_info\x18\x02 \x01(\v2\x1e.go.micro.service.SkuBasicInfoR\askuInfo\"\x8a\x02\n" +
+	"\x11RestockRecordInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\x04R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\rR\x06status\x12#\n" +
+	"\rfailed_reason\x18\a \x01(\tR\ffailedReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12%\n" +
+	"\x0eapplication_no\x18\t \x01(\tR\rapplicationNo\"x\n" +
+	"\fSkuBasicInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sku_no\x18\x02 \x01(\tR\x05skuNo\x12\x19\n" +
+	"\bsku_name\x18\x03 \x01(\tR\askuName\x12&\n" +
+	"\x0fspec_value_text\x18\x04 \x01(\tR\rspecValueText\"o\n" +
+	"\x18GetSkuSalesVolumeRequest\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x81\x01\n" +
+	"\x19GetSkuSalesVolumeResponse\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12!\n" +
+	"\fsales_volume\x18\x02 \x01(\x03R\vsalesVolume\x12&\n" +
+	"\x0fdaily_avg_sales\x18\x03 \x01(\x01R\rdailyAvgSales\"3\n" +
+	"\x16GetSupplierInfoRequest\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\"\xfd\x01\n" +
+	"\x10SupplierInfoItem\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x1f\n" +
+	"\vsupplier_id\x18\x02 \x01(\x03R\n" +
+	"supplierId\x12!\n" +
+	"\fsupply_price\x18\x03 \x01(\x01R\vsupplyPrice\x12,\n" +
+	"\x12min_order_quantity\x18\x04 \x01(\x05R\x10minOrderQuantity\x12!\n" +
+	"\fis_preferred\x18\x05 \x01(\bR\visPreferred\x129\n" +
+	"\bsupplier\x18\x06 \x01(\v2\x1d.go.micro.service.SupplierDTOR\bsupplier\"\x81\x02\n" +
+	"\vSupplierDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0econtact_person\x18\x03 \x01(\tR\rcontactPerson\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06rating\x18\a \x01(\x01R\x06rating\x12$\n" +
+	"\x0elead_time_days\x18\b \x01(\x05R\fleadTimeDays\x12#\n" +
+	"\rpayment_terms\x18\t \x01(\tR\fpaymentTerms\"[\n" +
+	"\x17GetSupplierInfoResponse\x12@\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\".go.micro.service.SupplierInfoItemR\tsuppliers\"n\n" +
+	"\x17GetSkuDailySalesRequest\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"b\n" +
+	"\x0eDailySalesItem\x12\x19\n" +
+	"\bsku_code\x18\x01 \x01(\tR\askuCode\x12!\n" +
+	"\fsales_volume\x18\x02 \x01(\x03R\vsalesVolume\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"]\n" +
+	"\x18GetSkuDailySalesResponse\x12A\n" +
+	"\vdaily_sales\x18\x01 \x03(\v2 .go.micro.service.DailySalesItemR\n" +
+	"dailySales\"\\\n" +
+	"\x1aGetRestockApplyInfoRequest\x12%\n" +
+	"\x0eapplication_no\x18\x01 \x01(\tR\rapplicationNo\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xf6\x01\n" +
+	"\x10RestockAuditInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"restock_id\x18\x02 \x01(\x03R\trestockId\x12\"\n" +
+	"\raudit_user_id\x18\x03 \x01(\rR\vauditUserId\x12!\n" +
+	"\faudit_status\x18\x04 \x01(\rR\vauditStatus\x12.\n" +
+	"\x13audit_failed_reason\x18\x05 \x01(\tR\x11auditFailedReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xf1\x01\n" +
+	"\x1bGetRestockApplyInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\rR\x06status\x12%\n" +
+	"\x0eapplication_no\x18\x06 \x01(\tR\rapplicationNo\x128\n" +
+	"\x05audit\x18\a \x01(\v2\".go.micro.service.RestockAuditInfoR\x05audit\"g\n" +
+	"\x1aApproveRestockApplyRequest\x12%\n" +
+	"\x0eapplication_no\x18\x01 \x01(\tR\rapplicationNo\x12\"\n" +
+	"\raudit_user_id\x18\x02 \x01(\rR\vauditUserId\"\xa3\x01\n" +
+	"\x1bApproveRestockApplyResponse\x12J\n" +
+	"\x0erestock_record\x18\x01 \x01(\v2#.go.micro.service.RestockRecordInfoR\rrestockRecord\x128\n" +
+	"\x05audit\x18\x02 \x01(\v2\".go.micro.service.RestockAuditInfoR\x05audit\"~\n" +
+	"\x19RejectRestockApplyRequest\x12%\n" +
+	"\x0eapplication_no\x18\x01 \x01(\tR\rapplicationNo\x12\"\n" +
+	"\raudit_user_id\x18\x02 \x01(\rR\vauditUserId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xa2\x01\n" +
+	"\x1aRejectRestockApplyResponse\x12J\n" +
+	"\x0erestock_record\x18\x01 \x01(\v2#.go.micro.service.RestockRecordInfoR\rrestockRecord\x128\n" +
+	"\x05audit\x18\x02 \x01(\v2\".go.micro.service.RestockAuditInfoR\x05audit\"E\n" +
+	"\x10ReserveStockItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"\x8b\x01\n" +
+	"\x13ReserveStockRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x128\n" +
+	"\x05items\x18\x02 \x03(\v2\".go.micro.service.ReserveStockItemR\x05items\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"\xab\x01\n" +
+	"\x14StockReservationInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\x03R\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\rR\bquantity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\rR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\"b\n" +
+	"\x14ReserveStockResponse\x12J\n" +
+	"\freservations\x18\x01 \x03(\v2&.go.micro.service.StockReservationInfoR\freservations\"6\n" +
+	"\x19ConfirmReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"h\n" +
+	"\x1aConfirmReservationResponse\x12J\n" +
+	"\freservations\x18\x01 \x03(\v2&.go.micro.service.StockReservationInfoR\freservations\"6\n" +
+	"\x19ReleaseReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"h\n" +
+	"\x1aReleaseReservationResponse\x12J\n" +
+	"\freservations\x18\x01 \x03(\v2&.go.micro.service.StockReservationInfoR\freservations\"j\n" +
+	"\x13TccDeductSkuRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x128\n" +
+	"\x05items\x18\x02 \x03(\v2\".go.micro.service.ReserveStockItemR\x05items\"\x16\n" +
+	"\x14TccDeductSkuResponse\"\xe5\x01\n" +
+	"\fProductInput\x12\x1d\n" +
+	"\n" +
+	"product_no\x18\x01 \x01(\tR\tproductNo\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x04 \x01(\x03R\abrandId\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\x05 \x01(\tR\tmainImage\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\"P\n" +
+	"\x0eSpecValueInput\x12\x1d\n" +
+	"\n" +
+	"value_name\x18\x01 \x01(\tR\tvalueName\x12\x1f\n" +
+	"\vvalue_image\x18\x02 \x01(\tR\n" +
+	"valueImage\"b\n" +
+	"\tSpecInput\x12\x1b\n" +
+	"\tspec_name\x18\x01 \x01(\tR\bspecName\x128\n" +
+	"\x06values\x18\x02 \x03(\v2 .go.micro.service.SpecValueInputR\x06values\"\xeb\x01\n" +
+	"\bSkuInput\x12\x1f\n" +
+	"\vspec_values\x18\x01 \x03(\tR\n" +
+	"specValues\x12\x19\n" +
+	"\bsku_name\x18\x02 \x01(\tR\askuName\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\x04 \x01(\x01R\vmarketPrice\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\rR\x05stock\x12\x1d\n" +
+	"\n" +
+	"stock_warn\x18\x06 \x01(\rR\tstockWarn\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\a \x01(\tR\tmainImage\x12\x16\n" +
+	"\x06images\x18\b \x03(\tR\x06images\"P\n" +
+	"\x14CreateProductRequest\x128\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1e.go.micro.service.ProductInputR\aproduct\"R\n" +
+	"\x15CreateProductResponse\x129\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1f.go.micro.service.ProductDetailR\aproduct\"\xf8\x01\n" +
+	"\x1cCreateProductWithSkusRequest\x128\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1e.go.micro.service.ProductInputR\aproduct\x121\n" +
+	"\x05specs\x18\x02 \x03(\v2\x1b.go.micro.service.SpecInputR\x05specs\x12;\n" +
+	"\vdefault_sku\x18\x03 \x01(\v2\x1a.go.micro.service.SkuInputR\n" +
+	"defaultSku\x12.\n" +
+	"\x04skus\x18\x04 \x03(\v2\x1a.go.micro.service.SkuInputR\x04skus\"Z\n" +
+	"\x1dCreateProductWithSkusResponse\x129\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1f.go.micro.service.ProductDetailR\aproduct\"\xca\x01\n" +
+	"\x0eSkuUpdateInput\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bsku_name\x18\x02 \x01(\tR\askuName\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\x04 \x01(\x01R\vmarketPrice\x12\x1d\n" +
+	"\n" +
+	"stock_warn\x18\x05 \x01(\rR\tstockWarn\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\x06 \x01(\tR\tmainImage\x12\x16\n" +
+	"\x06images\x18\a \x03(\tR\x06images\"\x96\x01\n" +
+	"\x14UpdateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\aproduct\x18\x02 \x01(\v2\x1e.go.micro.service.ProductInputR\aproduct\x124\n" +
+	"\x04skus\x18\x03 \x03(\v2 .go.micro.service.SkuUpdateInputR\x04skus\"R\n" +
+	"\x15UpdateProductResponse\x129\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1f.go.micro.service.ProductDetailR\aproduct\"#\n" +
+	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x12GetProductResponse\x129\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1f.go.micro.service.ProductDetailR\aproduct\"\xb8\x01\n" +
+	"\x13ListProductsRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x03 \x01(\x03R\abrandId\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\x05R\bstatuses\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\x9a\x01\n" +
+	"\x14ListProductsResponse\x12;\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1f.go.micro.service.ProductDetailR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x17\n" +
+	"\x15DeleteProductResponse\"\xa3\x03\n" +
+	"\rProductDetail\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_no\x18\x02 \x01(\tR\tproductNo\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x05 \x01(\x03R\abrandId\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\x06 \x01(\tR\tmainImage\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x127\n" +
+	"\x05specs\x18\v \x03(\v2!.go.micro.service.ProductSpecInfoR\x05specs\x124\n" +
+	"\x04skus\x18\f \x03(\v2 .go.micro.service.ProductSkuInfoR\x04skus\"\x9c\x01\n" +
+	"\x0fProductSpecInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tspec_name\x18\x02 \x01(\tR\bspecName\x12#\n" +
+	"\rdisplay_order\x18\x03 \x01(\x05R\fdisplayOrder\x127\n" +
+	"\x06values\x18\x04 \x03(\v2\x1f.go.micro.service.SpecValueInfoR\x06values\"\x84\x01\n" +
+	"\rSpecValueInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"value_name\x18\x02 \x01(\tR\tvalueName\x12\x1f\n" +
+	"\vvalue_image\x18\x03 \x01(\tR\n" +
+	"valueImage\x12#\n" +
+	"\rdisplay_order\x18\x04 \x01(\x05R\fdisplayOrder\"\x93\x03\n" +
+	"\x0eProductSkuInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sku_no\x18\x02 \x01(\tR\x05skuNo\x12\x19\n" +
+	"\bsku_name\x18\x03 \x01(\tR\askuName\x12$\n" +
+	"\x0espec_value_ids\x18\x04 \x01(\tR\fspecValueIds\x12&\n" +
+	"\x0fspec_value_text\x18\x05 \x01(\tR\rspecValueText\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\a \x01(\x01R\vmarketPrice\x12\x14\n" +
+	"\x05stock\x18\b \x01(\rR\x05stock\x12\x1d\n" +
+	"\n" +
+	"stock_warn\x18\t \x01(\rR\tstockWarn\x12\x14\n" +
+	"\x05sales\x18\n" +
+	" \x01(\x05R\x05sales\x12\x1d\n" +
+	"\n" +
+	"main_image\x18\v \x01(\tR\tmainImage\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x126\n" +
+	"\x06images\x18\r \x03(\v2\x1e.go.micro.service.SkuImageInfoR\x06images\"\xd1\x01\n" +
+	"\x10SkuMatrixRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x121\n" +
+	"\x05specs\x18\x02 \x03(\v2\x1b.go.micro.service.SpecInputR\x05specs\x12;\n" +
+	"\vdefault_sku\x18\x03 \x01(\v2\x1a.go.micro.service.SkuInputR\n" +
+	"defaultSku\x12.\n" +
+	"\x04skus\x18\x04 \x03(\v2\x1a.go.micro.service.SkuInputR\x04skus\"\xea\x01\n" +
+	"\rSkuMatrixItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x15\n" +
+	"\x06sku_no\x18\x02 \x01(\tR\x05skuNo\x12\x19\n" +
+	"\bsku_name\x18\x03 \x01(\tR\askuName\x12$\n" +
+	"\x0espec_value_ids\x18\x04 \x01(\tR\fspecValueIds\x12&\n" +
+	"\x0fspec_value_text\x18\x05 \x01(\tR\rspecValueText\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05stock\x18\a \x01(\rR\x05stock\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\"\xbc\x01\n" +
+	"\x11SkuMatrixResponse\x127\n" +
+	"\x06create\x18\x01 \x03(\v2\x1f.go.micro.service.SkuMatrixItemR\x06create\x129\n" +
+	"\adisable\x18\x02 \x03(\v2\x1f.go.micro.service.SkuMatrixItemR\adisable\x123\n" +
+	"\x04keep\x18\x03 \x03(\v2\x1f.go.micro.service.SkuMatrixItemR\x04keep\"v\n" +
+	"\fCategoryInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\"\xb2\x01\n" +
+	"\fCategoryNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12:\n" +
+	"\bchildren\x18\x05 \x03(\v2\x1e.go.micro.service.CategoryNodeR\bchildren\"Y\n" +
+	"\x15CreateCategoryRequest\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\"T\n" +
+	"\x16CreateCategoryResponse\x12:\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.go.micro.service.CategoryInfoR\bcategory\"L\n" +
+	"\x15RenameCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\"T\n" +
+	"\x16RenameCategoryResponse\x12:\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.go.micro.service.CategoryInfoR\bcategory\"B\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\"R\n" +
+	"\x14MoveCategoryResponse\x12:\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1e.go.micro.service.CategoryInfoR\bcategory\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x18\n" +
+	"\x16DeleteCategoryResponse\"\x18\n" +
+	"\x16GetCategoryTreeRequest\"Y\n" +
+	"\x17GetCategoryTreeResponse\x12>\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1e.go.micro.service.CategoryNodeR\n" +
+	"categories\"k\n" +
+	"\x17ListCategorySkusRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x97\x01\n" +
+	"\x18ListCategorySkusResponse\x124\n" +
+	"\x04skus\x18\x01 \x03(\v2 .go.micro.service.ProductSkuInfoR\x04skus\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe8\x01\n" +
+	"\tBrandInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"brand_name\x18\x02 \x01(\tR\tbrandName\x12\x12\n" +
+	"\x04logo\x18\x03 \x01(\tR\x04logo\x120\n" +
+	"\x14active_product_count\x18\x04 \x01(\x03R\x12activeProductCount\x12(\n" +
+	"\x10active_sku_count\x18\x05 \x01(\x03R\x0eactiveSkuCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"G\n" +
+	"\x12CreateBrandRequest\x12\x1d\n" +
+	"\n" +
+	"brand_name\x18\x01 \x01(\tR\tbrandName\x12\x12\n" +
+	"\x04logo\x18\x02 \x01(\tR\x04logo\"H\n" +
+	"\x13CreateBrandResponse\x121\n" +
+	"\x05brand\x18\x01 \x01(\v2\x1b.go.micro.service.BrandInfoR\x05brand\"W\n" +
+	"\x12UpdateBrandRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"brand_name\x18\x02 \x01(\tR\tbrandName\x12\x12\n" +
+	"\x04logo\x18\x03 \x01(\tR\x04logo\"H\n" +
+	"\x13UpdateBrandResponse\x121\n" +
+	"\x05brand\x18\x01 \x01(\v2\x1b.go.micro.service.BrandInfoR\x05brand\"!\n" +
+	"\x0fGetBrandRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"E\n" +
+	"\x10GetBrandResponse\x121\n" +
+	"\x05brand\x18\x01 \x01(\v2\x1b.go.micro.service.BrandInfoR\x05brand\"^\n" +
+	"\x11ListBrandsRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x90\x01\n" +
+	"\x12ListBrandsResponse\x123\n" +
+	"\x06brands\x18\x01 \x03(\v2\x1b.go.micro.service.BrandInfoR\x06brands\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"$\n" +
+	"\x12DeleteBrandRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x15\n" +
+	"\x13DeleteBrandResponse\"\x83\x01\n" +
+	"\x1cReassignBrandProductsRequest\x12\"\n" +
+	"\rfrom_brand_id\x18\x01 \x01(\x03R\vfromBrandId\x12\x1e\n" +
+	"\vto_brand_id\x18\x02 \x01(\x03R\ttoBrandId\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\x03R\n" +
+	"productIds\";\n" +
+	"\x1dReassignBrandProductsResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\"\xf3\x01\n" +
+	"\rSupplierInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0econtact_person\x18\x02 \x01(\tR\rcontactPerson\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06rating\x18\x06 \x01(\x01R\x06rating\x12$\n" +
+	"\x0elead_time_days\x18\a \x01(\x05R\fleadTimeDays\x12#\n" +
+	"\rpayment_terms\x18\b \x01(\tR\fpaymentTerms\"T\n" +
+	"\x15CreateSupplierRequest\x12;\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x1f.go.micro.service.SupplierInputR\bsupplier\"S\n" +
+	"\x16CreateSupplierResponse\x129\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x1d.go.micro.service.SupplierDTOR\bsupplier\"d\n" +
+	"\x15UpdateSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\bsupplier\x18\x02 \x01(\v2\x1f.go.micro.service.SupplierInputR\bsupplier\"S\n" +
+	"\x16UpdateSupplierResponse\x129\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x1d.go.micro.service.SupplierDTOR\bsupplier\"$\n" +
+	"\x12GetSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
+	"\x13GetSupplierResponse\x129\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x1d.go.micro.service.SupplierDTOR\bsupplier\"a\n" +
+	"\x14ListSuppliersRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x9b\x01\n" +
+	"\x15ListSuppliersResponse\x12;\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x1d.go.micro.service.SupplierDTOR\tsuppliers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"'\n" +
+	"\x15DeleteSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x18\n" +
+	"\x16DeleteSupplierResponse\"\xdb\x02\n" +
+	"\x0fSupplierSkuItem\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
+	"supplierId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x15\n" +
+	"\x06sku_no\x18\x03 \x01(\tR\x05skuNo\x12\x19\n" +
+	"\bsku_name\x18\x04 \x01(\tR\askuName\x12&\n" +
+	"\x0fspec_value_text\x18\x05 \x01(\tR\rspecValueText\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05stock\x18\a \x01(\rR\x05stock\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12!\n" +
+	"\fsupply_price\x18\t \x01(\x01R\vsupplyPrice\x12,\n" +
+	"\x12min_order_quantity\x18\n" +
+	" \x01(\x05R\x10minOrderQuantity\x12!\n" +
+	"\fis_preferred\x18\v \x01(\bR\visPreferred\"\xc4\x01\n" +
+	"\x16LinkSupplierSkuRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
+	"supplierId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12!\n" +
+	"\fsupply_price\x18\x03 \x01(\x01R\vsupplyPrice\x12,\n" +
+	"\x12min_order_quantity\x18\x04 \x01(\x05R\x10minOrderQuantity\x12!\n" +
+	"\fis_preferred\x18\x05 \x01(\bR\visPreferred\"P\n" +
+	"\x17LinkSupplierSkuResponse\x125\n" +
+	"\x04item\x18\x01 \x01(\v2!.go.micro.service.SupplierSkuItemR\x04item\"R\n" +
+	"\x18UnlinkSupplierSkuRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
+	"supplierId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\"\x1b\n" +
+	"\x19UnlinkSupplierSkuResponse\"m\n" +
+	"\x19ListSkusBySupplierRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
+	"supplierId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x9a\x01\n" +
+	"\x1aListSkusBySupplierResponse\x125\n" +
+	"\x04skus\x18\x01 \x03(\v2!.go.micro.service.SupplierSkuItemR\x04skus\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x8e\x02\n" +
+	"\x15PurchaseOrderLineInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1f\n" +
+	"\vrestock_ids\x18\x03 \x03(\x03R\n" +
+	"restockIds\x12-\n" +
+	"\x12requested_quantity\x18\x04 \x01(\x05R\x11requestedQuantity\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12+\n" +
+	"\x11received_quantity\x18\x06 \x01(\x05R\x10receivedQuantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\a \x01(\x01R\tunitPrice\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\"\x88\x03\n" +
+	"\x11PurchaseOrderInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x11purchase_order_no\x18\x02 \x01(\tR\x0fpurchaseOrderNo\x12\x1f\n" +
+	"\vsupplier_id\x18\x03 \x01(\x03R\n" +
+	"supplierId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\rR\x06status\x12%\n" +
+	"\x0etotal_quantity\x18\x05 \x01(\x05R\rtotalQuantity\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x01R\vtotalAmount\x12\x1f\n" +
+	"\voperator_id\x18\a \x01(\x05R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06remark\x18\b \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12=\n" +
+	"\x05lines\x18\v \x03(\v2'.go.micro.service.PurchaseOrderLineInfoR\x05lines\"S\n" +
+	"\x16PurchaseOrderItemInput\x12\x1d\n" +
+	"\n" +
+	"restock_id\x18\x01 \x01(\x03R\trestockId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x96\x01\n" +
+	"\x1bCreatePurchaseOrdersRequest\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.go.micro.service.PurchaseOrderItemInputR\x05items\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x05R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\"l\n" +
+	"\x1cCreatePurchaseOrdersResponse\x12L\n" +
+	"\x0fpurchase_orders\x18\x01 \x03(\v2#.go.micro.service.PurchaseOrderInfoR\x0epurchaseOrders\")\n" +
+	"\x17GetPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"f\n" +
+	"\x18GetPurchaseOrderResponse\x12J\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2#.go.micro.service.PurchaseOrderInfoR\rpurchaseOrder\"\x89\x01\n" +
+	"\x19ListPurchaseOrdersRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
+	"supplierId\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\x05R\bstatuses\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb1\x01\n" +
+	"\x1aListPurchaseOrdersResponse\x12L\n" +
+	"\x0fpurchase_orders\x18\x01 \x03(\v2#.go.micro.service.PurchaseOrderInfoR\x0epurchaseOrders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"G\n" +
+	"\x10ReceiveGoodsItem\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\x03R\x06lineId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xbf\x01\n" +
+	"\x13ReceiveGoodsRequest\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\x03R\x0fpurchaseOrderId\x128\n" +
+	"\x05items\x18\x02 \x03(\v2\".go.micro.service.ReceiveGoodsItemR\x05items\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x05R\n" +
+	"operatorId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x03R\vwarehouseId\"b\n" +
+	"\x14ReceiveGoodsResponse\x12J\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2#.go.micro.service.PurchaseOrderInfoR\rpurchaseOrder\"\xd0\x01\n" +
+	"\x11StockAdjustResult\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x12!\n" +
+	"\fbefore_stock\x18\x03 \x01(\rR\vbeforeStock\x12\x1f\n" +
+	"\vafter_stock\x18\x04 \x01(\rR\n" +
+	"afterStock\x12!\n" +
+	"\fwarehouse_id\x18\x05 \x01(\x03R\vwarehouseId\x12'\n" +
+	"\x0fwarehouse_stock\x18\x06 \x01(\rR\x0ewarehouseStock\"\x9d\x01\n" +
+	"\x12AdjustStockRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\x03R\n" +
+	"operatorId\x12!\n" +
+	"\fwarehouse_id\x18\x05 \x01(\x03R\vwarehouseId\"R\n" +
+	"\x13AdjustStockResponse\x12;\n" +
+	"\x06result\x18\x01 \x01(\v2#.go.micro.service.StockAdjustResultR\x06result\"Q\n" +
+	"\rStocktakeItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12)\n" +
+	"\x10counted_quantity\x18\x02 \x01(\rR\x0fcountedQuantity\"\xab\x01\n" +
+	"\x16SubmitStocktakeRequest\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.go.micro.service.StocktakeItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x03R\n" +
+	"operatorId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x03R\vwarehouseId\"X\n" +
+	"\x17SubmitStocktakeResponse\x12=\n" +
+	"\aresults\x18\x01 \x03(\v2#.go.micro.service.StockAdjustResultR\aresults\"\xd8\x02\n" +
+	"\x0fStockChangeInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\x03R\x05skuId\x12\x1f\n" +
+	"\vsource_type\x18\x04 \x01(\x05R\n" +
+	"sourceType\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12!\n" +
+	"\fbefore_stock\x18\x06 \x01(\x03R\vbeforeStock\x12\x1f\n" +
+	"\vafter_stock\x18\a \x01(\x03R\n" +
+	"afterStock\x12\x1f\n" +
+	"\voperator_id\x18\b \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12*\n" +
+	"\x11purchase_order_id\x18\v \x01(\x03R\x0fpurchaseOrderId\"\x80\x02\n" +
+	"\x17ListStockChangesRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vsource_type\x18\x03 \x01(\x05R\n" +
+	"sourceType\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12*\n" +
+	"\x11purchase_order_id\x18\b \x01(\x03R\x0fpurchaseOrderId\"x\n" +
+	"\x18ListStockChangesResponse\x12;\n" +
+	"\arecords\x18\x01 \x03(\v2!.go.micro.service.StockChangeInfoR\arecords\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\">\n" +
+	"\x15GetStockAtTimeRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\"b\n" +
+	"\x16GetStockAtTimeResponse\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x03R\x05stock\x12\x1b\n" +
+	"\trecord_id\x18\x03 \x01(\x03R\brecordId\"\xb9\x01\n" +
+	"\rStockMismatch\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\rR\x05stock\x12!\n" +
+	"\fledger_stock\x18\x03 \x01(\x03R\vledgerStock\x12\x14\n" +
+	"\x05drift\x18\x04 \x01(\x03R\x05drift\x12$\n" +
+	"\x0elast_record_id\x18\x05 \x01(\x03R\flastRecordId\x12\x1c\n" +
+	"\tcorrected\x18\x06 \x01(\bR\tcorrected\"N\n" +
+	"\x1eGetReconciliationReportRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xbb\x01\n" +
+	"\x1fGetReconciliationReportResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12\x1c\n" +
+	"\tuntracked\x18\x02 \x01(\x05R\tuntracked\x12?\n" +
+	"\n" +
+	"mismatches\x18\x03 \x03(\v2\x1f.go.micro.service.StockMismatchR\n" +
+	"mismatches\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\x03R\n" +
+	"nextCursor\"U\n" +
+	"\x19CorrectStockLedgerRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\x03R\x06skuIds\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\"[\n" +
+	"\x1aCorrectStockLedgerResponse\x12=\n" +
+	"\tcorrected\x18\x01 \x03(\v2\x1f.go.micro.service.StockMismatchR\tcorrected\"\xd4\x01\n" +
+	"\rWarehouseInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x96\x01\n" +
+	"\x16CreateWarehouseRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"X\n" +
+	"\x17CreateWarehouseResponse\x12=\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x1f.go.micro.service.WarehouseInfoR\twarehouse\"\x17\n" +
+	"\x15ListWarehousesRequest\"Y\n" +
+	"\x16ListWarehousesResponse\x12?\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x1f.go.micro.service.WarehouseInfoR\n" +
+	"warehouses\"\x8d\x01\n" +
+	"\x12WarehouseStockItem\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\rR\x05stock\"\xc6\x01\n" +
+	"\x15SkuWarehouseStockInfo\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12'\n" +
+	"\x0ftotal_available\x18\x02 \x01(\rR\x0etotalAvailable\x12'\n" +
+	"\x0fwarehouse_total\x18\x03 \x01(\rR\x0ewarehouseTotal\x12D\n" +
+	"\n" +
+	"warehouses\x18\x04 \x03(\v2$.go.micro.service.WarehouseStockItemR\n" +
+	"warehouses\"\xa6\x01\n" +
+	"\x1bSetSkuWarehouseStockRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x03R\vwarehouseId\x12\x14\n" +
+	"\x05stock\x18\x03 \x01(\rR\x05stock\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\"]\n" +
+	"\x1cSetSkuWarehouseStockResponse\x12=\n" +
+	"\x05stock\x18\x01 \x01(\v2'.go.micro.service.SkuWarehouseStockInfoR\x05stock\"4\n" +
+	"\x1bGetSkuWarehouseStockRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\"]\n" +
+	"\x1cGetSkuWarehouseStockResponse\x12=\n" +
+	"\x05stock\x18\x01 \x01(\v2'.go.micro.service.SkuWarehouseStockInfoR\x05stock\"\xe6\x03\n" +
+	"\x12SkuPriceChangeInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x03R\x05skuId\x12\x1f\n" +
+	"\vchange_type\x18\x03 \x01(\x05R\n" +
+	"changeType\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\x05 \x01(\x01R\vmarketPrice\x12%\n" +
+	"\x0eprevious_price\x18\x06 \x01(\x01R\rpreviousPrice\x122\n" +
+	"\x15previous_market_price\x18\a \x01(\x01R\x13previousMarketPrice\x12%\n" +
+	"\x0eeffective_from\x18\b \x01(\tR\reffectiveFrom\x12!\n" +
+	"\feffective_to\x18\t \x01(\tR\veffectiveTo\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\x05R\x06status\x12\x1b\n" +
+	"\trevert_of\x18\v \x01(\x03R\brevertOf\x12\x1d\n" +
+	"\n" +
+	"applied_at\x18\f \x01(\tR\tappliedAt\x12\x1f\n" +
+	"\voperator_id\x18\r \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x0e \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\"\xef\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\x03 \x01(\x01R\vmarketPrice\x12%\n" +
+	"\x0eeffective_from\x18\x04 \x01(\tR\reffectiveFrom\x12!\n" +
+	"\feffective_to\x18\x05 \x01(\tR\veffectiveTo\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\a \x01(\x03R\n" +
+	"operatorId\"[\n" +
+	"\x1bSchedulePriceChangeResponse\x12<\n" +
+	"\x06change\x18\x01 \x01(\v2$.go.micro.service.SkuPriceChangeInfoR\x06change\"=\n" +
+	"\x14GetSkuPriceAtRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\"\x84\x01\n" +
+	"\x15GetSkuPriceAtResponse\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12!\n" +
+	"\fmarket_price\x18\x03 \x01(\x01R\vmarketPrice\x12\x1b\n" +
+	"\tchange_id\x18\x04 \x01(\x03R\bchangeId\"d\n" +
+	"\x1aListSkuPriceHistoryRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa4\x01\n" +
+	"\x1bListSkuPriceHistoryResponse\x12>\n" +
+	"\achanges\x18\x01 \x03(\v2$.go.micro.service.SkuPriceChangeInfoR\achanges\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"f\n" +
+	"\x0fSkuStatusChange\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\x05R\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\x05R\btoStatus\"\xc7\x01\n" +
+	"\x13ProductStatusChange\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\x05R\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\x05R\btoStatus\x12\x1c\n" +
+	"\n" +
+	"on_sale_at\x18\x04 \x01(\tR\bonSaleAt\x125\n" +
+	"\x04skus\x18\x05 \x03(\v2!.go.micro.service.SkuStatusChangeR\x04skus\"\xa6\x01\n" +
+	"\x15PublishProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x17\n" +
+	"\asku_ids\x18\x02 \x03(\x03R\x06skuIds\x12\x1c\n" +
+	"\n" +
+	"on_sale_at\x18\x03 \x01(\tR\bonSaleAt\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"W\n" +
+	"\x16PublishProductResponse\x12=\n" +
+	"\x06change\x18\x01 \x01(\v2%.go.micro.service.ProductStatusChangeR\x06change\"q\n" +
+	"\x17UnpublishProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"Y\n" +
+	"\x18UnpublishProductResponse\x12=\n" +
+	"\x06change\x18\x01 \x01(\v2%.go.micro.service.ProductStatusChangeR\x06change\"e\n" +
+	"\x13UnpublishSkuRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x03R\x05skuId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"U\n" +
+	"\x14UnpublishSkuResponse\x12=\n" +
+	"\x06change\x18\x01 \x01(\v2%.go.micro.service.ProductStatusChangeR\x06change\"o\n" +
+	"\x15ArchiveProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"W\n" +
+	"\x16ArchiveProductResponse\x12=\n" +
+	"\x06change\x18\x01 \x01(\v2%.go.micro.service.ProductStatusChangeR\x06change\"\xfc\x04\n" +
+	"\x0eDeadLetterInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\x12!\n" +
+	"\forigin_topic\x18\x04 \x01(\tR\voriginTopic\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x05 \x01(\tR\teventType\x12\x1b\n" +
+	"\tevent_key\x18\x06 \x01(\tR\beventKey\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12)\n" +
+	"\x10origin_timestamp\x18\b \x01(\x03R\x0foriginTimestamp\x12\x17\n" +
+	"\adead_at\x18\t \x01(\x03R\x06deadAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\x05R\x06status\x12!\n" +
+	"\freplay_count\x18\v \x01(\x05R\vreplayCount\x12\x1f\n" +
+	"\vreplayed_at\x18\f \x01(\tR\n" +
+	"replayedAt\x12\x1d\n" +
+	"\n" +
+	"handled_at\x18\r \x01(\tR\thandledAt\x12\x1f\n" +
+	"\voperator_id\x18\x0e \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x0f \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\x12G\n" +
+	"\aheaders\x18\x11 \x03(\v2-.go.micro.service.DeadLetterInfo.HeadersEntryR\aheaders\x12\x18\n" +
+	"\apayload\x18\x12 \x01(\fR\apayload\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x16ListDeadLettersRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x7f\n" +
+	"\x17ListDeadLettersResponse\x12C\n" +
+	"\fdead_letters\x18\x01 \x03(\v2 .go.micro.service.DeadLetterInfoR\vdeadLetters\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"&\n" +
+	"\x14GetDeadLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Z\n" +
+	"\x15GetDeadLetterResponse\x12A\n" +
+	"\vdead_letter\x18\x01 \x01(\v2 .go.micro.service.DeadLetterInfoR\n" +
+	"deadLetter\"M\n" +
+	"\x18ReplayDeadLettersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\"Z\n" +
+	"\x16DeadLetterReplayResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"_\n" +
+	"\x19ReplayDeadLettersResponse\x12B\n" +
+	"\aresults\x18\x01 \x03(\v2(.go.micro.service.DeadLetterReplayResultR\aresults\"~\n" +
+	"\x19ResolveDeadLettersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"a\n" +
+	"\x1aResolveDeadLettersResponse\x12C\n" +
+	"\fdead_letters\x18\x01 \x03(\v2 .go.micro.service.DeadLetterInfoR\vdeadLetters2\x9d:\n" +
+	"\aProduct\x12P\n" +
+	"\n" +
+	"AddProduct\x12\x1d.go.micro.service.ProductInfo\x1a!.go.micro.service.ResponseProduct\"\x00\x12t\n" +
+	"\x13GetProductSkuDetail\x12,.go.micro.service.GetProductSkuDetailRequest\x1a-.go.micro.service.GetProductSkuDetailResponse\"\x00\x12\x89\x01\n" +
+	"\x1aCheckSkuInventoryThreshold\x123.go.micro.service.CheckSkuInventoryThresholdRequest\x1a4.go.micro.service.CheckSkuInventoryThresholdResponse\"\x00\x12q\n" +
+	"\x12GetSkuStockBySkuNo\x12+.go.micro.service.GetSkuStockBySkuNoRequest\x1a,.go.micro.service.GetSkuStockBySkuNoResponse\"\x00\x12q\n" +
+	"\x12CreateRestockApply\x12+.go.micro.service.CreateRestockApplyRequest\x1a,.go.micro.service.CreateRestockApplyResponse\"\x00\x12n\n" +
+	"\x11GetSkuSalesVolume\x12*.go.micro.service.GetSkuSalesVolumeRequest\x1a+.go.micro.service.GetSkuSalesVolumeResponse\"\x00\x12h\n" +
+	"\x0fGetSupplierInfo\x12(.go.micro.service.GetSupplierInfoRequest\x1a).go.micro.service.GetSupplierInfoResponse\"\x00\x12t\n" +
+	"\x13GetRestockApplyInfo\x12,.go.micro.service.GetRestockApplyInfoRequest\x1a-.go.micro.service.GetRestockApplyInfoResponse\"\x00\x12t\n" +
+	"\x13ApproveRestockApply\x12,.go.micro.service.ApproveRestockApplyRequest\x1a-.go.micro.service.ApproveRestockApplyResponse\"\x00\x12q\n" +
+	"\x12RejectRestockApply\x12+.go.micro.service.RejectRestockApplyRequest\x1a,.go.micro.service.RejectRestockApplyResponse\"\x00\x12k\n" +
+	"\x10GetSkuDailySales\x12).go.micro.service.GetSkuDailySalesRequest\x1a*.go.micro.service.GetSkuDailySalesResponse\"\x00\x12_\n" +
+	"\fReserveStock\x12%.go.micro.service.ReserveStockRequest\x1a&.go.micro.service.ReserveStockResponse\"\x00\x12q\n" +
+	"\x12ConfirmReservation\x12+.go.micro.service.ConfirmReservationRequest\x1a,.go.micro.service.ConfirmReservationResponse\"\x00\x12q\n" +
+	"\x12ReleaseReservation\x12+.go.micro.service.ReleaseReservationRequest\x1a,.go.micro.service.ReleaseReservationResponse\"\x00\x12_\n" +
+	"\fTryDeductSku\x12%.go.micro.service.TccDeductSkuRequest\x1a&.go.micro.service.TccDeductSkuResponse\"\x00\x12c\n" +
+	"\x10ConfirmDeductSku\x12%.go.micro.service.TccDeductSkuRequest\x1a&.go.micro.service.TccDeductSkuResponse\"\x00\x12b\n" +
+	"\x0fCancelDeductSku\x12%.go.micro.service.TccDeductSkuRequest\x1a&.go.micro.service.TccDeductSkuResponse\"\x00\x12b\n" +
+	"\rCreateProduct\x12&.go.micro.service.CreateProductRequest\x1a'.go.micro.service.CreateProductResponse\"\x00\x12z\n" +
+	"\x15CreateProductWithSkus\x12..go.micro.service.CreateProductWithSkusRequest\x1a/.go.micro.service.CreateProductWithSkusResponse\"\x00\x12b\n" +
+	"\rUpdateProduct\x12&.go.micro.service.UpdateProductRequest\x1a'.go.micro.service.UpdateProductResponse\"\x00\x12Y\n" +
+	"\n" +
+	"GetProduct\x12#.go.micro.service.GetProductRequest\x1a$.go.micro.service.GetProductResponse\"\x00\x12_\n" +
+	"\fListProducts\x12%.go.micro.service.ListProductsRequest\x1a&.go.micro.service.ListProductsResponse\"\x00\x12b\n" +
+	"\rDeleteProduct\x12&.go.micro.service.DeleteProductRequest\x1a'.go.micro.service.DeleteProductResponse\"\x00\x12]\n" +
+	"\x10PreviewSkuMatrix\x12\".go.micro.service.SkuMatrixRequest\x1a#.go.micro.service.SkuMatrixResponse\"\x00\x12[\n" +
+	"\x0eApplySkuMatrix\x12\".go.micro.service.SkuMatrixRequest\x1a#.go.micro.service.SkuMatrixResponse\"\x00\x12e\n" +
+	"\x0eCreateCategory\x12'.go.micro.service.CreateCategoryRequest\x1a(.go.micro.service.CreateCategoryResponse\"\x00\x12e\n" +
+	"\x0eRenameCategory\x12'.go.micro.service.RenameCategoryRequest\x1a(.go.micro.service.RenameCategoryResponse\"\x00\x12_\n" +
+	"\fMoveCategory\x12%.go.micro.service.MoveCategoryRequest\x1a&.go.micro.service.MoveCategoryResponse\"\x00\x12e\n" +
+	"\x0eDeleteCategory\x12'.go.micro.service.DeleteCategoryRequest\x1a(.go.micro.service.DeleteCategoryResponse\"\x00\x12h\n" +
+	"\x0fGetCategoryTree\x12(.go.micro.service.GetCategoryTreeRequest\x1a).go.micro.service.GetCategoryTreeResponse\"\x00\x12k\n" +
+	"\x10ListCategorySkus\x12).go.micro.service.ListCategorySkusRequest\x1a*.go.micro.service.ListCategorySkusResponse\"\x00\x12\\\n" +
+	"\vCreateBrand\x12$.go.micro.service.CreateBrandRequest\x1a%.go.micro.service.CreateBrandResponse\"\x00\x12\\\n" +
+	"\vUpdateBrand\x12$.go.micro.service.UpdateBrandRequest\x1a%.go.micro.service.UpdateBrandResponse\"\x00\x12S\n" +
+	"\bGetBrand\x12!.go.micro.service.GetBrandRequest\x1a\".go.micro.service.GetBrandResponse\"\x00\x12Y\n" +
+	"\n" +
+	"ListBrands\x12#.go.micro.service.ListBrandsRequest\x1a$.go.micro.service.ListBrandsResponse\"\x00\x12\\\n" +
+	"\vDeleteBrand\x12$.go.micro.service.DeleteBrandRequest\x1a%.go.micro.service.DeleteBrandResponse\"\x00\x12z\n" +
+	"\x15ReassignBrandProducts\x12..go.micro.service.ReassignBrandProductsRequest\x1a/.go.micro.service.ReassignBrandProductsResponse\"\x00\x12e\n" +
+	"\x0eCreateSupplier\x12'.go.micro.service.CreateSupplierRequest\x1a(.go.micro.service.CreateSupplierResponse\"\x00\x12e\n" +
+	"\x0eUpdateSupplier\x12'.go.micro.service.UpdateSupplierRequest\x1a(.go.micro.service.UpdateSupplierResponse\"\x00\x12\\\n" +
+	"\vGetSupplier\x12$.go.micro.service.GetSupplierRequest\x1a%.go.micro.service.GetSupplierResponse\"\x00\x12b\n" +
+	"\rListSuppliers\x12&.go.micro.service.ListSuppliersRequest\x1a'.go.micro.service.ListSuppliersResponse\"\x00\x12e\n" +
+	"\x0eDeleteSupplier\x12'.go.micro.service.DeleteSupplierRequest\x1a(.go.micro.service.DeleteSupplierResponse\"\x00\x12h\n" +
+	"\x0fLinkSupplierSku\x12(.go.micro.service.LinkSupplierSkuRequest\x1a).go.micro.service.LinkSupplierSkuResponse\"\x00\x12n\n" +
+	"\x11UnlinkSupplierSku\x12*.go.micro.service.UnlinkSupplierSkuRequest\x1a+.go.micro.service.UnlinkSupplierSkuResponse\"\x00\x12q\n" +
+	"\x12ListSkusBySupplier\x12+.go.micro.service.ListSkusBySupplierRequest\x1a,.go.micro.service.ListSkusBySupplierResponse\"\x00\x12w\n" +
+	"\x14CreatePurchaseOrders\x12-.go.micro.service.CreatePurchaseOrdersRequest\x1a..go.micro.service.CreatePurchaseOrdersResponse\"\x00\x12k\n" +
+	"\x10GetPurchaseOrder\x12).go.micro.service.GetPurchaseOrderRequest\x1a*.go.micro.service.GetPurchaseOrderResponse\"\x00\x12q\n" +
+	"\x12ListPurchaseOrders\x12+.go.micro.service.ListPurchaseOrdersRequest\x1a,.go.micro.service.ListPurchaseOrdersResponse\"\x00\x12_\n" +
+	"\fReceiveGoods\x12%.go.micro.service.ReceiveGoodsRequest\x1a&.go.micro.service.ReceiveGoodsResponse\"\x00\x12\\\n" +
+	"\vAdjustStock\x12$.go.micro.service.AdjustStockRequest\x1a%.go.micro.service.AdjustStockResponse\"\x00\x12h\n" +
+	"\x0fSubmitStocktake\x12(.go.micro.service.SubmitStocktakeRequest\x1a).go.micro.service.SubmitStocktakeResponse\"\x00\x12k\n" +
+	"\x10ListStockChanges\x12).go.micro.service.ListStockChangesRequest\x1a*.go.micro.service.ListStockChangesResponse\"\x00\x12e\n" +
+	"\x0eGetStockAtTime\x12'.go.micro.service.GetStockAtTimeRequest\x1a(.go.micro.service.GetStockAtTimeResponse\"\x00\x12\x80\x01\n" +
+	"\x17GetReconciliationReport\x120.go.micro.service.GetReconciliationReportRequest\x1a1.go.micro.service.GetReconciliationReportResponse\"\x00\x12q\n" +
+	"\x12CorrectStockLedger\x12+.go.micro.service.CorrectStockLedgerRequest\x1a,.go.micro.service.CorrectStockLedgerResponse\"\x00\x12h\n" +
+	"\x0fCreateWarehouse\x12(.go.micro.service.CreateWarehouseRequest\x1a).go.micro.service.CreateWarehouseResponse\"\x00\x12e\n" +
+	"\x0eListWarehouses\x12'.go.micro.service.ListWarehousesRequest\x1a(.go.micro.service.ListWarehousesResponse\"\x00\x12w\n" +
+	"\x14SetSkuWarehouseStock\x12-.go.micro.service.SetSkuWarehouseStockRequest\x1a..go.micro.service.SetSkuWarehouseStockResponse\"\x00\x12w\n" +
+	"\x14GetSkuWarehouseStock\x12-.go.micro.service.GetSkuWarehouseStockRequest\x1a..go.micro.service.GetSkuWarehouseStockResponse\"\x00\x12t\n" +
+	"\x13SchedulePriceChange\x12,.go.micro.service.SchedulePriceChangeRequest\x1a-.go.micro.service.SchedulePriceChangeResponse\"\x00\x12b\n" +
+	"\rGetSkuPriceAt\x12&.go.micro.service.GetSkuPriceAtRequest\x1a'.go.micro.service.GetSkuPriceAtResponse\"\x00\x12t\n" +
+	"\x13ListSkuPriceHistory\x12,.go.micro.service.ListSkuPriceHistoryRequest\x1a-.go.micro.service.ListSkuPriceHistoryResponse\"\x00\x12e\n" +
+	"\x0ePublishProduct\x12'.go.micro.service.PublishProductRequest\x1a(.go.micro.service.PublishProductResponse\"\x00\x12k\n" +
+	"\x10UnpublishProduct\x12).go.micro.service.UnpublishProductRequest\x1a*.go.micro.service.UnpublishProductResponse\"\x00\x12_\n" +
+	"\fUnpublishSku\x12%.go.micro.service.UnpublishSkuRequest\x1a&.go.micro.service.UnpublishSkuResponse\"\x00\x12e\n" +
+	"\x0eArchiveProduct\x12'.go.micro.service.ArchiveProductRequest\x1a(.go.micro.service.ArchiveProductResponse\"\x00\x12h\n" +
+	"\x0fListDeadLetters\x12(.go.micro.service.ListDeadLettersRequest\x1a).go.micro.service.ListDeadLettersResponse\"\x00\x12b\n" +
+	"\rGetDeadLetter\x12&.go.micro.service.GetDeadLetterRequest\x1a'.go.micro.service.GetDeadLetterResponse\"\x00\x12n\n" +
+	"\x11ReplayDeadLetters\x12*.go.micro.service.ReplayDeadLettersRequest\x1a+.go.micro.service.ReplayDeadLettersResponse\"\x00\x12q\n" +
+	"\x12ResolveDeadLetters\x12+.go.micro.service.ResolveDeadLettersRequest\x1a,.go.micro.service.ResolveDeadLettersResponse\"\x00B\x11Z\x0f./proto/productb\x06proto3"
 
 var (
 	file_product_product_proto_rawDescOnce sync.Once
@@ -2107,7 +12113,7 @@ func file_product_product_proto_rawDescGZIP() []byte {
 	return file_product_product_proto_rawDescData
 }
 
-var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 177)
 var file_product_product_proto_goTypes = []any{
 	(*ProductInfo)(nil),                        // 0: go.micro.service.ProductInfo
 	(*ResponseProduct)(nil),                    // 1: go.micro.service.ResponseProduct
@@ -2136,40 +12142,392 @@ var file_product_product_proto_goTypes = []any{
 	(*GetRestockApplyInfoRequest)(nil),         // 24: go.micro.service.GetRestockApplyInfoRequest
 	(*RestockAuditInfo)(nil),                   // 25: go.micro.service.RestockAuditInfo
 	(*GetRestockApplyInfoResponse)(nil),        // 26: go.micro.service.GetRestockApplyInfoResponse
+	(*ApproveRestockApplyRequest)(nil),         // 27: go.micro.service.ApproveRestockApplyRequest
+	(*ApproveRestockApplyResponse)(nil),        // 28: go.micro.service.ApproveRestockApplyResponse
+	(*RejectRestockApplyRequest)(nil),          // 29: go.micro.service.RejectRestockApplyRequest
+	(*RejectRestockApplyResponse)(nil),         // 30: go.micro.service.RejectRestockApplyResponse
+	(*ReserveStockItem)(nil),                   // 31: go.micro.service.ReserveStockItem
+	(*ReserveStockRequest)(nil),                // 32: go.micro.service.ReserveStockRequest
+	(*StockReservationInfo)(nil),               // 33: go.micro.service.StockReservationInfo
+	(*ReserveStockResponse)(nil),               // 34: go.micro.service.ReserveStockResponse
+	(*ConfirmReservationRequest)(nil),          // 35: go.micro.service.ConfirmReservationRequest
+	(*ConfirmReservationResponse)(nil),         // 36: go.micro.service.ConfirmReservationResponse
+	(*ReleaseReservationRequest)(nil),          // 37: go.micro.service.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil),         // 38: go.micro.service.ReleaseReservationResponse
+	(*TccDeductSkuRequest)(nil),                // 39: go.micro.service.TccDeductSkuRequest
+	(*TccDeductSkuResponse)(nil),               // 40: go.micro.service.TccDeductSkuResponse
+	(*ProductInput)(nil),                       // 41: go.micro.service.ProductInput
+	(*SpecValueInput)(nil),                     // 42: go.micro.service.SpecValueInput
+	(*SpecInput)(nil),                          // 43: go.micro.service.SpecInput
+	(*SkuInput)(nil),                           // 44: go.micro.service.SkuInput
+	(*CreateProductRequest)(nil),               // 45: go.micro.service.CreateProductRequest
+	(*CreateProductResponse)(nil),              // 46: go.micro.service.CreateProductResponse
+	(*CreateProductWithSkusRequest)(nil),       // 47: go.micro.service.CreateProductWithSkusRequest
+	(*CreateProductWithSkusResponse)(nil),      // 48: go.micro.service.CreateProductWithSkusResponse
+	(*SkuUpdateInput)(nil),                     // 49: go.micro.service.SkuUpdateInput
+	(*UpdateProductRequest)(nil),               // 50: go.micro.service.UpdateProductRequest
+	(*UpdateProductResponse)(nil),              // 51: go.micro.service.UpdateProductResponse
+	(*GetProductRequest)(nil),                  // 52: go.micro.service.GetProductRequest
+	(*GetProductResponse)(nil),                 // 53: go.micro.service.GetProductResponse
+	(*ListProductsRequest)(nil),                // 54: go.micro.service.ListProductsRequest
+	(*ListProductsResponse)(nil),               // 55: go.micro.service.ListProductsResponse
+	(*DeleteProductRequest)(nil),               // 56: go.micro.service.DeleteProductRequest
+	(*DeleteProductResponse)(nil),              // 57: go.micro.service.DeleteProductResponse
+	(*ProductDetail)(nil),                      // 58: go.micro.service.ProductDetail
+	(*ProductSpecInfo)(nil),                    // 59: go.micro.service.ProductSpecInfo
+	(*SpecValueInfo)(nil),                      // 60: go.micro.service.SpecValueInfo
+	(*ProductSkuInfo)(nil),                     // 61: go.micro.service.ProductSkuInfo
+	(*SkuMatrixRequest)(nil),                   // 62: go.micro.service.SkuMatrixRequest
+	(*SkuMatrixItem)(nil),                      // 63: go.micro.service.SkuMatrixItem
+	(*SkuMatrixResponse)(nil),                  // 64: go.micro.service.SkuMatrixResponse
+	(*CategoryInfo)(nil),                       // 65: go.micro.service.CategoryInfo
+	(*CategoryNode)(nil),                       // 66: go.micro.service.CategoryNode
+	(*CreateCategoryRequest)(nil),              // 67: go.micro.service.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),             // 68: go.micro.service.CreateCategoryResponse
+	(*RenameCategoryRequest)(nil),              // 69: go.micro.service.RenameCategoryRequest
+	(*RenameCategoryResponse)(nil),             // 70: go.micro.service.RenameCategoryResponse
+	(*MoveCategoryRequest)(nil),                // 71: go.micro.service.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),               // 72: go.micro.service.MoveCategoryResponse
+	(*DeleteCategoryRequest)(nil),              // 73: go.micro.service.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),             // 74: go.micro.service.DeleteCategoryResponse
+	(*GetCategoryTreeRequest)(nil),             // 75: go.micro.service.GetCategoryTreeRequest
+	(*GetCategoryTreeResponse)(nil),            // 76: go.micro.service.GetCategoryTreeResponse
+	(*ListCategorySkusRequest)(nil),            // 77: go.micro.service.ListCategorySkusRequest
+	(*ListCategorySkusResponse)(nil),           // 78: go.micro.service.ListCategorySkusResponse
+	(*BrandInfo)(nil),                          // 79: go.micro.service.BrandInfo
+	(*CreateBrandRequest)(nil),                 // 80: go.micro.service.CreateBrandRequest
+	(*CreateBrandResponse)(nil),                // 81: go.micro.service.CreateBrandResponse
+	(*UpdateBrandRequest)(nil),                 // 82: go.micro.service.UpdateBrandRequest
+	(*UpdateBrandResponse)(nil),                // 83: go.micro.service.UpdateBrandResponse
+	(*GetBrandRequest)(nil),                    // 84: go.micro.service.GetBrandRequest
+	(*GetBrandResponse)(nil),                   // 85: go.micro.service.GetBrandResponse
+	(*ListBrandsRequest)(nil),                  // 86: go.micro.service.ListBrandsRequest
+	(*ListBrandsResponse)(nil),                 // 87: go.micro.service.ListBrandsResponse
+	(*DeleteBrandRequest)(nil),                 // 88: go.micro.service.DeleteBrandRequest
+	(*DeleteBrandResponse)(nil),                // 89: go.micro.service.DeleteBrandResponse
+	(*ReassignBrandProductsRequest)(nil),       // 90: go.micro.service.ReassignBrandProductsRequest
+	(*ReassignBrandProductsResponse)(nil),      // 91: go.micro.service.ReassignBrandProductsResponse
+	(*SupplierInput)(nil),                      // 92: go.micro.service.SupplierInput
+	(*CreateSupplierRequest)(nil),              // 93: go.micro.service.CreateSupplierRequest
+	(*CreateSupplierResponse)(nil),             // 94: go.micro.service.CreateSupplierResponse
+	(*UpdateSupplierRequest)(nil),              // 95: go.micro.service.UpdateSupplierRequest
+	(*UpdateSupplierResponse)(nil),             // 96: go.micro.service.UpdateSupplierResponse
+	(*GetSupplierRequest)(nil),                 // 97: go.micro.service.GetSupplierRequest
+	(*GetSupplierResponse)(nil),                // 98: go.micro.service.GetSupplierResponse
+	(*ListSuppliersRequest)(nil),               // 99: go.micro.service.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),              // 100: go.micro.service.ListSuppliersResponse
+	(*DeleteSupplierRequest)(nil),              // 101: go.micro.service.DeleteSupplierRequest
+	(*DeleteSupplierResponse)(nil),             // 102: go.micro.service.DeleteSupplierResponse
+	(*SupplierSkuItem)(nil),                    // 103: go.micro.service.SupplierSkuItem
+	(*LinkSupplierSkuRequest)(nil),             // 104: go.micro.service.LinkSupplierSkuRequest
+	(*LinkSupplierSkuResponse)(nil),            // 105: go.micro.service.LinkSupplierSkuResponse
+	(*UnlinkSupplierSkuRequest)(nil),           // 106: go.micro.service.UnlinkSupplierSkuRequest
+	(*UnlinkSupplierSkuResponse)(nil),          // 107: go.micro.service.UnlinkSupplierSkuResponse
+	(*ListSkusBySupplierRequest)(nil),          // 108: go.micro.service.ListSkusBySupplierRequest
+	(*ListSkusBySupplierResponse)(nil),         // 109: go.micro.service.ListSkusBySupplierResponse
+	(*PurchaseOrderLineInfo)(nil),              // 110: go.micro.service.PurchaseOrderLineInfo
+	(*PurchaseOrderInfo)(nil),                  // 111: go.micro.service.PurchaseOrderInfo
+	(*PurchaseOrderItemInput)(nil),             // 112: go.micro.service.PurchaseOrderItemInput
+	(*CreatePurchaseOrdersRequest)(nil),        // 113: go.micro.service.CreatePurchaseOrdersRequest
+	(*CreatePurchaseOrdersResponse)(nil),       // 114: go.micro.service.CreatePurchaseOrdersResponse
+	(*GetPurchaseOrderRequest)(nil),            // 115: go.micro.service.GetPurchaseOrderRequest
+	(*GetPurchaseOrderResponse)(nil),           // 116: go.micro.service.GetPurchaseOrderResponse
+	(*ListPurchaseOrdersRequest)(nil),          // 117: go.micro.service.ListPurchaseOrdersRequest
+	(*ListPurchaseOrdersResponse)(nil),         // 118: go.micro.service.ListPurchaseOrdersResponse
+	(*ReceiveGoodsItem)(nil),                   // 119: go.micro.service.ReceiveGoodsItem
+	(*ReceiveGoodsRequest)(nil),                // 120: go.micro.service.ReceiveGoodsRequest
+	(*ReceiveGoodsResponse)(nil),               // 121: go.micro.service.ReceiveGoodsResponse
+	(*StockAdjustResult)(nil),                  // 122: go.micro.service.StockAdjustResult
+	(*AdjustStockRequest)(nil),                 // 123: go.micro.service.AdjustStockRequest
+	(*AdjustStockResponse)(nil),                // 124: go.micro.service.AdjustStockResponse
+	(*StocktakeItem)(nil),                      // 125: go.micro.service.StocktakeItem
+	(*SubmitStocktakeRequest)(nil),             // 126: go.micro.service.SubmitStocktakeRequest
+	(*SubmitStocktakeResponse)(nil),            // 127: go.micro.service.SubmitStocktakeResponse
+	(*StockChangeInfo)(nil),                    // 128: go.micro.service.StockChangeInfo
+	(*ListStockChangesRequest)(nil),            // 129: go.micro.service.ListStockChangesRequest
+	(*ListStockChangesResponse)(nil),           // 130: go.micro.service.ListStockChangesResponse
+	(*GetStockAtTimeRequest)(nil),              // 131: go.micro.service.GetStockAtTimeRequest
+	(*GetStockAtTimeResponse)(nil),             // 132: go.micro.service.GetStockAtTimeResponse
+	(*StockMismatch)(nil),                      // 133: go.micro.service.StockMismatch
+	(*GetReconciliationReportRequest)(nil),     // 134: go.micro.service.GetReconciliationReportRequest
+	(*GetReconciliationReportResponse)(nil),    // 135: go.micro.service.GetReconciliationReportResponse
+	(*CorrectStockLedgerRequest)(nil),          // 136: go.micro.service.CorrectStockLedgerRequest
+	(*CorrectStockLedgerResponse)(nil),         // 137: go.micro.service.CorrectStockLedgerResponse
+	(*WarehouseInfo)(nil),                      // 138: go.micro.service.WarehouseInfo
+	(*CreateWarehouseRequest)(nil),             // 139: go.micro.service.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),            // 140: go.micro.service.CreateWarehouseResponse
+	(*ListWarehousesRequest)(nil),              // 141: go.micro.service.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),             // 142: go.micro.service.ListWarehousesResponse
+	(*WarehouseStockItem)(nil),                 // 143: go.micro.service.WarehouseStockItem
+	(*SkuWarehouseStockInfo)(nil),              // 144: go.micro.service.SkuWarehouseStockInfo
+	(*SetSkuWarehouseStockRequest)(nil),        // 145: go.micro.service.SetSkuWarehouseStockRequest
+	(*SetSkuWarehouseStockResponse)(nil),       // 146: go.micro.service.SetSkuWarehouseStockResponse
+	(*GetSkuWarehouseStockRequest)(nil),        // 147: go.micro.service.GetSkuWarehouseStockRequest
+	(*GetSkuWarehouseStockResponse)(nil),       // 148: go.micro.service.GetSkuWarehouseStockResponse
+	(*SkuPriceChangeInfo)(nil),                 // 149: go.micro.service.SkuPriceChangeInfo
+	(*SchedulePriceChangeRequest)(nil),         // 150: go.micro.service.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),        // 151: go.micro.service.SchedulePriceChangeResponse
+	(*GetSkuPriceAtRequest)(nil),               // 152: go.micro.service.GetSkuPriceAtRequest
+	(*GetSkuPriceAtResponse)(nil),              // 153: go.micro.service.GetSkuPriceAtResponse
+	(*ListSkuPriceHistoryRequest)(nil),         // 154: go.micro.service.ListSkuPriceHistoryRequest
+	(*ListSkuPriceHistoryResponse)(nil),        // 155: go.micro.service.ListSkuPriceHistoryResponse
+	(*SkuStatusChange)(nil),                    // 156: go.micro.service.SkuStatusChange
+	(*ProductStatusChange)(nil),                // 157: go.micro.service.ProductStatusChange
+	(*PublishProductRequest)(nil),              // 158: go.micro.service.PublishProductRequest
+	(*PublishProductResponse)(nil),             // 159: go.micro.service.PublishProductResponse
+	(*UnpublishProductRequest)(nil),            // 160: go.micro.service.UnpublishProductRequest
+	(*UnpublishProductResponse)(nil),           // 161: go.micro.service.UnpublishProductResponse
+	(*UnpublishSkuRequest)(nil),                // 162: go.micro.service.UnpublishSkuRequest
+	(*UnpublishSkuResponse)(nil),               // 163: go.micro.service.UnpublishSkuResponse
+	(*ArchiveProductRequest)(nil),              // 164: go.micro.service.ArchiveProductRequest
+	(*ArchiveProductResponse)(nil),             // 165: go.micro.service.ArchiveProductResponse
+	(*DeadLetterInfo)(nil),                     // 166: go.micro.service.DeadLetterInfo
+	(*ListDeadLettersRequest)(nil),             // 167: go.micro.service.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),            // 168: go.micro.service.ListDeadLettersResponse
+	(*GetDeadLetterRequest)(nil),               // 169: go.micro.service.GetDeadLetterRequest
+	(*GetDeadLetterResponse)(nil),              // 170: go.micro.service.GetDeadLetterResponse
+	(*ReplayDeadLettersRequest)(nil),           // 171: go.micro.service.ReplayDeadLettersRequest
+	(*DeadLetterReplayResult)(nil),             // 172: go.micro.service.DeadLetterReplayResult
+	(*ReplayDeadLettersResponse)(nil),          // 173: go.micro.service.ReplayDeadLettersResponse
+	(*ResolveDeadLettersRequest)(nil),          // 174: go.micro.service.ResolveDeadLettersRequest
+	(*ResolveDeadLettersResponse)(nil),         // 175: go.micro.service.ResolveDeadLettersResponse
+	nil,                                        // 176: go.micro.service.DeadLetterInfo.HeadersEntry
 }
 var file_product_product_proto_depIdxs = []int32{
-	4,  // 0: go.micro.service.GetProductSkuDetailResponse.product:type_name -> go.micro.service.ProductBasicInfo
-	5,  // 1: go.micro.service.GetProductSkuDetailResponse.images:type_name -> go.micro.service.SkuImageInfo
-	7,  // 2: go.micro.service.CheckSkuInventoryThresholdResponse.results:type_name -> go.micro.service.SkuInventoryCheckResult
-	13, // 3: go.micro.service.CreateRestockApplyResponse.restock_record:type_name -> go.micro.service.RestockRecordInfo
-	14, // 4: go.micro.service.CreateRestockApplyResponse.sku_info:type_name -> go.micro.service.SkuBasicInfo
-	19, // 5: go.micro.service.SupplierInfoItem.supplier:type_name -> go.micro.service.SupplierDTO
-	18, // 6: go.micro.service.GetSupplierInfoResponse.suppliers:type_name -> go.micro.service.SupplierInfoItem
-	22, // 7: go.micro.service.GetSkuDailySalesResponse.daily_sales:type_name -> go.micro.service.DailySalesItem
-	25, // 8: go.micro.service.GetRestockApplyInfoResponse.audit:type_name -> go.micro.service.RestockAuditInfo
-	0,  // 9: go.micro.service.Product.AddProduct:input_type -> go.micro.service.ProductInfo
-	2,  // 10: go.micro.service.Product.GetProductSkuDetail:input_type -> go.micro.service.GetProductSkuDetailRequest
-	6,  // 11: go.micro.service.Product.CheckSkuInventoryThreshold:input_type -> go.micro.service.CheckSkuInventoryThresholdRequest
-	9,  // 12: go.micro.service.Product.GetSkuStockBySkuNo:input_type -> go.micro.service.GetSkuStockBySkuNoRequest
-	11, // 13: go.micro.service.Product.CreateRestockApply:input_type -> go.micro.service.CreateRestockApplyRequest
-	15, // 14: go.micro.service.Product.GetSkuSalesVolume:input_type -> go.micro.service.GetSkuSalesVolumeRequest
-	17, // 15: go.micro.service.Product.GetSupplierInfo:input_type -> go.micro.service.GetSupplierInfoRequest
-	24, // 16: go.micro.service.Product.GetRestockApplyInfo:input_type -> go.micro.service.GetRestockApplyInfoRequest
-	21, // 17: go.micro.service.Product.GetSkuDailySales:input_type -> go.micro.service.GetSkuDailySalesRequest
-	1,  // 18: go.micro.service.Product.AddProduct:output_type -> go.micro.service.ResponseProduct
-	3,  // 19: go.micro.service.Product.GetProductSkuDetail:output_type -> go.micro.service.GetProductSkuDetailResponse
-	8,  // 20: go.micro.service.Product.CheckSkuInventoryThreshold:output_type -> go.micro.service.CheckSkuInventoryThresholdResponse
-	10, // 21: go.micro.service.Product.GetSkuStockBySkuNo:output_type -> go.micro.service.GetSkuStockBySkuNoResponse
-	12, // 22: go.micro.service.Product.CreateRestockApply:output_type -> go.micro.service.CreateRestockApplyResponse
-	16, // 23: go.micro.service.Product.GetSkuSalesVolume:output_type -> go.micro.service.GetSkuSalesVolumeResponse
-	20, // 24: go.micro.service.Product.GetSupplierInfo:output_type -> go.micro.service.GetSupplierInfoResponse
-	26, // 25: go.micro.service.Product.GetRestockApplyInfo:output_type -> go.micro.service.GetRestockApplyInfoResponse
-	23, // 26: go.micro.service.Product.GetSkuDailySales:output_type -> go.micro.service.GetSkuDailySalesResponse
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	4,   // 0: go.micro.service.GetProductSkuDetailResponse.product:type_name -> go.micro.service.ProductBasicInfo
+	5,   // 1: go.micro.service.GetProductSkuDetailResponse.images:type_name -> go.micro.service.SkuImageInfo
+	7,   // 2: go.micro.service.CheckSkuInventoryThresholdResponse.results:type_name -> go.micro.service.SkuInventoryCheckResult
+	13,  // 3: go.micro.service.CreateRestockApplyResponse.restock_record:type_name -> go.micro.service.RestockRecordInfo
+	14,  // 4: go.micro.service.CreateRestockApplyResponse.sku_info:type_name -> go.micro.service.SkuBasicInfo
+	19,  // 5: go.micro.service.SupplierInfoItem.supplier:type_name -> go.micro.service.SupplierDTO
+	18,  // 6: go.micro.service.GetSupplierInfoResponse.suppliers:type_name -> go.micro.service.SupplierInfoItem
+	22,  // 7: go.micro.service.GetSkuDailySalesResponse.daily_sales:type_name -> go.micro.service.DailySalesItem
+	25,  // 8: go.micro.service.GetRestockApplyInfoResponse.audit:type_name -> go.micro.service.RestockAuditInfo
+	13,  // 9: go.micro.service.ApproveRestockApplyResponse.restock_record:type_name -> go.micro.service.RestockRecordInfo
+	25,  // 10: go.micro.service.ApproveRestockApplyResponse.audit:type_name -> go.micro.service.RestockAuditInfo
+	13,  // 11: go.micro.service.RejectRestockApplyResponse.restock_record:type_name -> go.micro.service.RestockRecordInfo
+	25,  // 12: go.micro.service.RejectRestockApplyResponse.audit:type_name -> go.micro.service.RestockAuditInfo
+	31,  // 13: go.micro.service.ReserveStockRequest.items:type_name -> go.micro.service.ReserveStockItem
+	33,  // 14: go.micro.service.ReserveStockResponse.reservations:type_name -> go.micro.service.StockReservationInfo
+	33,  // 15: go.micro.service.ConfirmReservationResponse.reservations:type_name -> go.micro.service.StockReservationInfo
+	33,  // 16: go.micro.service.ReleaseReservationResponse.reservations:type_name -> go.micro.service.StockReservationInfo
+	31,  // 17: go.micro.service.TccDeductSkuRequest.items:type_name -> go.micro.service.ReserveStockItem
+	42,  // 18: go.micro.service.SpecInput.values:type_name -> go.micro.service.SpecValueInput
+	41,  // 19: go.micro.service.CreateProductRequest.product:type_name -> go.micro.service.ProductInput
+	58,  // 20: go.micro.service.CreateProductResponse.product:type_name -> go.micro.service.ProductDetail
+	41,  // 21: go.micro.service.CreateProductWithSkusRequest.product:type_name -> go.micro.service.ProductInput
+	43,  // 22: go.micro.service.CreateProductWithSkusRequest.specs:type_name -> go.micro.service.SpecInput
+	44,  // 23: go.micro.service.CreateProductWithSkusRequest.default_sku:type_name -> go.micro.service.SkuInput
+	44,  // 24: go.micro.service.CreateProductWithSkusRequest.skus:type_name -> go.micro.service.SkuInput
+	58,  // 25: go.micro.service.CreateProductWithSkusResponse.product:type_name -> go.micro.service.ProductDetail
+	41,  // 26: go.micro.service.UpdateProductRequest.product:type_name -> go.micro.service.ProductInput
+	49,  // 27: go.micro.service.UpdateProductRequest.skus:type_name -> go.micro.service.SkuUpdateInput
+	58,  // 28: go.micro.service.UpdateProductResponse.product:type_name -> go.micro.service.ProductDetail
+	58,  // 29: go.micro.service.GetProductResponse.product:type_name -> go.micro.service.ProductDetail
+	58,  // 30: go.micro.service.ListProductsResponse.products:type_name -> go.micro.service.ProductDetail
+	59,  // 31: go.micro.service.ProductDetail.specs:type_name -> go.micro.service.ProductSpecInfo
+	61,  // 32: go.micro.service.ProductDetail.skus:type_name -> go.micro.service.ProductSkuInfo
+	60,  // 33: go.micro.service.ProductSpecInfo.values:type_name -> go.micro.service.SpecValueInfo
+	5,   // 34: go.micro.service.ProductSkuInfo.images:type_name -> go.micro.service.SkuImageInfo
+	43,  // 35: go.micro.service.SkuMatrixRequest.specs:type_name -> go.micro.service.SpecInput
+	44,  // 36: go.micro.service.SkuMatrixRequest.default_sku:type_name -> go.micro.service.SkuInput
+	44,  // 37: go.micro.service.SkuMatrixRequest.skus:type_name -> go.micro.service.SkuInput
+	63,  // 38: go.micro.service.SkuMatrixResponse.create:type_name -> go.micro.service.SkuMatrixItem
+	63,  // 39: go.micro.service.SkuMatrixResponse.disable:type_name -> go.micro.service.SkuMatrixItem
+	63,  // 40: go.micro.service.SkuMatrixResponse.keep:type_name -> go.micro.service.SkuMatrixItem
+	66,  // 41: go.micro.service.CategoryNode.children:type_name -> go.micro.service.CategoryNode
+	65,  // 42: go.micro.service.CreateCategoryResponse.category:type_name -> go.micro.service.CategoryInfo
+	65,  // 43: go.micro.service.RenameCategoryResponse.category:type_name -> go.micro.service.CategoryInfo
+	65,  // 44: go.micro.service.MoveCategoryResponse.category:type_name -> go.micro.service.CategoryInfo
+	66,  // 45: go.micro.service.GetCategoryTreeResponse.categories:type_name -> go.micro.service.CategoryNode
+	61,  // 46: go.micro.service.ListCategorySkusResponse.skus:type_name -> go.micro.service.ProductSkuInfo
+	79,  // 47: go.micro.service.CreateBrandResponse.brand:type_name -> go.micro.service.BrandInfo
+	79,  // 48: go.micro.service.UpdateBrandResponse.brand:type_name -> go.micro.service.BrandInfo
+	79,  // 49: go.micro.service.GetBrandResponse.brand:type_name -> go.micro.service.BrandInfo
+	79,  // 50: go.micro.service.ListBrandsResponse.brands:type_name -> go.micro.service.BrandInfo
+	92,  // 51: go.micro.service.CreateSupplierRequest.supplier:type_name -> go.micro.service.SupplierInput
+	19,  // 52: go.micro.service.CreateSupplierResponse.supplier:type_name -> go.micro.service.SupplierDTO
+	92,  // 53: go.micro.service.UpdateSupplierRequest.supplier:type_name -> go.micro.service.SupplierInput
+	19,  // 54: go.micro.service.UpdateSupplierResponse.supplier:type_name -> go.micro.service.SupplierDTO
+	19,  // 55: go.micro.service.GetSupplierResponse.supplier:type_name -> go.micro.service.SupplierDTO
+	19,  // 56: go.micro.service.ListSuppliersResponse.suppliers:type_name -> go.micro.service.SupplierDTO
+	103, // 57: go.micro.service.LinkSupplierSkuResponse.item:type_name -> go.micro.service.SupplierSkuItem
+	103, // 58: go.micro.service.ListSkusBySupplierResponse.skus:type_name -> go.micro.service.SupplierSkuItem
+	110, // 59: go.micro.service.PurchaseOrderInfo.lines:type_name -> go.micro.service.PurchaseOrderLineInfo
+	112, // 60: go.micro.service.CreatePurchaseOrdersRequest.items:type_name -> go.micro.service.PurchaseOrderItemInput
+	111, // 61: go.micro.service.CreatePurchaseOrdersResponse.purchase_orders:type_name -> go.micro.service.PurchaseOrderInfo
+	111, // 62: go.micro.service.GetPurchaseOrderResponse.purchase_order:type_name -> go.micro.service.PurchaseOrderInfo
+	111, // 63: go.micro.service.ListPurchaseOrdersResponse.purchase_orders:type_name -> go.micro.service.PurchaseOrderInfo
+	119, // 64: go.micro.service.ReceiveGoodsRequest.items:type_name -> go.micro.service.ReceiveGoodsItem
+	111, // 65: go.micro.service.ReceiveGoodsResponse.purchase_order:type_name -> go.micro.service.PurchaseOrderInfo
+	122, // 66: go.micro.service.AdjustStockResponse.result:type_name -> go.micro.service.StockAdjustResult
+	125, // 67: go.micro.service.SubmitStocktakeRequest.items:type_name -> go.micro.service.StocktakeItem
+	122, // 68: go.micro.service.SubmitStocktakeResponse.results:type_name -> go.micro.service.StockAdjustResult
+	128, // 69: go.micro.service.ListStockChangesResponse.records:type_name -> go.micro.service.StockChangeInfo
+	133, // 70: go.micro.service.GetReconciliationReportResponse.mismatches:type_name -> go.micro.service.StockMismatch
+	133, // 71: go.micro.service.CorrectStockLedgerResponse.corrected:type_name -> go.micro.service.StockMismatch
+	138, // 72: go.micro.service.CreateWarehouseResponse.warehouse:type_name -> go.micro.service.WarehouseInfo
+	138, // 73: go.micro.service.ListWarehousesResponse.warehouses:type_name -> go.micro.service.WarehouseInfo
+	143, // 74: go.micro.service.SkuWarehouseStockInfo.warehouses:type_name -> go.micro.service.WarehouseStockItem
+	144, // 75: go.micro.service.SetSkuWarehouseStockResponse.stock:type_name -> go.micro.service.SkuWarehouseStockInfo
+	144, // 76: go.micro.service.GetSkuWarehouseStockResponse.stock:type_name -> go.micro.service.SkuWarehouseStockInfo
+	149, // 77: go.micro.service.SchedulePriceChangeResponse.change:type_name -> go.micro.service.SkuPriceChangeInfo
+	149, // 78: go.micro.service.ListSkuPriceHistoryResponse.changes:type_name -> go.micro.service.SkuPriceChangeInfo
+	156, // 79: go.micro.service.ProductStatusChange.skus:type_name -> go.micro.service.SkuStatusChange
+	157, // 80: go.micro.service.PublishProductResponse.change:type_name -> go.micro.service.ProductStatusChange
+	157, // 81: go.micro.service.UnpublishProductResponse.change:type_name -> go.micro.service.ProductStatusChange
+	157, // 82: go.micro.service.UnpublishSkuResponse.change:type_name -> go.micro.service.ProductStatusChange
+	157, // 83: go.micro.service.ArchiveProductResponse.change:type_name -> go.micro.service.ProductStatusChange
+	176, // 84: go.micro.service.DeadLetterInfo.headers:type_name -> go.micro.service.DeadLetterInfo.HeadersEntry
+	166, // 85: go.micro.service.ListDeadLettersResponse.dead_letters:type_name -> go.micro.service.DeadLetterInfo
+	166, // 86: go.micro.service.GetDeadLetterResponse.dead_letter:type_name -> go.micro.service.DeadLetterInfo
+	172, // 87: go.micro.service.ReplayDeadLettersResponse.results:type_name -> go.micro.service.DeadLetterReplayResult
+	166, // 88: go.micro.service.ResolveDeadLettersResponse.dead_letters:type_name -> go.micro.service.DeadLetterInfo
+	0,   // 89: go.micro.service.Product.AddProduct:input_type -> go.micro.service.ProductInfo
+	2,   // 90: go.micro.service.Product.GetProductSkuDetail:input_type -> go.micro.service.GetProductSkuDetailRequest
+	6,   // 91: go.micro.service.Product.CheckSkuInventoryThreshold:input_type -> go.micro.service.CheckSkuInventoryThresholdRequest
+	9,   // 92: go.micro.service.Product.GetSkuStockBySkuNo:input_type -> go.micro.service.GetSkuStockBySkuNoRequest
+	11,  // 93: go.micro.service.Product.CreateRestockApply:input_type -> go.micro.service.CreateRestockApplyRequest
+	15,  // 94: go.micro.service.Product.GetSkuSalesVolume:input_type -> go.micro.service.GetSkuSalesVolumeRequest
+	17,  // 95: go.micro.service.Product.GetSupplierInfo:input_type -> go.micro.service.GetSupplierInfoRequest
+	24,  // 96: go.micro.service.Product.GetRestockApplyInfo:input_type -> go.micro.service.GetRestockApplyInfoRequest
+	27,  // 97: go.micro.service.Product.ApproveRestockApply:input_type -> go.micro.service.ApproveRestockApplyRequest
+	29,  // 98: go.micro.service.Product.RejectRestockApply:input_type -> go.micro.service.RejectRestockApplyRequest
+	21,  // 99: go.micro.service.Product.GetSkuDailySales:input_type -> go.micro.service.GetSkuDailySalesRequest
+	32,  // 100: go.micro.service.Product.ReserveStock:input_type -> go.micro.service.ReserveStockRequest
+	35,  // 101: go.micro.service.Product.ConfirmReservation:input_type -> go.micro.service.ConfirmReservationRequest
+	37,  // 102: go.micro.service.Product.ReleaseReservation:input_type -> go.micro.service.ReleaseReservationRequest
+	39,  // 103: go.micro.service.Product.TryDeductSku:input_type -> go.micro.service.TccDeductSkuRequest
+	39,  // 104: go.micro.service.Product.ConfirmDeductSku:input_type -> go.micro.service.TccDeductSkuRequest
+	39,  // 105: go.micro.service.Product.CancelDeductSku:input_type -> go.micro.service.TccDeductSkuRequest
+	45,  // 106: go.micro.service.Product.CreateProduct:input_type -> go.micro.service.CreateProductRequest
+	47,  // 107: go.micro.service.Product.CreateProductWithSkus:input_type -> go.micro.service.CreateProductWithSkusRequest
+	50,  // 108: go.micro.service.Product.UpdateProduct:input_type -> go.micro.service.UpdateProductRequest
+	52,  // 109: go.micro.service.Product.GetProduct:input_type -> go.micro.service.GetProductRequest
+	54,  // 110: go.micro.service.Product.ListProducts:input_type -> go.micro.service.ListProductsRequest
+	56,  // 111: go.micro.service.Product.DeleteProduct:input_type -> go.micro.service.DeleteProductRequest
+	62,  // 112: go.micro.service.Product.PreviewSkuMatrix:input_type -> go.micro.service.SkuMatrixRequest
+	62,  // 113: go.micro.service.Product.ApplySkuMatrix:input_type -> go.micro.service.SkuMatrixRequest
+	67,  // 114: go.micro.service.Product.CreateCategory:input_type -> go.micro.service.CreateCategoryRequest
+	69,  // 115: go.micro.service.Product.RenameCategory:input_type -> go.micro.service.RenameCategoryRequest
+	71,  // 116: go.micro.service.Product.MoveCategory:input_type -> go.micro.service.MoveCategoryRequest
+	73,  // 117: go.micro.service.Product.DeleteCategory:input_type -> go.micro.service.DeleteCategoryRequest
+	75,  // 118: go.micro.service.Product.GetCategoryTree:input_type -> go.micro.service.GetCategoryTreeRequest
+	77,  // 119: go.micro.service.Product.ListCategorySkus:input_type -> go.micro.service.ListCategorySkusRequest
+	80,  // 120: go.micro.service.Product.CreateBrand:input_type -> go.micro.service.CreateBrandRequest
+	82,  // 121: go.micro.service.Product.UpdateBrand:input_type -> go.micro.service.UpdateBrandRequest
+	84,  // 122: go.micro.service.Product.GetBrand:input_type -> go.micro.service.GetBrandRequest
+	86,  // 123: go.micro.service.Product.ListBrands:input_type -> go.micro.service.ListBrandsRequest
+	88,  // 124: go.micro.service.Product.DeleteBrand:input_type -> go.micro.service.DeleteBrandRequest
+	90,  // 125: go.micro.service.Product.ReassignBrandProducts:input_type -> go.micro.service.ReassignBrandProductsRequest
+	93,  // 126: go.micro.service.Product.CreateSupplier:input_type -> go.micro.service.CreateSupplierRequest
+	95,  // 127: go.micro.service.Product.UpdateSupplier:input_type -> go.micro.service.UpdateSupplierRequest
+	97,  // 128: go.micro.service.Product.GetSupplier:input_type -> go.micro.service.GetSupplierRequest
+	99,  // 129: go.micro.service.Product.ListSuppliers:input_type -> go.micro.service.ListSuppliersRequest
+	101, // 130: go.micro.service.Product.DeleteSupplier:input_type -> go.micro.service.DeleteSupplierRequest
+	104, // 131: go.micro.service.Product.LinkSupplierSku:input_type -> go.micro.service.LinkSupplierSkuRequest
+	106, // 132: go.micro.service.Product.UnlinkSupplierSku:input_type -> go.micro.service.UnlinkSupplierSkuRequest
+	108, // 133: go.micro.service.Product.ListSkusBySupplier:input_type -> go.micro.service.ListSkusBySupplierRequest
+	113, // 134: go.micro.service.Product.CreatePurchaseOrders:input_type -> go.micro.service.CreatePurchaseOrdersRequest
+	115, // 135: go.micro.service.Product.GetPurchaseOrder:input_type -> go.micro.service.GetPurchaseOrderRequest
+	117, // 136: go.micro.service.Product.ListPurchaseOrders:input_type -> go.micro.service.ListPurchaseOrdersRequest
+	120, // 137: go.micro.service.Product.ReceiveGoods:input_type -> go.micro.service.ReceiveGoodsRequest
+	123, // 138: go.micro.service.Product.AdjustStock:input_type -> go.micro.service.AdjustStockRequest
+	126, // 139: go.micro.service.Product.SubmitStocktake:input_type -> go.micro.service.SubmitStocktakeRequest
+	129, // 140: go.micro.service.Product.ListStockChanges:input_type -> go.micro.service.ListStockChangesRequest
+	131, // 141: go.micro.service.Product.GetStockAtTime:input_type -> go.micro.service.GetStockAtTimeRequest
+	134, // 142: go.micro.service.Product.GetReconciliationReport:input_type -> go.micro.service.GetReconciliationReportRequest
+	136, // 143: go.micro.service.Product.CorrectStockLedger:input_type -> go.micro.service.CorrectStockLedgerRequest
+	139, // 144: go.micro.service.Product.CreateWarehouse:input_type -> go.micro.service.CreateWarehouseRequest
+	141, // 145: go.micro.service.Product.ListWarehouses:input_type -> go.micro.service.ListWarehousesRequest
+	145, // 146: go.micro.service.Product.SetSkuWarehouseStock:input_type -> go.micro.service.SetSkuWarehouseStockRequest
+	147, // 147: go.micro.service.Product.GetSkuWarehouseStock:input_type -> go.micro.service.GetSkuWarehouseStockRequest
+	150, // 148: go.micro.service.Product.SchedulePriceChange:input_type -> go.micro.service.SchedulePriceChangeRequest
+	152, // 149: go.micro.service.Product.GetSkuPriceAt:input_type -> go.micro.service.GetSkuPriceAtRequest
+	154, // 150: go.micro.service.Product.ListSkuPriceHistory:input_type -> go.micro.service.ListSkuPriceHistoryRequest
+	158, // 151: go.micro.service.Product.PublishProduct:input_type -> go.micro.service.PublishProductRequest
+	160, // 152: go.micro.service.Product.UnpublishProduct:input_type -> go.micro.service.UnpublishProductRequest
+	162, // 153: go.micro.service.Product.UnpublishSku:input_type -> go.micro.service.UnpublishSkuRequest
+	164, // 154: go.micro.service.Product.ArchiveProduct:input_type -> go.micro.service.ArchiveProductRequest
+	167, // 155: go.micro.service.Product.ListDeadLetters:input_type -> go.micro.service.ListDeadLettersRequest
+	169, // 156: go.micro.service.Product.GetDeadLetter:input_type -> go.micro.service.GetDeadLetterRequest
+	171, // 157: go.micro.service.Product.ReplayDeadLetters:input_type -> go.micro.service.ReplayDeadLettersRequest
+	174, // 158: go.micro.service.Product.ResolveDeadLetters:input_type -> go.micro.service.ResolveDeadLettersRequest
+	1,   // 159: go.micro.service.Product.AddProduct:output_type -> go.micro.service.ResponseProduct
+	3,   // 160: go.micro.service.Product.GetProductSkuDetail:output_type -> go.micro.service.GetProductSkuDetailResponse
+	8,   // 161: go.micro.service.Product.CheckSkuInventoryThreshold:output_type -> go.micro.service.CheckSkuInventoryThresholdResponse
+	10,  // 162: go.micro.service.Product.GetSkuStockBySkuNo:output_type -> go.micro.service.GetSkuStockBySkuNoResponse
+	12,  // 163: go.micro.service.Product.CreateRestockApply:output_type -> go.micro.service.CreateRestockApplyResponse
+	16,  // 164: go.micro.service.Product.GetSkuSalesVolume:output_type -> go.micro.service.GetSkuSalesVolumeResponse
+	20,  // 165: go.micro.service.Product.GetSupplierInfo:output_type -> go.micro.service.GetSupplierInfoResponse
+	26,  // 166: go.micro.service.Product.GetRestockApplyInfo:output_type -> go.micro.service.GetRestockApplyInfoResponse
+	28,  // 167: go.micro.service.Product.ApproveRestockApply:output_type -> go.micro.service.ApproveRestockApplyResponse
+	30,  // 168: go.micro.service.Product.RejectRestockApply:output_type -> go.micro.service.RejectRestockApplyResponse
+	23,  // 169: go.micro.service.Product.GetSkuDailySales:output_type -> go.micro.service.GetSkuDailySalesResponse
+	34,  // 170: go.micro.service.Product.ReserveStock:output_type -> go.micro.service.ReserveStockResponse
+	36,  // 171: go.micro.service.Product.ConfirmReservation:output_type -> go.micro.service.ConfirmReservationResponse
+	38,  // 172: go.micro.service.Product.ReleaseReservation:output_type -> go.micro.service.ReleaseReservationResponse
+	40,  // 173: go.micro.service.Product.TryDeductSku:output_type -> go.micro.service.TccDeductSkuResponse
+	40,  // 174: go.micro.service.Product.ConfirmDeductSku:output_type -> go.micro.service.TccDeductSkuResponse
+	40,  // 175: go.micro.service.Product.CancelDeductSku:output_type -> go.micro.service.TccDeductSkuResponse
+	46,  // 176: go.micro.service.Product.CreateProduct:output_type -> go.micro.service.CreateProductResponse
+	48,  // 177: go.micro.service.Product.CreateProductWithSkus:output_type -> go.micro.service.CreateProductWithSkusResponse
+	51,  // 178: go.micro.service.Product.UpdateProduct:output_type -> go.micro.service.UpdateProductResponse
+	53,  // 179: go.micro.service.Product.GetProduct:output_type -> go.micro.service.GetProductResponse
+	55,  // 180: go.micro.service.Product.ListProducts:output_type -> go.micro.service.ListProductsResponse
+	57,  // 181: go.micro.service.Product.DeleteProduct:output_type -> go.micro.service.DeleteProductResponse
+	64,  // 182: go.micro.service.Product.PreviewSkuMatrix:output_type -> go.micro.service.SkuMatrixResponse
+	64,  // 183: go.micro.service.Product.ApplySkuMatrix:output_type -> go.micro.service.SkuMatrixResponse
+	68,  // 184: go.micro.service.Product.CreateCategory:output_type -> go.micro.service.CreateCategoryResponse
+	70,  // 185: go.micro.service.Product.RenameCategory:output_type -> go.micro.service.RenameCategoryResponse
+	72,  // 186: go.micro.service.Product.MoveCategory:output_type -> go.micro.service.MoveCategoryResponse
+	74,  // 187: go.micro.service.Product.DeleteCategory:output_type -> go.micro.service.DeleteCategoryResponse
+	76,  // 188: go.micro.service.Product.GetCategoryTree:output_type -> go.micro.service.GetCategoryTreeResponse
+	78,  // 189: go.micro.service.Product.ListCategorySkus:output_type -> go.micro.service.ListCategorySkusResponse
+	81,  // 190: go.micro.service.Product.CreateBrand:output_type -> go.micro.service.CreateBrandResponse
+	83,  // 191: go.micro.service.Product.UpdateBrand:output_type -> go.micro.service.UpdateBrandResponse
+	85,  // 192: go.micro.service.Product.GetBrand:output_type -> go.micro.service.GetBrandResponse
+	87,  // 193: go.micro.service.Product.ListBrands:output_type -> go.micro.service.ListBrandsResponse
+	89,  // 194: go.micro.service.Product.DeleteBrand:output_type -> go.micro.service.DeleteBrandResponse
+	91,  // 195: go.micro.service.Product.ReassignBrandProducts:output_type -> go.micro.service.ReassignBrandProductsResponse
+	94,  // 196: go.micro.service.Product.CreateSupplier:output_type -> go.micro.service.CreateSupplierResponse
+	96,  // 197: go.micro.service.Product.UpdateSupplier:output_type -> go.micro.service.UpdateSupplierResponse
+	98,  // 198: go.micro.service.Product.GetSupplier:output_type -> go.micro.service.GetSupplierResponse
+	100, // 199: go.micro.service.Product.ListSuppliers:output_type -> go.micro.service.ListSuppliersResponse
+	102, // 200: go.micro.service.Product.DeleteSupplier:output_type -> go.micro.service.DeleteSupplierResponse
+	105, // 201: go.micro.service.Product.LinkSupplierSku:output_type -> go.micro.service.LinkSupplierSkuResponse
+	107, // 202: go.micro.service.Product.UnlinkSupplierSku:output_type -> go.micro.service.UnlinkSupplierSkuResponse
+	109, // 203: go.micro.service.Product.ListSkusBySupplier:output_type -> go.micro.service.ListSkusBySupplierResponse
+	114, // 204: go.micro.service.Product.CreatePurchaseOrders:output_type -> go.micro.service.CreatePurchaseOrdersResponse
+	116, // 205: go.micro.service.Product.GetPurchaseOrder:output_type -> go.micro.service.GetPurchaseOrderResponse
+	118, // 206: go.micro.service.Product.ListPurchaseOrders:output_type -> go.micro.service.ListPurchaseOrdersResponse
+	121, // 207: go.micro.service.Product.ReceiveGoods:output_type -> go.micro.service.ReceiveGoodsResponse
+	124, // 208: go.micro.service.Product.AdjustStock:output_type -> go.micro.service.AdjustStockResponse
+	127, // 209: go.micro.service.Product.SubmitStocktake:output_type -> go.micro.service.SubmitStocktakeResponse
+	130, // 210: go.micro.service.Product.ListStockChanges:output_type -> go.micro.service.ListStockChangesResponse
+	132, // 211: go.micro.service.Product.GetStockAtTime:output_type -> go.micro.service.GetStockAtTimeResponse
+	135, // 212: go.micro.service.Product.GetReconciliationReport:output_type -> go.micro.service.GetReconciliationReportResponse
+	137, // 213: go.micro.service.Product.CorrectStockLedger:output_type -> go.micro.service.CorrectStockLedgerResponse
+	140, // 214: go.micro.service.Product.CreateWarehouse:output_type -> go.micro.service.CreateWarehouseResponse
+	142, // 215: go.micro.service.Product.ListWarehouses:output_type -> go.micro.service.ListWarehousesResponse
+	146, // 216: go.micro.service.Product.SetSkuWarehouseStock:output_type -> go.micro.service.SetSkuWarehouseStockResponse
+	148, // 217: go.micro.service.Product.GetSkuWarehouseStock:output_type -> go.micro.service.GetSkuWarehouseStockResponse
+	151, // 218: go.micro.service.Product.SchedulePriceChange:output_type -> go.micro.service.SchedulePriceChangeResponse
+	153, // 219: go.micro.service.Product.GetSkuPriceAt:output_type -> go.micro.service.GetSkuPriceAtResponse
+	155, // 220: go.micro.service.Product.ListSkuPriceHistory:output_type -> go.micro.service.ListSkuPriceHistoryResponse
+	159, // 221: go.micro.service.Product.PublishProduct:output_type -> go.micro.service.PublishProductResponse
+	161, // 222: go.micro.service.Product.UnpublishProduct:output_type -> go.micro.service.UnpublishProductResponse
+	163, // 223: go.micro.service.Product.UnpublishSku:output_type -> go.micro.service.UnpublishSkuResponse
+	165, // 224: go.micro.service.Product.ArchiveProduct:output_type -> go.micro.service.ArchiveProductResponse
+	168, // 225: go.micro.service.Product.ListDeadLetters:output_type -> go.micro.service.ListDeadLettersResponse
+	170, // 226: go.micro.service.Product.GetDeadLetter:output_type -> go.micro.service.GetDeadLetterResponse
+	173, // 227: go.micro.service.Product.ReplayDeadLetters:output_type -> go.micro.service.ReplayDeadLettersResponse
+	175, // 228: go.micro.service.Product.ResolveDeadLetters:output_type -> go.micro.service.ResolveDeadLettersResponse
+	159, // [159:229] is the sub-list for method output_type
+	89,  // [89:159] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -2183,7 +12541,7 @@ func file_product_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_proto_rawDesc), len(file_product_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   177,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -44,7 +44,68 @@ type ProductService interface {
 	GetSkuSalesVolume(ctx context.Context, in *GetSkuSalesVolumeRequest, opts ...client.CallOption) (*GetSkuSalesVolumeResponse, error)
 	GetSupplierInfo(ctx context.Context, in *GetSupplierInfoRequest, opts ...client.CallOption) (*GetSupplierInfoResponse, error)
 	GetRestockApplyInfo(ctx context.Context, in *GetRestockApplyInfoRequest, opts ...client.CallOption) (*GetRestockApplyInfoResponse, error)
+	ApproveRestockApply(ctx context.Context, in *ApproveRestockApplyRequest, opts ...client.CallOption) (*ApproveRestockApplyResponse, error)
+	RejectRestockApply(ctx context.Context, in *RejectRestockApplyRequest, opts ...client.CallOption) (*RejectRestockApplyResponse, error)
 	GetSkuDailySales(ctx context.Context, in *GetSkuDailySalesRequest, opts ...client.CallOption) (*GetSkuDailySalesResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...client.CallOption) (*ReserveStockResponse, error)
+	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...client.CallOption) (*ConfirmReservationResponse, error)
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...client.CallOption) (*ReleaseReservationResponse, error)
+	TryDeductSku(ctx context.Context, in *TccDeductSkuRequest, opts ...client.CallOption) (*TccDeductSkuResponse, error)
+	ConfirmDeductSku(ctx context.Context, in *TccDeductSkuRequest, opts ...client.CallOption) (*TccDeductSkuResponse, error)
+	CancelDeductSku(ctx context.Context, in *TccDeductSkuRequest, opts ...client.CallOption) (*TccDeductSkuResponse, error)
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...client.CallOption) (*CreateProductResponse, error)
+	CreateProductWithSkus(ctx context.Context, in *CreateProductWithSkusRequest, opts ...client.CallOption) (*CreateProductWithSkusResponse, error)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...client.CallOption) (*UpdateProductResponse, error)
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...client.CallOption) (*GetProductResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...client.CallOption) (*ListProductsResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...client.CallOption) (*DeleteProductResponse, error)
+	PreviewSkuMatrix(ctx context.Context, in *SkuMatrixRequest, opts ...client.CallOption) (*SkuMatrixResponse, error)
+	ApplySkuMatrix(ctx context.Context, in *SkuMatrixRequest, opts ...client.CallOption) (*SkuMatrixResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...client.CallOption) (*CreateCategoryResponse, error)
+	RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...client.CallOption) (*RenameCategoryResponse, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...client.CallOption) (*MoveCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...client.CallOption) (*DeleteCategoryResponse, error)
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...client.CallOption) (*GetCategoryTreeResponse, error)
+	ListCategorySkus(ctx context.Context, in *ListCategorySkusRequest, opts ...client.CallOption) (*ListCategorySkusResponse, error)
+	CreateBrand(ctx context.Context, in *CreateBrandRequest, opts ...client.CallOption) (*CreateBrandResponse, error)
+	UpdateBrand(ctx context.Context, in *UpdateBrandRequest, opts ...client.CallOption) (*UpdateBrandResponse, error)
+	GetBrand(ctx context.Context, in *GetBrandRequest, opts ...client.CallOption) (*GetBrandResponse, error)
+	ListBrands(ctx context.Context, in *ListBrandsRequest, opts ...client.CallOption) (*ListBrandsResponse, error)
+	DeleteBrand(ctx context.Context, in *DeleteBrandRequest, opts ...client.CallOption) (*DeleteBrandResponse, error)
+	ReassignBrandProducts(ctx context.Context, in *ReassignBrandProductsRequest, opts ...client.CallOption) (*ReassignBrandProductsResponse, error)
+	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...client.CallOption) (*CreateSupplierResponse, error)
+	UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...client.CallOption) (*UpdateSupplierResponse, error)
+	GetSupplier(ctx context.Context, in *GetSupplierRequest, opts ...client.CallOption) (*GetSupplierResponse, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...client.CallOption) (*ListSuppliersResponse, error)
+	DeleteSupplier(ctx context.Context, in *DeleteSupplierRequest, opts ...client.CallOption) (*DeleteSupplierResponse, error)
+	LinkSupplierSku(ctx context.Context, in *LinkSupplierSkuRequest, opts ...client.CallOption) (*LinkSupplierSkuResponse, error)
+	UnlinkSupplierSku(ctx context.Context, in *UnlinkSupplierSkuRequest, opts ...client.CallOption) (*UnlinkSupplierSkuResponse, error)
+	ListSkusBySupplier(ctx context.Context, in *ListSkusBySupplierRequest, opts ...client.CallOption) (*ListSkusBySupplierResponse, error)
+	CreatePurchaseOrders(ctx context.Context, in *CreatePurchaseOrdersRequest, opts ...client.CallOption) (*CreatePurchaseOrdersResponse, error)
+	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...client.CallOption) (*GetPurchaseOrderResponse, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...client.CallOption) (*ListPurchaseOrdersResponse, error)
+	ReceiveGoods(ctx context.Context, in *ReceiveGoodsRequest, opts ...client.CallOption) (*ReceiveGoodsResponse, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...client.CallOption) (*AdjustStockResponse, error)
+	SubmitStocktake(ctx context.Context, in *SubmitStocktakeRequest, opts ...client.CallOption) (*SubmitStocktakeResponse, error)
+	ListStockChanges(ctx context.Context, in *ListStockChangesRequest, opts ...client.CallOption) (*ListStockChangesResponse, error)
+	GetStockAtTime(ctx context.Context, in *GetStockAtTimeRequest, opts ...client.CallOption) (*GetStockAtTimeResponse, error)
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...client.CallOption) (*GetReconciliationReportResponse, error)
+	CorrectStockLedger(ctx context.Context, in *CorrectStockLedgerRequest, opts ...client.CallOption) (*CorrectStockLedgerResponse, error)
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...client.CallOption) (*CreateWarehouseResponse, error)
+	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...client.CallOption) (*ListWarehousesResponse, error)
+	SetSkuWarehouseStock(ctx context.Context, in *SetSkuWarehouseStockRequest, opts ...client.CallOption) (*SetSkuWarehouseStockResponse, error)
+	GetSkuWarehouseStock(ctx context.Context, in *GetSkuWarehouseStockRequest, opts ...client.CallOption) (*GetSkuWarehouseStockResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...client.CallOption) (*SchedulePriceChangeResponse, error)
+	GetSkuPriceAt(ctx context.Context, in *GetSkuPriceAtRequest, opts ...client.CallOption) (*GetSkuPriceAtResponse, error)
+	ListSkuPriceHistory(ctx context.Context, in *ListSkuPriceHistoryRequest, opts ...client.CallOption) (*ListSkuPriceHistoryResponse, error)
+	PublishProduct(ctx context.Context, in *PublishProductRequest, opts ...client.CallOption) (*PublishProductResponse, error)
+	UnpublishProduct(ctx context.Context, in *UnpublishProductRequest, opts ...client.CallOption) (*UnpublishProductResponse, error)
+	UnpublishSku(ctx context.Context, in *UnpublishSkuRequest, opts ...client.CallOption) (*UnpublishSkuResponse, error)
+	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...client.CallOption) (*ArchiveProductResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...client.CallOption) (*ListDeadLettersResponse, error)
+	GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...client.CallOption) (*GetDeadLetterResponse, error)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...client.CallOption) (*ReplayDeadLettersResponse, error)
+	ResolveDeadLetters(ctx context.Context, in *ResolveDeadLettersRequest, opts ...client.CallOption) (*ResolveDeadLettersResponse, error)
 }
 
 type productService struct {
@@ -139,6 +200,26 @@ func (c *productService) GetRestockApplyInfo(ctx context.Context, in *GetRestock
 	return out, nil
 }
 
+func (c *productService) ApproveRestockApply(ctx context.Context, in *ApproveRestockApplyRequest, opts ...client.CallOption) (*ApproveRestockApplyResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ApproveRestockApply", in)
+	out := new(ApproveRestockApplyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) RejectRestockApply(ctx context.Context, in *RejectRestockApplyRequest, opts ...client.CallOption) (*RejectRestockApplyResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.RejectRestockApply", in)
+	out := new(RejectRestockApplyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productService) GetSkuDailySales(ctx context.Context, in *GetSkuDailySalesRequest, opts ...client.CallOption) (*GetSkuDailySalesResponse, error) {
 	req := c.c.NewRequest(c.name, "Product.GetSkuDailySales", in)
 	out := new(GetSkuDailySalesResponse)
@@ -149,6 +230,596 @@ func (c *productService) GetSkuDailySales(ctx context.Context, in *GetSkuDailySa
 	return out, nil
 }
 
+func (c *productService) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...client.CallOption) (*ReserveStockResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ReserveStock", in)
+	out := new(ReserveStockResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...client.CallOption) (*ConfirmReservationResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ConfirmReservation", in)
+	out := new(ConfirmReservationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...client.CallOption) (*ReleaseReservationResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ReleaseReservation", in)
+	out := new(ReleaseReservationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) TryDeductSku(ctx context.Context, in *TccDeductSkuRequest, opts ...client.CallOption) (*TccDeductSkuResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.TryDeductSku", in)
+	out := new(TccDeductSkuResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ConfirmDeductSku(ctx context.Context, in *TccDeductSkuRequest, opts ...client.CallOption) (*TccDeductSkuResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ConfirmDeductSku", in)
+	out := new(TccDeductSkuResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CancelDeductSku(ctx context.Context, in *TccDeductSkuRequest, opts ...client.CallOption) (*TccDeductSkuResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CancelDeductSku", in)
+	out := new(TccDeductSkuResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...client.CallOption) (*CreateProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreateProduct", in)
+	out := new(CreateProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateProductWithSkus(ctx context.Context, in *CreateProductWithSkusRequest, opts ...client.CallOption) (*CreateProductWithSkusResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreateProductWithSkus", in)
+	out := new(CreateProductWithSkusResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...client.CallOption) (*UpdateProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.UpdateProduct", in)
+	out := new(UpdateProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetProduct(ctx context.Context, in *GetProductRequest, opts ...client.CallOption) (*GetProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetProduct", in)
+	out := new(GetProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...client.CallOption) (*ListProductsResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListProducts", in)
+	out := new(ListProductsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...client.CallOption) (*DeleteProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.DeleteProduct", in)
+	out := new(DeleteProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) PreviewSkuMatrix(ctx context.Context, in *SkuMatrixRequest, opts ...client.CallOption) (*SkuMatrixResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.PreviewSkuMatrix", in)
+	out := new(SkuMatrixResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ApplySkuMatrix(ctx context.Context, in *SkuMatrixRequest, opts ...client.CallOption) (*SkuMatrixResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ApplySkuMatrix", in)
+	out := new(SkuMatrixResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...client.CallOption) (*CreateCategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreateCategory", in)
+	out := new(CreateCategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...client.CallOption) (*RenameCategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.RenameCategory", in)
+	out := new(RenameCategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...client.CallOption) (*MoveCategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.MoveCategory", in)
+	out := new(MoveCategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...client.CallOption) (*DeleteCategoryResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.DeleteCategory", in)
+	out := new(DeleteCategoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...client.CallOption) (*GetCategoryTreeResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetCategoryTree", in)
+	out := new(GetCategoryTreeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListCategorySkus(ctx context.Context, in *ListCategorySkusRequest, opts ...client.CallOption) (*ListCategorySkusResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListCategorySkus", in)
+	out := new(ListCategorySkusResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateBrand(ctx context.Context, in *CreateBrandRequest, opts ...client.CallOption) (*CreateBrandResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreateBrand", in)
+	out := new(CreateBrandResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) UpdateBrand(ctx context.Context, in *UpdateBrandRequest, opts ...client.CallOption) (*UpdateBrandResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.UpdateBrand", in)
+	out := new(UpdateBrandResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetBrand(ctx context.Context, in *GetBrandRequest, opts ...client.CallOption) (*GetBrandResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetBrand", in)
+	out := new(GetBrandResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListBrands(ctx context.Context, in *ListBrandsRequest, opts ...client.CallOption) (*ListBrandsResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListBrands", in)
+	out := new(ListBrandsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) DeleteBrand(ctx context.Context, in *DeleteBrandRequest, opts ...client.CallOption) (*DeleteBrandResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.DeleteBrand", in)
+	out := new(DeleteBrandResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ReassignBrandProducts(ctx context.Context, in *ReassignBrandProductsRequest, opts ...client.CallOption) (*ReassignBrandProductsResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ReassignBrandProducts", in)
+	out := new(ReassignBrandProductsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...client.CallOption) (*CreateSupplierResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreateSupplier", in)
+	out := new(CreateSupplierResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...client.CallOption) (*UpdateSupplierResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.UpdateSupplier", in)
+	out := new(UpdateSupplierResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetSupplier(ctx context.Context, in *GetSupplierRequest, opts ...client.CallOption) (*GetSupplierResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetSupplier", in)
+	out := new(GetSupplierResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...client.CallOption) (*ListSuppliersResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListSuppliers", in)
+	out := new(ListSuppliersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) DeleteSupplier(ctx context.Context, in *DeleteSupplierRequest, opts ...client.CallOption) (*DeleteSupplierResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.DeleteSupplier", in)
+	out := new(DeleteSupplierResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) LinkSupplierSku(ctx context.Context, in *LinkSupplierSkuRequest, opts ...client.CallOption) (*LinkSupplierSkuResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.LinkSupplierSku", in)
+	out := new(LinkSupplierSkuResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) UnlinkSupplierSku(ctx context.Context, in *UnlinkSupplierSkuRequest, opts ...client.CallOption) (*UnlinkSupplierSkuResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.UnlinkSupplierSku", in)
+	out := new(UnlinkSupplierSkuResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListSkusBySupplier(ctx context.Context, in *ListSkusBySupplierRequest, opts ...client.CallOption) (*ListSkusBySupplierResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListSkusBySupplier", in)
+	out := new(ListSkusBySupplierResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreatePurchaseOrders(ctx context.Context, in *CreatePurchaseOrdersRequest, opts ...client.CallOption) (*CreatePurchaseOrdersResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreatePurchaseOrders", in)
+	out := new(CreatePurchaseOrdersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...client.CallOption) (*GetPurchaseOrderResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetPurchaseOrder", in)
+	out := new(GetPurchaseOrderResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...client.CallOption) (*ListPurchaseOrdersResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListPurchaseOrders", in)
+	out := new(ListPurchaseOrdersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ReceiveGoods(ctx context.Context, in *ReceiveGoodsRequest, opts ...client.CallOption) (*ReceiveGoodsResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ReceiveGoods", in)
+	out := new(ReceiveGoodsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...client.CallOption) (*AdjustStockResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.AdjustStock", in)
+	out := new(AdjustStockResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) SubmitStocktake(ctx context.Context, in *SubmitStocktakeRequest, opts ...client.CallOption) (*SubmitStocktakeResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.SubmitStocktake", in)
+	out := new(SubmitStocktakeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListStockChanges(ctx context.Context, in *ListStockChangesRequest, opts ...client.CallOption) (*ListStockChangesResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListStockChanges", in)
+	out := new(ListStockChangesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetStockAtTime(ctx context.Context, in *GetStockAtTimeRequest, opts ...client.CallOption) (*GetStockAtTimeResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetStockAtTime", in)
+	out := new(GetStockAtTimeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...client.CallOption) (*GetReconciliationReportResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetReconciliationReport", in)
+	out := new(GetReconciliationReportResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CorrectStockLedger(ctx context.Context, in *CorrectStockLedgerRequest, opts ...client.CallOption) (*CorrectStockLedgerResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CorrectStockLedger", in)
+	out := new(CorrectStockLedgerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...client.CallOption) (*CreateWarehouseResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.CreateWarehouse", in)
+	out := new(CreateWarehouseResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...client.CallOption) (*ListWarehousesResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListWarehouses", in)
+	out := new(ListWarehousesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) SetSkuWarehouseStock(ctx context.Context, in *SetSkuWarehouseStockRequest, opts ...client.CallOption) (*SetSkuWarehouseStockResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.SetSkuWarehouseStock", in)
+	out := new(SetSkuWarehouseStockResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetSkuWarehouseStock(ctx context.Context, in *GetSkuWarehouseStockRequest, opts ...client.CallOption) (*GetSkuWarehouseStockResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetSkuWarehouseStock", in)
+	out := new(GetSkuWarehouseStockResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...client.CallOption) (*SchedulePriceChangeResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.SchedulePriceChange", in)
+	out := new(SchedulePriceChangeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetSkuPriceAt(ctx context.Context, in *GetSkuPriceAtRequest, opts ...client.CallOption) (*GetSkuPriceAtResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetSkuPriceAt", in)
+	out := new(GetSkuPriceAtResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListSkuPriceHistory(ctx context.Context, in *ListSkuPriceHistoryRequest, opts ...client.CallOption) (*ListSkuPriceHistoryResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListSkuPriceHistory", in)
+	out := new(ListSkuPriceHistoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) PublishProduct(ctx context.Context, in *PublishProductRequest, opts ...client.CallOption) (*PublishProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.PublishProduct", in)
+	out := new(PublishProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) UnpublishProduct(ctx context.Context, in *UnpublishProductRequest, opts ...client.CallOption) (*UnpublishProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.UnpublishProduct", in)
+	out := new(UnpublishProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) UnpublishSku(ctx context.Context, in *UnpublishSkuRequest, opts ...client.CallOption) (*UnpublishSkuResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.UnpublishSku", in)
+	out := new(UnpublishSkuResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...client.CallOption) (*ArchiveProductResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ArchiveProduct", in)
+	out := new(ArchiveProductResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...client.CallOption) (*ListDeadLettersResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ListDeadLetters", in)
+	out := new(ListDeadLettersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...client.CallOption) (*GetDeadLetterResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.GetDeadLetter", in)
+	out := new(GetDeadLetterResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...client.CallOption) (*ReplayDeadLettersResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ReplayDeadLetters", in)
+	out := new(ReplayDeadLettersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productService) ResolveDeadLetters(ctx context.Context, in *ResolveDeadLettersRequest, opts ...client.CallOption) (*ResolveDeadLettersResponse, error) {
+	req := c.c.NewRequest(c.name, "Product.ResolveDeadLetters", in)
+	out := new(ResolveDeadLettersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Product service
 
 type ProductHandler interface {
@@ -160,7 +831,68 @@ type ProductHandler interface {
 	GetSkuSalesVolume(context.Context, *GetSkuSalesVolumeRequest, *GetSkuSalesVolumeResponse) error
 	GetSupplierInfo(context.Context, *GetSupplierInfoRequest, *GetSupplierInfoResponse) error
 	GetRestockApplyInfo(context.Context, *GetRestockApplyInfoRequest, *GetRestockApplyInfoResponse) error
+	ApproveRestockApply(context.Context, *ApproveRestockApplyRequest, *ApproveRestockApplyResponse) error
+	RejectRestockApply(context.Context, *RejectRestockApplyRequest, *RejectRestockApplyResponse) error
 	GetSkuDailySales(context.Context, *GetSkuDailySalesRequest, *GetSkuDailySalesResponse) error
+	ReserveStock(context.Context, *ReserveStockRequest, *ReserveStockResponse) error
+	ConfirmReservation(context.Context, *ConfirmReservationRequest, *ConfirmReservationResponse) error
+	ReleaseReservation(context.Context, *ReleaseReservationRequest, *ReleaseReservationResponse) error
+	TryDeductSku(context.Context, *TccDeductSkuRequest, *TccDeductSkuResponse) error
+	ConfirmDeductSku(context.Context, *TccDeductSkuRequest, *TccDeductSkuResponse) error
+	CancelDeductSku(context.Context, *TccDeductSkuRequest, *TccDeductSkuResponse) error
+	CreateProduct(context.Context, *CreateProductRequest, *CreateProductResponse) error
+	CreateProductWithSkus(context.Context, *CreateProductWithSkusRequest, *CreateProductWithSkusResponse) error
+	UpdateProduct(context.Context, *UpdateProductRequest, *UpdateProductResponse) error
+	GetProduct(context.Context, *GetProductRequest, *GetProductResponse) error
+	ListProducts(context.Context, *ListProductsRequest, *ListProductsResponse) error
+	DeleteProduct(context.Context, *DeleteProductRequest, *DeleteProductResponse) error
+	PreviewSkuMatrix(context.Context, *SkuMatrixRequest, *SkuMatrixResponse) error
+	ApplySkuMatrix(context.Context, *SkuMatrixRequest, *SkuMatrixResponse) error
+	CreateCategory(context.Context, *CreateCategoryRequest, *CreateCategoryResponse) error
+	RenameCategory(context.Context, *RenameCategoryRequest, *RenameCategoryResponse) error
+	MoveCategory(context.Context, *MoveCategoryRequest, *MoveCategoryResponse) error
+	DeleteCategory(context.Context, *DeleteCategoryRequest, *DeleteCategoryResponse) error
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest, *GetCategoryTreeResponse) error
+	ListCategorySkus(context.Context, *ListCategorySkusRequest, *ListCategorySkusResponse) error
+	CreateBrand(context.Context, *CreateBrandRequest, *CreateBrandResponse) error
+	UpdateBrand(context.Context, *UpdateBrandRequest, *UpdateBrandResponse) error
+	GetBrand(context.Context, *GetBrandRequest, *GetBrandResponse) error
+	ListBrands(context.Context, *ListBrandsRequest, *ListBrandsResponse) error
+	DeleteBrand(context.Context, *DeleteBrandRequest, *DeleteBrandResponse) error
+	ReassignBrandProducts(context.Context, *ReassignBrandProductsRequest, *ReassignBrandProductsResponse) error
+	CreateSupplier(context.Context, *CreateSupplierRequest, *CreateSupplierResponse) error
+	UpdateSupplier(context.Context, *UpdateSupplierRequest, *UpdateSupplierResponse) error
+	GetSupplier(context.Context, *GetSupplierRequest, *GetSupplierResponse) error
+	ListSuppliers(context.Context, *ListSuppliersRequest, *ListSuppliersResponse) error
+	DeleteSupplier(context.Context, *DeleteSupplierRequest, *DeleteSupplierResponse) error
+	LinkSupplierSku(context.Context, *LinkSupplierSkuRequest, *LinkSupplierSkuResponse) error
+	UnlinkSupplierSku(context.Context, *UnlinkSupplierSkuRequest, *UnlinkSupplierSkuResponse) error
+	ListSkusBySupplier(context.Context, *ListSkusBySupplierRequest, *ListSkusBySupplierResponse) error
+	CreatePurchaseOrders(context.Context, *CreatePurchaseOrdersRequest, *CreatePurchaseOrdersResponse) error
+	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest, *GetPurchaseOrderResponse) error
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest, *ListPurchaseOrdersResponse) error
+	ReceiveGoods(context.Context, *ReceiveGoodsRequest, *ReceiveGoodsResponse) error
+	AdjustStock(context.Context, *AdjustStockRequest, *AdjustStockResponse) error
+	SubmitStocktake(context.Context, *SubmitStocktakeRequest, *SubmitStocktakeResponse) error
+	ListStockChanges(context.Context, *ListStockChangesRequest, *ListStockChangesResponse) error
+	GetStockAtTime(context.Context, *GetStockAtTimeRequest, *GetStockAtTimeResponse) error
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest, *GetReconciliationReportResponse) error
+	CorrectStockLedger(context.Context, *CorrectStockLedgerRequest, *CorrectStockLedgerResponse) error
+	CreateWarehouse(context.Context, *CreateWarehouseRequest, *CreateWarehouseResponse) error
+	ListWarehouses(context.Context, *ListWarehousesRequest, *ListWarehousesResponse) error
+	SetSkuWarehouseStock(context.Context, *SetSkuWarehouseStockRequest, *SetSkuWarehouseStockResponse) error
+	GetSkuWarehouseStock(context.Context, *GetSkuWarehouseStockRequest, *GetSkuWarehouseStockResponse) error
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest, *SchedulePriceChangeResponse) error
+	GetSkuPriceAt(context.Context, *GetSkuPriceAtRequest, *GetSkuPriceAtResponse) error
+	ListSkuPriceHistory(context.Context, *ListSkuPriceHistoryRequest, *ListSkuPriceHistoryResponse) error
+	PublishProduct(context.Context, *PublishProductRequest, *PublishProductResponse) error
+	UnpublishProduct(context.Context, *UnpublishProductRequest, *UnpublishProductResponse) error
+	UnpublishSku(context.Context, *UnpublishSkuRequest, *UnpublishSkuResponse) error
+	ArchiveProduct(context.Context, *ArchiveProductRequest, *ArchiveProductResponse) error
+	ListDeadLetters(context.Context, *ListDeadLettersRequest, *ListDeadLettersResponse) error
+	GetDeadLetter(context.Context, *GetDeadLetterRequest, *GetDeadLetterResponse) error
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest, *ReplayDeadLettersResponse) error
+	ResolveDeadLetters(context.Context, *ResolveDeadLettersRequest, *ResolveDeadLettersResponse) error
 }
 
 func RegisterProductHandler(s server.Server, hdlr ProductHandler, opts ...server.HandlerOption) error {
@@ -173,7 +905,68 @@ func RegisterProductHandler(s server.Server, hdlr ProductHandler, opts ...server
 		GetSkuSalesVolume(ctx context.Context, in *GetSkuSalesVolumeRequest, out *GetSkuSalesVolumeResponse) error
 		GetSupplierInfo(ctx context.Context, in *GetSupplierInfoRequest, out *GetSupplierInfoResponse) error
 		GetRestockApplyInfo(ctx context.Context, in *GetRestockApplyInfoRequest, out *GetRestockApplyInfoResponse) error
+		ApproveRestockApply(ctx context.Context, in *ApproveRestockApplyRequest, out *ApproveRestockApplyResponse) error
+		RejectRestockApply(ctx context.Context, in *RejectRestockApplyRequest, out *RejectRestockApplyResponse) error
 		GetSkuDailySales(ctx context.Context, in *GetSkuDailySalesRequest, out *GetSkuDailySalesResponse) error
+		ReserveStock(ctx context.Context, in *ReserveStockRequest, out *ReserveStockResponse) error
+		ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, out *ConfirmReservationResponse) error
+		ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, out *ReleaseReservationResponse) error
+		TryDeductSku(ctx context.Context, in *TccDeductSkuRequest, out *TccDeductSkuResponse) error
+		ConfirmDeductSku(ctx context.Context, in *TccDeductSkuRequest, out *TccDeductSkuResponse) error
+		CancelDeductSku(ctx context.Context, in *TccDeductSkuRequest, out *TccDeductSkuResponse) error
+		CreateProduct(ctx context.Context, in *CreateProductRequest, out *CreateProductResponse) error
+		CreateProductWithSkus(ctx context.Context, in *CreateProductWithSkusRequest, out *CreateProductWithSkusResponse) error
+		UpdateProduct(ctx context.Context, in *UpdateProductRequest, out *UpdateProductResponse) error
+		GetProduct(ctx context.Context, in *GetProductRequest, out *GetProductResponse) error
+		ListProducts(ctx context.Context, in *ListProductsRequest, out *ListProductsResponse) error
+		DeleteProduct(ctx context.Context, in *DeleteProductRequest, out *DeleteProductResponse) error
+		PreviewSkuMatrix(ctx context.Context, in *SkuMatrixRequest, out *SkuMatrixResponse) error
+		ApplySkuMatrix(ctx context.Context, in *SkuMatrixRequest, out *SkuMatrixResponse) error
+		CreateCategory(ctx context.Context, in *CreateCategoryRequest, out *CreateCategoryResponse) error
+		RenameCategory(ctx context.Context, in *RenameCategoryRequest, out *RenameCategoryResponse) error
+		MoveCategory(ctx context.Context, in *MoveCategoryRequest, out *MoveCategoryResponse) error
+		DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, out *DeleteCategoryResponse) error
+		GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, out *GetCategoryTreeResponse) error
+		ListCategorySkus(ctx context.Context, in *ListCategorySkusRequest, out *ListCategorySkusResponse) error
+		CreateBrand(ctx context.Context, in *CreateBrandRequest, out *CreateBrandResponse) error
+		UpdateBrand(ctx context.Context, in *UpdateBrandRequest, out *UpdateBrandResponse) error
+		GetBrand(ctx context.Context, in *GetBrandRequest, out *GetBrandResponse) error
+		ListBrands(ctx context.Context, in *ListBrandsRequest, out *ListBrandsResponse) error
+		DeleteBrand(ctx context.Context, in *DeleteBrandRequest, out *DeleteBrandResponse) error
+		ReassignBrandProducts(ctx context.Context, in *ReassignBrandProductsRequest, out *ReassignBrandProductsResponse) error
+		CreateSupplier(ctx context.Context, in *CreateSupplierRequest, out *CreateSupplierResponse) error
+		UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, out *UpdateSupplierResponse) error
+		GetSupplier(ctx context.Context, in *GetSupplierRequest, out *GetSupplierResponse) error
+		ListSuppliers(ctx context.Context, in *ListSuppliersRequest, out *ListSuppliersResponse) error
+		DeleteSupplier(ctx context.Context, in *DeleteSupplierRequest, out *DeleteSupplierResponse) error
+		LinkSupplierSku(ctx context.Context, in *LinkSupplierSkuRequest, out *LinkSupplierSkuResponse) error
+		UnlinkSupplierSku(ctx context.Context, in *UnlinkSupplierSkuRequest, out *UnlinkSupplierSkuResponse) error
+		ListSkusBySupplier(ctx context.Context, in *ListSkusBySupplierRequest, out *ListSkusBySupplierResponse) error
+		CreatePurchaseOrders(ctx context.Context, in *CreatePurchaseOrdersRequest, out *CreatePurchaseOrdersResponse) error
+		GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, out *GetPurchaseOrderResponse) error
+		ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, out *ListPurchaseOrdersResponse) error
+		ReceiveGoods(ctx context.Context, in *ReceiveGoodsRequest, out *ReceiveGoodsResponse) error
+		AdjustStock(ctx context.Context, in *AdjustStockRequest, out *AdjustStockResponse) error
+		SubmitStocktake(ctx context.Context, in *SubmitStocktakeRequest, out *SubmitStocktakeResponse) error
+		ListStockChanges(ctx context.Context, in *ListStockChangesRequest, out *ListStockChangesResponse) error
+		GetStockAtTime(ctx context.Context, in *GetStockAtTimeRequest, out *GetStockAtTimeResponse) error
+		GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, out *GetReconciliationReportResponse) error
+		CorrectStockLedger(ctx context.Context, in *CorrectStockLedgerRequest, out *CorrectStockLedgerResponse) error
+		CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, out *CreateWarehouseResponse) error
+		ListWarehouses(ctx context.Context, in *ListWarehousesRequest, out *ListWarehousesResponse) error
+		SetSkuWarehouseStock(ctx context.Context, in *SetSkuWarehouseStockRequest, out *SetSkuWarehouseStockResponse) error
+		GetSkuWarehouseStock(ctx context.Context, in *GetSkuWarehouseStockRequest, out *GetSkuWarehouseStockResponse) error
+		SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, out *SchedulePriceChangeResponse) error
+		GetSkuPriceAt(ctx context.Context, in *GetSkuPriceAtRequest, out *GetSkuPriceAtResponse) error
+		ListSkuPriceHistory(ctx context.Context, in *ListSkuPriceHistoryRequest, out *ListSkuPriceHistoryResponse) error
+		PublishProduct(ctx context.Context, in *PublishProductRequest, out *PublishProductResponse) error
+		UnpublishProduct(ctx context.Context, in *UnpublishProductRequest, out *UnpublishProductResponse) error
+		UnpublishSku(ctx context.Context, in *UnpublishSkuRequest, out *UnpublishSkuResponse) error
+		ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, out *ArchiveProductResponse) error
+		ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, out *ListDeadLettersResponse) error
+		GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, out *GetDeadLetterResponse) error
+		ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, out *ReplayDeadLettersResponse) error
+		ResolveDeadLetters(ctx context.Context, in *ResolveDeadLettersRequest, out *ResolveDeadLettersResponse) error
 	}
 	type Product struct {
 		product
@@ -218,6 +1011,250 @@ func (h *productHandler) GetRestockApplyInfo(ctx context.Context, in *GetRestock
 	return h.ProductHandler.GetRestockApplyInfo(ctx, in, out)
 }
 
+func (h *productHandler) ApproveRestockApply(ctx context.Context, in *ApproveRestockApplyRequest, out *ApproveRestockApplyResponse) error {
+	return h.ProductHandler.ApproveRestockApply(ctx, in, out)
+}
+
+func (h *productHandler) RejectRestockApply(ctx context.Context, in *RejectRestockApplyRequest, out *RejectRestockApplyResponse) error {
+	return h.ProductHandler.RejectRestockApply(ctx, in, out)
+}
+
 func (h *productHandler) GetSkuDailySales(ctx context.Context, in *GetSkuDailySalesRequest, out *GetSkuDailySalesResponse) error {
 	return h.ProductHandler.GetSkuDailySales(ctx, in, out)
 }
+
+func (h *productHandler) ReserveStock(ctx context.Context, in *ReserveStockRequest, out *ReserveStockResponse) error {
+	return h.ProductHandler.ReserveStock(ctx, in, out)
+}
+
+func (h *productHandler) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, out *ConfirmReservationResponse) error {
+	return h.ProductHandler.ConfirmReservation(ctx, in, out)
+}
+
+func (h *productHandler) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, out *ReleaseReservationResponse) error {
+	return h.ProductHandler.ReleaseReservation(ctx, in, out)
+}
+
+func (h *productHandler) TryDeductSku(ctx context.Context, in *TccDeductSkuRequest, out *TccDeductSkuResponse) error {
+	return h.ProductHandler.TryDeductSku(ctx, in, out)
+}
+
+func (h *productHandler) ConfirmDeductSku(ctx context.Context, in *TccDeductSkuRequest, out *TccDeductSkuResponse) error {
+	return h.ProductHandler.ConfirmDeductSku(ctx, in, out)
+}
+
+func (h *productHandler) CancelDeductSku(ctx context.Context, in *TccDeductSkuRequest, out *TccDeductSkuResponse) error {
+	return h.ProductHandler.CancelDeductSku(ctx, in, out)
+}
+
+func (h *productHandler) CreateProduct(ctx context.Context, in *CreateProductRequest, out *CreateProductResponse) error {
+	return h.ProductHandler.CreateProduct(ctx, in, out)
+}
+
+func (h *productHandler) CreateProductWithSkus(ctx context.Context, in *CreateProductWithSkusRequest, out *CreateProductWithSkusResponse) error {
+	return h.ProductHandler.CreateProductWithSkus(ctx, in, out)
+}
+
+func (h *productHandler) UpdateProduct(ctx context.Context, in *UpdateProductRequest, out *UpdateProductResponse) error {
+	return h.ProductHandler.UpdateProduct(ctx, in, out)
+}
+
+func (h *productHandler) GetProduct(ctx context.Context, in *GetProductRequest, out *GetProductResponse) error {
+	return h.ProductHandler.GetProduct(ctx, in, out)
+}
+
+func (h *productHandler) ListProducts(ctx context.Context, in *ListProductsRequest, out *ListProductsResponse) error {
+	return h.ProductHandler.ListProducts(ctx, in, out)
+}
+
+func (h *productHandler) DeleteProduct(ctx context.Context, in *DeleteProductRequest, out *DeleteProductResponse) error {
+	return h.ProductHandler.DeleteProduct(ctx, in, out)
+}
+
+func (h *productHandler) PreviewSkuMatrix(ctx context.Context, in *SkuMatrixRequest, out *SkuMatrixResponse) error {
+	return h.ProductHandler.PreviewSkuMatrix(ctx, in, out)
+}
+
+func (h *productHandler) ApplySkuMatrix(ctx context.Context, in *SkuMatrixRequest, out *SkuMatrixResponse) error {
+	return h.ProductHandler.ApplySkuMatrix(ctx, in, out)
+}
+
+func (h *productHandler) CreateCategory(ctx context.Context, in *CreateCategoryRequest, out *CreateCategoryResponse) error {
+	return h.ProductHandler.CreateCategory(ctx, in, out)
+}
+
+func (h *productHandler) RenameCategory(ctx context.Context, in *RenameCategoryRequest, out *RenameCategoryResponse) error {
+	return h.ProductHandler.RenameCategory(ctx, in, out)
+}
+
+func (h *productHandler) MoveCategory(ctx context.Context, in *MoveCategoryRequest, out *MoveCategoryResponse) error {
+	return h.ProductHandler.MoveCategory(ctx, in, out)
+}
+
+func (h *productHandler) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, out *DeleteCategoryResponse) error {
+	return h.ProductHandler.DeleteCategory(ctx, in, out)
+}
+
+func (h *productHandler) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, out *GetCategoryTreeResponse) error {
+	return h.ProductHandler.GetCategoryTree(ctx, in, out)
+}
+
+func (h *productHandler) ListCategorySkus(ctx context.Context, in *ListCategorySkusRequest, out *ListCategorySkusResponse) error {
+	return h.ProductHandler.ListCategorySkus(ctx, in, out)
+}
+
+func (h *productHandler) CreateBrand(ctx context.Context, in *CreateBrandRequest, out *CreateBrandResponse) error {
+	return h.ProductHandler.CreateBrand(ctx, in, out)
+}
+
+func (h *productHandler) UpdateBrand(ctx context.Context, in *UpdateBrandRequest, out *UpdateBrandResponse) error {
+	return h.ProductHandler.UpdateBrand(ctx, in, out)
+}
+
+func (h *productHandler) GetBrand(ctx context.Context, in *GetBrandRequest, out *GetBrandResponse) error {
+	return h.ProductHandler.GetBrand(ctx, in, out)
+}
+
+func (h *productHandler) ListBrands(ctx context.Context, in *ListBrandsRequest, out *ListBrandsResponse) error {
+	return h.ProductHandler.ListBrands(ctx, in, out)
+}
+
+func (h *productHandler) DeleteBrand(ctx context.Context, in *DeleteBrandRequest, out *DeleteBrandResponse) error {
+	return h.ProductHandler.DeleteBrand(ctx, in, out)
+}
+
+func (h *productHandler) ReassignBrandProducts(ctx context.Context, in *ReassignBrandProductsRequest, out *ReassignBrandProductsResponse) error {
+	return h.ProductHandler.ReassignBrandProducts(ctx, in, out)
+}
+
+func (h *productHandler) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, out *CreateSupplierResponse) error {
+	return h.ProductHandler.CreateSupplier(ctx, in, out)
+}
+
+func (h *productHandler) UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, out *UpdateSupplierResponse) error {
+	return h.ProductHandler.UpdateSupplier(ctx, in, out)
+}
+
+func (h *productHandler) GetSupplier(ctx context.Context, in *GetSupplierRequest, out *GetSupplierResponse) error {
+	return h.ProductHandler.GetSupplier(ctx, in, out)
+}
+
+func (h *productHandler) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, out *ListSuppliersResponse) error {
+	return h.ProductHandler.ListSuppliers(ctx, in, out)
+}
+
+func (h *productHandler) DeleteSupplier(ctx context.Context, in *DeleteSupplierRequest, out *DeleteSupplierResponse) error {
+	return h.ProductHandler.DeleteSupplier(ctx, in, out)
+}
+
+func (h *productHandler) LinkSupplierSku(ctx context.Context, in *LinkSupplierSkuRequest, out *LinkSupplierSkuResponse) error {
+	return h.ProductHandler.LinkSupplierSku(ctx, in, out)
+}
+
+func (h *productHandler) UnlinkSupplierSku(ctx context.Context, in *UnlinkSupplierSkuRequest, out *UnlinkSupplierSkuResponse) error {
+	return h.ProductHandler.UnlinkSupplierSku(ctx, in, out)
+}
+
+func (h *productHandler) ListSkusBySupplier(ctx context.Context, in *ListSkusBySupplierRequest, out *ListSkusBySupplierResponse) error {
+	return h.ProductHandler.ListSkusBySupplier(ctx, in, out)
+}
+
+func (h *productHandler) CreatePurchaseOrders(ctx context.Context, in *CreatePurchaseOrdersRequest, out *CreatePurchaseOrdersResponse) error {
+	return h.ProductHandler.CreatePurchaseOrders(ctx, in, out)
+}
+
+func (h *productHandler) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, out *GetPurchaseOrderResponse) error {
+	return h.ProductHandler.GetPurchaseOrder(ctx, in, out)
+}
+
+func (h *productHandler) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, out *ListPurchaseOrdersResponse) error {
+	return h.ProductHandler.ListPurchaseOrders(ctx, in, out)
+}
+
+func (h *productHandler) ReceiveGoods(ctx context.Context, in *ReceiveGoodsRequest, out *ReceiveGoodsResponse) error {
+	return h.ProductHandler.ReceiveGoods(ctx, in, out)
+}
+
+func (h *productHandler) AdjustStock(ctx context.Context, in *AdjustStockRequest, out *AdjustStockResponse) error {
+	return h.ProductHandler.AdjustStock(ctx, in, out)
+}
+
+func (h *productHandler) SubmitStocktake(ctx context.Context, in *SubmitStocktakeRequest, out *SubmitStocktakeResponse) error {
+	return h.ProductHandler.SubmitStocktake(ctx, in, out)
+}
+
+func (h *productHandler) ListStockChanges(ctx context.Context, in *ListStockChangesRequest, out *ListStockChangesResponse) error {
+	return h.ProductHandler.ListStockChanges(ctx, in, out)
+}
+
+func (h *productHandler) GetStockAtTime(ctx context.Context, in *GetStockAtTimeRequest, out *GetStockAtTimeResponse) error {
+	return h.ProductHandler.GetStockAtTime(ctx, in, out)
+}
+
+func (h *productHandler) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, out *GetReconciliationReportResponse) error {
+	return h.ProductHandler.GetReconciliationReport(ctx, in, out)
+}
+
+func (h *productHandler) CorrectStockLedger(ctx context.Context, in *CorrectStockLedgerRequest, out *CorrectStockLedgerResponse) error {
+	return h.ProductHandler.CorrectStockLedger(ctx, in, out)
+}
+
+func (h *productHandler) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, out *CreateWarehouseResponse) error {
+	return h.ProductHandler.CreateWarehouse(ctx, in, out)
+}
+
+func (h *productHandler) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, out *ListWarehousesResponse) error {
+	return h.ProductHandler.ListWarehouses(ctx, in, out)
+}
+
+func (h *productHandler) SetSkuWarehouseStock(ctx context.Context, in *SetSkuWarehouseStockRequest, out *SetSkuWarehouseStockResponse) error {
+	return h.ProductHandler.SetSkuWarehouseStock(ctx, in, out)
+}
+
+func (h *productHandler) GetSkuWarehouseStock(ctx context.Context, in *GetSkuWarehouseStockRequest, out *GetSkuWarehouseStockResponse) error {
+	return h.ProductHandler.GetSkuWarehouseStock(ctx, in, out)
+}
+
+func (h *productHandler) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, out *SchedulePriceChangeResponse) error {
+	return h.ProductHandler.SchedulePriceChange(ctx, in, out)
+}
+
+func (h *productHandler) GetSkuPriceAt(ctx context.Context, in *GetSkuPriceAtRequest, out *GetSkuPriceAtResponse) error {
+	return h.ProductHandler.GetSkuPriceAt(ctx, in, out)
+}
+
+func (h *productHandler) ListSkuPriceHistory(ctx context.Context, in *ListSkuPriceHistoryRequest, out *ListSkuPriceHistoryResponse) error {
+	return h.ProductHandler.ListSkuPriceHistory(ctx, in, out)
+}
+
+func (h *productHandler) PublishProduct(ctx context.Context, in *PublishProductRequest, out *PublishProductResponse) error {
+	return h.ProductHandler.PublishProduct(ctx, in, out)
+}
+
+func (h *productHandler) UnpublishProduct(ctx context.Context, in *UnpublishProductRequest, out *UnpublishProductResponse) error {
+	return h.ProductHandler.UnpublishProduct(ctx, in, out)
+}
+
+func (h *productHandler) UnpublishSku(ctx context.Context, in *UnpublishSkuRequest, out *UnpublishSkuResponse) error {
+	return h.ProductHandler.UnpublishSku(ctx, in, out)
+}
+
+func (h *productHandler) ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, out *ArchiveProductResponse) error {
+	return h.ProductHandler.ArchiveProduct(ctx, in, out)
+}
+
+func (h *productHandler) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, out *ListDeadLettersResponse) error {
+	return h.ProductHandler.ListDeadLetters(ctx, in, out)
+}
+
+func (h *productHandler) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, out *GetDeadLetterResponse) error {
+	return h.ProductHandler.GetDeadLetter(ctx, in, out)
+}
+
+func (h *productHandler) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, out *ReplayDeadLettersResponse) error {
+	return h.ProductHandler.ReplayDeadLetters(ctx, in, out)
+}
+
+func (h *productHandler) ResolveDeadLetters(ctx context.Context, in *ResolveDeadLettersRequest, out *ResolveDeadLettersResponse) error {
+	return h.ProductHandler.ResolveDeadLetters(ctx, in, out)
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryBrandRepo 内存中的品牌仓储
+type memoryBrandRepo struct {
+	repository.BrandRepository
+	brands  map[int64]*model.ProductBrand
+	stats   []repository.BrandProductStat
+	deleted []int64
+}
+
+func (r *memoryBrandRepo) FindByID(ctx context.Context, id int64) (*model.ProductBrand, error) {
+	if brand, ok := r.brands[id]; ok {
+		copied := *brand
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryBrandRepo) List(ctx context.Context, keyword string, offset, limit int) ([]model.ProductBrand, int64, error) {
+	result := make([]model.ProductBrand, 0, len(r.brands))
+	for id := int64(1); id <= int64(len(r.brands)); id++ {
+		result = append(result, *r.brands[id])
+	}
+	return result, int64(len(result)), nil
+}
+
+func (r *memoryBrandRepo) CountActiveProducts(ctx context.Context, brandIDs []int64) ([]repository.BrandProductStat, error) {
+	return r.stats, nil
+}
+
+func (r *memoryBrandRepo) Delete(ctx context.Context, id int64) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+// brandProductRepo 按品牌统计商品数量的商品仓储
+type brandProductRepo struct {
+	repository.IProductRepository
+	counts map[int64]int64
+}
+
+func (r *brandProductRepo) CountByBrandId(ctx context.Context, brandId int64) (int64, error) {
+	return r.counts[brandId], nil
+}
+
+func newBrandFixture() (*memoryBrandRepo, *brandProductRepo) {
+	brandRepo := &memoryBrandRepo{
+		brands: map[int64]*model.ProductBrand{
+			1: {ID: 1, BrandName: "A"},
+			2: {ID: 2, BrandName: "B"},
+		},
+		stats: []repository.BrandProductStat{{BrandID: 1, ProductCount: 2, SkuCount: 5}},
+	}
+	return brandRepo, &brandProductRepo{counts: map[int64]int64{1: 3}}
+}
+
+// TestBrand_ListFillsCounts 没有上架商品的品牌数量为0
+func TestBrand_ListFillsCounts(t *testing.T) {
+	brandRepo, productRepo := newBrandFixture()
+	svc := service.NewBrandService(brandRepo, productRepo)
+
+	brands, total, err := svc.ListBrands(context.Background(), "", 1, 10)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if total != 2 || len(brands) != 2 {
+		t.Fatalf("expected 2 brands, got %d", len(brands))
+	}
+	if brands[0].ActiveProductCount != 2 || brands[0].ActiveSkuCount != 5 {
+		t.Errorf("unexpected counts of brand 1: %+v", brands[0])
+	}
+	if brands[1].ActiveProductCount != 0 || brands[1].ActiveSkuCount != 0 {
+		t.Errorf("unexpected counts of brand 2: %+v", brands[1])
+	}
+}
+
+// TestBrand_DeleteReferencedBrand 被商品引用的品牌不能删除，包括下架的商品
+func TestBrand_DeleteReferencedBrand(t *testing.T) {
+	brandRepo, productRepo := newBrandFixture()
+	svc := service.NewBrandService(brandRepo, productRepo)
+
+	if err := svc.DeleteBrand(context.Background(), 1); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	if err := svc.DeleteBrand(context.Background(), 2); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if len(brandRepo.deleted) != 1 || brandRepo.deleted[0] != 2 {
+		t.Errorf("unexpected deleted brands: %v", brandRepo.deleted)
+	}
+}
@@ -0,0 +1,394 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/zhanshen02154/product/internal/config"
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/client"
+)
+
+// ackRecorder 记录发布回调
+type ackRecorder struct {
+	mu   sync.Mutex
+	acks []*event2.PublishAck
+}
+
+func (r *ackRecorder) wrapper(next event2.PublishCallbackFunc) event2.PublishCallbackFunc {
+	return func(ctx context.Context, msg *broker.Message, err error) {
+		r.mu.Lock()
+		r.acks = append(r.acks, &event2.PublishAck{Message: msg, Err: err})
+		r.mu.Unlock()
+		next(ctx, msg, err)
+	}
+}
+
+func (r *ackRecorder) wait(t *testing.T, n int) []*event2.PublishAck {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		if len(r.acks) >= n {
+			acks := append([]*event2.PublishAck(nil), r.acks...)
+			r.mu.Unlock()
+			return acks
+		}
+		r.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("expected %d publish callbacks", n)
+	return nil
+}
+
+func TestNewBroker_MemoryDriverFeedsCallbacks(t *testing.T) {
+	b, ackOption, err := infrastructure.NewBroker(&config.Broker{Driver: infrastructure.BrokerDriverMemory, Memory: &config.Memory{ChannelBufferSize: 16}})
+	if err != nil {
+		t.Fatalf("new broker failed: %v", err)
+	}
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+
+	recorder := &ackRecorder{}
+	listener := event2.NewListener(ackOption, event2.WrapPublishCallback(recorder.wrapper))
+	listener.Start()
+	defer listener.Close()
+	listener.Register("ProductEvent", client.NewClient(client.Broker(b)))
+
+	if err := listener.Publish(context.Background(), "ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "sku-1", "OnSkuCreated"); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	acks := recorder.wait(t, 1)
+	if acks[0].Err != nil || acks[0].Message.Header["Event-Type"] != "OnSkuCreated" {
+		t.Fatalf("unexpected publish callback: %+v", acks[0])
+	}
+}
+
+func TestListener_PublishAcks(t *testing.T) {
+	acks := make(chan *event2.PublishAck, 2)
+	recorder := &ackRecorder{}
+	listener := event2.NewListener(event2.WithPublishAcks(acks), event2.WrapPublishCallback(recorder.wrapper))
+	listener.Start()
+	defer listener.Close()
+
+	acks <- &event2.PublishAck{Message: &broker.Message{Header: map[string]string{"Micro-Topic": "ProductEvent"}}, Partition: -1, Offset: 7}
+	acks <- &event2.PublishAck{Message: &broker.Message{Header: map[string]string{"Micro-Topic": "ProductEvent"}}, Partition: -1, Offset: -1, Err: errors.New("nack")}
+
+	got := recorder.wait(t, 2)
+	if got[0].Err != nil || got[1].Err == nil {
+		t.Fatalf("unexpected publish callbacks: %+v", got)
+	}
+}
+
+func TestNewBroker_UnsupportedDriver(t *testing.T) {
+	for _, conf := range []*config.Broker{
+		{Driver: "unknown"},
+		{Driver: infrastructure.BrokerDriverNats},
+		{Driver: infrastructure.BrokerDriverRabbitMQ, RabbitMQ: &config.RabbitMQ{}},
+	} {
+		if _, _, err := infrastructure.NewBroker(conf); err == nil {
+			t.Fatalf("expected error for driver %s", conf.Driver)
+		}
+	}
+}
+
+func TestNewBroker_NatsDriverFeedsCallbacks(t *testing.T) {
+	url := natsTestServer(t)
+	b, ackOption, err := infrastructure.NewBroker(&config.Broker{
+		Driver: infrastructure.BrokerDriverNats,
+		Nats:   &config.Nats{Hosts: []string{url}, AckBufferSize: 16, MaxProcessingTime: 30000},
+	})
+	if err != nil {
+		t.Fatalf("new broker failed: %v", err)
+	}
+	assertDriverFeedsCallbacks(t, b, ackOption, natsTestTopic(t, url))
+}
+
+func TestNewBroker_RabbitMQDriverFeedsCallbacks(t *testing.T) {
+	url := rabbitmqTestServer(t)
+	b, ackOption, err := infrastructure.NewBroker(&config.Broker{
+		Driver:   infrastructure.BrokerDriverRabbitMQ,
+		RabbitMQ: &config.RabbitMQ{Url: url, Exchange: "product", PrefetchCount: 10, AckBufferSize: 16, MaxProcessingTime: 1800000},
+	})
+	if err != nil {
+		t.Fatalf("new broker failed: %v", err)
+	}
+	assertDriverFeedsCallbacks(t, b, ackOption, rabbitmqTestTopic(t, url, "product"))
+}
+
+func TestNatsBroker_RedeliversFailedMessage(t *testing.T) {
+	url := natsTestServer(t)
+	assertDriverRedelivers(t, infrastructure.NewNatsBroker(broker.Addrs(url)), natsTestTopic(t, url))
+}
+
+func TestRabbitMQBroker_RedeliversFailedMessage(t *testing.T) {
+	url := rabbitmqTestServer(t)
+	assertDriverRedelivers(t, infrastructure.NewRabbitMQBroker(broker.Addrs(url)), rabbitmqTestTopic(t, url, "product"))
+}
+
+func TestNatsBroker_DisconnectUnblocksPublish(t *testing.T) {
+	url := natsTestServer(t)
+	acks := make(chan *event2.PublishAck)
+	assertDisconnectUnblocksPublish(t, infrastructure.NewNatsBroker(broker.Addrs(url), infrastructure.NatsPublishAcks(acks)), natsTestTopic(t, url))
+}
+
+func TestRabbitMQBroker_DisconnectUnblocksPublish(t *testing.T) {
+	url := rabbitmqTestServer(t)
+	acks := make(chan *event2.PublishAck)
+	assertDisconnectUnblocksPublish(t, infrastructure.NewRabbitMQBroker(broker.Addrs(url), infrastructure.RabbitMQPublishAcks(acks)), rabbitmqTestTopic(t, url, "product"))
+}
+
+// natsTestServer 返回PRODUCT_TEST_NATS_URL指向的开启JetStream的NATS服务，未配置或不可用时跳过
+func natsTestServer(t *testing.T) string {
+	t.Helper()
+	url := os.Getenv("PRODUCT_TEST_NATS_URL")
+	if url == "" {
+		t.Skip("PRODUCT_TEST_NATS_URL not set")
+	}
+	conn, err := nats.Connect(url, nats.Timeout(2*time.Second))
+	if err != nil {
+		t.Skipf("nats server unavailable: %v", err)
+	}
+	defer conn.Close()
+	js, err := conn.JetStream()
+	if err == nil {
+		_, err = js.AccountInfo()
+	}
+	if err != nil {
+		t.Skipf("nats jetstream unavailable: %v", err)
+	}
+	return url
+}
+
+// natsTestTopic 生成本次测试独占的主题，测试结束后删除对应的流
+func natsTestTopic(t *testing.T, url string) string {
+	t.Helper()
+	topic := "ProductEvent" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	t.Cleanup(func() {
+		conn, err := nats.Connect(url)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if js, err := conn.JetStream(); err == nil {
+			_ = js.DeleteStream(topic)
+		}
+	})
+	return topic
+}
+
+// rabbitmqTestServer 返回PRODUCT_TEST_RABBITMQ_URL指向的RabbitMQ服务，未配置或不可用时跳过
+func rabbitmqTestServer(t *testing.T) string {
+	t.Helper()
+	url := os.Getenv("PRODUCT_TEST_RABBITMQ_URL")
+	if url == "" {
+		t.Skip("PRODUCT_TEST_RABBITMQ_URL not set")
+	}
+	conn, err := amqp.DialConfig(url, amqp.Config{Dial: amqp.DefaultDial(2 * time.Second)})
+	if err != nil {
+		t.Skipf("rabbitmq server unavailable: %v", err)
+	}
+	_ = conn.Close()
+	return url
+}
+
+// rabbitmqTestTopic 生成本次测试独占的主题，测试结束后删除消费者组对应的持久化队列
+func rabbitmqTestTopic(t *testing.T, url, queue string) string {
+	t.Helper()
+	topic := "ProductEvent." + uuid.New().String()
+	t.Cleanup(func() {
+		conn, err := amqp.Dial(url)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if ch, err := conn.Channel(); err == nil {
+			_, _ = ch.QueueDelete(topic+"."+queue, false, false, false)
+			_ = ch.Close()
+		}
+	})
+	return topic
+}
+
+// assertDriverFeedsCallbacks 经侦听器发布，服务端的发布确认进入发布回调，订阅者收到消息并确认后不再重新投递
+func assertDriverFeedsCallbacks(t *testing.T, b broker.Broker, ackOption event2.Option, topic string) {
+	t.Helper()
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+
+	received := make(chan *broker.Message, 2)
+	sub, err := b.Subscribe(topic, func(e broker.Event) error {
+		received <- e.Message()
+		return nil
+	}, broker.Queue("product"))
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	recorder := &ackRecorder{}
+	listener := event2.NewListener(ackOption, event2.WrapPublishCallback(recorder.wrapper))
+	listener.Start()
+	defer listener.Close()
+	listener.Register(topic, client.NewClient(client.Broker(b)))
+
+	if err := listener.Publish(context.Background(), topic, &event.BaseEvent{EventType: "OnSkuCreated"}, "sku-1", "OnSkuCreated"); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	acks := recorder.wait(t, 1)
+	if acks[0].Err != nil || acks[0].Message.Header["Event-Type"] != "OnSkuCreated" {
+		t.Fatalf("unexpected publish callback: %+v", acks[0])
+	}
+	select {
+	case msg := <-received:
+		if msg.Header["Event-Type"] != "OnSkuCreated" || msg.Header["Micro-Topic"] != topic || msg.Header["Pkey"] != "sku-1" {
+			t.Fatalf("unexpected message header: %v", msg.Header)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected message to be delivered")
+	}
+	select {
+	case msg := <-received:
+		t.Fatalf("acked message redelivered: %v", msg.Header)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+// assertDriverRedelivers 没有ErrorHandler时处理失败的消息由服务端重新投递
+func assertDriverRedelivers(t *testing.T, b broker.Broker, topic string) {
+	t.Helper()
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+
+	var mu sync.Mutex
+	attempts := 0
+	sub, err := b.Subscribe(topic, func(e broker.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			return errors.New("handler failed")
+		}
+		return nil
+	}, broker.Queue("product"))
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	if err := b.Publish(topic, &broker.Message{Header: map[string]string{"Event-Type": "OnPaymentSuccess"}, Body: []byte("{}")}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	waitFor(t, "redelivery", func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return attempts == 2
+	})
+}
+
+// waitFor 等待条件成立
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// assertDisconnectUnblocksPublish 回执无人读取时发布阻塞在待确认队列，Disconnect仍能返回并让阻塞的发布失败
+func assertDisconnectUnblocksPublish(t *testing.T, b broker.Broker, topic string) {
+	t.Helper()
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+
+	blocked := make(chan error, 1)
+	go func() {
+		for {
+			if err := b.Publish(topic, &broker.Message{Header: map[string]string{"Event-Type": "OnSkuCreated"}, Body: []byte("{}")}); err != nil {
+				blocked <- err
+				return
+			}
+		}
+	}()
+	// 待确认队列写满后发布阻塞
+	time.Sleep(500 * time.Millisecond)
+
+	disconnected := make(chan error, 1)
+	go func() {
+		disconnected <- b.Disconnect()
+	}()
+	select {
+	case err := <-disconnected:
+		if err != nil {
+			t.Fatalf("disconnect failed: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("disconnect blocked by pending publish")
+	}
+	select {
+	case err := <-blocked:
+		if err == nil {
+			t.Fatal("expected blocked publish to fail")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected blocked publish to return after disconnect")
+	}
+}
+
+// brokerSysConfig 只配置指定驱动的最小配置
+func brokerSysConfig(b *config.Broker) *config.SysConfig {
+	return &config.SysConfig{
+		Service:  &config.ServiceInfo{},
+		Consul:   &config.ConsulInfo{RegistryAddrs: []string{"127.0.0.1:8500"}},
+		Database: &config.MySqlConfig{},
+		Redis:    &config.Redis{Addr: "127.0.0.1:6379"},
+		Broker:   b,
+	}
+}
+
+func TestCheckConfig_BrokerDriversWithoutKafka(t *testing.T) {
+	memory := brokerSysConfig(&config.Broker{Driver: "memory", SubscribeSlowThreshold: 1000})
+	if err := memory.CheckConfig(); err != nil {
+		t.Fatalf("memory config rejected: %v", err)
+	}
+	if memory.Broker.Memory == nil || memory.Broker.Memory.ChannelBufferSize != 1024 {
+		t.Fatalf("unexpected memory defaults: %+v", memory.Broker.Memory)
+	}
+
+	nats := brokerSysConfig(&config.Broker{Driver: "nats", SubscribeSlowThreshold: 1000, Nats: &config.Nats{Hosts: []string{"nats://127.0.0.1:4222"}}})
+	if err := nats.CheckConfig(); err != nil {
+		t.Fatalf("nats config rejected: %v", err)
+	}
+	if nats.Broker.Nats.MaxProcessingTime != 30000 {
+		t.Fatalf("unexpected nats max_processing_time %d", nats.Broker.Nats.MaxProcessingTime)
+	}
+
+	rabbitmq := brokerSysConfig(&config.Broker{Driver: "rabbitmq", SubscribeSlowThreshold: 1000, RabbitMQ: &config.RabbitMQ{Url: "amqp://127.0.0.1:5672", MaxProcessingTime: 500}})
+	if err := rabbitmq.CheckConfig(); err == nil {
+		t.Fatal("expected subscribe_slow_threshold above rabbitmq.max_processing_time to be rejected")
+	}
+
+	kafka := brokerSysConfig(&config.Broker{Driver: "kafka", SubscribeSlowThreshold: 1000})
+	if err := kafka.CheckConfig(); err == nil {
+		t.Fatal("expected kafka driver without kafka config to be rejected")
+	}
+}
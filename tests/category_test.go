@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryCategoryRepo 内存中的分类仓储
+type memoryCategoryRepo struct {
+	repository.ProductCategoryRepository
+	categories map[int64]*model.ProductCategory
+}
+
+func newMemoryCategoryRepo(categories ...model.ProductCategory) *memoryCategoryRepo {
+	repo := &memoryCategoryRepo{categories: make(map[int64]*model.ProductCategory)}
+	for i := range categories {
+		repo.categories[categories[i].ID] = &categories[i]
+	}
+	return repo
+}
+
+func (r *memoryCategoryRepo) FindByID(ctx context.Context, id int64) (*model.ProductCategory, error) {
+	if category, ok := r.categories[id]; ok {
+		copied := *category
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryCategoryRepo) FindAll(ctx context.Context) ([]model.ProductCategory, error) {
+	result := make([]model.ProductCategory, 0, len(r.categories))
+	for _, category := range r.categories {
+		result = append(result, *category)
+	}
+	return result, nil
+}
+
+func (r *memoryCategoryRepo) ExistsSiblingName(ctx context.Context, parentID int64, name string, excludeID int64) (bool, error) {
+	for _, category := range r.categories {
+		if int64(category.ParentID) == parentID && category.CategoryName == name && category.ID != excludeID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryCategoryRepo) UpdateParent(ctx context.Context, id int64, parentID int64, level int) error {
+	r.categories[id].ParentID = uint(parentID)
+	r.categories[id].Level = level
+	return nil
+}
+
+func (r *memoryCategoryRepo) ShiftLevel(ctx context.Context, ids []int64, delta int) error {
+	for _, id := range ids {
+		r.categories[id].Level += delta
+	}
+	return nil
+}
+
+// newCategoryFixture 服装(1) > 上衣(2) > T恤(3)，数码(4)
+func newCategoryFixture() *memoryCategoryRepo {
+	return newMemoryCategoryRepo(
+		model.ProductCategory{ID: 1, CategoryName: "服装", ParentID: 0, Level: 1},
+		model.ProductCategory{ID: 2, CategoryName: "上衣", ParentID: 1, Level: 2},
+		model.ProductCategory{ID: 3, CategoryName: "T恤", ParentID: 2, Level: 3},
+		model.ProductCategory{ID: 4, CategoryName: "数码", ParentID: 0, Level: 1},
+	)
+}
+
+// TestCategory_MoveRejectsCycle 不能移动到自身的后代下
+func TestCategory_MoveRejectsCycle(t *testing.T) {
+	repo := newCategoryFixture()
+	svc := service.NewCategoryService(repo, nil, nil)
+
+	_, err := svc.MoveCategory(context.Background(), 1, 3)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	if repo.categories[1].ParentID != 0 {
+		t.Error("category must not be moved")
+	}
+}
+
+// TestCategory_MoveRecomputesLevel 移动子树时重新计算后代层级
+func TestCategory_MoveRecomputesLevel(t *testing.T) {
+	repo := newCategoryFixture()
+	svc := service.NewCategoryService(repo, nil, nil)
+
+	if _, err := svc.MoveCategory(context.Background(), 2, 0); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	if repo.categories[2].Level != 1 || repo.categories[3].Level != 2 {
+		t.Errorf("unexpected levels: %d, %d", repo.categories[2].Level, repo.categories[3].Level)
+	}
+
+	// 上衣(1) > T恤(2) 移动到 服装 > 数码 下会超过最大层级
+	if _, err := svc.MoveCategory(context.Background(), 4, 1); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	_, err := svc.MoveCategory(context.Background(), 2, 4)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when exceeding max level, got %v", err)
+	}
+}
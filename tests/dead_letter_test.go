@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/broker"
+)
+
+// memoryDeadLetterRepo 内存中的死信
+type memoryDeadLetterRepo struct {
+	repository.DeadLetterRepository
+	deadLetters []*model.DeadLetter
+}
+
+func (r *memoryDeadLetterRepo) Create(ctx context.Context, deadLetter *model.DeadLetter) (bool, error) {
+	for _, item := range r.deadLetters {
+		if item.EventId == deadLetter.EventId && item.DeadAt == deadLetter.DeadAt {
+			return false, nil
+		}
+	}
+	deadLetter.ID = int64(len(r.deadLetters) + 1)
+	r.deadLetters = append(r.deadLetters, deadLetter)
+	return true, nil
+}
+
+func TestDeadLetter_IndexAndReplay(t *testing.T) {
+	b := broker.NewMemoryBroker()
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+
+	repo := &memoryDeadLetterRepo{}
+	indexer := event.NewDeadLetterIndexer(repo, b, []string{"ProductEvent", "ProductEventDLQ", "OrderEvent"}, "test-indexer")
+	if err := indexer.Start(); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	defer indexer.Close(context.Background())
+
+	msg := &broker.Message{
+		Header: map[string]string{
+			"Event-Type":         "OnProductStatusChangedDLQ",
+			"Event_id":           "evt-1",
+			"Pkey":               "10",
+			"Timestamp":          "1700000001000",
+			"Micro-Topic":        "ProductEventDLQ",
+			"Content-Type":       "application/protobuf",
+			"Traceparent":        "00-trace",
+			"x-error":            "kafka: broker not available",
+			"x-origin-topic":     "ProductEvent",
+			"x-origin-timestamp": "1700000000000",
+		},
+		Body: []byte("payload"),
+	}
+	for i := 0; i < 2; i++ {
+		if err := b.Publish("ProductEventDLQ", msg); err != nil {
+			t.Fatalf("publish failed: %v", err)
+		}
+	}
+	if len(repo.deadLetters) != 1 {
+		t.Fatalf("expected duplicated dead letter to be indexed once, got %d", len(repo.deadLetters))
+	}
+	deadLetter := repo.deadLetters[0]
+	if deadLetter.OriginTopic != "ProductEvent" || deadLetter.EventType != "OnProductStatusChanged" || deadLetter.EventKey != "10" ||
+		deadLetter.OriginTimestamp != 1700000000000 || deadLetter.DeadAt != 1700000001000 || deadLetter.Status != model.DeadLetterStatusPending {
+		t.Fatalf("unexpected dead letter: %+v", deadLetter)
+	}
+
+	var replayed *broker.Message
+	if _, err := b.Subscribe("ProductEvent", func(e broker.Event) error {
+		replayed = e.Message()
+		return nil
+	}); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	if err := event.NewDeadLetterReplayer(event.WithBroker(b)).Replay(context.Background(), deadLetter); err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if replayed == nil {
+		t.Fatal("expected message replayed to origin topic")
+	}
+	if replayed.Header["Event-Type"] != "OnProductStatusChanged" || replayed.Header["Micro-Topic"] != "ProductEvent" || replayed.Header["Event_id"] != "evt-1" {
+		t.Errorf("unexpected replayed headers: %v", replayed.Header)
+	}
+	for _, key := range []string{"x-error", "x-origin-topic", "x-origin-timestamp", "Traceparent"} {
+		if _, ok := replayed.Header[key]; ok {
+			t.Errorf("expected header %s to be stripped", key)
+		}
+	}
+	if string(replayed.Body) != "payload" {
+		t.Errorf("unexpected replayed body: %s", replayed.Body)
+	}
+}
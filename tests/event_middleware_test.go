@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/config"
+	"github.com/zhanshen02154/product/internal/domain/event"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"github.com/zhanshen02154/product/internal/infrastructure/retry"
+	"go-micro.dev/v4/metadata"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordMiddleware 记录中间件的执行顺序
+func recordMiddleware(name string, calls *[]string) event2.HandlerMiddleware {
+	return func(next event2.EventHandler) event2.EventHandler {
+		return event2.NewHandlerAdapter(next.EventType(), func(ctx context.Context, e *event.BaseEvent) error {
+			*calls = append(*calls, name)
+			return next.Handle(ctx, e)
+		})
+	}
+}
+
+func newTestRetryPolicy(maxRetries uint64) retry.Policy {
+	conf := &config.KafkaConsumer{}
+	conf.Retry.MaxRetries = maxRetries
+	return retry.NewRetryPolicy(retry.WithKafkaConsumerConfig(conf), retry.WithLogger(zap.NewNop()))
+}
+
+func TestEventMiddleware_RegisterOptions(t *testing.T) {
+	var calls []string
+	dispatcher := event2.NewEventDispatcher()
+	dispatcher.Use(recordMiddleware("global", &calls))
+
+	noop := func(ctx context.Context, e *event.BaseEvent) error { return nil }
+	if err := dispatcher.RegisterHandler(event2.NewHandlerAdapter("OnPaymentSuccess", noop), "OrderEvent", "test-consumer",
+		event2.WithMiddlewares(recordMiddleware("extra", &calls))); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+	if err := dispatcher.RegisterHandler(event2.NewHandlerAdapter("OnOrderCancelled", noop), "OrderEvent", "test-consumer",
+		event2.OverrideMiddlewares(recordMiddleware("override", &calls))); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := dispatcher.Dispatch(ctx, &event.BaseEvent{EventType: "OnPaymentSuccess"}); err != nil {
+		t.Fatalf("dispatch failed: %v", err)
+	}
+	if err := dispatcher.Dispatch(ctx, &event.BaseEvent{EventType: "OnOrderCancelled"}); err != nil {
+		t.Fatalf("dispatch failed: %v", err)
+	}
+	expected := []string{"global", "extra", "override"}
+	if len(calls) != len(expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Fatalf("expected calls %v, got %v", expected, calls)
+		}
+	}
+}
+
+func TestEventMiddleware_RecoveryAndTimeout(t *testing.T) {
+	dispatcher := event2.NewEventDispatcher()
+	dispatcher.Use(
+		event2.NewRecoveryMiddleware(),
+		event2.NewTimeoutMiddleware(time.Second, map[string]time.Duration{"OnOrderCancelled": 10 * time.Millisecond}),
+	)
+	if err := dispatcher.RegisterHandler(event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		panic("boom")
+	}), "OrderEvent", "test-consumer"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+	if err := dispatcher.RegisterHandler(event2.NewHandlerAdapter("OnOrderCancelled", func(ctx context.Context, e *event.BaseEvent) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}), "OrderEvent", "test-consumer"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	if err := dispatcher.Dispatch(context.Background(), &event.BaseEvent{EventType: "OnPaymentSuccess"}); err == nil {
+		t.Fatal("expected panic converted to error")
+	}
+	err := dispatcher.Dispatch(context.Background(), &event.BaseEvent{EventType: "OnOrderCancelled"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestEventMiddleware_Retry(t *testing.T) {
+	dispatcher := event2.NewEventDispatcher()
+	dispatcher.Use(event2.NewRetryMiddleware(newTestRetryPolicy(3)))
+
+	transientCalls := 0
+	if err := dispatcher.RegisterHandler(event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		transientCalls++
+		if transientCalls < 3 {
+			return status.Error(codes.Aborted, "lock conflict")
+		}
+		return nil
+	}), "OrderEvent", "test-consumer"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+	permanentCalls := 0
+	if err := dispatcher.RegisterHandler(event2.NewHandlerAdapter("OnOrderCancelled", func(ctx context.Context, e *event.BaseEvent) error {
+		permanentCalls++
+		return status.Error(codes.InvalidArgument, "invalid order")
+	}), "OrderEvent", "test-consumer"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	ctx := metadata.NewContext(context.Background(), metadata.Metadata{"Micro-Topic": "OrderEvent"})
+	if err := dispatcher.Dispatch(ctx, &event.BaseEvent{EventType: "OnPaymentSuccess"}); err != nil {
+		t.Fatalf("expected retry to succeed, got %v", err)
+	}
+	if transientCalls != 3 {
+		t.Fatalf("expected 3 calls, got %d", transientCalls)
+	}
+	if err := dispatcher.Dispatch(ctx, &event.BaseEvent{EventType: "OnOrderCancelled"}); err == nil {
+		t.Fatal("expected permanent error")
+	}
+	if permanentCalls != 1 {
+		t.Fatalf("expected permanent error not retried, got %d calls", permanentCalls)
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
+	"go-micro.dev/v4/metadata"
+)
+
+// memoryProcessedEventRepo 内存中的处理记录
+type memoryProcessedEventRepo struct {
+	repository.ProcessedEventRepository
+	processed map[string]bool
+}
+
+func (r *memoryProcessedEventRepo) Create(ctx context.Context, processedEvent *model.ProcessedEvent) (bool, error) {
+	key := processedEvent.EventId + "/" + processedEvent.Handler
+	if r.processed[key] {
+		return false, nil
+	}
+	r.processed[key] = true
+	return true, nil
+}
+
+// rollbackTxManager 出错时回滚处理记录
+type rollbackTxManager struct {
+	repo *memoryProcessedEventRepo
+}
+
+func (m *rollbackTxManager) Execute(ctx context.Context, fn func(txCtx context.Context) error) error {
+	snapshot := make(map[string]bool, len(m.repo.processed))
+	for k, v := range m.repo.processed {
+		snapshot[k] = v
+	}
+	if err := fn(ctx); err != nil {
+		m.repo.processed = snapshot
+		return err
+	}
+	return nil
+}
+
+func (m *rollbackTxManager) ExecuteWithBarrier(ctx context.Context, fn func(txCtx context.Context) error) error {
+	return m.Execute(ctx, fn)
+}
+
+func TestIdempotencyMiddleware_SkipsProcessedEvents(t *testing.T) {
+	repo := &memoryProcessedEventRepo{processed: map[string]bool{}}
+	dispatcher := event2.NewEventDispatcher()
+	dispatcher.Use(event2.NewIdempotencyMiddleware(repo, &rollbackTxManager{repo: repo}))
+
+	calls := 0
+	fail := true
+	handler := event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		calls++
+		if fail {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+	if err := dispatcher.RegisterHandler(handler, "OrderEvent", "test-consumer"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	ctx := metadata.NewContext(context.Background(), metadata.Metadata{"Event_id": "evt-1"})
+	baseEvent := &event.BaseEvent{EventType: "OnPaymentSuccess", Timestamp: time.Now().UnixMilli()}
+	if err := dispatcher.Dispatch(ctx, baseEvent); err == nil {
+		t.Fatal("expected handler error")
+	}
+	fail = false
+	for i := 0; i < 2; i++ {
+		if err := dispatcher.Dispatch(ctx, baseEvent); err != nil {
+			t.Fatalf("dispatch failed: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected failed event to be retried once and duplicate skipped, got %d calls", calls)
+	}
+
+	// 没有事件ID的事件不去重
+	for i := 0; i < 2; i++ {
+		if err := dispatcher.Dispatch(context.Background(), baseEvent); err != nil {
+			t.Fatalf("dispatch failed: %v", err)
+		}
+	}
+	if calls != 4 {
+		t.Fatalf("expected events without id to be handled every time, got %d calls", calls)
+	}
+}
+
+// TestIdempotencyMiddleware_ReleasesAfterOuterCommit 处理器的事务是幂等中间件事务内的保存点，
+// 处理器登记的锁释放须等到外层事务提交之后
+func TestIdempotencyMiddleware_ReleasesAfterOuterCommit(t *testing.T) {
+	txManager, _, mock := newBarrierTestManager(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := &memoryProcessedEventRepo{processed: map[string]bool{}}
+	dispatcher := event2.NewEventDispatcher()
+	dispatcher.Use(event2.NewIdempotencyMiddleware(repo, txManager))
+
+	released := false
+	handler := event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		registered := transaction.AfterCompletion(ctx, func() {
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("lock released before outer commit: %v", err)
+			}
+			released = true
+		})
+		if !registered {
+			t.Error("expected handler to run inside the idempotency transaction")
+		}
+		err := txManager.Execute(ctx, func(txCtx context.Context) error {
+			return nil
+		})
+		if released {
+			t.Error("lock released when the nested transaction ended")
+		}
+		return err
+	})
+	if err := dispatcher.RegisterHandler(handler, "OrderEvent", "test-consumer"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	ctx := metadata.NewContext(context.Background(), metadata.Metadata{"Event_id": "evt-1"})
+	if err := dispatcher.Dispatch(ctx, &event.BaseEvent{EventType: "OnPaymentSuccess"}); err != nil {
+		t.Fatalf("dispatch failed: %v", err)
+	}
+	if !released {
+		t.Fatal("expected lock to be released after the outer transaction")
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+)
+
+// reconcileSkuRepo SKU按ID升序保存
+type reconcileSkuRepo struct {
+	repository.ProductSkuRepository
+	skus []model.ProductSku
+}
+
+func (r *reconcileSkuRepo) ListSkuStockAfterID(ctx context.Context, afterId int64, limit int) ([]model.ProductSku, error) {
+	result := make([]model.ProductSku, 0, limit)
+	for _, sku := range r.skus {
+		if sku.ID > afterId && len(result) < limit {
+			result = append(result, sku)
+		}
+	}
+	return result, nil
+}
+
+func (r *reconcileSkuRepo) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	for _, sku := range r.skus {
+		if sku.ID == skuIDs[0] {
+			return []model.ProductSku{sku}, nil
+		}
+	}
+	return nil, nil
+}
+
+// reconcileLedgerRepo 按ID升序保存的流水
+type reconcileLedgerRepo struct {
+	repository.InventoryStockChangeRecordRepository
+	records []*model.InventoryStockChangeRecord
+}
+
+func (r *reconcileLedgerRepo) FindLatestBySkuIDs(ctx context.Context, skuIDs []int64) (map[int64]*model.InventoryStockChangeRecord, error) {
+	result := make(map[int64]*model.InventoryStockChangeRecord)
+	for _, record := range r.records {
+		for _, id := range skuIDs {
+			if record.SkuID == id {
+				result[id] = record
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r *reconcileLedgerRepo) BatchCreate(ctx context.Context, records []*model.InventoryStockChangeRecord) error {
+	for _, record := range records {
+		record.ID = int64(len(r.records) + 1)
+		r.records = append(r.records, record)
+	}
+	return nil
+}
+
+// TestInventoryReconciliation 按批对账，修正流水后再次对账一致
+func TestInventoryReconciliation(t *testing.T) {
+	skuRepo := &reconcileSkuRepo{skus: []model.ProductSku{{ID: 1, Stock: 8}, {ID: 2, Stock: 5}, {ID: 3, Stock: 4}}}
+	ledgerRepo := &reconcileLedgerRepo{records: []*model.InventoryStockChangeRecord{
+		{ID: 1, SkuID: 1, Quantity: 10, AfterStock: 10},
+		{ID: 2, SkuID: 2, Quantity: 5, AfterStock: 5},
+		{ID: 3, SkuID: 1, Quantity: -1, BeforeStock: 10, AfterStock: 9},
+	}}
+	svc := service.NewInventoryReconciliationService(skuRepo, ledgerRepo)
+
+	report, err := svc.Reconcile(context.Background(), 0, 2)
+	if err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+	if report.Checked != 2 || report.NextCursor != 2 || len(report.Mismatches) != 1 {
+		t.Fatalf("unexpected first batch: %+v", report)
+	}
+	mismatch := report.Mismatches[0]
+	if mismatch.SkuID != 1 || mismatch.LedgerStock != 9 || mismatch.Drift != -1 || mismatch.LastRecordID != 3 {
+		t.Errorf("unexpected mismatch: %+v", mismatch)
+	}
+	report, err = svc.Reconcile(context.Background(), report.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+	if report.Checked != 1 || report.Untracked != 1 || report.NextCursor != 0 {
+		t.Fatalf("unexpected last batch: %+v", report)
+	}
+
+	corrected, err := svc.Correct(context.Background(), 1, 7)
+	if err != nil {
+		t.Fatalf("correct failed: %v", err)
+	}
+	record := ledgerRepo.records[len(ledgerRepo.records)-1]
+	if corrected == nil || !corrected.Corrected || record.SourceType != model.SourceTypeManual || record.BeforeStock != 9 || record.AfterStock != 8 || record.OperatorID != 7 {
+		t.Fatalf("unexpected corrective record: %+v", record)
+	}
+	if corrected, err = svc.Correct(context.Background(), 1, 7); err != nil || corrected != nil {
+		t.Fatalf("expected nothing to correct, got %+v %v", corrected, err)
+	}
+	if corrected, err = svc.Correct(context.Background(), 3, 7); err != nil || corrected != nil {
+		t.Fatalf("expected untracked sku to be skipped, got %+v %v", corrected, err)
+	}
+}
@@ -0,0 +1,238 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/domain/event"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// manualBroker 由测试逐条投递消息并记录确认顺序
+type manualBroker struct {
+	broker.Broker
+	mu      sync.Mutex
+	handler broker.Handler
+	opts    broker.SubscribeOptions
+	acks    []string
+	failed  []string
+}
+
+func (b *manualBroker) Options() broker.Options {
+	return broker.Options{ErrorHandler: func(e broker.Event) error {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.failed = append(b.failed, e.Message().Header["Seq"]+":"+e.Error().Error())
+		return e.Ack()
+	}}
+}
+
+func (b *manualBroker) Subscribe(topic string, h broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	for _, o := range opts {
+		o(&b.opts)
+	}
+	b.handler = h
+	return &manualSubscriber{}, nil
+}
+
+func (b *manualBroker) deliver(t *testing.T, key string, seq string) {
+	b.deliverTo(t, "", key, seq)
+}
+
+// deliverTo 投递到指定分区，partition为空时不写分区消息头
+func (b *manualBroker) deliverTo(t *testing.T, partition, key, seq string) {
+	body, err := proto.Marshal(&event.BaseEvent{EventType: "OnPaymentSuccess"})
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	msg := &broker.Message{
+		Header: map[string]string{"Pkey": key, "Seq": seq, "Content-Type": "application/protobuf"},
+		Body:   body,
+	}
+	if partition != "" {
+		msg.Header[event2.PartitionHeader] = partition
+	}
+	if err := b.handler(&manualEvent{b: b, msg: msg}); err != nil {
+		t.Fatalf("handler failed: %v", err)
+	}
+}
+
+func (b *manualBroker) ackedSeqs() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.acks...)
+}
+
+type manualSubscriber struct {
+	broker.Subscriber
+}
+
+func (s *manualSubscriber) Unsubscribe() error {
+	return nil
+}
+
+type manualEvent struct {
+	b   *manualBroker
+	msg *broker.Message
+}
+
+func (e *manualEvent) Topic() string {
+	return "OrderEvent"
+}
+
+func (e *manualEvent) Message() *broker.Message {
+	return e.msg
+}
+
+func (e *manualEvent) Ack() error {
+	e.b.mu.Lock()
+	defer e.b.mu.Unlock()
+	e.b.acks = append(e.b.acks, e.msg.Header["Seq"])
+	return nil
+}
+
+func (e *manualEvent) Error() error {
+	return nil
+}
+
+func TestKeyedConsumer_OrderedPerKeyAndAckInOrder(t *testing.T) {
+	dispatcher := event2.NewEventDispatcher()
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var processed []string
+	handler := event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		md, _ := metadata.FromContext(ctx)
+		if md["Seq"] == "a1" {
+			<-release
+		}
+		mu.Lock()
+		processed = append(processed, md["Seq"])
+		mu.Unlock()
+		return nil
+	})
+	if err := dispatcher.RegisterHandler(handler, "OrderEvent", "product"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+
+	b := &manualBroker{}
+	consumer := event2.NewKeyedConsumer(dispatcher, b, []string{"OrderEvent"}, event2.WithKeyedConcurrency(4))
+	if err := consumer.Start(); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	if b.opts.AutoAck || b.opts.Queue != "product" {
+		t.Fatalf("expected manual ack with consumer group, got %+v", b.opts)
+	}
+
+	b.deliver(t, "order-a", "a1")
+	b.deliver(t, "order-b", "b1")
+	b.deliver(t, "order-a", "a2")
+	b.deliver(t, "order-b", "b2")
+
+	// order-a被阻塞时order-b继续处理，但a1之后的消息都不能确认
+	waitFor(t, "order-b processed", func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(processed) == 2
+	})
+	mu.Lock()
+	if processed[0] != "b1" || processed[1] != "b2" {
+		t.Fatalf("unexpected processing order %v", processed)
+	}
+	mu.Unlock()
+	if acks := b.ackedSeqs(); len(acks) != 0 {
+		t.Fatalf("expected no acks before a1 is done, got %v", acks)
+	}
+
+	close(release)
+	waitFor(t, "all messages acked", func() bool { return len(b.ackedSeqs()) == 4 })
+	expected := []string{"a1", "b1", "a2", "b2"}
+	acks := b.ackedSeqs()
+	for i := range expected {
+		if acks[i] != expected[i] {
+			t.Fatalf("expected acks %v, got %v", expected, acks)
+		}
+	}
+	mu.Lock()
+	if processed[2] != "a1" || processed[3] != "a2" {
+		t.Fatalf("messages of order-a out of order: %v", processed)
+	}
+	mu.Unlock()
+	if err := consumer.Close(context.Background()); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+}
+
+func TestKeyedConsumer_AcksPartitionsIndependently(t *testing.T) {
+	dispatcher := event2.NewEventDispatcher()
+	release := make(chan struct{})
+	handler := event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		md, _ := metadata.FromContext(ctx)
+		if md["Seq"] == "a1" {
+			<-release
+		}
+		return nil
+	})
+	if err := dispatcher.RegisterHandler(handler, "OrderEvent", "product"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+	b := &manualBroker{}
+	consumer := event2.NewKeyedConsumer(dispatcher, b, []string{"OrderEvent"}, event2.WithKeyedConcurrency(4))
+	if err := consumer.Start(); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+
+	b.deliverTo(t, "0", "order-a", "a1")
+	b.deliverTo(t, "1", "order-b", "b1")
+	b.deliverTo(t, "0", "order-c", "c1")
+	b.deliverTo(t, "1", "order-b", "b2")
+
+	// 分区0的a1阻塞时分区1的消息照常确认，分区0内a1之后的c1须等待
+	waitFor(t, "partition 1 acked", func() bool { return len(b.ackedSeqs()) == 2 })
+	time.Sleep(20 * time.Millisecond)
+	if acks := b.ackedSeqs(); len(acks) != 2 || acks[0] != "b1" || acks[1] != "b2" {
+		t.Fatalf("expected only partition 1 acked, got %v", acks)
+	}
+
+	close(release)
+	waitFor(t, "all messages acked", func() bool { return len(b.ackedSeqs()) == 4 })
+	if acks := b.ackedSeqs(); acks[2] != "a1" || acks[3] != "c1" {
+		t.Fatalf("expected partition 0 acked in order, got %v", acks)
+	}
+	if err := consumer.Close(context.Background()); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+}
+
+func TestKeyedConsumer_FailedMessageGoesToErrorHandler(t *testing.T) {
+	dispatcher := event2.NewEventDispatcher()
+	handler := event2.NewHandlerAdapter("OnPaymentSuccess", func(ctx context.Context, e *event.BaseEvent) error {
+		return errors.New("deduct failed")
+	})
+	if err := dispatcher.RegisterHandler(handler, "OrderEvent", "product"); err != nil {
+		t.Fatalf("register failed: %v", err)
+	}
+	b := &manualBroker{}
+	consumer := event2.NewKeyedConsumer(dispatcher, b, []string{"OrderEvent"})
+	if err := consumer.Start(); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+
+	b.deliver(t, "order-a", "a1")
+	if err := consumer.Close(context.Background()); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.failed) != 1 || b.failed[0] != "a1:deduct failed" {
+		t.Fatalf("unexpected failed messages %v", b.failed)
+	}
+	if len(b.acks) != 1 {
+		t.Fatalf("expected failed message acked once, got %v", b.acks)
+	}
+}
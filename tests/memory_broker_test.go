@@ -0,0 +1,247 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/zhanshen02154/product/internal/config"
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"github.com/zhanshen02154/product/internal/infrastructure/event/wrapper"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/client"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// collectMessages 订阅主题并收集消息
+func collectMessages(t *testing.T, b broker.Broker, topic string, queue string) (func(n int) []*broker.Message, broker.Subscriber) {
+	var mu sync.Mutex
+	var messages []*broker.Message
+	sub, err := b.Subscribe(topic, func(e broker.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, e.Message())
+		return nil
+	}, broker.Queue(queue))
+	if err != nil {
+		t.Fatalf("subscribe %s failed: %v", topic, err)
+	}
+	wait := func(n int) []*broker.Message {
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			mu.Lock()
+			if len(messages) >= n {
+				result := append([]*broker.Message(nil), messages...)
+				mu.Unlock()
+				return result
+			}
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		t.Fatalf("expected %d messages on %s, got %d", n, topic, len(messages))
+		return nil
+	}
+	return wait, sub
+}
+
+func TestMemoryBroker_PartitionKeyAndConsumerGroups(t *testing.T) {
+	b := infrastructure.NewMemoryBroker(infrastructure.MemoryPartitions(4))
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+
+	var mu sync.Mutex
+	received := map[string][]int{}
+	partitions := map[string]map[string]bool{}
+	handler := func(e broker.Event) error {
+		seq, _ := strconv.Atoi(e.Message().Header["Seq"])
+		key := e.Message().Header["Pkey"]
+		mu.Lock()
+		defer mu.Unlock()
+		received[key] = append(received[key], seq)
+		if partitions[key] == nil {
+			partitions[key] = map[string]bool{}
+		}
+		partitions[key][e.Message().Header[event2.PartitionHeader]] = true
+		return nil
+	}
+	// 同组的两个消费者共同消费，每条消息只处理一次
+	for i := 0; i < 2; i++ {
+		if _, err := b.Subscribe("OrderEvent", handler, broker.Queue("product")); err != nil {
+			t.Fatalf("subscribe failed: %v", err)
+		}
+	}
+	waitOther, _ := collectMessages(t, b, "OrderEvent", "inventory")
+
+	keys := []string{"order-1", "order-2", "order-3"}
+	for i := 0; i < 30; i++ {
+		msg := &broker.Message{Header: map[string]string{
+			"Pkey": keys[i%len(keys)],
+			"Seq":  strconv.Itoa(i),
+		}}
+		if err := b.Publish("OrderEvent", msg); err != nil {
+			t.Fatalf("publish failed: %v", err)
+		}
+	}
+
+	if got := len(waitOther(30)); got != 30 {
+		t.Fatalf("expected other group to receive 30 messages, got %d", got)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		mu.Lock()
+		total := 0
+		for _, seqs := range received {
+			total += len(seqs)
+		}
+		mu.Unlock()
+		if total == 30 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, key := range keys {
+		seqs := received[key]
+		if len(seqs) != 10 {
+			t.Fatalf("expected 10 messages for %s, got %d", key, len(seqs))
+		}
+		if len(partitions[key]) != 1 || partitions[key][""] {
+			t.Fatalf("expected messages of %s to carry one partition header, got %v", key, partitions[key])
+		}
+		for i := 1; i < len(seqs); i++ {
+			if seqs[i] <= seqs[i-1] {
+				t.Fatalf("messages of %s out of order: %v", key, seqs)
+			}
+		}
+	}
+}
+
+func TestMemoryBroker_PublishFailureToDeadLetter(t *testing.T) {
+	successChan := make(chan *sarama.ProducerMessage, 16)
+	errorChan := make(chan *sarama.ProducerError, 16)
+	b := infrastructure.NewMemoryBroker(
+		infrastructure.MemoryAsyncProducer(errorChan, successChan),
+		infrastructure.WithMemoryPublishInterceptor(func(topic string, msg *broker.Message) error {
+			if topic == "ProductEvent" && msg.Header["Pkey"] == "broken" {
+				return errors.New("leader not available")
+			}
+			return nil
+		}),
+	)
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+
+	var mu sync.Mutex
+	var callbackErrs []error
+	record := func(next event2.PublishCallbackFunc) event2.PublishCallbackFunc {
+		return func(ctx context.Context, msg *broker.Message, err error) {
+			mu.Lock()
+			callbackErrs = append(callbackErrs, err)
+			mu.Unlock()
+			next(ctx, msg, err)
+		}
+	}
+	listener := event2.NewListener(
+		event2.WithProducerChannels(successChan, errorChan),
+		event2.WithServiceName("product"),
+		event2.WithServiceVersion("v1"),
+		event2.WrapPublishCallback(
+			record,
+			event2.NewDeadletterWrapper(
+				event2.WithTracer(trace.NewNoopTracerProvider()),
+				event2.WithServiceInfo(&config.ServiceInfo{Name: "product", Version: "v1"}),
+				event2.WithBroker(b),
+			),
+		),
+	)
+	listener.Start()
+	defer listener.Close()
+	listener.Register("ProductEvent", client.NewClient(client.Broker(b)))
+
+	waitEvents, _ := collectMessages(t, b, "ProductEvent", "consumer")
+	waitDeadLetters, _ := collectMessages(t, b, "ProductEventDLQ", "dlq-indexer")
+
+	ctx := context.Background()
+	if err := listener.Publish(ctx, "ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "ok", "OnSkuCreated"); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	if err := listener.Publish(ctx, "ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "broken", "OnSkuCreated"); err != nil {
+		t.Fatalf("async publish should not fail: %v", err)
+	}
+
+	events := waitEvents(1)
+	if events[0].Header["Pkey"] != "ok" {
+		t.Fatalf("unexpected event key %s", events[0].Header["Pkey"])
+	}
+	deadLetters := waitDeadLetters(1)
+	dl := deadLetters[0]
+	if dl.Header["x-origin-topic"] != "ProductEvent" || dl.Header["x-error"] != "leader not available" {
+		t.Fatalf("unexpected dead letter headers: %v", dl.Header)
+	}
+	if dl.Header["Event-Type"] != "OnSkuCreatedDLQ" || dl.Header["Pkey"] != "broken" {
+		t.Fatalf("unexpected dead letter headers: %v", dl.Header)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	failures := 0
+	for _, err := range callbackErrs {
+		if err != nil {
+			failures++
+		}
+	}
+	// 成功、失败以及死信本身各有一次回调
+	if len(callbackErrs) != 3 || failures != 1 {
+		t.Fatalf("expected 3 callbacks with 1 failure, got %v", callbackErrs)
+	}
+}
+
+func TestMemoryBroker_SubscriberErrorToDeadLetter(t *testing.T) {
+	b := infrastructure.NewMemoryBroker(broker.ErrorHandler(wrapper.ErrorHandler()))
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer b.Disconnect()
+	defaultBroker := broker.DefaultBroker
+	broker.DefaultBroker = b
+	defer func() { broker.DefaultBroker = defaultBroker }()
+
+	if _, err := b.Subscribe("OrderEvent", func(e broker.Event) error {
+		return errors.New("deduct inventory failed")
+	}, broker.Queue("product")); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	waitDeadLetters, _ := collectMessages(t, b, "OrderEventDLQ", "dlq-indexer")
+
+	msg := &broker.Message{
+		Header: map[string]string{"Pkey": "order-1", "Event-Type": "OnPaymentSuccess", "Timestamp": "1700000000000"},
+		Body:   []byte("payload"),
+	}
+	if err := b.Publish("OrderEvent", msg); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+
+	dl := waitDeadLetters(1)[0]
+	if dl.Header["x-error"] != "deduct inventory failed" || dl.Header["x-origin-topic"] != "OrderEvent" {
+		t.Fatalf("unexpected dead letter headers: %v", dl.Header)
+	}
+	if dl.Header["x-origin-timestamp"] != "1700000000000" || dl.Header["Event-Type"] != "OnPaymentSuccessDLQ" {
+		t.Fatalf("unexpected dead letter headers: %v", dl.Header)
+	}
+	if string(dl.Body) != "payload" {
+		t.Fatalf("unexpected dead letter body %q", dl.Body)
+	}
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+)
+
+// memoryRestoreRepo 内存中的回补记录，回补键唯一
+type memoryRestoreRepo struct {
+	repository.OrderInventoryRestoreRepository
+	restores map[string]model.OrderInventoryRestore
+}
+
+func (r *memoryRestoreRepo) ExistsByRestoreKey(ctx context.Context, restoreKey string) (bool, error) {
+	_, ok := r.restores[restoreKey]
+	return ok, nil
+}
+
+func (r *memoryRestoreRepo) Create(ctx context.Context, restore *model.OrderInventoryRestore) (bool, error) {
+	if _, ok := r.restores[restore.RestoreKey]; ok {
+		return false, nil
+	}
+	r.restores[restore.RestoreKey] = *restore
+	return true, nil
+}
+
+// newRestoreFixture 订单100已支付SKU 1共5件（库存10→5）
+func newRestoreFixture(t *testing.T) (*reservationFixture, service.IProductDataService) {
+	f := newReservationFixture()
+	svc := service.NewProductDataService(nil, nil, f.skuRepo, f.ledger, nil, f.reservationRepo, &memoryRestoreRepo{restores: map[string]model.OrderInventoryRestore{}},
+		f.warehouseRepo, service.AllocationStrategyPriority)
+	deductReserved(t, f, 5)
+	f.assertSku(t, 1, 5, 5)
+	return f, svc
+}
+
+func restoreRequest(key string, reason string, quantity uint32) *dto.OrderInventoryRestoreDto {
+	return &dto.OrderInventoryRestoreDto{
+		OrderID:    100,
+		Reason:     reason,
+		RestoreKey: key,
+		Sku:        []*dto.OrderInventoryRestoreItem{{SkuID: 1, Quantity: quantity}},
+	}
+}
+
+func TestOrderInventoryRestore_RefundRestoresStock(t *testing.T) {
+	ctx := context.Background()
+	f, svc := newRestoreFixture(t)
+
+	result, err := svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-1", model.RestoreReasonRefund, 2))
+	if err != nil {
+		t.Fatalf("refund failed: %v", err)
+	}
+	if len(result.Sku) != 1 || result.Sku[0].Quantity != 2 || result.Sku[0].Stock != 7 {
+		t.Fatalf("unexpected refund result %+v", result.Sku)
+	}
+	f.assertSku(t, 1, 7, 3)
+	last := f.ledger.records[len(f.ledger.records)-1]
+	if last.SourceType != model.SourceTypeOrderRefund || last.Quantity != 2 || last.BeforeStock != 5 || last.AfterStock != 7 {
+		t.Fatalf("unexpected refund record %+v", last)
+	}
+
+	// 回补数量不超过已扣减未回补的数量
+	result, err = svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-2", model.RestoreReasonRefund, 10))
+	if err != nil {
+		t.Fatalf("refund failed: %v", err)
+	}
+	if len(result.Sku) != 1 || result.Sku[0].Quantity != 3 {
+		t.Fatalf("expected refund capped at 3, got %+v", result.Sku)
+	}
+	f.assertSku(t, 1, 10, 0)
+
+	result, err = svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-3", model.RestoreReasonRefund, 1))
+	if err != nil || len(result.Sku) != 0 {
+		t.Fatalf("expected nothing left to refund, got %+v %v", result, err)
+	}
+	f.assertSku(t, 1, 10, 0)
+}
+
+func TestOrderInventoryRestore_DuplicateEventIgnored(t *testing.T) {
+	ctx := context.Background()
+	f, svc := newRestoreFixture(t)
+
+	if _, err := svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-1", model.RestoreReasonRefund, 2)); err != nil {
+		t.Fatalf("refund failed: %v", err)
+	}
+	records := len(f.ledger.records)
+	result, err := svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-1", model.RestoreReasonRefund, 2))
+	if err != nil {
+		t.Fatalf("duplicate refund failed: %v", err)
+	}
+	if len(result.Sku) != 0 || len(f.ledger.records) != records {
+		t.Fatalf("expected duplicate refund to change nothing, got %+v", result.Sku)
+	}
+	f.assertSku(t, 1, 7, 3)
+}
+
+func TestOrderInventoryRestore_CancelReleasesReservation(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	svc := service.NewProductDataService(nil, nil, f.skuRepo, f.ledger, nil, f.reservationRepo, &memoryRestoreRepo{restores: map[string]model.OrderInventoryRestore{}},
+		f.warehouseRepo, service.AllocationStrategyPriority)
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 4}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+
+	// 未支付的订单取消时只释放预占，不回补销量
+	result, err := svc.DeductOrderInvetoryRevert(ctx, restoreRequest("cancel-1", model.RestoreReasonCancel, 4))
+	if err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	if len(result.Sku) != 1 || result.Sku[0].Quantity != 4 || result.Sku[0].Stock != 10 {
+		t.Fatalf("unexpected cancel result %+v", result.Sku)
+	}
+	f.assertSku(t, 1, 10, 0)
+	if f.reservationRepo.statusOf(100)[1] != model.ReservationStatusReleased {
+		t.Fatalf("expected released reservation, got %v", f.reservationRepo.statusOf(100))
+	}
+}
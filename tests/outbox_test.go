@@ -0,0 +1,380 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"github.com/zhanshen02154/product/internal/infrastructure/event/wrapper"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/client"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// memoryOutboxRepo 内存中的发件箱，状态流转与MySQL实现一致
+type memoryOutboxRepo struct {
+	repository.OutboxEventRepository
+	mu     sync.Mutex
+	nextID int64
+	events map[int64]*model.OutboxEvent
+}
+
+func newMemoryOutboxRepo() *memoryOutboxRepo {
+	return &memoryOutboxRepo{events: map[int64]*model.OutboxEvent{}}
+}
+
+func (r *memoryOutboxRepo) Create(ctx context.Context, outboxEvent *model.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	outboxEvent.ID = r.nextID
+	outboxEvent.CreatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	outboxEvent.UpdatedAt = outboxEvent.CreatedAt
+	item := *outboxEvent
+	r.events[item.ID] = &item
+	return nil
+}
+
+func (r *memoryOutboxRepo) FindPendingForUpdate(ctx context.Context, publishingBefore time.Time, limit int) ([]model.OutboxEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]int64, 0, len(r.events))
+	for id := range r.events {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	result := make([]model.OutboxEvent, 0)
+	for _, id := range ids {
+		item := r.events[id]
+		if item.Status == model.OutboxStatusPending || (item.Status == model.OutboxStatusPublishing && item.UpdatedAt.Time.Before(publishingBefore)) {
+			result = append(result, *item)
+		}
+		if len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryOutboxRepo) MarkPublishing(ctx context.Context, ids []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		r.events[id].Status = model.OutboxStatusPublishing
+		r.events[id].UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	return nil
+}
+
+func (r *memoryOutboxRepo) MarkSent(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events[id].Status = model.OutboxStatusSent
+	r.events[id].PublishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return nil
+}
+
+func (r *memoryOutboxRepo) MarkFailed(ctx context.Context, id int64, reason string, maxRetries int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item := r.events[id]
+	if item.Status == model.OutboxStatusSent {
+		return nil
+	}
+	item.Retries++
+	item.LastError = reason
+	item.Status = model.OutboxStatusPending
+	if item.Retries >= maxRetries {
+		item.Status = model.OutboxStatusFailed
+	}
+	return nil
+}
+
+func (r *memoryOutboxRepo) get(id int64) model.OutboxEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.events[id]
+}
+
+func (r *memoryOutboxRepo) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.events)
+}
+
+// outboxTxManager 模拟事务，业务出错时丢弃事务内写入的发件箱记录
+type outboxTxManager struct {
+	repo *memoryOutboxRepo
+}
+
+func (m *outboxTxManager) Execute(ctx context.Context, fn func(txCtx context.Context) error) error {
+	m.repo.mu.Lock()
+	snapshot := make(map[int64]*model.OutboxEvent, len(m.repo.events))
+	for id, item := range m.repo.events {
+		snapshot[id] = item
+	}
+	m.repo.mu.Unlock()
+	if err := fn(ctx); err != nil {
+		m.repo.mu.Lock()
+		m.repo.events = snapshot
+		m.repo.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (m *outboxTxManager) ExecuteWithBarrier(ctx context.Context, fn func(txCtx context.Context) error) error {
+	return m.Execute(ctx, fn)
+}
+
+// failingListener 同步发布失败的事件总线
+type failingListener struct {
+	event2.Listener
+	mu    sync.Mutex
+	calls int
+}
+
+func (l *failingListener) PublishRaw(ctx context.Context, topic string, payload []byte, key string, eventType string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls++
+	return errors.New("event not found")
+}
+
+// newOutboxListener 基于内存broker的事件总线，发布结果经发件箱回调回写
+func newOutboxListener(t *testing.T, repo *memoryOutboxRepo, maxRetries int, failKey string) (event2.Listener, func(n int) []*broker.Message) {
+	successChan := make(chan *sarama.ProducerMessage, 16)
+	errorChan := make(chan *sarama.ProducerError, 16)
+	b := infrastructure.NewMemoryBroker(
+		infrastructure.MemoryAsyncProducer(errorChan, successChan),
+		infrastructure.WithMemoryPublishInterceptor(func(topic string, msg *broker.Message) error {
+			if msg.Header["Pkey"] == failKey {
+				return errors.New("leader not available")
+			}
+			return nil
+		}),
+	)
+	if err := b.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	t.Cleanup(func() { b.Disconnect() })
+	wait, _ := collectMessages(t, b, "ProductEvent", "consumer")
+
+	listener := event2.NewListener(
+		event2.WithProducerChannels(successChan, errorChan),
+		event2.WrapPublishCallback(event2.NewOutboxWrapper(repo, maxRetries)),
+	)
+	listener.Start()
+	t.Cleanup(listener.Close)
+	listener.Register("ProductEvent", wrapper.NewMetaDataWrapper("product", "v1")(client.NewClient(client.Broker(b))))
+	return listener, wait
+}
+
+// waitOutboxStatus 等待发件箱记录进入指定状态
+func waitOutboxStatus(t *testing.T, repo *memoryOutboxRepo, id int64, status uint8) model.OutboxEvent {
+	var item model.OutboxEvent
+	waitFor(t, "outbox status", func() bool {
+		item = repo.get(id)
+		return item.Status == status
+	})
+	return item
+}
+
+func TestOutbox_WrittenInBusinessTransaction(t *testing.T) {
+	repo := newMemoryOutboxRepo()
+	txManager := &outboxTxManager{repo: repo}
+	ctx := context.Background()
+
+	err := txManager.Execute(ctx, func(txCtx context.Context) error {
+		outboxEvent, err := event2.NewOutboxEvent("ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "sku-1", "OnSkuCreated")
+		if err != nil {
+			return err
+		}
+		return repo.Create(txCtx, outboxEvent)
+	})
+	if err != nil {
+		t.Fatalf("transaction failed: %v", err)
+	}
+	item := repo.get(1)
+	if item.Status != model.OutboxStatusPending || item.EventId == "" || item.EventKey != "sku-1" || item.EventType != "OnSkuCreated" {
+		t.Fatalf("unexpected outbox event %+v", item)
+	}
+
+	// 业务失败时事件随事务回滚，不会被发布
+	err = txManager.Execute(ctx, func(txCtx context.Context) error {
+		outboxEvent, err := event2.NewOutboxEvent("ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "sku-2", "OnSkuCreated")
+		if err != nil {
+			return err
+		}
+		if err := repo.Create(txCtx, outboxEvent); err != nil {
+			return err
+		}
+		return errors.New("insufficient stock")
+	})
+	if err == nil {
+		t.Fatal("expected business error")
+	}
+	if repo.count() != 1 {
+		t.Fatalf("expected rolled back outbox event to be discarded, got %d events", repo.count())
+	}
+
+	if _, err := event2.NewOutboxEvent("", &event.BaseEvent{}, "sku-1", "OnSkuCreated"); err == nil {
+		t.Fatal("expected error for empty topic")
+	}
+}
+
+func TestOutboxRelay_PublishesAndMarksSent(t *testing.T) {
+	repo := newMemoryOutboxRepo()
+	listener, wait := newOutboxListener(t, repo, 3, "")
+	for _, key := range []string{"sku-1", "sku-2"} {
+		outboxEvent, err := event2.NewOutboxEvent("ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, key, "OnSkuCreated")
+		if err != nil {
+			t.Fatalf("new outbox event failed: %v", err)
+		}
+		if err := repo.Create(context.Background(), outboxEvent); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+
+	relay := event2.NewOutboxRelay(repo, &outboxTxManager{repo: repo}, listener, event2.WithOutboxPollInterval(10*time.Millisecond))
+	relay.Start()
+	defer relay.Close(context.Background())
+
+	messages := wait(2)
+	if messages[0].Header["Pkey"] != "sku-1" || messages[1].Header["Pkey"] != "sku-2" {
+		t.Fatalf("unexpected published keys %v %v", messages[0].Header, messages[1].Header)
+	}
+	for id := int64(1); id <= 2; id++ {
+		item := waitOutboxStatus(t, repo, id, model.OutboxStatusSent)
+		if messages[id-1].Header["Event_id"] != item.EventId {
+			t.Fatalf("expected event id %s, got headers %v", item.EventId, messages[id-1].Header)
+		}
+	}
+}
+
+// TestOutboxRelay_PublishesStoredSchemaVersion 按写入发件箱时的结构版本发布，消息头的版本来自注册表而不是服务版本
+func TestOutboxRelay_PublishesStoredSchemaVersion(t *testing.T) {
+	repo := newMemoryOutboxRepo()
+	listener, wait := newOutboxListener(t, repo, 3, "")
+	for _, key := range []string{"order-1", "order-2"} {
+		outboxEvent, err := event2.NewOutboxEvent("ProductEvent", &event.BaseEvent{EventType: "OnPaymentSuccess"}, key, "OnPaymentSuccess")
+		if err != nil {
+			t.Fatalf("new outbox event failed: %v", err)
+		}
+		if outboxEvent.SchemaVersion != event2.DefaultSchemaRegistry.LatestVersion("OnPaymentSuccess") || outboxEvent.SchemaVersion != 2 {
+			t.Fatalf("expected schema version 2 to be stamped when written, got %d", outboxEvent.SchemaVersion)
+		}
+		// 模拟登记新版本之前写入的事件
+		if key == "order-1" {
+			outboxEvent.SchemaVersion = 1
+		}
+		if err := repo.Create(context.Background(), outboxEvent); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+
+	relay := event2.NewOutboxRelay(repo, &outboxTxManager{repo: repo}, listener, event2.WithOutboxPollInterval(10*time.Millisecond))
+	relay.Start()
+	defer relay.Close(context.Background())
+
+	messages := wait(2)
+	for i, want := range []int32{1, 2} {
+		var baseEvent event.BaseEvent
+		if err := protojson.Unmarshal(messages[i].Body, &baseEvent); err != nil {
+			t.Fatalf("unmarshal failed: %v", err)
+		}
+		if baseEvent.SchemaVersion != want || messages[i].Header["Schema_version"] != strconv.Itoa(int(want)) {
+			t.Fatalf("expected schema version %d, got %d with headers %v", want, baseEvent.SchemaVersion, messages[i].Header)
+		}
+		if messages[i].Header["Producer_version"] != "v1" {
+			t.Fatalf("expected service version in Producer_version, got headers %v", messages[i].Header)
+		}
+	}
+}
+
+func TestOutboxRelay_FailedPublishRetriesUntilMaxRetries(t *testing.T) {
+	repo := newMemoryOutboxRepo()
+	listener, _ := newOutboxListener(t, repo, 3, "broken")
+	outboxEvent, err := event2.NewOutboxEvent("ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "broken", "OnSkuCreated")
+	if err != nil {
+		t.Fatalf("new outbox event failed: %v", err)
+	}
+	if err := repo.Create(context.Background(), outboxEvent); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	relay := event2.NewOutboxRelay(repo, &outboxTxManager{repo: repo}, listener, event2.WithOutboxPollInterval(10*time.Millisecond), event2.WithOutboxMaxRetries(3))
+	relay.Start()
+	defer relay.Close(context.Background())
+
+	item := waitOutboxStatus(t, repo, 1, model.OutboxStatusFailed)
+	if item.Retries != 3 || item.LastError != "leader not available" {
+		t.Fatalf("unexpected failed outbox event %+v", item)
+	}
+}
+
+func TestOutboxRelay_SyncPublishErrorMarksFailed(t *testing.T) {
+	repo := newMemoryOutboxRepo()
+	outboxEvent, err := event2.NewOutboxEvent("ProductEvent", &event.BaseEvent{EventType: "OnSkuCreated"}, "sku-1", "OnSkuCreated")
+	if err != nil {
+		t.Fatalf("new outbox event failed: %v", err)
+	}
+	if err := repo.Create(context.Background(), outboxEvent); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	listener := &failingListener{}
+	relay := event2.NewOutboxRelay(repo, &outboxTxManager{repo: repo}, listener, event2.WithOutboxPollInterval(10*time.Millisecond), event2.WithOutboxMaxRetries(2))
+	relay.Start()
+	defer relay.Close(context.Background())
+
+	item := waitOutboxStatus(t, repo, 1, model.OutboxStatusFailed)
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+	if item.Retries != 2 || listener.calls != 2 || item.LastError != "event not found" {
+		t.Fatalf("unexpected failed outbox event %+v after %d publishes", item, listener.calls)
+	}
+}
+
+func TestOutboxWrapper_MarksSentAndFailed(t *testing.T) {
+	repo := newMemoryOutboxRepo()
+	for i := 0; i < 2; i++ {
+		if err := repo.Create(context.Background(), &model.OutboxEvent{Status: model.OutboxStatusPublishing}); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+	callback := event2.NewOutboxWrapper(repo, 2)(func(ctx context.Context, msg *broker.Message, err error) {})
+	ctx := context.Background()
+
+	callback(ctx, &broker.Message{Header: map[string]string{"Micro-Topic": "ProductEvent", "Outbox_id": "1"}}, nil)
+	if item := repo.get(1); item.Status != model.OutboxStatusSent {
+		t.Fatalf("expected outbox event 1 sent, got %+v", item)
+	}
+
+	failed := &broker.Message{Header: map[string]string{"Micro-Topic": "ProductEvent", "Outbox_id": "2"}}
+	callback(ctx, failed, errors.New("timeout"))
+	if item := repo.get(2); item.Status != model.OutboxStatusPending || item.Retries != 1 {
+		t.Fatalf("expected outbox event 2 back to pending, got %+v", item)
+	}
+	callback(ctx, failed, errors.New("timeout"))
+	if item := repo.get(2); item.Status != model.OutboxStatusFailed || item.Retries != 2 {
+		t.Fatalf("expected outbox event 2 failed, got %+v", item)
+	}
+
+	// 死信消息与非发件箱消息不回写
+	callback(ctx, &broker.Message{Header: map[string]string{"Micro-Topic": "ProductEventDLQ", "Outbox_id": "1"}}, errors.New("timeout"))
+	callback(ctx, &broker.Message{Header: map[string]string{"Micro-Topic": "ProductEvent"}}, errors.New("timeout"))
+	if item := repo.get(1); item.Status != model.OutboxStatusSent || item.Retries != 0 {
+		t.Fatalf("expected outbox event 1 unchanged, got %+v", item)
+	}
+}
@@ -0,0 +1,332 @@
+package tests
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// catalogProductRepo 内存中的商品仓储，筛选与排序和MySQL实现一致
+type catalogProductRepo struct {
+	repository.IProductRepository
+	products map[int64]*model.Product
+	nextID   int64
+	filter   *repository.ProductListFilter
+}
+
+func (r *catalogProductRepo) CreateProduct(ctx context.Context, product *model.Product) (int64, error) {
+	r.nextID++
+	product.ID = r.nextID
+	copied := *product
+	r.products[product.ID] = &copied
+	return product.ID, nil
+}
+
+func (r *catalogProductRepo) ExistsProductNo(ctx context.Context, productNo string) (bool, error) {
+	for _, product := range r.products {
+		if product.ProductNo == productNo {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *catalogProductRepo) FindProductDetailByID(ctx context.Context, id int64) (*model.Product, error) {
+	if product, ok := r.products[id]; ok {
+		copied := *product
+		copied.Skus = append([]model.ProductSku(nil), product.Skus...)
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *catalogProductRepo) UpdateProduct(ctx context.Context, product *model.Product) error {
+	stored, ok := r.products[product.ID]
+	if !ok {
+		return repository.ErrProductNotFound
+	}
+	stored.ProductName, stored.CategoryID, stored.BrandID = product.ProductName, product.CategoryID, product.BrandID
+	stored.MainImage, stored.Description = product.MainImage, product.Description
+	return nil
+}
+
+func (r *catalogProductRepo) DeleteProduct(ctx context.Context, id int64) error {
+	if _, ok := r.products[id]; !ok {
+		return repository.ErrProductNotFound
+	}
+	delete(r.products, id)
+	return nil
+}
+
+func (r *catalogProductRepo) ListProducts(ctx context.Context, filter *repository.ProductListFilter, offset, limit int) ([]model.Product, int64, error) {
+	r.filter = filter
+	matched := make([]model.Product, 0, len(r.products))
+	for _, product := range r.products {
+		if filter.Keyword != "" && !strings.Contains(product.ProductName, filter.Keyword) && !strings.Contains(product.ProductNo, filter.Keyword) {
+			continue
+		}
+		if filter.CategoryID > 0 && int64(product.CategoryID) != filter.CategoryID {
+			continue
+		}
+		if filter.BrandID > 0 && (product.BrandID == nil || int64(*product.BrandID) != filter.BrandID) {
+			continue
+		}
+		if len(filter.Statuses) > 0 {
+			found := false
+			for _, s := range filter.Statuses {
+				found = found || s == product.Status
+			}
+			if !found {
+				continue
+			}
+		}
+		matched = append(matched, *product)
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID > matched[j].ID })
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return []model.Product{}, total, nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[offset:end], total, nil
+}
+
+// catalogSkuRepo 记录SKU更新
+type catalogSkuRepo struct {
+	repository.ProductSkuRepository
+	updated []model.ProductSku
+	images  map[int64][]*model.SkuImage
+}
+
+func (r *catalogSkuRepo) UpdateSkuInfo(ctx context.Context, sku *model.ProductSku) error {
+	r.updated = append(r.updated, *sku)
+	return nil
+}
+
+func (r *catalogSkuRepo) ReplaceSkuImages(ctx context.Context, skuID int64, images []*model.SkuImage) error {
+	r.images[skuID] = images
+	return nil
+}
+
+// newCatalogFixture 分类沿用newCategoryFixture，品牌A(1)、B(2)；商品按编号P1~P5创建
+func newCatalogFixture(t *testing.T) (service.IProductCatalogService, *catalogProductRepo, *catalogSkuRepo) {
+	brandRepo, _ := newBrandFixture()
+	productRepo := &catalogProductRepo{products: map[int64]*model.Product{}}
+	skuRepo := &catalogSkuRepo{images: map[int64][]*model.SkuImage{}}
+	svc := service.NewProductCatalogService(productRepo, skuRepo, newCategoryFixture(), brandRepo, nil)
+	inputs := []*dto.ProductInputDto{
+		{ProductNo: "P1", ProductName: "纯棉T恤", CategoryID: 3, BrandID: 1, Status: model.ProductStatusOnSale},
+		{ProductNo: "P2", ProductName: "圆领T恤", CategoryID: 3, BrandID: 2, Status: model.ProductStatusDraft},
+		{ProductNo: "P3", ProductName: "衬衫", CategoryID: 2, BrandID: 1, Status: model.ProductStatusOffSale},
+		{ProductNo: "P4", ProductName: "耳机", CategoryID: 4, Status: model.ProductStatusOnSale},
+		{ProductNo: "P5", ProductName: "印花T恤", CategoryID: 3, BrandID: 1, Status: model.ProductStatusOnSale},
+	}
+	for _, input := range inputs {
+		if _, err := svc.CreateProduct(context.Background(), input); err != nil {
+			t.Fatalf("create %s failed: %v", input.ProductNo, err)
+		}
+	}
+	return svc, productRepo, skuRepo
+}
+
+// productNos 商品编号列表
+func productNos(products []model.Product) string {
+	nos := make([]string, 0, len(products))
+	for _, product := range products {
+		nos = append(nos, product.ProductNo)
+	}
+	return strings.Join(nos, ",")
+}
+
+func TestProductCatalog_ListFilters(t *testing.T) {
+	ctx := context.Background()
+	svc, productRepo, _ := newCatalogFixture(t)
+
+	cases := []struct {
+		name string
+		req  dto.ListProductsDto
+		want string
+	}{
+		{"keyword", dto.ListProductsDto{Keyword: "  T恤 ", PageSize: 10}, "P5,P2,P1"},
+		{"keyword matches product_no", dto.ListProductsDto{Keyword: "P4", PageSize: 10}, "P4"},
+		{"category", dto.ListProductsDto{CategoryID: 3, PageSize: 10}, "P5,P2,P1"},
+		{"brand", dto.ListProductsDto{BrandID: 1, PageSize: 10}, "P5,P3,P1"},
+		{"statuses", dto.ListProductsDto{Statuses: []int32{model.ProductStatusOffSale, model.ProductStatusDraft}, PageSize: 10}, "P3,P2"},
+		{"combined", dto.ListProductsDto{Keyword: "T恤", BrandID: 1, Statuses: []int32{model.ProductStatusOnSale}, PageSize: 10}, "P5,P1"},
+	}
+	for _, c := range cases {
+		req := c.req
+		products, total, err := svc.ListProducts(ctx, &req)
+		if err != nil {
+			t.Fatalf("%s: list failed: %v", c.name, err)
+		}
+		if got := productNos(products); got != c.want || total != int64(len(products)) {
+			t.Fatalf("%s: expected %s, got %s total %d", c.name, c.want, got, total)
+		}
+	}
+	if productRepo.filter.Keyword != "T恤" {
+		t.Fatalf("expected keyword to be trimmed, got %q", productRepo.filter.Keyword)
+	}
+}
+
+func TestProductCatalog_ListPagination(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newCatalogFixture(t)
+
+	pages := []string{"P5,P4", "P3,P2", "P1", ""}
+	for i, want := range pages {
+		products, total, err := svc.ListProducts(ctx, &dto.ListProductsDto{Page: int32(i + 1), PageSize: 2})
+		if err != nil {
+			t.Fatalf("page %d failed: %v", i+1, err)
+		}
+		if got := productNos(products); got != want || total != 5 {
+			t.Fatalf("page %d: expected %q total 5, got %q total %d", i+1, want, got, total)
+		}
+	}
+
+	// 页码小于1时按第一页查询
+	products, _, err := svc.ListProducts(ctx, &dto.ListProductsDto{Page: 0, PageSize: 2})
+	if err != nil || productNos(products) != "P5,P4" {
+		t.Fatalf("expected first page, got %s %v", productNos(products), err)
+	}
+
+	for _, req := range []*dto.ListProductsDto{
+		{Page: 1, PageSize: 0},
+		{Page: 1, PageSize: 101},
+		{Page: 1, PageSize: 10, Statuses: []int32{4}},
+		{Page: 1, PageSize: 10, Statuses: []int32{-1}},
+	} {
+		if _, _, err := svc.ListProducts(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument for %+v, got %v", req, err)
+		}
+	}
+}
+
+func TestProductCatalog_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	svc, productRepo, _ := newCatalogFixture(t)
+
+	cases := []struct {
+		name string
+		req  *dto.ProductInputDto
+		code codes.Code
+	}{
+		{"nil product", nil, codes.InvalidArgument},
+		{"empty product_no", &dto.ProductInputDto{ProductNo: " ", ProductName: "A", CategoryID: 1}, codes.InvalidArgument},
+		{"long product_no", &dto.ProductInputDto{ProductNo: strings.Repeat("P", 65), ProductName: "A", CategoryID: 1}, codes.InvalidArgument},
+		{"archived status", &dto.ProductInputDto{ProductNo: "P9", ProductName: "A", CategoryID: 1, Status: model.ProductStatusArchived}, codes.InvalidArgument},
+		{"empty name", &dto.ProductInputDto{ProductNo: "P9", ProductName: " ", CategoryID: 1}, codes.InvalidArgument},
+		{"long name", &dto.ProductInputDto{ProductNo: "P9", ProductName: strings.Repeat("长", 256), CategoryID: 1}, codes.InvalidArgument},
+		{"empty category", &dto.ProductInputDto{ProductNo: "P9", ProductName: "A"}, codes.InvalidArgument},
+		{"missing category", &dto.ProductInputDto{ProductNo: "P9", ProductName: "A", CategoryID: 99}, codes.InvalidArgument},
+		{"negative brand", &dto.ProductInputDto{ProductNo: "P9", ProductName: "A", CategoryID: 1, BrandID: -1}, codes.InvalidArgument},
+		{"missing brand", &dto.ProductInputDto{ProductNo: "P9", ProductName: "A", CategoryID: 1, BrandID: 99}, codes.InvalidArgument},
+		{"long main_image", &dto.ProductInputDto{ProductNo: "P9", ProductName: "A", CategoryID: 1, MainImage: strings.Repeat("a", 501)}, codes.InvalidArgument},
+		{"duplicate product_no", &dto.ProductInputDto{ProductNo: " P1 ", ProductName: "A", CategoryID: 1}, codes.AlreadyExists},
+	}
+	for _, c := range cases {
+		if _, err := svc.CreateProduct(ctx, c.req); status.Code(err) != c.code {
+			t.Fatalf("%s: expected %s, got %v", c.name, c.code, err)
+		}
+	}
+	if len(productRepo.products) != 5 {
+		t.Fatalf("invalid products must not be created, got %d products", len(productRepo.products))
+	}
+
+	product, err := svc.CreateProduct(ctx, &dto.ProductInputDto{ProductNo: " P9 ", ProductName: " 卫衣 ", CategoryID: 2, Status: model.ProductStatusDraft})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if product.ProductNo != "P9" || product.ProductName != "卫衣" || product.BrandID != nil || product.Status != model.ProductStatusDraft {
+		t.Fatalf("unexpected product %+v", product)
+	}
+	if _, err := svc.CreateProductWithSkus(ctx, &dto.CreateProductWithSkusDto{Product: &dto.ProductInputDto{ProductNo: "P10", ProductName: "A", CategoryID: 1}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument without default_sku, got %v", err)
+	}
+}
+
+func TestProductCatalog_UpdateValidation(t *testing.T) {
+	ctx := context.Background()
+	svc, productRepo, skuRepo := newCatalogFixture(t)
+	productRepo.products[1].Skus = []model.ProductSku{{ID: 11, ProductID: 1}, {ID: 12, ProductID: 1}}
+	productRepo.products[2].Status = model.ProductStatusArchived
+	input := &dto.ProductInputDto{ProductName: "新T恤", CategoryID: 3, BrandID: 2}
+
+	cases := []struct {
+		name string
+		req  *dto.UpdateProductDto
+		code codes.Code
+	}{
+		{"empty id", &dto.UpdateProductDto{Product: input}, codes.InvalidArgument},
+		{"nil product", &dto.UpdateProductDto{ID: 1}, codes.InvalidArgument},
+		{"missing product", &dto.UpdateProductDto{ID: 99, Product: input}, codes.NotFound},
+		{"archived", &dto.UpdateProductDto{ID: 2, Product: input}, codes.FailedPrecondition},
+		{"missing brand", &dto.UpdateProductDto{ID: 1, Product: &dto.ProductInputDto{ProductName: "A", CategoryID: 3, BrandID: 99}}, codes.InvalidArgument},
+		{"foreign sku", &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{{ID: 21, SkuName: "S", Price: 1}}}, codes.InvalidArgument},
+		{"duplicate sku", &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{{ID: 11, SkuName: "S", Price: 1}, {ID: 11, SkuName: "S", Price: 1}}}, codes.InvalidArgument},
+		{"empty sku name", &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{{ID: 11, Price: 1}}}, codes.InvalidArgument},
+		{"zero price", &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{{ID: 11, SkuName: "S"}}}, codes.InvalidArgument},
+		{"negative market price", &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{{ID: 11, SkuName: "S", Price: 1, MarketPrice: -1}}}, codes.InvalidArgument},
+		{"empty image", &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{{ID: 11, SkuName: "S", Price: 1, Images: []string{""}}}}, codes.InvalidArgument},
+	}
+	for _, c := range cases {
+		if _, err := svc.UpdateProduct(ctx, c.req); status.Code(err) != c.code {
+			t.Fatalf("%s: expected %s, got %v", c.name, c.code, err)
+		}
+	}
+	if productRepo.products[1].ProductName != "纯棉T恤" || len(skuRepo.updated) != 0 {
+		t.Fatal("invalid update must not modify product or skus")
+	}
+
+	product, err := svc.UpdateProduct(ctx, &dto.UpdateProductDto{ID: 1, Product: input, Skus: []*dto.SkuUpdateDto{
+		{ID: 12, SkuName: " 白色 ", Price: 59, MarketPrice: 99, Images: []string{"a.jpg", "b.jpg"}},
+	}})
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if product.ProductName != "新T恤" || product.BrandID == nil || *product.BrandID != 2 {
+		t.Fatalf("unexpected product %+v", product)
+	}
+	sku := skuRepo.updated[0]
+	if sku.ID != 12 || sku.SkuName != "白色" || sku.MarketPrice == nil || *sku.MarketPrice != 99 || sku.MainImage == nil || *sku.MainImage != "a.jpg" {
+		t.Fatalf("unexpected sku update %+v", sku)
+	}
+	if images := skuRepo.images[12]; len(images) != 2 || !images[0].IsMain || images[1].IsMain {
+		t.Fatalf("unexpected sku images %+v", images)
+	}
+}
+
+func TestProductCatalog_GetAndDelete(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newCatalogFixture(t)
+
+	if _, err := svc.GetProduct(ctx, 0); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if _, err := svc.GetProduct(ctx, 99); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+	if err := svc.DeleteProduct(ctx, 0); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if err := svc.DeleteProduct(ctx, 1); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := svc.DeleteProduct(ctx, 1); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for deleted product, got %v", err)
+	}
+	if _, err := svc.GetProduct(ctx, 1); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for deleted product, got %v", err)
+	}
+}
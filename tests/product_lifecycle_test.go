@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lifecycleProductRepo 内存中的商品状态
+type lifecycleProductRepo struct {
+	repository.IProductRepository
+	product model.Product
+}
+
+func (r *lifecycleProductRepo) FindProductStatusForUpdate(ctx context.Context, id int64) (*model.Product, error) {
+	if id != r.product.ID {
+		return nil, nil
+	}
+	product := r.product
+	return &product, nil
+}
+
+func (r *lifecycleProductRepo) UpdateProductStatus(ctx context.Context, product *model.Product) error {
+	r.product.Status, r.product.OnSaleAt, r.product.OnSaleSkuIDs = product.Status, product.OnSaleAt, product.OnSaleSkuIDs
+	return nil
+}
+
+// lifecycleSkuRepo 内存中的SKU状态
+type lifecycleSkuRepo struct {
+	repository.ProductSkuRepository
+	skus []model.ProductSku
+}
+
+func (r *lifecycleSkuRepo) FindSkuStatusByProductIDForUpdate(ctx context.Context, productID int64) ([]model.ProductSku, error) {
+	skus := make([]model.ProductSku, len(r.skus))
+	copy(skus, r.skus)
+	return skus, nil
+}
+
+func (r *lifecycleSkuRepo) GetSkuDetailByID(ctx context.Context, skuID int64) (*model.ProductSku, error) {
+	for _, sku := range r.skus {
+		if sku.ID == skuID {
+			return &sku, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *lifecycleSkuRepo) UpdateSkuStatusByIds(ctx context.Context, ids []int64, status int8) error {
+	for _, id := range ids {
+		for i := range r.skus {
+			if r.skus[i].ID == id {
+				r.skus[i].Status = status
+			}
+		}
+	}
+	return nil
+}
+
+func newLifecycleService() (service.IProductLifecycleService, *lifecycleProductRepo, *lifecycleSkuRepo) {
+	productRepo := &lifecycleProductRepo{product: model.Product{ID: 1, Status: model.ProductStatusDraft}}
+	skuRepo := &lifecycleSkuRepo{skus: []model.ProductSku{
+		{ID: 11, ProductID: 1, Status: model.ProductStatusDraft},
+		{ID: 12, ProductID: 1, Status: model.ProductStatusDraft},
+	}}
+	return service.NewProductLifecycleService(productRepo, skuRepo), productRepo, skuRepo
+}
+
+func TestProductLifecycle_PublishUnpublishArchive(t *testing.T) {
+	ctx := context.Background()
+	svc, productRepo, skuRepo := newLifecycleService()
+
+	change, err := svc.PublishProduct(ctx, &dto.PublishProductDto{ProductID: 1, OperatorID: 7}, time.Now())
+	if err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	if change.FromStatus != model.ProductStatusDraft || change.ToStatus != model.ProductStatusOnSale || len(change.Skus) != 2 {
+		t.Fatalf("unexpected publish change: %+v", change)
+	}
+
+	if _, err := svc.UnpublishSku(ctx, &dto.UnpublishSkuDto{SkuID: 12, OperatorID: 7}); err != nil {
+		t.Fatalf("unpublish sku failed: %v", err)
+	}
+	if productRepo.product.Status != model.ProductStatusOnSale || skuRepo.skus[1].Status != model.ProductStatusOffSale {
+		t.Fatalf("expected only sku 12 off sale, got product %d sku %d", productRepo.product.Status, skuRepo.skus[1].Status)
+	}
+
+	change, err = svc.UnpublishProduct(ctx, &dto.ProductStatusDto{ProductID: 1, OperatorID: 7})
+	if err != nil {
+		t.Fatalf("unpublish failed: %v", err)
+	}
+	if change.ToStatus != model.ProductStatusOffSale || len(change.Skus) != 1 || change.Skus[0].SkuID != 11 {
+		t.Fatalf("expected sku 11 to cascade off sale, got %+v", change)
+	}
+
+	if _, err := svc.ArchiveProduct(ctx, &dto.ProductStatusDto{ProductID: 1, OperatorID: 7}); err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	for _, sku := range skuRepo.skus {
+		if sku.Status != model.ProductStatusArchived {
+			t.Errorf("expected sku %d archived, got %d", sku.ID, sku.Status)
+		}
+	}
+	_, err = svc.PublishProduct(ctx, &dto.PublishProductDto{ProductID: 1, SkuIDs: []int64{11}, OperatorID: 7}, time.Now())
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for archived product, got %v", err)
+	}
+}
+
+func TestProductLifecycle_ScheduledOnSale(t *testing.T) {
+	ctx := context.Background()
+	svc, productRepo, skuRepo := newLifecycleService()
+	now := time.Now()
+	onSaleAt := now.Add(time.Hour).Format("2006-01-02 15:04:05")
+
+	change, err := svc.PublishProduct(ctx, &dto.PublishProductDto{ProductID: 1, SkuIDs: []int64{12}, OnSaleAt: onSaleAt, OperatorID: 7}, now)
+	if err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	if change.ToStatus != model.ProductStatusDraft || change.OnSaleAt == nil || productRepo.product.OnSaleSkuIDs != "12" {
+		t.Fatalf("expected scheduled on sale without status change, got %+v", change)
+	}
+
+	if change, err := svc.ApplyDueOnSale(ctx, 1, now); err != nil || change != nil {
+		t.Fatalf("expected nothing due, got %+v %v", change, err)
+	}
+	change, err = svc.ApplyDueOnSale(ctx, 1, now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+	if change.ToStatus != model.ProductStatusOnSale || len(change.Skus) != 1 || change.Skus[0].SkuID != 12 {
+		t.Fatalf("unexpected scheduled change: %+v", change)
+	}
+	if productRepo.product.OnSaleAt != nil || skuRepo.skus[0].Status != model.ProductStatusDraft {
+		t.Errorf("expected schedule cleared and sku 11 left in draft")
+	}
+}
@@ -0,0 +1,299 @@
+package tests
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	gorm2 "github.com/zhanshen02154/product/internal/infrastructure/persistence/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// orderableRestockRepo 审核通过的补货记录
+type orderableRestockRepo struct {
+	repository.SkuRestockRepository
+	records map[int64]*model.SkuRestockRecord
+}
+
+func (r *orderableRestockRepo) ListOrderableByIDsForUpdate(ctx context.Context, ids []int64) ([]model.SkuRestockRecord, error) {
+	result := make([]model.SkuRestockRecord, 0, len(ids))
+	for _, id := range ids {
+		if record, ok := r.records[id]; ok && record.RemainingQuantity() > 0 {
+			result = append(result, *record)
+		}
+	}
+	return result, nil
+}
+
+func (r *orderableRestockRepo) UpdateOrdered(ctx context.Context, id int64, orderedQuantity int32, status uint8) error {
+	r.records[id].OrderedQuantity = orderedQuantity
+	r.records[id].Status = status
+	return nil
+}
+
+// preferredSupplierRepo SKU 10、11由供应商1供货，SKU 12由供应商2供货
+type preferredSupplierRepo struct {
+	repository.SupplierRepository
+}
+
+func (r *preferredSupplierRepo) GetSupplierInfoBySkuID(ctx context.Context, skuID int64) ([]*repository.SupplierInfo, error) {
+	switch skuID {
+	case 10:
+		return []*repository.SupplierInfo{
+			{SkuID: 10, SupplierID: 3, SupplyPrice: 9},
+			{SkuID: 10, SupplierID: 1, SupplyPrice: 2.5, MinOrderQuantity: 10, IsPreferred: true},
+		}, nil
+	case 11:
+		return []*repository.SupplierInfo{{SkuID: 11, SupplierID: 1, SupplyPrice: 1, IsPreferred: true}}, nil
+	case 12:
+		return []*repository.SupplierInfo{{SkuID: 12, SupplierID: 2, SupplyPrice: 4, MinOrderQuantity: 6, IsPreferred: true}}, nil
+	}
+	return nil, nil
+}
+
+// memoryPurchaseOrderRepo 内存中的采购单仓储
+type memoryPurchaseOrderRepo struct {
+	repository.PurchaseOrderRepository
+	orders []*model.PurchaseOrder
+}
+
+func (r *memoryPurchaseOrderRepo) Create(ctx context.Context, order *model.PurchaseOrder) error {
+	order.ID = int64(len(r.orders) + 1)
+	r.orders = append(r.orders, order)
+	return nil
+}
+
+func (r *memoryPurchaseOrderRepo) FindByIDForUpdate(ctx context.Context, id int64) (*model.PurchaseOrder, error) {
+	for _, order := range r.orders {
+		if order.ID == id {
+			return order, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryPurchaseOrderRepo) UpdateStatus(ctx context.Context, id int64, status uint8) error {
+	return nil
+}
+
+func (r *memoryPurchaseOrderRepo) UpdateLineReceived(ctx context.Context, lineID int64, receivedQuantity int32) error {
+	return nil
+}
+
+func (r *memoryPurchaseOrderRepo) CountOpenLinesByRestockID(ctx context.Context, restockID int64) (int64, error) {
+	var count int64
+	for _, order := range r.orders {
+		for _, line := range order.Lines {
+			for _, restock := range line.Restocks {
+				if restock.RestockID == restockID && line.RemainingQuantity() > 0 {
+					count++
+				}
+			}
+		}
+	}
+	return count, nil
+}
+
+func (r *orderableRestockRepo) GetByID(ctx context.Context, id int64) (*model.SkuRestockRecord, error) {
+	return r.records[id], nil
+}
+
+func (r *orderableRestockRepo) UpdateStatus(ctx context.Context, id int64, status uint8, failedReason string) error {
+	r.records[id].Status = status
+	return nil
+}
+
+// receivingSkuRepo 收货SKU仓储，记录库存增加
+type receivingSkuRepo struct {
+	repository.ProductSkuRepository
+	stock map[int64]uint32
+}
+
+func (r *receivingSkuRepo) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	skus := make([]model.ProductSku, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		skus = append(skus, model.ProductSku{ID: id, Stock: r.stock[id], StockWarn: 5})
+	}
+	return skus, nil
+}
+
+func (r *receivingSkuRepo) RestoreInventoryById(ctx context.Context, id int64, count uint32) error {
+	r.stock[id] += count
+	return nil
+}
+
+func newPurchaseOrderFixture() *orderableRestockRepo {
+	return &orderableRestockRepo{records: map[int64]*model.SkuRestockRecord{
+		1: {ID: 1, SkuID: 10, Quantity: 7, Status: model.RestockStatusPending},
+		2: {ID: 2, SkuID: 10, Quantity: 5, Status: model.RestockStatusPending},
+		3: {ID: 3, SkuID: 11, Quantity: 3, Status: model.RestockStatusPending},
+		4: {ID: 4, SkuID: 12, Quantity: 20, Status: model.RestockStatusPending},
+	}}
+}
+
+// TestPurchaseOrder_GroupBySupplier 按首选供应商分单，同一SKU合并并按最小起订量取整
+func TestPurchaseOrder_GroupBySupplier(t *testing.T) {
+	restockRepo := newPurchaseOrderFixture()
+	orderRepo := &memoryPurchaseOrderRepo{}
+	svc := service.NewPurchaseOrderService(orderRepo, restockRepo, &preferredSupplierRepo{}, nil, nil, nil)
+
+	orders, err := svc.CreatePurchaseOrders(context.Background(), &dto.CreatePurchaseOrdersDto{
+		Items: []*dto.PurchaseOrderItemDto{{RestockID: 1}, {RestockID: 2}, {RestockID: 3}, {RestockID: 4, Quantity: 8}},
+	})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if len(orders) != 2 || orders[0].SupplierID != 1 || orders[1].SupplierID != 2 {
+		t.Fatalf("expected one order per supplier, got %d", len(orders))
+	}
+	lines := orders[0].Lines
+	if len(lines) != 2 || lines[0].SkuID != 10 || !reflect.DeepEqual(lines[0].RestockIDs(), []int64{1, 2}) {
+		t.Fatalf("unexpected lines of supplier 1: %+v", lines)
+	}
+	// 7 + 5 按起订量10取整为20
+	if lines[0].RequestedQuantity != 12 || lines[0].Quantity != 20 || lines[0].Amount != 50 {
+		t.Errorf("unexpected line of sku 10: %+v", lines[0])
+	}
+	if orders[0].TotalQuantity != 23 || orders[0].TotalAmount != 53 {
+		t.Errorf("unexpected totals: %d %v", orders[0].TotalQuantity, orders[0].TotalAmount)
+	}
+	if orders[1].Lines[0].Quantity != 12 {
+		t.Errorf("expected 8 to be rounded up to 12, got %d", orders[1].Lines[0].Quantity)
+	}
+	if restockRepo.records[1].Status != model.RestockStatusOrdered || restockRepo.records[4].Status != model.RestockStatusPartial {
+		t.Errorf("unexpected restock status: %d %d", restockRepo.records[1].Status, restockRepo.records[4].Status)
+	}
+
+	// 部分订货的记录只能订剩余数量
+	_, err = svc.CreatePurchaseOrders(context.Background(), &dto.CreatePurchaseOrdersDto{
+		Items: []*dto.PurchaseOrderItemDto{{RestockID: 4, Quantity: 13}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if _, err = svc.CreatePurchaseOrders(context.Background(), &dto.CreatePurchaseOrdersDto{
+		Items: []*dto.PurchaseOrderItemDto{{RestockID: 4}},
+	}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if restockRepo.records[4].Status != model.RestockStatusOrdered || restockRepo.records[4].OrderedQuantity != 20 {
+		t.Errorf("expected restock 4 to be fully ordered, got %+v", restockRepo.records[4])
+	}
+}
+
+// TestPurchaseOrder_ReceiveGoods 分批收货增加库存，明细全部收满后采购单与补货记录完成
+func TestPurchaseOrder_ReceiveGoods(t *testing.T) {
+	restockRepo := newPurchaseOrderFixture()
+	orderRepo := &memoryPurchaseOrderRepo{}
+	skuRepo := &receivingSkuRepo{stock: map[int64]uint32{10: 1, 11: 0}}
+	stockChangeRepo := &matrixStockChangeRepo{}
+	warehouseRepo := newAdjustmentWarehouseRepo()
+	svc := service.NewPurchaseOrderService(orderRepo, restockRepo, &preferredSupplierRepo{}, skuRepo, stockChangeRepo, warehouseRepo)
+
+	orders, err := svc.CreatePurchaseOrders(context.Background(), &dto.CreatePurchaseOrdersDto{
+		Items: []*dto.PurchaseOrderItemDto{{RestockID: 1}, {RestockID: 2}, {RestockID: 3}},
+	})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	order := orders[0]
+	for i := range order.Lines {
+		order.Lines[i].ID = int64(i + 1)
+	}
+
+	_, _, err = svc.ReceiveGoods(context.Background(), &dto.ReceiveGoodsDto{
+		PurchaseOrderID: order.ID,
+		Items:           []*dto.ReceiveGoodsItemDto{{LineID: 1, Quantity: 8}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for missing warehouse, got %v", err)
+	}
+
+	// 超过未收货数量
+	_, _, err = svc.ReceiveGoods(context.Background(), &dto.ReceiveGoodsDto{
+		PurchaseOrderID: order.ID,
+		WarehouseID:     1,
+		Items:           []*dto.ReceiveGoodsItemDto{{LineID: 1, Quantity: 21}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	received, skuDto, err := svc.ReceiveGoods(context.Background(), &dto.ReceiveGoodsDto{
+		PurchaseOrderID: order.ID,
+		WarehouseID:     1,
+		Items:           []*dto.ReceiveGoodsItemDto{{LineID: 1, Quantity: 8}, {LineID: 2, Quantity: 3}},
+		OperatorID:      9,
+	})
+	if err != nil {
+		t.Fatalf("receive failed: %v", err)
+	}
+	if received.Status != model.PurchaseOrderStatusPartial || skuRepo.stock[10] != 9 || skuDto.Sku[0].Stock != 9 {
+		t.Fatalf("unexpected partial receipt: status=%d stock=%d", received.Status, skuRepo.stock[10])
+	}
+	if restockRepo.records[3].Status != model.RestockStatusReceived || restockRepo.records[1].Status != model.RestockStatusOrdered {
+		t.Errorf("unexpected restock status: %d %d", restockRepo.records[3].Status, restockRepo.records[1].Status)
+	}
+	record := stockChangeRepo.records[0]
+	if record.SourceType != model.SourceTypePurchase || record.OrderID != 0 || record.PurchaseOrderID != order.ID || record.OperatorID != 9 ||
+		record.BeforeStock != 1 || record.AfterStock != 9 || record.Reason != "purchase receipt (BJ)" {
+		t.Errorf("unexpected stock change record: %+v", record)
+	}
+	// 收货数量计入收货仓库
+	if warehouseRepo.stockOf(10, 1) != 8 || warehouseRepo.stockOf(11, 1) != 3 {
+		t.Errorf("unexpected warehouse stock: %+v", warehouseRepo.stocks)
+	}
+	if warehouses := skuDto.Sku[0].Warehouses; len(warehouses) != 1 || warehouses[0].WarehouseID != 1 || warehouses[0].Quantity != 8 || warehouses[0].Stock != 8 {
+		t.Errorf("unexpected warehouse receipt: %+v", skuDto.Sku[0].Warehouses)
+	}
+
+	received, _, err = svc.ReceiveGoods(context.Background(), &dto.ReceiveGoodsDto{
+		PurchaseOrderID: order.ID,
+		WarehouseID:     1,
+		Items:           []*dto.ReceiveGoodsItemDto{{LineID: 1, Quantity: 12}},
+	})
+	if err != nil {
+		t.Fatalf("receive failed: %v", err)
+	}
+	if received.Status != model.PurchaseOrderStatusReceived || skuRepo.stock[10] != 21 {
+		t.Fatalf("expected order to be fully received, got status=%d stock=%d", received.Status, skuRepo.stock[10])
+	}
+	if restockRepo.records[1].Status != model.RestockStatusReceived || restockRepo.records[2].Status != model.RestockStatusReceived {
+		t.Errorf("expected restocks 1 and 2 to be received")
+	}
+	_, _, err = svc.ReceiveGoods(context.Background(), &dto.ReceiveGoodsDto{
+		PurchaseOrderID: order.ID,
+		WarehouseID:     1,
+		Items:           []*dto.ReceiveGoodsItemDto{{LineID: 2, Quantity: 1}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
+
+// TestPurchaseOrderRepository_CountOpenLinesByRestockID 通过关联表按补货记录ID统计未收满的明细
+func TestPurchaseOrderRepository_CountOpenLinesByRestockID(t *testing.T) {
+	_, db, mock := newBarrierTestManager(t)
+	repo := gorm2.NewPurchaseOrderRepository(db)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM purchase_order_line_restocks AS r JOIN purchase_order_lines AS l ON l.id = r.purchase_order_line_id WHERE r.restock_id = ? AND l.received_quantity < l.quantity")).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err := repo.CountOpenLinesByRestockID(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("count failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 open lines, got %d", count)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryRestockRepo 内存中的补货记录仓储
+type memoryRestockRepo struct {
+	repository.SkuRestockRepository
+	record *model.SkuRestockRecord
+}
+
+func (r *memoryRestockRepo) GetByApplicationNoForUpdate(ctx context.Context, applicationNo string) (*model.SkuRestockRecord, error) {
+	if r.record.ApplicationNo != applicationNo {
+		return nil, nil
+	}
+	copied := *r.record
+	return &copied, nil
+}
+
+func (r *memoryRestockRepo) UpdateStatus(ctx context.Context, id int64, status uint8, failedReason string) error {
+	r.record.Status = status
+	r.record.FailedReason = failedReason
+	return nil
+}
+
+// memoryRestockAuditRepo 内存中的补货审核记录仓储
+type memoryRestockAuditRepo struct {
+	repository.SkuRestockAuditRepository
+	audit *model.SkuRestockAudit
+}
+
+func (r *memoryRestockAuditRepo) GetLatestByRestockID(ctx context.Context, restockID uint64) (*model.SkuRestockAudit, error) {
+	if r.audit == nil {
+		return nil, nil
+	}
+	copied := *r.audit
+	return &copied, nil
+}
+
+func (r *memoryRestockAuditRepo) Update(ctx context.Context, audit *model.SkuRestockAudit) error {
+	copied := *audit
+	r.audit = &copied
+	return nil
+}
+
+func newRestockAuditFixture() (*memoryRestockRepo, *memoryRestockAuditRepo) {
+	return &memoryRestockRepo{record: &model.SkuRestockRecord{ID: 1, ApplicationNo: "RS1", SkuID: 10, Quantity: 5, Status: model.RestockStatusPending}},
+		&memoryRestockAuditRepo{audit: &model.SkuRestockAudit{ID: 1, RestockID: 1, AuditStatus: model.AuditStatusPending}}
+}
+
+// TestRestockAudit_RejectAfterApprove 审核通过后不能再驳回
+func TestRestockAudit_RejectAfterApprove(t *testing.T) {
+	restockRepo, auditRepo := newRestockAuditFixture()
+	svc := service.NewSkuRestockService(nil, restockRepo, auditRepo)
+
+	record, err := svc.ApproveRestockApply(context.Background(), "RS1", 7)
+	if err != nil {
+		t.Fatalf("approve failed: %v", err)
+	}
+	if record.Status != model.RestockStatusPending || auditRepo.audit.AuditStatus != model.AuditStatusApproved || auditRepo.audit.AuditUserID != 7 {
+		t.Errorf("unexpected state after approve: record=%d audit=%+v", record.Status, auditRepo.audit)
+	}
+	_, err = svc.RejectRestockApply(context.Background(), "RS1", 7, "预算不足")
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	if restockRepo.record.Status != model.RestockStatusPending {
+		t.Error("restock status must not change")
+	}
+}
+
+// TestRestockAudit_Reject 驳回后补货记录流转为失败，失败为终态
+func TestRestockAudit_Reject(t *testing.T) {
+	restockRepo, auditRepo := newRestockAuditFixture()
+	svc := service.NewSkuRestockService(nil, restockRepo, auditRepo)
+
+	if _, err := svc.RejectRestockApply(context.Background(), "RS1", 7, "预算不足"); err != nil {
+		t.Fatalf("reject failed: %v", err)
+	}
+	if restockRepo.record.Status != model.RestockStatusFailed || restockRepo.record.FailedReason != "预算不足" {
+		t.Errorf("unexpected record: %+v", restockRepo.record)
+	}
+	if auditRepo.audit.AuditStatus != model.AuditStatusRejected {
+		t.Errorf("unexpected audit status: %d", auditRepo.audit.AuditStatus)
+	}
+	if restockRepo.record.CanTransitTo(model.RestockStatusOrdered) {
+		t.Error("failed restock must be terminal")
+	}
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/config"
+	"github.com/zhanshen02154/product/internal/infrastructure/retry"
+	"go-micro.dev/v4/metadata"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicy_ReturnsErrorWhenRetriesRunOut(t *testing.T) {
+	conf := &config.KafkaConsumer{}
+	conf.Retry.MaxRetries = 2
+	policy := retry.NewRetryPolicy(retry.WithKafkaConsumerConfig(conf), retry.WithLogger(zap.NewNop()))
+	ctx := metadata.NewContext(context.Background(), metadata.Metadata{"Micro-Topic": "OrderEvent"})
+
+	calls := 0
+	err := policy.Execute(ctx, func() error {
+		calls++
+		return status.Error(codes.Aborted, "lock conflict")
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("expected last error after retries run out, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 1 call and 2 retries, got %d calls", calls)
+	}
+}
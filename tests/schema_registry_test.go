@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/domain/event"
+	"github.com/zhanshen02154/product/internal/domain/event/order"
+	event2 "github.com/zhanshen02154/product/internal/infrastructure/event"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// paymentSuccessV1Descriptor 旧版支付成功事件：每个订单只有一个SKU
+func paymentSuccessV1Descriptor(t *testing.T) protoreflect.MessageDescriptor {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   typ.Enum(),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+	}
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test/order_event_v1.proto"),
+		Package: proto.String("test.order.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("OnPaymentSuccess"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("OrderId", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+				field("SkuId", 2, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+				field("Quantity", 3, descriptorpb.FieldDescriptorProto_TYPE_UINT32),
+			},
+		}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to build descriptor: %v", err)
+	}
+	return file.Messages().Get(0)
+}
+
+func TestSchemaRegistry_UpcastInGenericHandler(t *testing.T) {
+	v1 := paymentSuccessV1Descriptor(t)
+	registry := event2.NewSchemaRegistry()
+	err := registry.Register(event2.EventSchema{
+		EventType:  "OnPaymentSuccess",
+		Version:    1,
+		Descriptor: v1,
+		Upcast: func(msg proto.Message) (proto.Message, error) {
+			old := msg.ProtoReflect()
+			get := func(name protoreflect.Name) protoreflect.Value { return old.Get(v1.Fields().ByName(name)) }
+			return &order.OnPaymentSuccess{
+				OrderId: get("OrderId").Int(),
+				OrderDetails: []*order.OrderDetail{
+					{SkuId: get("SkuId").Int(), Quantity: uint32(get("Quantity").Uint())},
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("register v1 failed: %v", err)
+	}
+	err = registry.Register(event2.EventSchema{
+		EventType:  "OnPaymentSuccess",
+		Version:    2,
+		Descriptor: (&order.OnPaymentSuccess{}).ProtoReflect().Descriptor(),
+	})
+	if err != nil {
+		t.Fatalf("register v2 failed: %v", err)
+	}
+	if registry.LatestVersion("OnPaymentSuccess") != 2 || registry.LatestVersion("OnOrderRefunded") != 1 {
+		t.Fatalf("unexpected latest versions")
+	}
+
+	var received *order.OnPaymentSuccess
+	handler := event2.NewGenericHandler("OnPaymentSuccess", func(ctx context.Context, req *order.OnPaymentSuccess) error {
+		received = req
+		return nil
+	}, func() *order.OnPaymentSuccess { return &order.OnPaymentSuccess{} }, event2.WithSchemaRegistry(registry))
+
+	old := dynamicpb.NewMessage(v1)
+	old.Set(v1.Fields().ByName("OrderId"), protoreflect.ValueOfInt64(1001))
+	old.Set(v1.Fields().ByName("SkuId"), protoreflect.ValueOfInt64(7))
+	old.Set(v1.Fields().ByName("Quantity"), protoreflect.ValueOfUint32(3))
+	payload, _ := proto.Marshal(old)
+	if err := handler.Handle(context.Background(), &event.BaseEvent{EventType: "OnPaymentSuccess", SchemaVersion: 1, Payload: payload}); err != nil {
+		t.Fatalf("handle v1 failed: %v", err)
+	}
+	if received.OrderId != 1001 || len(received.OrderDetails) != 1 || received.OrderDetails[0].SkuId != 7 || received.OrderDetails[0].Quantity != 3 {
+		t.Fatalf("unexpected upcasted event: %v", received)
+	}
+
+	payload, _ = proto.Marshal(&order.OnPaymentSuccess{OrderId: 1002, OrderDetails: []*order.OrderDetail{{SkuId: 8, Quantity: 1}}})
+	if err := handler.Handle(context.Background(), &event.BaseEvent{EventType: "OnPaymentSuccess", SchemaVersion: 2, Payload: payload}); err != nil {
+		t.Fatalf("handle v2 failed: %v", err)
+	}
+	if received.OrderId != 1002 || received.OrderDetails[0].SkuId != 8 {
+		t.Fatalf("unexpected current event: %v", received)
+	}
+
+	if err := handler.Handle(context.Background(), &event.BaseEvent{EventType: "OnPaymentSuccess", SchemaVersion: 3, Payload: payload}); err == nil {
+		t.Fatal("expected error for schema version newer than registered")
+	}
+}
+
+// TestSchemaRegistry_DefaultSchemas 默认注册表登记了服务的事件结构，版本1的支付成功事件升级后收货地址为空
+func TestSchemaRegistry_DefaultSchemas(t *testing.T) {
+	for eventType, want := range map[string]int32{"OnPaymentSuccess": 2, "OnOrderRefunded": 1, "OnInventoryDeductSuccess": 1} {
+		if got := event2.DefaultSchemaRegistry.LatestVersion(eventType); got != want {
+			t.Fatalf("expected latest version %d of %s, got %d", want, eventType, got)
+		}
+	}
+	if err := event2.RegisterEventSchemas(event2.DefaultSchemaRegistry); err == nil {
+		t.Fatal("expected error when registering the same schemas twice")
+	}
+
+	// 版本1与版本2的字段编号一致，版本1的内容不含经纬度
+	payload, _ := proto.Marshal(&order.OnPaymentSuccess{OrderId: 1001, OrderDetails: []*order.OrderDetail{{SkuId: 7, Quantity: 3}}})
+	var received order.OnPaymentSuccess
+	if err := event2.DefaultSchemaRegistry.Decode(&event.BaseEvent{EventType: "OnPaymentSuccess", SchemaVersion: 1, Payload: payload}, &received); err != nil {
+		t.Fatalf("decode v1 failed: %v", err)
+	}
+	if received.OrderId != 1001 || len(received.OrderDetails) != 1 || received.OrderDetails[0].SkuId != 7 || received.ShippingLatitude != 0 {
+		t.Fatalf("unexpected upcasted event: %v", &received)
+	}
+
+	payload, _ = proto.Marshal(&order.OnPaymentSuccess{OrderId: 1002, ShippingLatitude: 31.2, ShippingLongitude: 121.5})
+	if err := event2.DefaultSchemaRegistry.Decode(&event.BaseEvent{EventType: "OnPaymentSuccess", SchemaVersion: 2, Payload: payload}, &received); err != nil {
+		t.Fatalf("decode v2 failed: %v", err)
+	}
+	if received.OrderId != 1002 || received.ShippingLatitude != 31.2 || received.ShippingLongitude != 121.5 {
+		t.Fatalf("unexpected current event: %v", &received)
+	}
+}
@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+)
+
+// matrixProductRepo 内存中的商品仓储，仅实现规格矩阵用到的方法
+type matrixProductRepo struct {
+	repository.IProductRepository
+	product *model.Product
+	specs   []model.ProductSpec
+	nextId  int64
+}
+
+func (r *matrixProductRepo) FindProductDetailByID(ctx context.Context, id int64) (*model.Product, error) {
+	if r.product.ID != id {
+		return nil, nil
+	}
+	return r.product, nil
+}
+
+func (r *matrixProductRepo) FindSpecsByProductID(ctx context.Context, productID int64) ([]model.ProductSpec, error) {
+	return r.specs, nil
+}
+
+func (r *matrixProductRepo) SaveSpec(ctx context.Context, spec *model.ProductSpec) error {
+	if spec.ID == 0 {
+		r.nextId++
+		spec.ID = r.nextId
+	}
+	return nil
+}
+
+func (r *matrixProductRepo) SaveSpecValue(ctx context.Context, value *model.SpecValue) error {
+	if value.ID == 0 {
+		r.nextId++
+		value.ID = r.nextId
+	}
+	return nil
+}
+
+// matrixSkuRepo 内存中的SKU仓储，状态变更同步到商品的SKU
+type matrixSkuRepo struct {
+	repository.ProductSkuRepository
+	product  *model.Product
+	created  []*model.ProductSku
+	disabled []int64
+	enabled  []int64
+}
+
+func (r *matrixSkuRepo) BatchCreateSkus(ctx context.Context, skus []*model.ProductSku) error {
+	for i, sku := range skus {
+		sku.ID = int64(100 + i)
+	}
+	r.created = append(r.created, skus...)
+	return nil
+}
+
+func (r *matrixSkuRepo) UpdateSkuStatusByIds(ctx context.Context, ids []int64, status int8) error {
+	if status == model.ProductStatusOffSale {
+		r.disabled = append(r.disabled, ids...)
+	} else {
+		r.enabled = append(r.enabled, ids...)
+	}
+	if r.product == nil {
+		return nil
+	}
+	for _, id := range ids {
+		for i := range r.product.Skus {
+			if r.product.Skus[i].ID == id {
+				r.product.Skus[i].Status = status
+			}
+		}
+	}
+	return nil
+}
+
+// matrixStockChangeRepo 内存中的库存变更记录仓储
+type matrixStockChangeRepo struct {
+	repository.InventoryStockChangeRecordRepository
+	records []*model.InventoryStockChangeRecord
+}
+
+func (r *matrixStockChangeRepo) BatchCreate(ctx context.Context, records []*model.InventoryStockChangeRecord) error {
+	r.records = append(r.records, records...)
+	return nil
+}
+
+func (r *matrixStockChangeRepo) SumQuantityByOrderId(ctx context.Context, orderId int64, sourceType int32) (map[int64]int64, error) {
+	result := make(map[int64]int64)
+	for _, record := range r.records {
+		if record.OrderID == orderId && record.SourceType == sourceType {
+			result[record.SkuID] += record.Quantity
+		}
+	}
+	return result, nil
+}
+
+// newMatrixFixture 商品P1：颜色[红(1) 蓝(2)]，尺码[S(3)，已删除的M(4)]，SKU 1,3 与 2,3
+func newMatrixFixture() (*matrixProductRepo, *matrixSkuRepo, *matrixStockChangeRepo) {
+	removedM := model.SpecValue{ID: 4, SpecID: 2, ValueName: "M"}
+	removedM.SoftDelete(time.Now())
+	productRepo := &matrixProductRepo{
+		product: &model.Product{
+			ID:          1,
+			ProductNo:   "P1",
+			ProductName: "T恤",
+			Status:      1,
+			Skus: []model.ProductSku{
+				{ID: 10, ProductID: 1, SkuNo: "P1-1-3", SpecValueIDs: "1,3", Status: 1},
+				{ID: 11, ProductID: 1, SkuNo: "P1-2-3", SpecValueIDs: "2,3", Status: 1},
+			},
+		},
+		specs: []model.ProductSpec{
+			{ID: 1, ProductID: 1, SpecName: "颜色", SpecValues: []model.SpecValue{
+				{ID: 1, SpecID: 1, ValueName: "红"},
+				{ID: 2, SpecID: 1, ValueName: "蓝"},
+			}},
+			{ID: 2, ProductID: 1, SpecName: "尺码", SpecValues: []model.SpecValue{
+				{ID: 3, SpecID: 2, ValueName: "S"},
+				removedM,
+			}},
+		},
+		nextId: 20,
+	}
+	return productRepo, &matrixSkuRepo{product: productRepo.product}, &matrixStockChangeRepo{}
+}
+
+func matrixSpecs(specs ...[]string) []*dto.SpecInputDto {
+	result := make([]*dto.SpecInputDto, 0, len(specs))
+	for _, spec := range specs {
+		item := &dto.SpecInputDto{SpecName: spec[0]}
+		for _, value := range spec[1:] {
+			item.Values = append(item.Values, &dto.SpecValueInputDto{ValueName: value})
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// TestSkuMatrix_PreviewDiff 移除蓝色、新增L：红S保留，蓝S下架，新增红L
+func TestSkuMatrix_PreviewDiff(t *testing.T) {
+	productRepo, skuRepo, stockRepo := newMatrixFixture()
+	svc := service.NewSkuMatrixService(productRepo, skuRepo, stockRepo)
+
+	diff, err := svc.PreviewSkuMatrix(context.Background(), &dto.SkuMatrixDto{
+		ProductID:  1,
+		Specs:      matrixSpecs([]string{"颜色", "红"}, []string{"尺码", "S", "L"}),
+		DefaultSku: &dto.SkuInputDto{Price: 99},
+	})
+	if err != nil {
+		t.Fatalf("preview failed: %v", err)
+	}
+	if len(diff.Keep) != 1 || diff.Keep[0].SkuID != 10 {
+		t.Errorf("expected sku 10 to be kept, got %+v", diff.Keep)
+	}
+	if len(diff.Disable) != 1 || diff.Disable[0].SkuID != 11 {
+		t.Errorf("expected sku 11 to be disabled, got %+v", diff.Disable)
+	}
+	if len(diff.Create) != 1 {
+		t.Fatalf("expected one sku to be created, got %d", len(diff.Create))
+	}
+	created := diff.Create[0]
+	if created.SkuNo != "" || created.SpecValueText != "颜色:红;尺码:L" || created.SkuName != "T恤 红 L" {
+		t.Errorf("unexpected preview of new sku: %+v", created)
+	}
+	if len(skuRepo.created) != 0 || len(skuRepo.disabled) != 0 {
+		t.Error("preview must not write")
+	}
+}
+
+// TestSkuMatrix_ApplyRestoresRemovedValue 加回已删除的M时沿用原规格值ID，新增规格排在已有规格之后
+func TestSkuMatrix_ApplyRestoresRemovedValue(t *testing.T) {
+	productRepo, skuRepo, stockRepo := newMatrixFixture()
+	svc := service.NewSkuMatrixService(productRepo, skuRepo, stockRepo)
+
+	diff, err := svc.ApplySkuMatrix(context.Background(), &dto.SkuMatrixDto{
+		ProductID:  1,
+		Specs:      matrixSpecs([]string{"材质", "棉"}, []string{"颜色", "红", "蓝"}, []string{"尺码", "S", "M"}),
+		DefaultSku: &dto.SkuInputDto{Price: 99, Stock: 5},
+		Skus: []*dto.SkuInputDto{
+			{SpecValues: []string{"棉", "红", "M"}, SkuName: "红色M码", Price: 109},
+		},
+	})
+	if err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+	if len(diff.Keep) != 0 || len(diff.Disable) != 2 || len(diff.Create) != 4 {
+		t.Fatalf("unexpected diff: keep=%d disable=%d create=%d", len(diff.Keep), len(diff.Disable), len(diff.Create))
+	}
+	// 材质为新规格(21)，棉为新规格值(22)，M恢复为4
+	expected := map[string]string{
+		"1,3,22": "P1-1-3-22",
+		"1,4,22": "P1-1-4-22",
+		"2,3,22": "P1-2-3-22",
+		"2,4,22": "P1-2-4-22",
+	}
+	for _, sku := range skuRepo.created {
+		if expected[sku.SpecValueIDs] != sku.SkuNo {
+			t.Errorf("unexpected sku_no %s for spec values %s", sku.SkuNo, sku.SpecValueIDs)
+		}
+		if sku.SpecValueIDs == "1,4,22" && (sku.SkuName != "红色M码" || sku.Price != 109) {
+			t.Errorf("override not applied: %+v", sku)
+		}
+	}
+	if productRepo.specs[1].SpecValues[1].Removed() {
+		t.Error("expected removed value M to be restored")
+	}
+	if len(stockRepo.records) != 3 {
+		t.Errorf("expected initial stock records for 3 skus, got %d", len(stockRepo.records))
+	}
+	if len(skuRepo.disabled) != 2 {
+		t.Errorf("expected 2 skus to be disabled, got %v", skuRepo.disabled)
+	}
+}
+
+// TestSkuMatrix_ReAddedComboEnablesOffSaleSku 移除蓝色后再加回，原蓝S沿用并重新上架
+func TestSkuMatrix_ReAddedComboEnablesOffSaleSku(t *testing.T) {
+	productRepo, skuRepo, stockRepo := newMatrixFixture()
+	svc := service.NewSkuMatrixService(productRepo, skuRepo, stockRepo)
+
+	if _, err := svc.ApplySkuMatrix(context.Background(), &dto.SkuMatrixDto{
+		ProductID: 1,
+		Specs:     matrixSpecs([]string{"颜色", "红"}, []string{"尺码", "S"}),
+	}); err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+	if len(skuRepo.disabled) != 1 || skuRepo.disabled[0] != 11 || productRepo.product.Skus[1].Status != model.ProductStatusOffSale {
+		t.Fatalf("expected sku 11 to be disabled, got %v", skuRepo.disabled)
+	}
+
+	diff, err := svc.PreviewSkuMatrix(context.Background(), &dto.SkuMatrixDto{
+		ProductID: 1,
+		Specs:     matrixSpecs([]string{"颜色", "红", "蓝"}, []string{"尺码", "S"}),
+	})
+	if err != nil {
+		t.Fatalf("preview failed: %v", err)
+	}
+	if len(diff.Keep) != 2 || diff.Keep[1].SkuID != 11 || diff.Keep[1].Status != model.ProductStatusOnSale {
+		t.Fatalf("expected sku 11 to be kept on sale, got %+v", diff.Keep)
+	}
+	if len(skuRepo.enabled) != 0 {
+		t.Fatal("preview must not write")
+	}
+
+	diff, err = svc.ApplySkuMatrix(context.Background(), &dto.SkuMatrixDto{
+		ProductID: 1,
+		Specs:     matrixSpecs([]string{"颜色", "红", "蓝"}, []string{"尺码", "S"}),
+	})
+	if err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+	if len(diff.Create) != 0 || len(diff.Disable) != 0 || len(diff.Keep) != 2 {
+		t.Fatalf("unexpected diff: keep=%d disable=%d create=%d", len(diff.Keep), len(diff.Disable), len(diff.Create))
+	}
+	if len(skuRepo.enabled) != 1 || skuRepo.enabled[0] != 11 {
+		t.Fatalf("expected sku 11 to be enabled, got %v", skuRepo.enabled)
+	}
+	if productRepo.product.Skus[1].Status != model.ProductStatusOnSale {
+		t.Errorf("expected sku 11 on sale, got status %d", productRepo.product.Skus[1].Status)
+	}
+	if len(skuRepo.disabled) != 1 {
+		t.Errorf("expected no more skus disabled, got %v", skuRepo.disabled)
+	}
+}
@@ -0,0 +1,251 @@
+package tests
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// priceSkuRepo 内存中的SKU价格
+type priceSkuRepo struct {
+	repository.ProductSkuRepository
+	sku model.ProductSku
+}
+
+func (r *priceSkuRepo) FindSkuPriceForUpdate(ctx context.Context, id int64) (*model.ProductSku, error) {
+	if id != r.sku.ID {
+		return nil, nil
+	}
+	sku := r.sku
+	return &sku, nil
+}
+
+func (r *priceSkuRepo) GetSkuDetailByID(ctx context.Context, id int64) (*model.ProductSku, error) {
+	return r.FindSkuPriceForUpdate(ctx, id)
+}
+
+func (r *priceSkuRepo) UpdatePriceById(ctx context.Context, id int64, price float64, marketPrice *float64) error {
+	r.sku.Price, r.sku.MarketPrice = price, marketPrice
+	return nil
+}
+
+// priceHistoryRepo 内存中的价格变更记录
+type priceHistoryRepo struct {
+	repository.SkuPriceHistoryRepository
+	changes []*model.SkuPriceHistory
+}
+
+func (r *priceHistoryRepo) Create(ctx context.Context, history *model.SkuPriceHistory) error {
+	history.ID = int64(len(r.changes) + 1)
+	saved := *history
+	r.changes = append(r.changes, &saved)
+	return nil
+}
+
+func (r *priceHistoryRepo) Update(ctx context.Context, history *model.SkuPriceHistory) error {
+	saved := *history
+	r.changes[history.ID-1] = &saved
+	return nil
+}
+
+func (r *priceHistoryRepo) FindByID(ctx context.Context, id int64) (*model.SkuPriceHistory, error) {
+	change := *r.changes[id-1]
+	return &change, nil
+}
+
+func (r *priceHistoryRepo) ListOpenBySkuID(ctx context.Context, skuID int64) ([]model.SkuPriceHistory, error) {
+	var results []model.SkuPriceHistory
+	for _, change := range r.changes {
+		if change.ChangeType != model.PriceChangeTypeScheduled {
+			continue
+		}
+		if change.Status == model.PriceChangeStatusPending || (change.Status == model.PriceChangeStatusApplied && change.EffectiveTo != nil) {
+			results = append(results, *change)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].EffectiveFrom.Before(results[j].EffectiveFrom) })
+	return results, nil
+}
+
+func (r *priceHistoryRepo) MaxAppliedSeq(ctx context.Context, skuID int64) (int64, error) {
+	var seq int64
+	for _, change := range r.changes {
+		if change.AppliedSeq > seq {
+			seq = change.AppliedSeq
+		}
+	}
+	return seq, nil
+}
+
+// appliedBefore 按生效时间、生效序号比较两条已生效记录的先后
+func appliedBefore(a, b *model.SkuPriceHistory) bool {
+	if !a.AppliedAt.Equal(*b.AppliedAt) {
+		return a.AppliedAt.Before(*b.AppliedAt)
+	}
+	return a.AppliedSeq < b.AppliedSeq
+}
+
+func (r *priceHistoryRepo) FindLatestAppliedAtOrBefore(ctx context.Context, skuID int64, at time.Time) (*model.SkuPriceHistory, error) {
+	var latest *model.SkuPriceHistory
+	for _, change := range r.changes {
+		if change.AppliedAt != nil && !change.AppliedAt.After(at) && (latest == nil || appliedBefore(latest, change)) {
+			latest = change
+		}
+	}
+	return latest, nil
+}
+
+func (r *priceHistoryRepo) FindEarliestAppliedAfter(ctx context.Context, skuID int64, at time.Time) (*model.SkuPriceHistory, error) {
+	var earliest *model.SkuPriceHistory
+	for _, change := range r.changes {
+		if change.AppliedAt != nil && change.AppliedAt.After(at) && (earliest == nil || appliedBefore(change, earliest)) {
+			earliest = change
+		}
+	}
+	return earliest, nil
+}
+
+// TestSkuPrice_ApplyDueChanges 限时调价期间不能开始其他调价，到期时先恢复原价再执行同一时刻生效的调价
+func TestSkuPrice_ApplyDueChanges(t *testing.T) {
+	skuRepo := &priceSkuRepo{sku: model.ProductSku{ID: 1, ProductID: 7, Price: 100}}
+	historyRepo := &priceHistoryRepo{}
+	svc := service.NewSkuPriceService(skuRepo, historyRepo)
+	now := time.Now().Truncate(time.Second)
+	format := func(d time.Duration) string { return now.Add(d).Format("2006-01-02 15:04:05") }
+
+	_, _, err := svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{
+		SkuID: 1, Price: 80, EffectiveFrom: format(time.Hour), EffectiveTo: format(2 * time.Hour), OperatorID: 9,
+	}, now)
+	if err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	_, _, err = svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{
+		SkuID: 1, Price: 70, EffectiveFrom: format(90 * time.Minute), OperatorID: 9,
+	}, now)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for change inside a limited-time change, got %v", err)
+	}
+	_, _, err = svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{
+		SkuID: 1, Price: 90, MarketPrice: 120, EffectiveFrom: format(2 * time.Hour), OperatorID: 9,
+	}, now)
+	if err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+
+	changes, err := svc.ApplyDueChanges(context.Background(), 1, now.Add(30*time.Minute))
+	if err != nil || len(changes) != 0 {
+		t.Fatalf("expected no due changes, got %v %v", changes, err)
+	}
+	changes, err = svc.ApplyDueChanges(context.Background(), 1, now.Add(3*time.Hour))
+	if err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes, got %d", len(changes))
+	}
+	if changes[0].Price != 80 || changes[0].PreviousPrice != 100 {
+		t.Errorf("unexpected first change: %+v", changes[0])
+	}
+	if changes[1].ChangeType != model.PriceChangeTypeRevert || changes[1].Price != 100 || changes[1].PreviousPrice != 80 {
+		t.Errorf("expected revert to 100 before the next change, got %+v", changes[1])
+	}
+	if changes[2].Price != 90 || changes[2].PreviousPrice != 100 || changes[2].ProductID != 7 {
+		t.Errorf("unexpected last change: %+v", changes[2])
+	}
+	if skuRepo.sku.Price != 90 || skuRepo.sku.MarketPrice == nil || *skuRepo.sku.MarketPrice != 120 {
+		t.Errorf("unexpected sku price: %v %v", skuRepo.sku.Price, skuRepo.sku.MarketPrice)
+	}
+	if historyRepo.changes[0].Status != model.PriceChangeStatusReverted {
+		t.Errorf("expected limited-time change to be reverted, got status %d", historyRepo.changes[0].Status)
+	}
+}
+
+// TestSkuPrice_GetSkuPriceAtSameTick 限时调价到期与新调价在同一次执行中生效时，查询到的是最后执行的新调价
+func TestSkuPrice_GetSkuPriceAtSameTick(t *testing.T) {
+	skuRepo := &priceSkuRepo{sku: model.ProductSku{ID: 1, Price: 100}}
+	historyRepo := &priceHistoryRepo{}
+	svc := service.NewSkuPriceService(skuRepo, historyRepo)
+	now := time.Now().Truncate(time.Second)
+	format := func(d time.Duration) string { return now.Add(d).Format("2006-01-02 15:04:05") }
+
+	if _, _, err := svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{
+		SkuID: 1, Price: 80, EffectiveFrom: format(time.Hour), EffectiveTo: format(2 * time.Hour), OperatorID: 9,
+	}, now); err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	if _, _, err := svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{
+		SkuID: 1, Price: 90, EffectiveFrom: format(2 * time.Hour), OperatorID: 9,
+	}, now); err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	if _, err := svc.ApplyDueChanges(context.Background(), 1, now.Add(time.Hour)); err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+
+	// 恢复原价记录后创建、ID更大，但先于ID更小的调价执行
+	tick := now.Add(2 * time.Hour)
+	changes, err := svc.ApplyDueChanges(context.Background(), 1, tick)
+	if err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+	if len(changes) != 2 || changes[0].ChangeType != model.PriceChangeTypeRevert || changes[0].ChangeID <= changes[1].ChangeID {
+		t.Fatalf("expected revert to be created after the scheduled change, got %+v", changes)
+	}
+	if seq := historyRepo.changes; seq[2].AppliedSeq >= seq[1].AppliedSeq || seq[0].AppliedSeq >= seq[2].AppliedSeq {
+		t.Fatalf("expected applied sequence in execution order, got %d %d %d", seq[0].AppliedSeq, seq[2].AppliedSeq, seq[1].AppliedSeq)
+	}
+
+	at, err := svc.GetSkuPriceAt(context.Background(), 1, tick.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		t.Fatalf("get price failed: %v", err)
+	}
+	if at.Price != 90 || at.ChangeID != changes[1].ChangeID {
+		t.Fatalf("expected price 90 of change %d, got %+v", changes[1].ChangeID, at)
+	}
+	if skuRepo.sku.Price != 90 {
+		t.Errorf("unexpected sku price %v", skuRepo.sku.Price)
+	}
+}
+
+// TestSkuPrice_GetSkuPriceAt 立即生效的调价，生效前的时刻返回原价
+func TestSkuPrice_GetSkuPriceAt(t *testing.T) {
+	skuRepo := &priceSkuRepo{sku: model.ProductSku{ID: 1, Price: 100}}
+	svc := service.NewSkuPriceService(skuRepo, &priceHistoryRepo{})
+	now := time.Now().Truncate(time.Second)
+
+	change, applied, err := svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{SkuID: 1, Price: 59.9, OperatorID: 9}, now)
+	if err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	if change.Status != model.PriceChangeStatusApplied || len(applied) != 1 || skuRepo.sku.Price != 59.9 {
+		t.Fatalf("expected change to apply immediately, got %+v", change)
+	}
+
+	before, err := svc.GetSkuPriceAt(context.Background(), 1, now.Add(-time.Minute).Format("2006-01-02 15:04:05"))
+	if err != nil {
+		t.Fatalf("get price failed: %v", err)
+	}
+	if before.Price != 100 || before.ChangeID != 0 {
+		t.Errorf("expected price before change to be 100, got %+v", before)
+	}
+	after, err := svc.GetSkuPriceAt(context.Background(), 1, now.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		t.Fatalf("get price failed: %v", err)
+	}
+	if after.Price != 59.9 || after.ChangeID != change.ID {
+		t.Errorf("expected price 59.9 from change %d, got %+v", change.ID, after)
+	}
+
+	_, _, err = svc.SchedulePriceChange(context.Background(), &dto.SchedulePriceChangeDto{SkuID: 1, Price: 10.001, OperatorID: 9}, now)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for 3 decimal places, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adjustableSkuRepo 内存中的SKU库存
+type adjustableSkuRepo struct {
+	repository.ProductSkuRepository
+	stock map[int64]uint32
+}
+
+func (r *adjustableSkuRepo) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	skus := make([]model.ProductSku, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		if stock, ok := r.stock[id]; ok {
+			skus = append(skus, model.ProductSku{ID: id, Stock: stock, StockWarn: 5})
+		}
+	}
+	return skus, nil
+}
+
+func (r *adjustableSkuRepo) AdjustInventoryById(ctx context.Context, id int64, delta int64) error {
+	if int64(r.stock[id])+delta < 0 {
+		return repository.ErrInsufficientStock
+	}
+	r.stock[id] = uint32(int64(r.stock[id]) + delta)
+	return nil
+}
+
+func (r *adjustableSkuRepo) GetSkuDetailByID(ctx context.Context, skuID int64) (*model.ProductSku, error) {
+	stock, ok := r.stock[skuID]
+	if !ok {
+		return nil, nil
+	}
+	return &model.ProductSku{ID: skuID, Stock: stock}, nil
+}
+
+// newAdjustmentWarehouseRepo 北京仓与上海仓，SKU 1在北京仓6件、上海仓4件
+func newAdjustmentWarehouseRepo() *allocationWarehouseRepo {
+	return &allocationWarehouseRepo{
+		warehouses: []model.Warehouse{
+			{ID: 1, Code: "BJ", Status: model.WarehouseStatusEnabled},
+			{ID: 2, Code: "SH", Status: model.WarehouseStatusEnabled},
+		},
+		stocks: []model.SkuWarehouseStock{
+			{ID: 11, SkuID: 1, WarehouseID: 1, Stock: 6},
+			{ID: 12, SkuID: 1, WarehouseID: 2, Stock: 4},
+		},
+	}
+}
+
+// TestStockAdjustment_AdjustStock 手动调整须填写原因并指定仓库，分仓库存与总库存都不能调为负数
+func TestStockAdjustment_AdjustStock(t *testing.T) {
+	skuRepo := &adjustableSkuRepo{stock: map[int64]uint32{1: 10}}
+	stockChangeRepo := &matrixStockChangeRepo{}
+	warehouseRepo := newAdjustmentWarehouseRepo()
+	svc := service.NewStockAdjustmentService(skuRepo, stockChangeRepo, warehouseRepo)
+
+	_, err := svc.AdjustStock(context.Background(), &dto.AdjustStockDto{SkuID: 1, WarehouseID: 1, Delta: 3, OperatorID: 9, Reason: "  "})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for blank reason, got %v", err)
+	}
+	_, err = svc.AdjustStock(context.Background(), &dto.AdjustStockDto{SkuID: 1, Delta: 3, OperatorID: 9, Reason: "found"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for missing warehouse, got %v", err)
+	}
+	_, err = svc.AdjustStock(context.Background(), &dto.AdjustStockDto{SkuID: 1, WarehouseID: 9, Delta: 3, OperatorID: 9, Reason: "found"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for unknown warehouse, got %v", err)
+	}
+	// 总库存足够但北京仓只有6件
+	_, err = svc.AdjustStock(context.Background(), &dto.AdjustStockDto{SkuID: 1, WarehouseID: 1, Delta: -7, OperatorID: 9, Reason: "damaged"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	result, err := svc.AdjustStock(context.Background(), &dto.AdjustStockDto{SkuID: 1, WarehouseID: 1, Delta: -4, OperatorID: 9, Reason: "damaged"})
+	if err != nil {
+		t.Fatalf("adjust failed: %v", err)
+	}
+	if result.BeforeStock != 10 || result.AfterStock != 6 || skuRepo.stock[1] != 6 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.WarehouseID != 1 || result.WarehouseStock != 2 || warehouseRepo.stockOf(1, 1) != 2 || warehouseRepo.stockOf(1, 2) != 4 {
+		t.Fatalf("unexpected warehouse stock: %+v %+v", result, warehouseRepo.stocks)
+	}
+	record := stockChangeRepo.records[0]
+	if record.SourceType != model.SourceTypeManual || record.Quantity != -4 || record.OperatorID != 9 || record.Reason != "damaged (BJ)" {
+		t.Errorf("unexpected stock change record: %+v", record)
+	}
+}
+
+// TestStockAdjustment_SubmitStocktake 按实盘数量与仓库中的库存计算差异，无差异的SKU不写变更记录
+func TestStockAdjustment_SubmitStocktake(t *testing.T) {
+	skuRepo := &adjustableSkuRepo{stock: map[int64]uint32{1: 10, 2: 5, 3: 0}}
+	stockChangeRepo := &matrixStockChangeRepo{}
+	warehouseRepo := newAdjustmentWarehouseRepo()
+	warehouseRepo.stocks = append(warehouseRepo.stocks, model.SkuWarehouseStock{ID: 13, SkuID: 2, WarehouseID: 1, Stock: 5})
+	svc := service.NewStockAdjustmentService(skuRepo, stockChangeRepo, warehouseRepo)
+
+	_, err := svc.SubmitStocktake(context.Background(), &dto.StocktakeDto{
+		WarehouseID: 1,
+		Items:       []*dto.StocktakeItemDto{{SkuID: 1, CountedQuantity: 1}, {SkuID: 1, CountedQuantity: 2}},
+		OperatorID:  9,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for duplicate sku, got %v", err)
+	}
+	_, err = svc.SubmitStocktake(context.Background(), &dto.StocktakeDto{
+		Items:      []*dto.StocktakeItemDto{{SkuID: 1, CountedQuantity: 1}},
+		OperatorID: 9,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for missing warehouse, got %v", err)
+	}
+
+	// 北京仓SKU 1账面6件实盘3件，SKU 2无差异，SKU 3未登记按0件计
+	results, err := svc.SubmitStocktake(context.Background(), &dto.StocktakeDto{
+		WarehouseID: 1,
+		Items:       []*dto.StocktakeItemDto{{SkuID: 3, CountedQuantity: 2}, {SkuID: 1, CountedQuantity: 3}, {SkuID: 2, CountedQuantity: 5}},
+		OperatorID:  9,
+	})
+	if err != nil {
+		t.Fatalf("stocktake failed: %v", err)
+	}
+	if len(results) != 3 || results[0].SkuID != 1 || results[0].Delta != -3 || results[1].Delta != 0 || results[2].Delta != 2 {
+		t.Fatalf("unexpected results: %+v %+v %+v", results[0], results[1], results[2])
+	}
+	if results[0].WarehouseStock != 3 || results[1].WarehouseStock != 5 || results[2].WarehouseStock != 2 {
+		t.Fatalf("unexpected warehouse stock in results: %+v %+v %+v", results[0], results[1], results[2])
+	}
+	if skuRepo.stock[1] != 7 || skuRepo.stock[2] != 5 || skuRepo.stock[3] != 2 {
+		t.Errorf("unexpected stock: %v", skuRepo.stock)
+	}
+	if warehouseRepo.stockOf(1, 1) != 3 || warehouseRepo.stockOf(1, 2) != 4 || warehouseRepo.stockOf(3, 1) != 2 {
+		t.Errorf("unexpected warehouse stock: %+v", warehouseRepo.stocks)
+	}
+	if len(stockChangeRepo.records) != 2 || stockChangeRepo.records[0].BeforeStock != 10 || stockChangeRepo.records[0].AfterStock != 7 {
+		t.Errorf("unexpected stock change records: %d", len(stockChangeRepo.records))
+	}
+}
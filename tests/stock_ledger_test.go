@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryLedgerRepo 按ID升序保存的库存变更记录
+type memoryLedgerRepo struct {
+	repository.InventoryStockChangeRecordRepository
+	records []model.InventoryStockChangeRecord
+}
+
+func (r *memoryLedgerRepo) ListByCursor(ctx context.Context, filter *repository.StockChangeFilter, cursor int64, limit int) ([]model.InventoryStockChangeRecord, error) {
+	result := make([]model.InventoryStockChangeRecord, 0, limit)
+	for i := len(r.records) - 1; i >= 0 && len(result) < limit; i-- {
+		record := r.records[i]
+		if (cursor > 0 && record.ID >= cursor) || (filter.SkuID > 0 && record.SkuID != filter.SkuID) {
+			continue
+		}
+		if filter.SourceType > 0 && record.SourceType != filter.SourceType {
+			continue
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+func (r *memoryLedgerRepo) FindLatestAtOrBefore(ctx context.Context, skuID int64, at time.Time) (*model.InventoryStockChangeRecord, error) {
+	for i := len(r.records) - 1; i >= 0; i-- {
+		if r.records[i].SkuID == skuID && !r.records[i].CreatedAt.Time.After(at) {
+			return &r.records[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryLedgerRepo) FindEarliestAfter(ctx context.Context, skuID int64, at time.Time) (*model.InventoryStockChangeRecord, error) {
+	for i := range r.records {
+		if r.records[i].SkuID == skuID && r.records[i].CreatedAt.Time.After(at) {
+			return &r.records[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ledgerSkuRepo SKU 1 当前库存为3
+type ledgerSkuRepo struct {
+	repository.ProductSkuRepository
+}
+
+func (r *ledgerSkuRepo) GetSkuDetailByID(ctx context.Context, skuID int64) (*model.ProductSku, error) {
+	if skuID == 1 {
+		return &model.ProductSku{ID: 1, Stock: 3}, nil
+	}
+	return nil, nil
+}
+
+func newLedgerFixture() *memoryLedgerRepo {
+	at := func(value string) sql.NullTime {
+		t, _ := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+		return sql.NullTime{Time: t, Valid: true}
+	}
+	return &memoryLedgerRepo{records: []model.InventoryStockChangeRecord{
+		{ID: 1, SkuID: 2, SourceType: model.SourceTypeInitial, Quantity: 10, BeforeStock: 0, AfterStock: 10, CreatedAt: at("2024-03-01 09:00:00")},
+		{ID: 2, SkuID: 2, SourceType: model.SourceTypeOrderPayment, Quantity: -4, BeforeStock: 10, AfterStock: 6, CreatedAt: at("2024-03-02 10:00:00")},
+		{ID: 3, SkuID: 3, SourceType: model.SourceTypeManual, Quantity: 5, BeforeStock: 0, AfterStock: 5, CreatedAt: at("2024-03-02 10:00:00")},
+		{ID: 4, SkuID: 2, SourceType: model.SourceTypeOrderPayment, Quantity: -1, BeforeStock: 6, AfterStock: 5, CreatedAt: at("2024-03-02 10:00:00")},
+		{ID: 5, SkuID: 2, SourceType: model.SourceTypeManual, Quantity: 7, BeforeStock: 5, AfterStock: 12, CreatedAt: at("2024-03-05 18:30:00")},
+	}}
+}
+
+// TestStockLedger_ListStockChanges 按ID倒序游标分页，最后一页游标为0
+func TestStockLedger_ListStockChanges(t *testing.T) {
+	svc := service.NewStockLedgerService(newLedgerFixture(), &ledgerSkuRepo{})
+
+	records, cursor, err := svc.ListStockChanges(context.Background(), &dto.ListStockChangesDto{SkuID: 2, Limit: 2})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(records) != 2 || records[0].ID != 5 || records[1].ID != 4 || cursor != 4 {
+		t.Fatalf("unexpected first page: %d records, cursor %d", len(records), cursor)
+	}
+	records, cursor, err = svc.ListStockChanges(context.Background(), &dto.ListStockChangesDto{SkuID: 2, Limit: 2, Cursor: cursor})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(records) != 2 || records[0].ID != 2 || records[1].ID != 1 || cursor != 0 {
+		t.Fatalf("unexpected last page: %d records, cursor %d", len(records), cursor)
+	}
+
+	_, _, err = svc.ListStockChanges(context.Background(), &dto.ListStockChangesDto{Limit: 10, StartTime: "2024-03-02"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for malformed time, got %v", err)
+	}
+}
+
+// TestStockLedger_GetStockAtTime 同一时刻多条记录取最后写入的一条，早于所有记录时取首条的变更前库存
+func TestStockLedger_GetStockAtTime(t *testing.T) {
+	svc := service.NewStockLedgerService(newLedgerFixture(), &ledgerSkuRepo{})
+
+	cases := []struct {
+		skuId    int64
+		at       string
+		stock    int64
+		recordId int64
+	}{
+		{2, "2024-02-28 00:00:00", 0, 1},
+		{2, "2024-03-01 09:00:00", 10, 1},
+		{2, "2024-03-02 10:00:00", 5, 4},
+		{2, "2024-03-04 00:00:00", 5, 4},
+		{2, "2024-04-01 00:00:00", 12, 5},
+		{1, "2024-03-01 00:00:00", 3, 0},
+	}
+	for _, c := range cases {
+		result, err := svc.GetStockAtTime(context.Background(), c.skuId, c.at)
+		if err != nil {
+			t.Fatalf("sku %d at %s: %v", c.skuId, c.at, err)
+		}
+		if result.Stock != c.stock || result.RecordID != c.recordId {
+			t.Errorf("sku %d at %s: expected stock %d from record %d, got %d from %d", c.skuId, c.at, c.stock, c.recordId, result.Stock, result.RecordID)
+		}
+	}
+	if _, err := svc.GetStockAtTime(context.Background(), 9, "2024-03-01 00:00:00"); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
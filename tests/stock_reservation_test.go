@@ -0,0 +1,500 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/event/order"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+	gorm2 "github.com/zhanshen02154/product/internal/infrastructure/persistence/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// reservationSkuRepo 内存中的SKU库存与销量
+type reservationSkuRepo struct {
+	repository.ProductSkuRepository
+	skus map[int64]*model.ProductSku
+}
+
+func newReservationSkuRepo(stock map[int64]uint32) *reservationSkuRepo {
+	r := &reservationSkuRepo{skus: make(map[int64]*model.ProductSku, len(stock))}
+	for id, s := range stock {
+		r.skus[id] = &model.ProductSku{ID: id, Stock: s, Status: model.ProductStatusOnSale}
+	}
+	return r
+}
+
+func (r *reservationSkuRepo) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	skus := make([]model.ProductSku, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		if sku, ok := r.skus[id]; ok {
+			skus = append(skus, *sku)
+		}
+	}
+	return skus, nil
+}
+
+func (r *reservationSkuRepo) BatchGetSkuByIDsWithFields(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	return r.BatchGetSkuByIDsForUpdate(ctx, skuIDs)
+}
+
+func (r *reservationSkuRepo) ReserveInventoryById(ctx context.Context, id int64, count uint32) error {
+	if r.skus[id].Stock < count {
+		return repository.ErrInsufficientStock
+	}
+	r.skus[id].Stock -= count
+	return nil
+}
+
+func (r *reservationSkuRepo) DeductInventoryById(ctx context.Context, id int64, count uint32) error {
+	if r.skus[id].Stock < count {
+		return repository.ErrInsufficientStock
+	}
+	r.skus[id].Stock -= count
+	r.skus[id].Sales += int(count)
+	return nil
+}
+
+func (r *reservationSkuRepo) IncreaseSalesById(ctx context.Context, id int64, count uint32) error {
+	r.skus[id].Sales += int(count)
+	return nil
+}
+
+func (r *reservationSkuRepo) RestoreInventoryById(ctx context.Context, id int64, count uint32) error {
+	r.skus[id].Stock += count
+	return nil
+}
+
+func (r *reservationSkuRepo) RefundInventoryById(ctx context.Context, id int64, count uint32) error {
+	r.skus[id].Stock += count
+	r.skus[id].Sales -= int(count)
+	if r.skus[id].Sales < 0 {
+		r.skus[id].Sales = 0
+	}
+	return nil
+}
+
+// memoryReservationRepo 内存中的预占记录
+type memoryReservationRepo struct {
+	repository.SkuStockReservationRepository
+	reservations []model.SkuStockReservation
+}
+
+func (r *memoryReservationRepo) BatchCreate(ctx context.Context, reservations []*model.SkuStockReservation) error {
+	for _, item := range reservations {
+		item.ID = int64(len(r.reservations) + 1)
+		r.reservations = append(r.reservations, *item)
+	}
+	return nil
+}
+
+func (r *memoryReservationRepo) FindByOrderIdForUpdate(ctx context.Context, orderId int64) ([]model.SkuStockReservation, error) {
+	var result []model.SkuStockReservation
+	for _, item := range r.reservations {
+		if item.OrderID == orderId {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryReservationRepo) FindSkuIdsByOrderId(ctx context.Context, orderId int64) ([]int64, error) {
+	seen := make(map[int64]struct{})
+	var result []int64
+	for _, item := range r.reservations {
+		if _, ok := seen[item.SkuID]; !ok && item.OrderID == orderId {
+			seen[item.SkuID] = struct{}{}
+			result = append(result, item.SkuID)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+func (r *memoryReservationRepo) FindExpiredForUpdate(ctx context.Context, now time.Time, limit int) ([]model.SkuStockReservation, error) {
+	var result []model.SkuStockReservation
+	for _, item := range r.reservations {
+		if item.Status == model.ReservationStatusHeld && item.ExpiresAt.Valid && !item.ExpiresAt.Time.After(now) && len(result) < limit {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryReservationRepo) UpdateStatusByIds(ctx context.Context, ids []int64, fromStatus uint8, toStatus uint8) (int64, error) {
+	var rows int64
+	for _, id := range ids {
+		for i := range r.reservations {
+			if r.reservations[i].ID == id && r.reservations[i].Status == fromStatus {
+				r.reservations[i].Status = toStatus
+				rows++
+			}
+		}
+	}
+	return rows, nil
+}
+
+// statusOf 订单各SKU的预占状态
+func (r *memoryReservationRepo) statusOf(orderId int64) map[int64]uint8 {
+	result := make(map[int64]uint8)
+	for _, item := range r.reservations {
+		if item.OrderID == orderId {
+			result[item.SkuID] = item.Status
+		}
+	}
+	return result
+}
+
+// reservationFixture SKU 1库存10，SKU 2库存5，均未分仓
+type reservationFixture struct {
+	skuRepo         *reservationSkuRepo
+	reservationRepo *memoryReservationRepo
+	ledger          *matrixStockChangeRepo
+	warehouseRepo   *allocationWarehouseRepo
+	svc             service.IStockReservationService
+}
+
+func newReservationFixture() *reservationFixture {
+	f := &reservationFixture{
+		skuRepo:         newReservationSkuRepo(map[int64]uint32{1: 10, 2: 5}),
+		reservationRepo: &memoryReservationRepo{},
+		ledger:          &matrixStockChangeRepo{},
+		warehouseRepo:   &allocationWarehouseRepo{},
+	}
+	f.svc = service.NewStockReservationService(f.skuRepo, f.reservationRepo, f.ledger, f.warehouseRepo, service.AllocationStrategyPriority)
+	return f
+}
+
+// assertSku 校验SKU的库存与销量
+func (f *reservationFixture) assertSku(t *testing.T, id int64, stock uint32, sales int) {
+	t.Helper()
+	sku := f.skuRepo.skus[id]
+	if sku.Stock != stock || sku.Sales != sales {
+		t.Fatalf("expected sku %d stock %d sales %d, got stock %d sales %d", id, stock, sales, sku.Stock, sku.Sales)
+	}
+}
+
+// ledgerTypes 库存变更记录的来源类型
+func (f *reservationFixture) ledgerTypes() []int32 {
+	types := make([]int32, 0, len(f.ledger.records))
+	for _, record := range f.ledger.records {
+		types = append(types, record.SourceType)
+	}
+	return types
+}
+
+func TestStockReservation_ReserveAndConfirm(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	items := []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 2}, {SkuID: 2, Quantity: 1}, {SkuID: 1, Quantity: 1}}
+
+	reservations, err := f.svc.ReserveStock(ctx, 100, items, sql.NullTime{})
+	if err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if len(reservations) != 2 || reservations[0].Quantity != 3 || reservations[1].Quantity != 1 {
+		t.Fatalf("expected reservations merged by sku, got %+v", reservations)
+	}
+	// 预占只扣减库存，不计销量
+	f.assertSku(t, 1, 7, 0)
+	f.assertSku(t, 2, 4, 0)
+
+	// 同一订单重复预占返回已有记录
+	again, err := f.svc.ReserveStock(ctx, 100, items, sql.NullTime{})
+	if err != nil || len(again) != 2 {
+		t.Fatalf("expected existing reservations, got %+v %v", again, err)
+	}
+	f.assertSku(t, 1, 7, 0)
+
+	confirmed, err := f.svc.ConfirmReservation(ctx, 100)
+	if err != nil {
+		t.Fatalf("confirm failed: %v", err)
+	}
+	for _, item := range confirmed {
+		if item.Status != model.ReservationStatusConfirmed {
+			t.Fatalf("expected confirmed reservations, got %+v", confirmed)
+		}
+	}
+	f.assertSku(t, 1, 7, 3)
+	f.assertSku(t, 2, 4, 1)
+
+	// 重复确认不再计入销量
+	if _, err := f.svc.ConfirmReservation(ctx, 100); err != nil {
+		t.Fatalf("duplicate confirm failed: %v", err)
+	}
+	f.assertSku(t, 1, 7, 3)
+	if _, err := f.svc.ReleaseReservation(ctx, 100); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when releasing confirmed reservation, got %v", err)
+	}
+
+	types := f.ledgerTypes()
+	expected := []int32{model.SourceTypeReserve, model.SourceTypeReserve, model.SourceTypeOrderPayment, model.SourceTypeOrderPayment}
+	if len(types) != len(expected) {
+		t.Fatalf("expected ledger %v, got %v", expected, types)
+	}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Fatalf("expected ledger %v, got %v", expected, types)
+		}
+	}
+}
+
+func TestStockReservation_ReserveValidation(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+
+	if _, err := f.svc.ReserveStock(ctx, 0, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 1}}, sql.NullTime{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for empty order, got %v", err)
+	}
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 0}}, sql.NullTime{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for zero quantity, got %v", err)
+	}
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 3, Quantity: 1}}, sql.NullTime{}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for missing sku, got %v", err)
+	}
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 1}, {SkuID: 2, Quantity: 6}}, sql.NullTime{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for insufficient stock, got %v", err)
+	}
+	f.skuRepo.skus[2].Status = model.ProductStatusOffSale
+	if _, err := f.svc.ReserveStock(ctx, 101, []*dto.ReserveStockItemDto{{SkuID: 2, Quantity: 1}}, sql.NullTime{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for off sale sku, got %v", err)
+	}
+	if _, err := f.svc.ConfirmReservation(ctx, 999); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound when confirming unknown order, got %v", err)
+	}
+}
+
+func TestStockReservation_Release(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 4}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	f.assertSku(t, 1, 6, 0)
+
+	released, err := f.svc.ReleaseReservation(ctx, 100)
+	if err != nil {
+		t.Fatalf("release failed: %v", err)
+	}
+	if released[0].Status != model.ReservationStatusReleased {
+		t.Fatalf("expected released reservation, got %+v", released)
+	}
+	f.assertSku(t, 1, 10, 0)
+
+	// 重复释放不重复回补
+	if _, err := f.svc.ReleaseReservation(ctx, 100); err != nil {
+		t.Fatalf("duplicate release failed: %v", err)
+	}
+	f.assertSku(t, 1, 10, 0)
+	if _, err := f.svc.ConfirmReservation(ctx, 100); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when confirming released reservation, got %v", err)
+	}
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 1}}, sql.NullTime{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when reserving released order again, got %v", err)
+	}
+	last := f.ledger.records[len(f.ledger.records)-1]
+	if last.SourceType != model.SourceTypeRelease || last.BeforeStock != 6 || last.AfterStock != 10 {
+		t.Fatalf("unexpected release record %+v", last)
+	}
+}
+
+// TestStockReservation_FindReservedSkuIds 释放后仍返回订单预占过的SKU，用于回补时加SKU库存锁
+func TestStockReservation_FindReservedSkuIds(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	items := []*dto.ReserveStockItemDto{{SkuID: 2, Quantity: 1}, {SkuID: 1, Quantity: 2}}
+	if _, err := f.svc.ReserveStock(ctx, 100, items, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if _, err := f.svc.ReleaseReservation(ctx, 100); err != nil {
+		t.Fatalf("release failed: %v", err)
+	}
+	skuIds, err := f.svc.FindReservedSkuIds(ctx, 100)
+	if err != nil {
+		t.Fatalf("find failed: %v", err)
+	}
+	if !reflect.DeepEqual(skuIds, []int64{1, 2}) {
+		t.Fatalf("expected skus [1 2], got %v", skuIds)
+	}
+	if skuIds, _ = f.svc.FindReservedSkuIds(ctx, 200); len(skuIds) != 0 {
+		t.Fatalf("expected no skus for order without reservations, got %v", skuIds)
+	}
+}
+
+func TestStockReservation_ReleaseExpired(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	now := time.Now()
+	expired := sql.NullTime{Time: now.Add(-time.Minute), Valid: true}
+	active := sql.NullTime{Time: now.Add(time.Hour), Valid: true}
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 2}}, expired); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if _, err := f.svc.ReserveStock(ctx, 101, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 3}}, active); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if _, err := f.svc.ReserveStock(ctx, 102, []*dto.ReserveStockItemDto{{SkuID: 2, Quantity: 1}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	f.assertSku(t, 1, 5, 0)
+
+	count, err := f.svc.ReleaseExpired(ctx, now, 100)
+	if err != nil {
+		t.Fatalf("release expired failed: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 expired reservation, got %d", count)
+	}
+	f.assertSku(t, 1, 7, 0)
+	f.assertSku(t, 2, 4, 0)
+	if f.reservationRepo.statusOf(100)[1] != model.ReservationStatusExpired || f.reservationRepo.statusOf(101)[1] != model.ReservationStatusHeld {
+		t.Fatalf("unexpected reservation status %v %v", f.reservationRepo.statusOf(100), f.reservationRepo.statusOf(101))
+	}
+	if count, err := f.svc.ReleaseExpired(ctx, now, 100); err != nil || count != 0 {
+		t.Fatalf("expected nothing to release, got %d %v", count, err)
+	}
+	if _, err := f.svc.ConfirmReservation(ctx, 100); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when confirming expired reservation, got %v", err)
+	}
+}
+
+// deductReserved 预占后按支付事件扣减
+func deductReserved(t *testing.T, f *reservationFixture, quantity uint32) *dto.OrderSkuDto {
+	t.Helper()
+	svc := service.NewProductDataService(nil, nil, f.skuRepo, f.ledger, nil, f.reservationRepo, nil, f.warehouseRepo, service.AllocationStrategyPriority)
+	result, err := svc.DeductInventory(context.Background(), &order.OnPaymentSuccess{
+		OrderId:      100,
+		OrderDetails: []*order.OrderDetail{{SkuId: 1, Quantity: quantity}},
+	})
+	if err != nil {
+		t.Fatalf("deduct failed: %v", err)
+	}
+	return result
+}
+
+func TestStockReservation_DeductConsumesReservationWithSurplus(t *testing.T) {
+	f := newReservationFixture()
+	if _, err := f.svc.ReserveStock(context.Background(), 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 4}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+
+	// 订单只买3件，预占多出的1件回补
+	result := deductReserved(t, f, 3)
+	f.assertSku(t, 1, 7, 3)
+	if result.Sku[0].Quantity != 3 || result.Sku[0].Stock != 7 {
+		t.Fatalf("unexpected deduct result %+v", result.Sku)
+	}
+	if f.reservationRepo.statusOf(100)[1] != model.ReservationStatusConfirmed {
+		t.Fatalf("expected reservation confirmed, got %v", f.reservationRepo.statusOf(100))
+	}
+	records := f.ledger.records[1:]
+	if len(records) != 2 || records[0].SourceType != model.SourceTypeOrderPayment || records[0].Quantity != 3 ||
+		records[1].SourceType != model.SourceTypeRelease || records[1].Quantity != 1 || records[1].AfterStock != 7 {
+		t.Fatalf("unexpected stock change records %+v", records)
+	}
+}
+
+func TestStockReservation_DeductConsumesReservationWithShortfall(t *testing.T) {
+	f := newReservationFixture()
+	if _, err := f.svc.ReserveStock(context.Background(), 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 2}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+
+	// 订单买5件，预占的2件转为销量，不足的3件从可售库存扣减
+	result := deductReserved(t, f, 5)
+	f.assertSku(t, 1, 5, 5)
+	if result.Sku[0].Quantity != 5 || result.Sku[0].Stock != 5 {
+		t.Fatalf("unexpected deduct result %+v", result.Sku)
+	}
+	records := f.ledger.records[1:]
+	if len(records) != 1 || records[0].SourceType != model.SourceTypeOrderPayment || records[0].Quantity != 5 ||
+		records[0].BeforeStock != 8 || records[0].AfterStock != 5 {
+		t.Fatalf("unexpected stock change records %+v", records)
+	}
+}
+
+func TestStockReservation_DeductReleasesReservationOutsideOrder(t *testing.T) {
+	f := newReservationFixture()
+	items := []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 2}, {SkuID: 2, Quantity: 3}}
+	if _, err := f.svc.ReserveStock(context.Background(), 100, items, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+
+	// 支付的订单只包含SKU 1，SKU 2的预占释放而不是确认
+	deductReserved(t, f, 2)
+	f.assertSku(t, 1, 8, 2)
+	f.assertSku(t, 2, 5, 0)
+	statuses := f.reservationRepo.statusOf(100)
+	if statuses[1] != model.ReservationStatusConfirmed || statuses[2] != model.ReservationStatusReleased {
+		t.Fatalf("unexpected reservation status %v", statuses)
+	}
+	records := f.ledger.records[2:]
+	if len(records) != 2 || records[0].SkuID != 1 || records[0].SourceType != model.SourceTypeOrderPayment ||
+		records[1].SkuID != 2 || records[1].SourceType != model.SourceTypeRelease || records[1].Quantity != 3 ||
+		records[1].BeforeStock != 2 || records[1].AfterStock != 5 {
+		t.Fatalf("unexpected stock change records %+v", records)
+	}
+}
+
+func TestStockReservation_DeductAfterConfirmSkipsConfirmedQuantity(t *testing.T) {
+	f := newReservationFixture()
+	ctx := context.Background()
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 2}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if _, err := f.svc.ConfirmReservation(ctx, 100); err != nil {
+		t.Fatalf("confirm failed: %v", err)
+	}
+
+	// 已确认的2件不再扣减也不再计入销量
+	deductReserved(t, f, 2)
+	f.assertSku(t, 1, 8, 2)
+
+	// 库存不足时整单失败
+	svc := service.NewProductDataService(nil, nil, f.skuRepo, f.ledger, nil, f.reservationRepo, nil, f.warehouseRepo, service.AllocationStrategyPriority)
+	_, err := svc.DeductInventory(ctx, &order.OnPaymentSuccess{OrderId: 100, OrderDetails: []*order.OrderDetail{{SkuId: 1, Quantity: 11}}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for insufficient stock, got %v", err)
+	}
+	f.assertSku(t, 1, 8, 2)
+}
+
+// TestProductSkuRepository_DeductInventoryByIdGuardsStock 库存不足时条件更新不生效，返回库存不足而不是扣成负数
+func TestProductSkuRepository_DeductInventoryByIdGuardsStock(t *testing.T) {
+	_, db, mock := newBarrierTestManager(t)
+	repo := gorm2.NewProductSkuRepository(db)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_skus` SET `sales`=sales + ?,`stock`=stock - ?,`updated_at`=? WHERE (id = ? AND stock >= ?)")).
+		WithArgs(uint32(3), uint32(3), sqlmock.AnyArg(), int64(1), uint32(3)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `product_skus` WHERE id = ?")).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	if err := repo.DeductInventoryById(context.Background(), 1, 3); !errors.Is(err, repository.ErrInsufficientStock) {
+		t.Fatalf("expected ErrInsufficientStock, got %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `product_skus` SET `sales`=sales + ?,`stock`=stock - ?,`updated_at`=? WHERE (id = ? AND stock >= ?)")).
+		WithArgs(uint32(3), uint32(3), sqlmock.AnyArg(), int64(2), uint32(3)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `product_skus` WHERE id = ?")).
+		WithArgs(int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	if err := repo.DeductInventoryById(context.Background(), 2, 3); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("expected ErrRecordNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
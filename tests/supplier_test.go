@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+)
+
+// memorySupplierRepo 内存中的供应商仓储
+type memorySupplierRepo struct {
+	repository.SupplierRepository
+	suppliers map[int64]*model.Supplier
+	links     []*model.SupplierProduct
+}
+
+func (r *memorySupplierRepo) FindByID(ctx context.Context, id int64) (*model.Supplier, error) {
+	return r.suppliers[id], nil
+}
+
+func (r *memorySupplierRepo) FindSupplierProduct(ctx context.Context, supplierID int64, skuID int64) (*model.SupplierProduct, error) {
+	for _, link := range r.links {
+		if int64(link.SupplierID) == supplierID && int64(link.SkuID) == skuID {
+			copied := *link
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memorySupplierRepo) SaveSupplierProduct(ctx context.Context, supplierProduct *model.SupplierProduct) error {
+	for i, link := range r.links {
+		if link.ID == supplierProduct.ID {
+			copied := *supplierProduct
+			r.links[i] = &copied
+			return nil
+		}
+	}
+	supplierProduct.ID = int64(len(r.links) + 1)
+	copied := *supplierProduct
+	r.links = append(r.links, &copied)
+	return nil
+}
+
+func (r *memorySupplierRepo) ClearPreferred(ctx context.Context, skuID int64, excludeID int64) error {
+	for _, link := range r.links {
+		if int64(link.SkuID) == skuID && link.ID != excludeID {
+			link.IsPreferred = false
+		}
+	}
+	return nil
+}
+
+// supplierSkuRepo 锁定和查询SKU
+type supplierSkuRepo struct {
+	repository.ProductSkuRepository
+}
+
+func (r *supplierSkuRepo) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	return []model.ProductSku{{ID: skuIDs[0]}}, nil
+}
+
+func (r *supplierSkuRepo) GetSkuDetailByID(ctx context.Context, skuID int64) (*model.ProductSku, error) {
+	return &model.ProductSku{ID: skuID, SkuNo: "P1-1"}, nil
+}
+
+// TestSupplier_SinglePreferredPerSku 设为首选时取消同一SKU其他供应商的首选
+func TestSupplier_SinglePreferredPerSku(t *testing.T) {
+	repo := &memorySupplierRepo{suppliers: map[int64]*model.Supplier{
+		1: {ID: 1, Name: "S1"},
+		2: {ID: 2, Name: "S2"},
+	}}
+	svc := service.NewSupplierService(repo, &supplierSkuRepo{})
+	ctx := context.Background()
+
+	if _, err := svc.LinkSku(ctx, &dto.LinkSupplierSkuDto{SupplierID: 1, SkuID: 10, SupplyPrice: 5, IsPreferred: true}); err != nil {
+		t.Fatalf("link failed: %v", err)
+	}
+	item, err := svc.LinkSku(ctx, &dto.LinkSupplierSkuDto{SupplierID: 2, SkuID: 10, SupplyPrice: 4, MinOrderQuantity: 10, IsPreferred: true})
+	if err != nil {
+		t.Fatalf("link failed: %v", err)
+	}
+	if !item.IsPreferred || item.SkuNo != "P1-1" || item.MinOrderQuantity != 10 {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	preferred := 0
+	for _, link := range repo.links {
+		if link.IsPreferred {
+			preferred++
+			if link.SupplierID != 2 {
+				t.Errorf("expected supplier 2 to be preferred, got %d", link.SupplierID)
+			}
+		}
+	}
+	if len(repo.links) != 2 || preferred != 1 {
+		t.Fatalf("expected 2 links with one preferred, got %d links %d preferred", len(repo.links), preferred)
+	}
+
+	// 再次关联时更新供货信息而不是新增
+	if _, err := svc.LinkSku(ctx, &dto.LinkSupplierSkuDto{SupplierID: 1, SkuID: 10, SupplyPrice: 6}); err != nil {
+		t.Fatalf("link failed: %v", err)
+	}
+	if len(repo.links) != 2 || repo.links[0].SupplyPrice != 6 {
+		t.Errorf("expected link to be updated, got %+v", repo.links[0])
+	}
+}
@@ -0,0 +1,384 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zhanshen02154/product/internal/application/dto"
+	service2 "github.com/zhanshen02154/product/internal/application/service"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/infrastructure"
+	gorm2 "github.com/zhanshen02154/product/internal/infrastructure/persistence/gorm"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction"
+	"github.com/zhanshen02154/product/internal/infrastructure/persistence/transaction/dtm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+const barrierInsertSQL = `insert ignore into products\.barrier`
+
+// newBarrierTestManager 创建基于sqlmock的事务管理器
+func newBarrierTestManager(t *testing.T) (transaction.TransactionManager, *gorm.DB, sqlmock.Sqlmock) {
+	dtm.NewServer("")
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	return gorm2.NewGormTransactionManager(db), db, mock
+}
+
+// barrierContext 模拟DTM通过gRPC metadata传递的分支信息
+func barrierContext(op string) context.Context {
+	md := metadata.Pairs(
+		"dtm-gid", "tcc-gid-1",
+		"dtm-trans_type", "tcc",
+		"dtm-branch_id", "01",
+		"dtm-op", op,
+	)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// TestBarrier_TryExecutesInBarrierTx 正常Try在屏障事务内执行业务
+func TestBarrier_TryExecutesInBarrierTx(t *testing.T) {
+	txManager, db, mock := newBarrierTestManager(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", "try", "01", "try").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE product_skus").
+		WithArgs(2, 100).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	called := false
+	err := txManager.ExecuteWithBarrier(barrierContext("try"), func(txCtx context.Context) error {
+		called = true
+		return gorm2.GetDBFromContext(txCtx, db).Exec("UPDATE product_skus SET stock = stock - ? WHERE id = ?", 2, 100).Error
+	})
+	if err != nil {
+		t.Fatalf("try failed: %v", err)
+	}
+	if !called {
+		t.Error("expected try business to be executed")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestBarrier_EmptyCompensation 空补偿：Try未执行时收到Cancel，不执行业务
+func TestBarrier_EmptyCompensation(t *testing.T) {
+	txManager, _, mock := newBarrierTestManager(t)
+	mock.ExpectBegin()
+	// 先插入try记录，插入成功说明Try从未执行
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", "try", "01", "cancel").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", "cancel", "01", "cancel").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	called := false
+	err := txManager.ExecuteWithBarrier(barrierContext("cancel"), func(txCtx context.Context) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	if called {
+		t.Error("empty compensation must not execute cancel business")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestBarrier_HangingTry 悬挂：Cancel之后到达的Try不执行业务
+func TestBarrier_HangingTry(t *testing.T) {
+	txManager, _, mock := newBarrierTestManager(t)
+	mock.ExpectBegin()
+	// try记录已由空补偿写入，插入被忽略
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", "try", "01", "try").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	called := false
+	err := txManager.ExecuteWithBarrier(barrierContext("try"), func(txCtx context.Context) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("try failed: %v", err)
+	}
+	if called {
+		t.Error("hanging try must not execute business")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestBarrier_CancelAfterTry Try执行过后Cancel正常执行补偿
+func TestBarrier_CancelAfterTry(t *testing.T) {
+	txManager, _, mock := newBarrierTestManager(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", "try", "01", "cancel").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", "cancel", "01", "cancel").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	called := false
+	err := txManager.ExecuteWithBarrier(barrierContext("cancel"), func(txCtx context.Context) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	if !called {
+		t.Error("expected cancel business to be executed")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// memoryLockManager 内存中的分布式锁，记录加锁顺序
+type memoryLockManager struct {
+	infrastructure.LockManager
+	mu       sync.Mutex
+	held     map[string]bool
+	acquired []string
+}
+
+func newMemoryLockManager() *memoryLockManager {
+	return &memoryLockManager{held: make(map[string]bool)}
+}
+
+func (m *memoryLockManager) NewLock(key string, ttl int) infrastructure.DistributedLock {
+	return &memoryLock{manager: m, key: key}
+}
+
+// isHeld 锁是否被持有
+func (m *memoryLockManager) isHeld(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.held[key]
+}
+
+type memoryLock struct {
+	infrastructure.DistributedLock
+	manager *memoryLockManager
+	key     string
+}
+
+func (l *memoryLock) TryLock(ctx context.Context) error {
+	l.manager.mu.Lock()
+	defer l.manager.mu.Unlock()
+	if l.manager.held[l.key] {
+		return errors.New("lock " + l.key + " is held")
+	}
+	l.manager.held[l.key] = true
+	l.manager.acquired = append(l.manager.acquired, l.key)
+	return nil
+}
+
+func (l *memoryLock) UnLock(ctx context.Context) error {
+	l.manager.mu.Lock()
+	defer l.manager.mu.Unlock()
+	delete(l.manager.held, l.key)
+	return nil
+}
+
+func (l *memoryLock) GetKey() string {
+	return l.key
+}
+
+// newStockTccFixture 基于sqlmock子事务屏障与内存预占仓储的TCC分支
+func newStockTccFixture(t *testing.T) (*service2.StockTccService, *reservationFixture, sqlmock.Sqlmock) {
+	tcc, f, mock, _ := newLockedStockTccFixture(t)
+	return tcc, f, mock
+}
+
+// newLockedStockTccFixture 同newStockTccFixture，同时返回TCC分支使用的锁
+func newLockedStockTccFixture(t *testing.T) (*service2.StockTccService, *reservationFixture, sqlmock.Sqlmock, *memoryLockManager) {
+	txManager, _, mock := newBarrierTestManager(t)
+	f := newReservationFixture()
+	locks := newMemoryLockManager()
+	return service2.NewStockTccService(txManager, locks, f.svc), f, mock, locks
+}
+
+// expectBarrier 期望屏障写入，affected为0表示记录已存在
+func expectBarrier(mock sqlmock.Sqlmock, op string, reason string, affected int64) {
+	mock.ExpectExec(barrierInsertSQL).
+		WithArgs("tcc", "tcc-gid-1", "01", op, "01", reason).
+		WillReturnResult(sqlmock.NewResult(affected, affected))
+}
+
+var tccItems = []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 3}}
+
+// TestStockTcc_TryConfirm Try预占库存，Confirm计入销量，重复的Confirm被屏障拦截
+func TestStockTcc_TryConfirm(t *testing.T) {
+	tcc, f, mock := newStockTccFixture(t)
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "try", 1)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectBarrier(mock, "confirm", "confirm", 1)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectBarrier(mock, "confirm", "confirm", 0)
+	mock.ExpectCommit()
+
+	if err := tcc.Try(barrierContext("try"), &dto.ReserveStockDto{OrderID: 100, Items: tccItems}); err != nil {
+		t.Fatalf("try failed: %v", err)
+	}
+	f.assertSku(t, 1, 7, 0)
+	reservation := f.reservationRepo.reservations[0]
+	if reservation.Status != model.ReservationStatusHeld || reservation.ExpiresAt.Valid {
+		t.Fatalf("expected held reservation without expiry, got %+v", reservation)
+	}
+
+	if err := tcc.Confirm(barrierContext("confirm"), 100); err != nil {
+		t.Fatalf("confirm failed: %v", err)
+	}
+	f.assertSku(t, 1, 7, 3)
+	if err := tcc.Confirm(barrierContext("confirm"), 100); err != nil {
+		t.Fatalf("duplicate confirm failed: %v", err)
+	}
+	f.assertSku(t, 1, 7, 3)
+	if f.reservationRepo.statusOf(100)[1] != model.ReservationStatusConfirmed {
+		t.Fatalf("expected confirmed reservation, got %v", f.reservationRepo.statusOf(100))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestStockTcc_TryCancel Try之后Cancel释放预占并回补库存
+func TestStockTcc_TryCancel(t *testing.T) {
+	tcc, f, mock := newStockTccFixture(t)
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "try", 1)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "cancel", 0)
+	expectBarrier(mock, "cancel", "cancel", 1)
+	mock.ExpectCommit()
+
+	if err := tcc.Try(barrierContext("try"), &dto.ReserveStockDto{OrderID: 100, Items: tccItems}); err != nil {
+		t.Fatalf("try failed: %v", err)
+	}
+	if err := tcc.Cancel(barrierContext("cancel"), 100); err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	f.assertSku(t, 1, 10, 0)
+	if f.reservationRepo.statusOf(100)[1] != model.ReservationStatusReleased {
+		t.Fatalf("expected released reservation, got %v", f.reservationRepo.statusOf(100))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestStockTcc_CancelWithoutTry 空补偿不回补库存，之后到达的Try不再预占
+func TestStockTcc_CancelWithoutTry(t *testing.T) {
+	tcc, f, mock := newStockTccFixture(t)
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "cancel", 1)
+	expectBarrier(mock, "cancel", "cancel", 1)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "try", 0)
+	mock.ExpectCommit()
+
+	if err := tcc.Cancel(barrierContext("cancel"), 100); err != nil {
+		t.Fatalf("cancel failed: %v", err)
+	}
+	if err := tcc.Try(barrierContext("try"), &dto.ReserveStockDto{OrderID: 100, Items: tccItems}); err != nil {
+		t.Fatalf("hanging try failed: %v", err)
+	}
+	f.assertSku(t, 1, 10, 0)
+	if len(f.reservationRepo.reservations) != 0 || len(f.ledger.records) != 0 {
+		t.Fatalf("expected no reservation, got %+v", f.reservationRepo.reservations)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestStockTcc_TryInsufficientStockAborts 库存不足时Try回滚屏障并返回Aborted
+func TestStockTcc_TryInsufficientStockAborts(t *testing.T) {
+	tcc, f, mock := newStockTccFixture(t)
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "try", 1)
+	mock.ExpectRollback()
+
+	err := tcc.Try(barrierContext("try"), &dto.ReserveStockDto{OrderID: 100, Items: []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 11}}})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("expected Aborted, got %v", err)
+	}
+	f.assertSku(t, 1, 10, 0)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestStockTcc_LocksOrderAndSkus 各阶段先加订单锁和SKU库存锁，屏障事务结束后释放；锁被占用时返回Unavailable且不执行业务
+func TestStockTcc_LocksOrderAndSkus(t *testing.T) {
+	tcc, f, mock, locks := newLockedStockTccFixture(t)
+	mock.ExpectBegin()
+	expectBarrier(mock, "try", "try", 1)
+	mock.ExpectCommit()
+
+	if err := tcc.Try(barrierContext("try"), &dto.ReserveStockDto{OrderID: 100, Items: tccItems}); err != nil {
+		t.Fatalf("try failed: %v", err)
+	}
+	if len(locks.acquired) != 2 || locks.acquired[0] != "stockreservation-100" || locks.acquired[1] != "skustock-1" {
+		t.Fatalf("unexpected locks %v", locks.acquired)
+	}
+	if locks.isHeld("stockreservation-100") || locks.isHeld("skustock-1") {
+		t.Fatal("expected locks released after try")
+	}
+
+	// SKU被其他请求锁定时Confirm不进入屏障
+	if err := locks.NewLock("skustock-1", 15).TryLock(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := tcc.Confirm(barrierContext("confirm"), 100); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	if locks.isHeld("stockreservation-100") {
+		t.Fatal("expected order lock released after failed confirm")
+	}
+	f.assertSku(t, 1, 7, 0)
+
+	// 订单被其他请求锁定时Cancel不进入屏障
+	if err := locks.NewLock("stockreservation-100", 15).TryLock(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := tcc.Cancel(barrierContext("cancel"), 100); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	f.assertSku(t, 1, 7, 0)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
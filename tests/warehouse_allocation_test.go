@@ -0,0 +1,365 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/zhanshen02154/product/internal/application/dto"
+	"github.com/zhanshen02154/product/internal/domain/event/order"
+	"github.com/zhanshen02154/product/internal/domain/model"
+	"github.com/zhanshen02154/product/internal/domain/repository"
+	"github.com/zhanshen02154/product/internal/domain/service"
+)
+
+// allocationSkuRepo 内存中的SKU总库存
+type allocationSkuRepo struct {
+	repository.ProductSkuRepository
+	stock map[int64]uint32
+}
+
+func (r *allocationSkuRepo) BatchGetSkuByIDsForUpdate(ctx context.Context, skuIDs []int64) ([]model.ProductSku, error) {
+	skus := make([]model.ProductSku, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		skus = append(skus, model.ProductSku{ID: id, Stock: r.stock[id], Status: model.ProductStatusOnSale})
+	}
+	return skus, nil
+}
+
+func (r *allocationSkuRepo) DeductInventoryById(ctx context.Context, id int64, count uint32) error {
+	r.stock[id] -= count
+	return nil
+}
+
+// allocationReservationRepo 没有预占的订单
+type allocationReservationRepo struct {
+	repository.SkuStockReservationRepository
+}
+
+func (r *allocationReservationRepo) FindByOrderIdForUpdate(ctx context.Context, orderID int64) ([]model.SkuStockReservation, error) {
+	return nil, nil
+}
+
+// allocationWarehouseRepo 内存中的分仓库存及订单分仓记录
+type allocationWarehouseRepo struct {
+	repository.WarehouseRepository
+	warehouses  []model.Warehouse
+	stocks      []model.SkuWarehouseStock
+	allocations []model.OrderWarehouseAllocation
+}
+
+func (r *allocationWarehouseRepo) FindByID(ctx context.Context, id int64) (*model.Warehouse, error) {
+	for i := range r.warehouses {
+		if r.warehouses[i].ID == id {
+			warehouse := r.warehouses[i]
+			return &warehouse, nil
+		}
+	}
+	for _, stock := range r.stocks {
+		if stock.Warehouse != nil && stock.Warehouse.ID == id {
+			warehouse := *stock.Warehouse
+			return &warehouse, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *allocationWarehouseRepo) FindSkuStockForUpdate(ctx context.Context, skuID int64, warehouseID int64) (*model.SkuWarehouseStock, error) {
+	for _, stock := range r.stocks {
+		if stock.SkuID == skuID && stock.WarehouseID == warehouseID {
+			return &stock, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *allocationWarehouseRepo) SaveSkuStock(ctx context.Context, stock *model.SkuWarehouseStock) error {
+	for i := range r.stocks {
+		if r.stocks[i].ID == stock.ID && stock.ID != 0 {
+			r.stocks[i].Stock = stock.Stock
+			return nil
+		}
+	}
+	stock.ID = int64(100 + len(r.stocks))
+	r.stocks = append(r.stocks, *stock)
+	return nil
+}
+
+func (r *allocationWarehouseRepo) BatchCreateAllocations(ctx context.Context, allocations []*model.OrderWarehouseAllocation) error {
+	for _, allocation := range allocations {
+		allocation.ID = int64(len(r.allocations) + 1)
+		r.allocations = append(r.allocations, *allocation)
+	}
+	return nil
+}
+
+func (r *allocationWarehouseRepo) FindAllocationsByOrderIdForUpdate(ctx context.Context, orderID int64) ([]model.OrderWarehouseAllocation, error) {
+	result := make([]model.OrderWarehouseAllocation, 0)
+	for _, allocation := range r.allocations {
+		if allocation.OrderID == orderID {
+			result = append(result, allocation)
+		}
+	}
+	return result, nil
+}
+
+func (r *allocationWarehouseRepo) UpdateAllocationRestored(ctx context.Context, id int64, restoredQuantity uint32) error {
+	for i := range r.allocations {
+		if r.allocations[i].ID == id {
+			r.allocations[i].RestoredQuantity = restoredQuantity
+		}
+	}
+	return nil
+}
+
+// stockOf SKU在指定仓库的库存
+func (r *allocationWarehouseRepo) stockOf(skuID int64, warehouseID int64) uint32 {
+	for _, stock := range r.stocks {
+		if stock.SkuID == skuID && stock.WarehouseID == warehouseID {
+			return stock.Stock
+		}
+	}
+	return 0
+}
+
+func (r *allocationWarehouseRepo) ListSkuStocks(ctx context.Context, skuID int64) ([]model.SkuWarehouseStock, error) {
+	result := make([]model.SkuWarehouseStock, 0)
+	for _, stock := range r.stocks {
+		if stock.SkuID == skuID {
+			result = append(result, stock)
+		}
+	}
+	return result, nil
+}
+
+func (r *allocationWarehouseRepo) ListEnabledSkuStocksForUpdate(ctx context.Context, skuIDs []int64) ([]model.SkuWarehouseStock, error) {
+	return append([]model.SkuWarehouseStock(nil), r.stocks...), nil
+}
+
+func (r *allocationWarehouseRepo) DeductSkuStock(ctx context.Context, id int64, count uint32) error {
+	for i := range r.stocks {
+		if r.stocks[i].ID == id {
+			if r.stocks[i].Stock < count {
+				return repository.ErrInsufficientStock
+			}
+			r.stocks[i].Stock -= count
+			return nil
+		}
+	}
+	return repository.ErrInsufficientStock
+}
+
+// newAllocationFixture SKU 1 总库存20：北京仓(优先级1)4件，上海仓(优先级2)4件，广州仓(优先级3)10件
+func newAllocationFixture() *allocationWarehouseRepo {
+	beijing := &model.Warehouse{ID: 1, Code: "BJ", Priority: 1, Latitude: 39.90, Longitude: 116.40, Status: model.WarehouseStatusEnabled}
+	shanghai := &model.Warehouse{ID: 2, Code: "SH", Priority: 2, Latitude: 31.23, Longitude: 121.47, Status: model.WarehouseStatusEnabled}
+	guangzhou := &model.Warehouse{ID: 3, Code: "GZ", Priority: 3, Latitude: 23.13, Longitude: 113.26, Status: model.WarehouseStatusEnabled}
+	return &allocationWarehouseRepo{stocks: []model.SkuWarehouseStock{
+		{ID: 11, SkuID: 1, WarehouseID: 1, Stock: 4, Warehouse: beijing},
+		{ID: 12, SkuID: 1, WarehouseID: 2, Stock: 4, Warehouse: shanghai},
+		{ID: 13, SkuID: 1, WarehouseID: 3, Stock: 10, Warehouse: guangzhou},
+	}}
+}
+
+func deductWithStrategy(t *testing.T, strategy string, warehouseRepo *allocationWarehouseRepo, req *order.OnPaymentSuccess) []dto.WarehouseAllocationDto {
+	t.Helper()
+	svc := service.NewProductDataService(nil, nil, &allocationSkuRepo{stock: map[int64]uint32{1: 20}}, &matrixStockChangeRepo{},
+		nil, &allocationReservationRepo{}, nil, warehouseRepo, strategy)
+	result, err := svc.DeductInventory(context.Background(), req)
+	if err != nil {
+		t.Fatalf("deduct failed: %v", err)
+	}
+	if len(result.Sku) != 1 || result.Sku[0].Stock != 20-req.OrderDetails[0].Quantity {
+		t.Fatalf("unexpected deduct result: %+v", result.Sku)
+	}
+	return result.Sku[0].Warehouses
+}
+
+func assertAllocations(t *testing.T, got []dto.WarehouseAllocationDto, want []dto.WarehouseAllocationDto) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected allocations %+v, got %+v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected allocations %+v, got %+v", want, got)
+		}
+	}
+}
+
+// TestWarehouseAllocation_Strategies 不同分仓策略下的分配结果
+func TestWarehouseAllocation_Strategies(t *testing.T) {
+	req := &order.OnPaymentSuccess{
+		OrderId:           1,
+		OrderDetails:      []*order.OrderDetail{{SkuId: 1, Quantity: 10}},
+		ShippingLatitude:  31.30,
+		ShippingLongitude: 121.50,
+	}
+
+	warehouseRepo := newAllocationFixture()
+	assertAllocations(t, deductWithStrategy(t, service.AllocationStrategyPriority, warehouseRepo, req), []dto.WarehouseAllocationDto{
+		{WarehouseID: 1, Quantity: 4, Stock: 0},
+		{WarehouseID: 2, Quantity: 4, Stock: 0},
+		{WarehouseID: 3, Quantity: 2, Stock: 8},
+	})
+	if warehouseRepo.stocks[2].Stock != 8 {
+		t.Errorf("expected warehouse stock to be deducted, got %d", warehouseRepo.stocks[2].Stock)
+	}
+
+	assertAllocations(t, deductWithStrategy(t, service.AllocationStrategyNearest, newAllocationFixture(), req), []dto.WarehouseAllocationDto{
+		{WarehouseID: 2, Quantity: 4, Stock: 0},
+		{WarehouseID: 1, Quantity: 4, Stock: 0},
+		{WarehouseID: 3, Quantity: 2, Stock: 8},
+	})
+
+	assertAllocations(t, deductWithStrategy(t, service.AllocationStrategySplitMinimizing, newAllocationFixture(), req), []dto.WarehouseAllocationDto{
+		{WarehouseID: 3, Quantity: 10, Stock: 0},
+	})
+}
+
+// TestWarehouseAllocation_Unassigned 仓库库存不足或未分仓的SKU由未分仓库存满足
+func TestWarehouseAllocation_Unassigned(t *testing.T) {
+	req := &order.OnPaymentSuccess{OrderId: 1, OrderDetails: []*order.OrderDetail{{SkuId: 1, Quantity: 20}}}
+	assertAllocations(t, deductWithStrategy(t, service.AllocationStrategySplitMinimizing, newAllocationFixture(), req), []dto.WarehouseAllocationDto{
+		{WarehouseID: 3, Quantity: 10, Stock: 0},
+		{WarehouseID: 1, Quantity: 4, Stock: 0},
+		{WarehouseID: 2, Quantity: 4, Stock: 0},
+		{WarehouseID: 0, Quantity: 2, Stock: 0},
+	})
+
+	assertAllocations(t, deductWithStrategy(t, service.AllocationStrategyPriority, &allocationWarehouseRepo{}, req), []dto.WarehouseAllocationDto{
+		{WarehouseID: 0, Quantity: 20, Stock: 0},
+	})
+}
+
+// TestWarehouseAllocation_ReserveConfirmAndRefund 预占按策略分配分仓库存，确认与支付扣减不重复分配，退款按分仓记录回补
+func TestWarehouseAllocation_ReserveConfirmAndRefund(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	f.warehouseRepo = newAllocationFixture()
+	f.warehouseRepo.stocks[2].Stock = 2
+	f.svc = service.NewStockReservationService(f.skuRepo, f.reservationRepo, f.ledger, f.warehouseRepo, service.AllocationStrategyPriority)
+
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 6}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if f.warehouseRepo.stockOf(1, 1) != 0 || f.warehouseRepo.stockOf(1, 2) != 2 || f.warehouseRepo.stockOf(1, 3) != 2 {
+		t.Fatalf("unexpected warehouse stock after reserve: %+v", f.warehouseRepo.stocks)
+	}
+	if len(f.warehouseRepo.allocations) != 2 {
+		t.Fatalf("expected 2 warehouse allocations, got %+v", f.warehouseRepo.allocations)
+	}
+	if _, err := f.svc.ConfirmReservation(ctx, 100); err != nil {
+		t.Fatalf("confirm failed: %v", err)
+	}
+	if len(f.warehouseRepo.allocations) != 2 || f.warehouseRepo.stockOf(1, 2) != 2 {
+		t.Fatalf("reserved quantity allocated again on confirm: %+v", f.warehouseRepo.allocations)
+	}
+
+	// 已确认的数量在支付扣减时不再分配
+	deductReserved(t, f, 6)
+	f.assertSku(t, 1, 4, 6)
+	if len(f.warehouseRepo.allocations) != 2 || f.warehouseRepo.stockOf(1, 2) != 2 {
+		t.Fatalf("confirmed quantity allocated twice: %+v", f.warehouseRepo.allocations)
+	}
+
+	svc := service.NewProductDataService(nil, nil, f.skuRepo, f.ledger, nil, f.reservationRepo, &memoryRestoreRepo{restores: map[string]model.OrderInventoryRestore{}},
+		f.warehouseRepo, service.AllocationStrategyPriority)
+	result, err := svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-1", model.RestoreReasonRefund, 5))
+	if err != nil {
+		t.Fatalf("refund failed: %v", err)
+	}
+	// 先分配的北京仓先回补
+	assertAllocations(t, result.Sku[0].Warehouses, []dto.WarehouseAllocationDto{
+		{WarehouseID: 1, Quantity: 4, Stock: 4},
+		{WarehouseID: 2, Quantity: 1, Stock: 3},
+	})
+	f.assertSku(t, 1, 9, 1)
+
+	result, err = svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-2", model.RestoreReasonRefund, 5))
+	if err != nil {
+		t.Fatalf("refund failed: %v", err)
+	}
+	assertAllocations(t, result.Sku[0].Warehouses, []dto.WarehouseAllocationDto{
+		{WarehouseID: 2, Quantity: 1, Stock: 4},
+	})
+	if f.warehouseRepo.stockOf(1, 1) != 4 || f.warehouseRepo.stockOf(1, 2) != 4 || f.warehouseRepo.stockOf(1, 3) != 2 {
+		t.Fatalf("unexpected warehouse stock after refund: %+v", f.warehouseRepo.stocks)
+	}
+}
+
+// TestWarehouseAllocation_HeldStockNotAllocatedTwice 其他订单预占的分仓库存不会再分配给支付的订单，释放后回补到原仓库
+func TestWarehouseAllocation_HeldStockNotAllocatedTwice(t *testing.T) {
+	ctx := context.Background()
+	f := newReservationFixture()
+	f.skuRepo = newReservationSkuRepo(map[int64]uint32{1: 18})
+	f.warehouseRepo = newAllocationFixture()
+	f.svc = service.NewStockReservationService(f.skuRepo, f.reservationRepo, f.ledger, f.warehouseRepo, service.AllocationStrategyPriority)
+
+	if _, err := f.svc.ReserveStock(ctx, 100, []*dto.ReserveStockItemDto{{SkuID: 1, Quantity: 4}}, sql.NullTime{}); err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	svc := service.NewProductDataService(nil, nil, f.skuRepo, f.ledger, nil, f.reservationRepo, nil, f.warehouseRepo, service.AllocationStrategyPriority)
+	result, err := svc.DeductInventory(ctx, &order.OnPaymentSuccess{OrderId: 200, OrderDetails: []*order.OrderDetail{{SkuId: 1, Quantity: 4}}})
+	if err != nil {
+		t.Fatalf("deduct failed: %v", err)
+	}
+	// 北京仓的4件已被订单100预占，订单200从上海仓分配
+	assertAllocations(t, result.Sku[0].Warehouses, []dto.WarehouseAllocationDto{{WarehouseID: 2, Quantity: 4, Stock: 0}})
+
+	if _, err := f.svc.ReleaseReservation(ctx, 100); err != nil {
+		t.Fatalf("release failed: %v", err)
+	}
+	if f.warehouseRepo.stockOf(1, 1) != 4 || f.warehouseRepo.stockOf(1, 2) != 0 || f.warehouseRepo.stockOf(1, 3) != 10 {
+		t.Fatalf("unexpected warehouse stock after release: %+v", f.warehouseRepo.stocks)
+	}
+	f.assertSku(t, 1, 14, 4)
+}
+
+// TestWarehouseAllocation_RefundUnassigned 未分仓或没有分仓记录的数量回补到未分仓库存
+func TestWarehouseAllocation_RefundUnassigned(t *testing.T) {
+	ctx := context.Background()
+	f, svc := newRestoreFixture(t)
+	f.warehouseRepo.allocations = nil
+
+	result, err := svc.DeductOrderInvetoryRevert(ctx, restoreRequest("refund-1", model.RestoreReasonRefund, 2))
+	if err != nil {
+		t.Fatalf("refund failed: %v", err)
+	}
+	assertAllocations(t, result.Sku[0].Warehouses, []dto.WarehouseAllocationDto{{WarehouseID: 0, Quantity: 2}})
+	f.assertSku(t, 1, 7, 3)
+}
+
+// TestWarehouseService_SetSkuWarehouseStock 登记分仓库存先从未分仓库存转入，不足的部分才计入总库存
+func TestWarehouseService_SetSkuWarehouseStock(t *testing.T) {
+	ctx := context.Background()
+	// 总库存15，北京仓6件、上海仓4件，未分仓5件
+	skuRepo := &adjustableSkuRepo{stock: map[int64]uint32{1: 15}}
+	stockChangeRepo := &matrixStockChangeRepo{}
+	warehouseRepo := newAdjustmentWarehouseRepo()
+	svc := service.NewWarehouseService(warehouseRepo, skuRepo, stockChangeRepo)
+
+	if _, err := svc.SetSkuWarehouseStock(ctx, &dto.SetSkuWarehouseStockDto{SkuID: 1, WarehouseID: 1, Stock: 9, OperatorID: 9}); err != nil {
+		t.Fatalf("set warehouse stock failed: %v", err)
+	}
+	if skuRepo.stock[1] != 15 || len(stockChangeRepo.records) != 0 {
+		t.Fatalf("registering unallocated stock should not change total, got %d %+v", skuRepo.stock[1], stockChangeRepo.records)
+	}
+
+	// 未分仓只剩2件，多出的2件计入总库存
+	if _, err := svc.SetSkuWarehouseStock(ctx, &dto.SetSkuWarehouseStockDto{SkuID: 1, WarehouseID: 2, Stock: 8, OperatorID: 9}); err != nil {
+		t.Fatalf("set warehouse stock failed: %v", err)
+	}
+	if skuRepo.stock[1] != 17 || len(stockChangeRepo.records) != 1 || stockChangeRepo.records[0].Quantity != 2 ||
+		stockChangeRepo.records[0].BeforeStock != 15 || stockChangeRepo.records[0].AfterStock != 17 {
+		t.Fatalf("unexpected total %d and records %+v", skuRepo.stock[1], stockChangeRepo.records)
+	}
+
+	// 减少的数量转回未分仓库存
+	if _, err := svc.SetSkuWarehouseStock(ctx, &dto.SetSkuWarehouseStockDto{SkuID: 1, WarehouseID: 1, Stock: 5, OperatorID: 9}); err != nil {
+		t.Fatalf("set warehouse stock failed: %v", err)
+	}
+	if skuRepo.stock[1] != 17 || len(stockChangeRepo.records) != 1 || warehouseRepo.stockOf(1, 1) != 5 {
+		t.Fatalf("decreasing warehouse stock should not change total, got %d %+v", skuRepo.stock[1], stockChangeRepo.records)
+	}
+}